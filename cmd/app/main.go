@@ -1,67 +1,169 @@
 package main
 
 import (
-	"log"
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
 	"os"
 	"os/signal"
+	"runtime"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/devgugga/todo-it/internal/auth"
+	"github.com/devgugga/todo-it/internal/calendar"
 	"github.com/devgugga/todo-it/internal/config"
 	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/errorreport"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/fieldcrypt"
+	"github.com/devgugga/todo-it/internal/handlers"
+	"github.com/devgugga/todo-it/internal/health"
+	"github.com/devgugga/todo-it/internal/importer"
+	"github.com/devgugga/todo-it/internal/integrations/github"
+	"github.com/devgugga/todo-it/internal/integrations/inboundmail"
+	"github.com/devgugga/todo-it/internal/integrations/stripe"
+	"github.com/devgugga/todo-it/internal/integrations/telegram"
+	"github.com/devgugga/todo-it/internal/integrations/twilio"
+	"github.com/devgugga/todo-it/internal/jobs"
+	"github.com/devgugga/todo-it/internal/leader"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/mailer"
+	"github.com/devgugga/todo-it/internal/metrics"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/notifications"
+	"github.com/devgugga/todo-it/internal/plans"
+	"github.com/devgugga/todo-it/internal/quota"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/scheduler"
+	"github.com/devgugga/todo-it/internal/server"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/devgugga/todo-it/internal/web"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
-	"github.com/gofiber/fiber/v2/middleware/logger"
-	"github.com/gofiber/fiber/v2/middleware/recover"
 )
 
+// startedAt registra o início do processo (usado no uptime de /status)
+var startedAt = time.Now()
+
 func main() {
-	log.Println("🚀 Iniciando Todo API...")
+	configPath := flag.String("config", "", "arquivo de configuração YAML ou TOML (variáveis de ambiente têm prioridade)")
+	flag.Parse()
 
 	// Carrega configurações
-	cfg := config.LoadConfig()
+	cfg, err := config.LoadConfig(*configPath)
+	if err != nil {
+		fatal("falha ao carregar configuração", err)
+	}
+
+	// Subcomandos (ex.: "config print") executam e encerram sem subir o servidor
+	if args := flag.Args(); len(args) > 0 {
+		runCommand(cfg, args)
+		return
+	}
+
+	// Logs estruturados (nível e formato configuráveis)
+	logging.Setup(cfg.LogLevel, cfg.LogFormat)
+	slog.Info("iniciando Todo API")
 
 	// Configura MongoDB
 	mongoConfig := &database.MongoConfig{
-		URI:            cfg.MongoURI,
-		DBName:         cfg.MongoDBName,
-		MaxPoolSize:    20,
-		ConnectTimeout: 10 * time.Second,
-		PingTimeout:    5 * time.Second,
+		URI:                    cfg.MongoURI,
+		DBName:                 cfg.MongoDBName,
+		MaxPoolSize:            uint64(cfg.MongoMaxPoolSize),
+		MinPoolSize:            uint64(cfg.MongoMinPoolSize),
+		MaxConnIdleTime:        cfg.MongoMaxConnIdleTime,
+		ConnectTimeout:         cfg.MongoConnectTimeout,
+		PingTimeout:            cfg.MongoPingTimeout,
+		ServerSelectionTimeout: cfg.MongoServerSelectionTimeout,
+		Timeouts: database.OperationTimeouts{
+			Read:      cfg.MongoReadTimeout,
+			Write:     cfg.MongoWriteTimeout,
+			Aggregate: cfg.MongoAggregateTimeout,
+		},
+		FieldCipher: setupFieldCipher(cfg),
 	}
 
 	// Inicializa o banco de dados (cria collections, índices, etc.)
 	db, err := database.InitializeDatabase(mongoConfig)
 	if err != nil {
-		log.Fatalf("❌ Falha ao inicializar banco de dados: %v", err)
+		fatal("falha ao inicializar banco de dados", err)
 	}
-	defer func() {
-		log.Println("🔌 Fechando conexão com banco de dados...")
-		if err := db.Close(); err != nil {
-			log.Printf("❌ Erro ao fechar conexão: %v", err)
-		}
-	}()
+	setupAtlasSearch(db, cfg)
+
+	// Barramento de eventos de domínio (Redis quando configurado)
+	bus := setupEventBus(cfg)
+
+	// Jobs em segundo plano (encerrados junto com a aplicação)
+	mail := setupMailer(cfg)
+	sms := setupTwilio(cfg)
+	// Planos de uso: as cotas seguem o plano efetivo de cada usuário
+	catalog := plans.NewCatalog(
+		quota.Limits{quota.ResourceSMS: int64(cfg.SMSMonthlyLimit)},
+		quota.Limits{quota.ResourceSMS: int64(cfg.ProSMSMonthlyLimit)},
+	)
+	planService := services.NewPlanService(catalog, repositories.NewSubscriptionRepository(db), repositories.NewUserRepository(db))
+	quotas := quota.New(repositories.NewUsageRepository(db), planService)
+	notifier := setupNotifier(db, cfg, bus, mail, sms, quotas)
+
+	// Feed de mudanças consultado por integrações sem webhook (GET /todos/changes)
+	services.NewTaskChangeService(repositories.NewTaskChangeRepository(db), repositories.NewTodoRepository(db)).Subscribe(bus)
+
+	ingester := setupInboundEmail(db, cfg, bus)
+
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	sched := setupJobs(jobsCtx, db, cfg, bus, notifier, ingester)
+
+	// Contadores da instância (erros HTTP, entregas) somados aos das demais no banco
+	metrics.StartFlusher(jobsCtx, repositories.NewPlatformMetricsRepository(db), time.Minute)
+
+	// Importações de outras ferramentas rodam em segundo plano, canceladas junto com os jobs
+	imports := importer.NewRunner(jobsCtx, repositories.NewImportJobRepository(db))
 
 	// Configura Fiber
 	app := fiber.New(fiber.Config{
 		AppName:      "Todo API v1.0",
-		ReadTimeout:  15 * time.Second,
-		WriteTimeout: 15 * time.Second,
-		BodyLimit:    2 * 1024 * 1024, // 2MB
-		ErrorHandler: globalErrorHandler,
+		ReadTimeout:  cfg.ServerReadTimeout,
+		WriteTimeout: cfg.ServerWriteTimeout,
+		IdleTimeout:  cfg.ServerIdleTimeout,
+		BodyLimit:    cfg.MaxBodyLimit(),
+		ErrorHandler: handlers.ErrorHandler,
+		// Métodos WebDAV usados pelo CalDAV
+		RequestMethods: append(append([]string{}, fiber.DefaultMethods...), handlers.CalDAVMethods...),
 	})
 
-	// Middlewares globais
-	setupMiddlewares(app)
+	// Middlewares globais (CORS, log de corpos, limites de paginação e de
+	// requisições são recarregáveis)
+	rateLimiter := middleware.NewRateLimiter(cfg.RateLimitEnabled, rateLimitClasses(cfg))
+	applyHotConfig := setupMiddlewares(app, cfg, rateLimiter)
+
+	// Recarga de configuração via SIGHUP ou rota administrativa
+	reloader := config.NewReloader(*configPath, cfg, applyHotConfig)
+	watchReloadSignal(reloader)
 
 	// Health check com estatísticas do banco
 	app.Get("/health", createHealthCheckHandler(db))
 
 	// Status do banco (endpoint para monitoramento)
-	app.Get("/status", createStatusHandler(db))
+	app.Get("/status", createStatusHandler(db, sched))
+
+	// Prontidão por dependência (503 só quando uma obrigatória falha)
+	app.Get("/readyz", createReadinessHandler(setupHealthMonitor(cfg, db, bus, mail, sched)))
+
+	// Indicadores de negócio para o Prometheus (protegido por METRICS_TOKEN)
+	metricsHandler := handlers.NewMetricsHandler(services.NewPlatformMetricsService(repositories.NewPlatformMetricsRepository(db)))
+	app.Get("/metrics", middleware.RequireMetricsToken(cfg.MetricsToken), metricsHandler.Export)
+
+	// Descoberta do CalDAV (RFC 6764): clientes que recebem só o domínio
+	app.All("/.well-known/caldav", func(c *fiber.Ctx) error {
+		return c.Redirect(caldavBasePath+"/", fiber.StatusMovedPermanently)
+	})
 
-	// Rotas da API
-	api := app.Group("/api/v1")
+	// Rotas da API (o contexto de cada requisição recebe um deadline)
+	api := app.Group("/api/v1", middleware.Timeout(cfg.RequestTimeout))
 
 	// Middleware para injetar database nas rotas
 	api.Use(func(c *fiber.Ctx) error {
@@ -70,59 +172,131 @@ func main() {
 	})
 
 	// Registra todas as rotas
-	setupRoutes(api, db)
+	phones := services.NewPhoneService(repositories.NewUserRepository(db), sms, quotas)
+	setupRoutes(api, db, cfg, rateLimiter, bus, reloader, ingester, imports, phones, planService, quotas)
+
+	// Frontend em / (depois das rotas, para não encobri-las): o build em
+	// STATIC_DIR ou a interface embutida
+	switch {
+	case cfg.StaticDir != "":
+		app.Use(web.Files(os.DirFS(cfg.StaticDir), web.FilesConfig{
+			SPAFallback:    cfg.StaticSPAFallback,
+			ImmutablePaths: cfg.StaticImmutablePaths,
+		}))
+	case cfg.WebUIEnabled:
+		app.Use(web.Handler())
+	}
+
+	// Inicia o servidor e aguarda um sinal de encerramento (ou falha do servidor)
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- startServer(app, cfg)
+	}()
+
+	exitCode := waitForShutdown(serverErr)
 
-	// Graceful shutdown
-	setupGracefulShutdown(app, db)
+	// Encerramento ordenado: requisições em andamento, jobs, eventos pendentes e, por último, o banco
+	shutdown(app, cfg, stopJobs, sched, imports, bus, db)
 
-	// Inicia o servidor
-	startServer(app, cfg.Port)
+	os.Exit(exitCode)
 }
 
-// setupMiddlewares configura todos os middlewares
-func setupMiddlewares(app *fiber.App) {
-	// Logger
-	app.Use(logger.New(logger.Config{
-		Format:     "[${time}] ${status} - ${method} ${path} - ${latency} | IP: ${ip}\n",
-		TimeFormat: "2006-01-02 15:04:05",
-	}))
+// setupMiddlewares configura todos os middlewares e retorna a função que
+// aplica as configurações recarregáveis (config.HotReloadKeys)
+func setupMiddlewares(app *fiber.App, cfg *config.Config, rateLimiter *middleware.RateLimiter) func(*config.Config) {
+	// Request ID + log estruturado de acesso
+	app.Use(middleware.RequestLogger())
 
-	// Recover from panics
-	app.Use(recover.New(recover.Config{
-		EnableStackTrace: true,
-	}))
+	// Corpos amostrados para diagnóstico de integrações (requer LOG_LEVEL=debug)
+	bodyLogger := middleware.NewReloadable(newBodyLogger(cfg))
+	app.Use(bodyLogger.Handler())
+
+	// Panics viram 500 com o ID da requisição e são enviados ao serviço de erros
+	var reporter middleware.PanicReporter
+	if cfg.ErrorReportURL != "" {
+		reporter = errorreport.NewWebhookReporter(cfg.ErrorReportURL)
+	}
+	app.Use(middleware.Recover(reporter))
 
 	// CORS
-	app.Use(cors.New(cors.Config{
-		AllowOrigins:     "*", // Em produção, configure domínios específicos
-		AllowMethods:     "GET,POST,HEAD,PUT,DELETE,PATCH,OPTIONS",
-		AllowHeaders:     "Origin,Content-Type,Accept,Authorization",
-		AllowCredentials: false,
-	}))
+	corsHandler := middleware.NewReloadable(newCORS(cfg))
+	app.Use(corsHandler.Handler())
+
+	handlers.SetMaxPageLimit(cfg.PaginationMaxLimit)
+	handlers.SetMaxBulkIDs(cfg.BulkMaxIDs)
+	handlers.SetExportTimeout(cfg.ExportRequestTimeout)
+	handlers.SetRateLimiter(rateLimiter)
+	setBusinessCalendar(cfg)
+
+	return func(next *config.Config) {
+		logging.SetLevel(next.LogLevel)
+		handlers.SetMaxPageLimit(next.PaginationMaxLimit)
+		handlers.SetMaxBulkIDs(next.BulkMaxIDs)
+		setBusinessCalendar(next)
+		bodyLogger.Swap(newBodyLogger(next))
+		corsHandler.Swap(newCORS(next))
+		rateLimiter.Configure(next.RateLimitEnabled, rateLimitClasses(next))
+	}
 }
 
-// globalErrorHandler trata erros globais da aplicação
-func globalErrorHandler(c *fiber.Ctx, err error) error {
-	code := fiber.StatusInternalServerError
-	message := "Erro interno do servidor"
+// setBusinessCalendar aplica os dias úteis configurados (já validados no carregamento)
+func setBusinessCalendar(cfg *config.Config) {
+	cal, err := cfg.BusinessCalendar()
+	if err != nil {
+		slog.Error("calendário de dias úteis inválido, mantendo o atual", "error", err)
+		return
+	}
+	calendar.SetDefault(cal)
+}
 
-	if e, ok := err.(*fiber.Error); ok {
-		code = e.Code
-		message = e.Message
+// rateLimitClasses monta as classes do limite de requisições conforme a configuração
+func rateLimitClasses(cfg *config.Config) map[string]middleware.RateLimitClass {
+	return map[string]middleware.RateLimitClass{
+		middleware.RateLimitAuth:  {PerMinute: cfg.RateLimitAuthPerMinute, Burst: cfg.RateLimitAuthBurst},
+		middleware.RateLimitRead:  {PerMinute: cfg.RateLimitReadPerMinute, Burst: cfg.RateLimitReadBurst},
+		middleware.RateLimitWrite: {PerMinute: cfg.RateLimitWritePerMinute, Burst: cfg.RateLimitWriteBurst},
+		middleware.RateLimitBulk:  {PerMinute: cfg.RateLimitBulkPerMinute, Burst: cfg.RateLimitBulkBurst},
 	}
+}
 
-	log.Printf("❌ Erro na API: %v | Path: %s | Method: %s", err, c.Path(), c.Method())
+// newBodyLogger cria o log de corpos conforme a configuração (ou um passthrough se desabilitado)
+func newBodyLogger(cfg *config.Config) fiber.Handler {
+	if !cfg.DebugBodyLogging {
+		return middleware.Passthrough
+	}
+
+	return middleware.BodyLogger(middleware.BodyLoggerConfig{
+		SampleRate: cfg.DebugBodySampleRate,
+		MaxBytes:   cfg.DebugBodyMaxBytes,
+	})
+}
 
-	return c.Status(code).JSON(fiber.Map{
-		"success":   false,
-		"error":     true,
-		"message":   message,
-		"code":      code,
-		"timestamp": time.Now().Unix(),
-		"path":      c.Path(),
+// newCORS cria o middleware de CORS conforme a configuração
+func newCORS(cfg *config.Config) fiber.Handler {
+	return cors.New(cors.Config{
+		AllowOrigins:     strings.Join(cfg.CORSAllowOrigins, ","),
+		AllowMethods:     strings.Join(cfg.CORSAllowMethods, ","),
+		AllowHeaders:     strings.Join(cfg.CORSAllowHeaders, ","),
+		AllowCredentials: cfg.CORSAllowCredentials,
+		ExposeHeaders:    strings.Join(middleware.RateLimitHeaders, ","),
 	})
 }
 
+// watchReloadSignal recarrega a configuração a cada SIGHUP
+func watchReloadSignal(reloader *config.Reloader) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+
+	go func() {
+		for range hup {
+			slog.Info("SIGHUP recebido, recarregando configuração")
+			if _, err := reloader.Reload(); err != nil {
+				slog.Error("configuração mantida", "error", err)
+			}
+		}
+	}()
+}
+
 // createHealthCheckHandler cria handler para health check
 func createHealthCheckHandler(db database.Client) fiber.Handler {
 	return func(c *fiber.Ctx) error {
@@ -149,29 +323,146 @@ func createHealthCheckHandler(db database.Client) fiber.Handler {
 	}
 }
 
+// createReadinessHandler cria handler de prontidão para o balanceador
+func createReadinessHandler(monitor *health.Monitor) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		report := monitor.Check(c.UserContext())
+
+		status := fiber.StatusOK
+		if !report.Ready {
+			status = fiber.StatusServiceUnavailable
+		}
+
+		return c.Status(status).JSON(report)
+	}
+}
+
+// setupHealthMonitor registra a verificação de cada dependência configurada;
+// as de READINESS_REQUIRED tornam a instância não pronta quando falham
+func setupHealthMonitor(cfg *config.Config, db database.Client, bus events.Bus, mail mailer.Mailer, sched *scheduler.Scheduler) *health.Monitor {
+	required := make(map[string]bool, len(cfg.ReadinessRequired))
+	for _, name := range cfg.ReadinessRequired {
+		required[name] = true
+	}
+
+	monitor := health.NewMonitor()
+	monitor.Register(health.Check{
+		Name:     health.DependencyMongo,
+		Required: required[health.DependencyMongo],
+		Probe:    func(ctx context.Context) error { return db.Health() },
+	})
+
+	// Anexos ficam no bucket GridFS do próprio MongoDB
+	attachments := repositories.NewAttachmentRepository(db)
+	monitor.Register(health.Check{
+		Name:     health.DependencyStorage,
+		Required: required[health.DependencyStorage],
+		Probe:    attachments.Ping,
+	})
+
+	if redisBus, ok := bus.(*events.RedisBus); ok {
+		monitor.Register(health.Check{
+			Name:     health.DependencyRedis,
+			Required: required[health.DependencyRedis],
+			Probe:    redisBus.Ping,
+		})
+	}
+
+	// O SMTP é verificado no máximo uma vez por minuto (conexão e autenticação)
+	if checker, ok := mail.(mailer.Checker); ok {
+		monitor.Register(health.Check{
+			Name:        health.DependencySMTP,
+			Required:    required[health.DependencySMTP],
+			Timeout:     10 * time.Second,
+			MinInterval: time.Minute,
+			Probe:       checker.Check,
+		})
+	}
+
+	monitor.Register(health.Check{
+		Name:     health.DependencyJobs,
+		Required: required[health.DependencyJobs],
+		Probe: func(ctx context.Context) error {
+			jobs, err := sched.Status(ctx)
+			if err != nil {
+				return err
+			}
+
+			var failing []string
+			for _, job := range jobs {
+				if !job.Healthy {
+					failing = append(failing, job.Name)
+				}
+			}
+			if len(failing) > 0 {
+				return fmt.Errorf("jobs com falha ou atrasados: %s", strings.Join(failing, ", "))
+			}
+			return nil
+		},
+	})
+
+	return monitor
+}
+
 // createStatusHandler cria handler para status detalhado
-func createStatusHandler(db database.Client) fiber.Handler {
+func createStatusHandler(db database.Client, sched *scheduler.Scheduler) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		// Pega estatísticas do banco
-		stats, err := database.DatabaseStats(db)
+		stats, err := db.Stats(c.UserContext())
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-				"error": "Erro ao obter estatísticas do banco",
+			// Sem banco a topologia vista pelo driver explica a falha
+			code := fiber.StatusInternalServerError
+			if database.IsUnavailable(err) {
+				code = fiber.StatusServiceUnavailable
+			}
+			return c.Status(code).JSON(fiber.Map{
+				"error":          "Erro ao obter estatísticas do banco",
+				"mongo_topology": db.Topology(),
 			})
 		}
 
+		jobs, err := sched.Status(c.UserContext())
+		if err != nil {
+			logging.FromContext(c.UserContext()).Warn("erro ao obter status dos jobs", "error", err)
+		}
+
 		return c.JSON(fiber.Map{
-			"status":      "running",
-			"timestamp":   time.Now().Unix(),
-			"database":    stats,
-			"environment": os.Getenv("ENV"),
-			"uptime":      time.Now().Unix(),
+			"status":         "running",
+			"timestamp":      time.Now().Unix(),
+			"database":       stats,
+			"mongo_topology": db.Topology(),
+			"jobs":           jobs,
+			"leader":         sched.IsLeader(),
+			"runtime":        runtimeStats(),
+			"environment":    os.Getenv("ENV"),
+			"started_at":     startedAt,
+			"uptime_seconds": int64(time.Since(startedAt).Seconds()),
 		})
 	}
 }
 
+// runtimeStats retorna métricas do runtime Go do processo
+func runtimeStats() fiber.Map {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	return fiber.Map{
+		"go_version":     runtime.Version(),
+		"goroutines":     runtime.NumGoroutine(),
+		"gomaxprocs":     runtime.GOMAXPROCS(0),
+		"heap_alloc":     mem.HeapAlloc,
+		"heap_objects":   mem.HeapObjects,
+		"sys":            mem.Sys,
+		"num_gc":         mem.NumGC,
+		"gc_pause_total": time.Duration(mem.PauseTotalNs).String(),
+	}
+}
+
+// caldavBasePath é o caminho público do CalDAV, usado nos hrefs das respostas
+const caldavBasePath = "/api/v1/caldav"
+
 // setupRoutes configura todas as rotas da aplicação
-func setupRoutes(api fiber.Router, db database.Client) {
+func setupRoutes(api fiber.Router, db database.Client, cfg *config.Config, rateLimiter *middleware.RateLimiter, bus events.Bus, reloader *config.Reloader, ingester *inboundmail.Ingester, imports *importer.Runner, phones services.PhoneService, planService services.PlanService, quotas quota.Quota) {
 	// Rota de teste
 	api.Get("/ping", func(c *fiber.Ctx) error {
 		return c.JSON(fiber.Map{
@@ -181,41 +472,493 @@ func setupRoutes(api fiber.Router, db database.Client) {
 		})
 	})
 
-	// Registrar rotas:
-	// auth := api.Group("/auth")
-	// users := api.Group("/users")
-	// todos := api.Group("/todos")
+	// Modo de manutenção: alterável pelas rotas administrativas, que nunca são bloqueadas
+	mode, _ := middleware.ParseMaintenanceMode(cfg.MaintenanceMode)
+	maintenanceState := middleware.NewMaintenanceState(mode, cfg.MaintenanceRetryAfter)
+	if mode != middleware.MaintenanceOff {
+		slog.Warn("API iniciada em modo de manutenção", "mode", mode)
+	}
+	maintenance := middleware.Maintenance(maintenanceState)
+
+	// Limites de corpo por grupo: JSON da API, padrão (sincronização em lote,
+	// CalDAV e webhooks) e rotas que recebem arquivos
+	jsonBody := middleware.BodyLimit(cfg.JSONBodyLimit)
+	defaultBody := middleware.BodyLimit(cfg.BodyLimit)
+	uploadBody := middleware.BodyLimit(cfg.UploadBodyLimit)
+
+	// Rotas administrativas
+	admin := api.Group("/admin", jsonBody, middleware.RequireAdminToken(cfg.AdminToken))
+	handlers.SetupAdminRoutes(admin, db, newRetentionService(db, cfg), planService, maintenanceState, reloader)
+
+	// Rotas de autenticação e recursos do usuário
+	tokens := auth.NewTokenManager(cfg.JWTSecret, cfg.JWTExpiration)
+	// Contas suspensas e tokens de sessões encerradas são recusados a cada requisição
+	requireAuth := middleware.RequireAuth(tokens, services.NewSuspensionService(repositories.NewUserRepository(db)))
+	// Plano efetivo do usuário anexado à requisição, consultado pelas cotas
+	attachPlan := middleware.AttachPlan(planService)
+	// Limite de requisições por classe: leituras e escritas das rotas
+	// autenticadas (as rotas de lote aplicam também a classe de lote)
+	limited := rateLimiter.ByMethod()
+	// Links de download de exportações e anexos, abertos sem o token de acesso
+	signer := auth.NewURLSigner(cfg.JWTSecret, cfg.SignedURLTTL)
+
+	handlers.SetupAuthRoutes(api.Group("/auth", maintenance, rateLimiter.Limit(middleware.RateLimitAuth), jsonBody), db, tokens, bus)
+	handlers.SetupUserRoutes(api.Group("/users", maintenance, requireAuth, attachPlan, limited, jsonBody), db, tokens, bus, phones, cfg.AccountClosureMode)
+	handlers.SetupTodoRoutes(api.Group("/todos", maintenance, requireAuth, attachPlan, limited, jsonBody), db, bus, signer)
+	handlers.SetupFileRoutes(api.Group("/files", maintenance, limited, defaultBody), db, bus, signer)
+	handlers.SetupProjectRoutes(api.Group("/projects", maintenance, requireAuth, attachPlan, limited, jsonBody), db, bus)
+	handlers.SetupSyncRoutes(api.Group("/sync", maintenance, requireAuth, attachPlan, limited, defaultBody), db, bus)
+	handlers.SetupFocusRoutes(api.Group("/focus", maintenance, requireAuth, attachPlan, limited, jsonBody), db, bus)
+	handlers.SetupViewSettingsRoutes(api.Group("/view-settings", maintenance, requireAuth, attachPlan, limited, jsonBody), db)
+	handlers.SetupLabelRoutes(api.Group("/labels", maintenance, requireAuth, attachPlan, limited, jsonBody), db)
+	handlers.SetupOrganizationRoutes(api.Group("/organizations", maintenance, requireAuth, attachPlan, limited, jsonBody), db)
+	webhookDeliveries := repositories.NewWebhookDeliveryRepository(db)
+	webhookDeliveryService := services.NewWebhookDeliveryService(webhookDeliveries, repositories.NewUserRepository(db), notifications.NewWebhookChannel(webhookDeliveries))
+	handlers.SetupNotificationRoutes(api.Group("/notifications", maintenance, requireAuth, attachPlan, limited, jsonBody), db, webhookDeliveryService)
+	handlers.SetupPlanRoutes(api.Group("/plans", maintenance, requireAuth, attachPlan, limited, jsonBody), planService, quotas)
+
+	// Assinatura do plano pro via Stripe (checkout e webhook de assinaturas)
+	if cfg.StripeSecretKey != "" {
+		stripeProvider := stripe.NewProvider(stripe.Config{
+			SecretKey:     cfg.StripeSecretKey,
+			WebhookSecret: cfg.StripeWebhookSecret,
+			ProPriceID:    cfg.StripeProPriceID,
+			SuccessURL:    cfg.StripeSuccessURL,
+			CancelURL:     cfg.StripeCancelURL,
+		})
+		handlers.SetupBillingRoutes(api.Group("/billing", maintenance, defaultBody), planService, stripeProvider, requireAuth, attachPlan)
+	}
+
+	// Eventos das tarefas em tempo real (SSE), incluindo os de outras instâncias
+	handlers.SetupStreamRoutes(api.Group("/events", maintenance, requireAuth, attachPlan, limited, jsonBody), bus, cfg.ServerWriteTimeout)
+
+	// Feed Atom de atividades (autenticado pelo token na URL, para leitores de feed)
+	handlers.SetupFeedRoutes(api.Group("/feeds", maintenance, jsonBody), db)
+
+	// Importação de outras ferramentas
+	projects := repositories.NewProjectRepository(db)
+	todos := repositories.NewTodoRepository(db)
+	todoist := importer.NewTodoistImporter(repositories.NewUserRepository(db), projects, todos)
+	trello := importer.NewTrelloImporter(projects, todos)
+	msTodo := importer.NewMicrosoftTodoImporter(repositories.NewUserRepository(db), projects, todos)
+	handlers.SetupImportRoutes(api.Group("/import", maintenance, requireAuth, attachPlan, limited, uploadBody), imports, todoist, trello, msTodo)
+
+	// Sincronização de tarefas com clientes CalDAV (autenticação por senha de aplicativo)
+	handlers.SetupCalDAVRoutes(api.Group("/caldav", maintenance, defaultBody), db, bus, caldavBasePath)
+
+	// Integrações
+	if bot := setupTelegramBot(db, cfg, bus); bot != nil {
+		handlers.SetupTelegramRoutes(api.Group("/integrations/telegram", maintenance, defaultBody), bot, cfg.TelegramWebhookSecret, requireAuth)
+	}
+	if syncer := setupGitHubSync(db, cfg, bus); syncer != nil {
+		handlers.SetupGitHubRoutes(api.Group("/integrations/github", maintenance, defaultBody), syncer, requireAuth)
+	}
+	if ingester != nil {
+		handlers.SetupInboundEmailRoutes(api.Group("/integrations/email", maintenance, uploadBody), ingester, cfg.InboundEmailWebhookToken, requireAuth)
+	}
+}
+
+// setupFieldCipher cria a cifra dos campos sensíveis das tarefas (em claro se
+// FIELD_ENCRYPTION_KEY não estiver definida). As chaves já foram validadas em LoadConfig.
+func setupFieldCipher(cfg *config.Config) fieldcrypt.Cipher {
+	if cfg.FieldEncryptionKey == "" {
+		return fieldcrypt.Disabled()
+	}
+
+	key, err := fieldcrypt.ParseKey(cfg.FieldEncryptionKey)
+	if err != nil {
+		fatal("chave de criptografia inválida", err)
+	}
+
+	previous := make([][]byte, 0, len(cfg.FieldEncryptionPreviousKeys))
+	for _, encoded := range cfg.FieldEncryptionPreviousKeys {
+		k, err := fieldcrypt.ParseKey(encoded)
+		if err != nil {
+			fatal("chave de criptografia inválida", err)
+		}
+		previous = append(previous, k)
+	}
+
+	cipher, err := fieldcrypt.NewAESGCM(key, previous...)
+	if err != nil {
+		fatal("falha ao iniciar criptografia de campos", err)
+	}
 
-	// E chamar os handlers:
-	// handlers.SetupAuthRoutes(auth, db)
-	// handlers.SetupUserRoutes(users, db)
-	// handlers.SetupTodoRoutes(todos, db)
+	slog.Info("criptografia de campos ativa", "previous_keys", len(previous))
+	return cipher
 }
 
-// setupGracefulShutdown configura shutdown gracioso
-func setupGracefulShutdown(app *fiber.App, db database.Client) {
+// newRetentionService cria o serviço da política de retenção de dados
+func newRetentionService(db database.Client, cfg *config.Config) services.RetentionService {
+	policy := services.RetentionPolicy{
+		entities.RetentionCompletedTasks: cfg.RetentionCompletedTasksDays,
+		entities.RetentionAuditLogs:      cfg.AuditRetentionDays,
+		entities.RetentionNotifications:  cfg.RetentionNotificationsDays,
+	}
+
+	return services.NewRetentionService(
+		repositories.NewRetentionRepository(db),
+		repositories.NewUserRepository(db),
+		repositories.NewAttachmentRepository(db),
+		policy,
+	)
+}
+
+// setupAtlasSearch habilita a busca de tarefas pelo Atlas Search quando
+// ATLAS_SEARCH_INDEX estiver definido e o índice existir; senão a busca usa o
+// índice de texto
+func setupAtlasSearch(db database.Client, cfg *config.Config) {
+	if cfg.AtlasSearchIndex == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	enabled, err := repositories.DetectAtlasSearch(ctx, db, cfg.AtlasSearchIndex)
+	switch {
+	case err != nil:
+		slog.Warn("Atlas Search indisponível, busca usará o índice de texto", "index", cfg.AtlasSearchIndex, "error", err)
+	case !enabled:
+		slog.Warn("índice do Atlas Search não encontrado ou não pronto, busca usará o índice de texto", "index", cfg.AtlasSearchIndex)
+	default:
+		slog.Info("busca de tarefas via Atlas Search", "index", cfg.AtlasSearchIndex)
+	}
+}
+
+// setupEventBus cria o barramento de eventos, distribuído via Redis se REDIS_URL estiver definido
+func setupEventBus(cfg *config.Config) events.Bus {
+	if cfg.RedisURL == "" {
+		return events.NewLocalBus()
+	}
+
+	bus, err := events.NewRedisBus(cfg.RedisURL)
+	if err != nil {
+		fatal("falha ao iniciar barramento de eventos", err)
+	}
+
+	slog.Info("barramento de eventos distribuído via Redis")
+	return bus
+}
+
+// setupMailer cria o serviço de envio de emails
+func setupMailer(cfg *config.Config) mailer.Mailer {
+	return mailer.New(mailer.Config{
+		Host:     cfg.SMTPHost,
+		Port:     cfg.SMTPPort,
+		Username: cfg.SMTPUsername,
+		Password: cfg.SMTPPassword,
+		From:     cfg.MailFrom,
+		FromName: cfg.MailFromName,
+		TLS:      mailer.TLSMode(cfg.SMTPTLS),
+		DryRun:   cfg.MailDryRun,
+	})
+}
+
+// setupTwilio cria o cliente de SMS (nil se TWILIO_ACCOUNT_SID não estiver definido)
+func setupTwilio(cfg *config.Config) twilio.Client {
+	if cfg.TwilioAccountSID == "" {
+		return nil
+	}
+
+	return twilio.NewClient(cfg.TwilioAccountSID, cfg.TwilioAuthToken, cfg.TwilioFromNumber, "")
+}
+
+// setupNotifier cria o serviço de notificações e o inscreve no barramento de eventos.
+// O canal sms só é registrado com a Twilio configurada.
+func setupNotifier(db database.Client, cfg *config.Config, bus events.Bus, mail mailer.Mailer, sms twilio.Client, quotas quota.Quota) *notifications.Notifier {
+	notificationRepo := repositories.NewNotificationRepository(db)
+
+	channels := []notifications.Channel{
+		notifications.NewInboxChannel(notificationRepo),
+		notifications.NewEmailChannel(mail),
+		notifications.NewPushChannel(cfg.PushGatewayURL),
+		notifications.NewWebhookChannel(repositories.NewWebhookDeliveryRepository(db)),
+	}
+	if sms != nil {
+		channels = append(channels, notifications.NewSMSChannel(sms, quotas))
+	}
+
+	notifier := notifications.NewNotifier(notificationRepo, repositories.NewUserRepository(db), channels...)
+	notifier.Subscribe(bus)
+
+	return notifier
+}
+
+// setupTelegramBot cria o bot do Telegram (nil se TELEGRAM_BOT_TOKEN não estiver
+// definido) e registra o webhook quando TELEGRAM_WEBHOOK_URL é informado
+func setupTelegramBot(db database.Client, cfg *config.Config, bus events.Bus) *telegram.Bot {
+	if cfg.TelegramBotToken == "" {
+		return nil
+	}
+
+	client := telegram.NewClient(cfg.TelegramBotToken, "")
+	tasks := services.NewTaskService(repositories.NewTodoRepository(db), repositories.NewUserRepository(db), repositories.NewProjectRepository(db), repositories.NewTaskArchiveRepository(db), repositories.NewAttachmentRepository(db), repositories.NewLabelRepository(db), bus)
+	bot := telegram.NewBot(client, cfg.TelegramBotUsername, repositories.NewTelegramLinkRepository(db), repositories.NewUserRepository(db), tasks)
+
+	if cfg.TelegramWebhookURL != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		// Falha aqui não impede a subida: o webhook pode já estar registrado
+		if err := client.SetWebhook(ctx, cfg.TelegramWebhookURL, cfg.TelegramWebhookSecret); err != nil {
+			slog.Warn("erro ao registrar webhook do Telegram", "error", err)
+		} else {
+			slog.Info("webhook do Telegram registrado")
+		}
+	}
+
+	return bot
+}
+
+// setupGitHubSync cria a sincronização de issues do GitHub (nil se
+// GITHUB_CLIENT_ID não estiver definido) e a inscreve na conclusão de tarefas
+func setupGitHubSync(db database.Client, cfg *config.Config, bus events.Bus) *github.Syncer {
+	if cfg.GitHubClientID == "" {
+		return nil
+	}
+
+	client := github.NewClient(cfg.GitHubClientID, cfg.GitHubClientSecret, cfg.GitHubRedirectURL)
+	syncer := github.NewSyncer(client, repositories.NewGitHubAccountRepository(db), repositories.NewTodoRepository(db), cfg.GitHubWebhookURL, cfg.GitHubWebhookSecret)
+	syncer.Subscribe(bus)
+
+	return syncer
+}
+
+// setupInboundEmail cria o conversor de emails em tarefas (nil se
+// INBOUND_EMAIL_ADDRESS não estiver definido)
+func setupInboundEmail(db database.Client, cfg *config.Config, bus events.Bus) *inboundmail.Ingester {
+	if cfg.InboundEmailAddress == "" {
+		return nil
+	}
+
+	todos := repositories.NewTodoRepository(db)
+	attachments := repositories.NewAttachmentRepository(db)
+	tasks := services.NewTaskService(todos, repositories.NewUserRepository(db), repositories.NewProjectRepository(db), repositories.NewTaskArchiveRepository(db), attachments, repositories.NewLabelRepository(db), bus)
+
+	ingester, err := inboundmail.NewIngester(
+		inboundmail.Config{
+			Address:            cfg.InboundEmailAddress,
+			MaxAttachmentBytes: int64(cfg.InboundEmailMaxAttachmentBytes),
+		},
+		repositories.NewUserRepository(db),
+		todos,
+		attachments,
+		repositories.NewInboundEmailRepository(db),
+		tasks,
+	)
+	if err != nil {
+		fatal("falha ao configurar email de entrada", err)
+	}
+
+	return ingester
+}
+
+// setupJobs registra e inicia os jobs periódicos habilitados
+func setupJobs(ctx context.Context, db database.Client, cfg *config.Config, bus events.Bus, notifier *notifications.Notifier, ingester *inboundmail.Ingester) *scheduler.Scheduler {
+	sched := scheduler.New(db)
+	sched.RequireLeader(leader.NewElector(db, "scheduler", cfg.LeaderLeaseTTL))
+
+	sched.Register(scheduler.Job{
+		Name:     "notifications-dispatch",
+		Interval: cfg.NotificationsInterval,
+		Run:      notifier.Dispatch,
+	})
+
+	dueSoon := jobs.NewDueSoonJob(repositories.NewTodoRepository(db), bus, cfg.DueSoonWindow)
+	sched.Register(scheduler.Job{
+		Name:     "due-soon",
+		Interval: cfg.DueSoonInterval,
+		Run:      dueSoon.Run,
+	})
+
+	overdueSummary := jobs.NewOverdueSummaryJob(repositories.NewUserRepository(db), repositories.NewTodoRepository(db), bus, cfg.OverdueSummaryHour)
+	sched.Register(scheduler.Job{
+		Name:     "overdue-summary",
+		Interval: cfg.OverdueSummaryInterval,
+		Run:      overdueSummary.Run,
+	})
+
+	if ingester != nil && cfg.IMAPAddr != "" {
+		poller := inboundmail.NewIMAPPoller(inboundmail.IMAPConfig{
+			Addr:     cfg.IMAPAddr,
+			Username: cfg.IMAPUsername,
+			Password: cfg.IMAPPassword,
+			Mailbox:  cfg.IMAPMailbox,
+			TLS:      cfg.IMAPTLS,
+		}, ingester)
+		sched.Register(scheduler.Job{
+			Name:     "email-ingestion",
+			Interval: cfg.IMAPPollInterval,
+			Run:      poller.Poll,
+		})
+	}
+
+	retention := newRetentionService(db, cfg)
+	sched.Register(scheduler.Job{
+		Name:     "retention",
+		Interval: cfg.RetentionInterval,
+		Timeout:  30 * time.Minute,
+		Run: func(ctx context.Context) error {
+			report, err := retention.Apply(ctx)
+			if err != nil {
+				return err
+			}
+			for _, resource := range report.Resources {
+				if resource.Count > 0 {
+					slog.Info("dados expirados removidos", "resource", resource.Resource, "count", resource.Count)
+				}
+			}
+			return nil
+		},
+	})
+
+	platformMetrics := services.NewPlatformMetricsService(repositories.NewPlatformMetricsRepository(db))
+	sched.Register(scheduler.Job{
+		Name:     "platform-metrics",
+		Interval: 15 * time.Minute,
+		Run:      platformMetrics.Refresh,
+	})
+	sched.Register(scheduler.Job{
+		Name:     "business-kpis",
+		Interval: time.Minute,
+		Run:      platformMetrics.RefreshKPIs,
+	})
+
+	organizations := services.NewOrganizationService(
+		repositories.NewOrganizationRepository(db),
+		repositories.NewOrgUsageRepository(db),
+		repositories.NewUserRepository(db),
+	)
+	sched.Register(scheduler.Job{
+		Name:     "org-usage",
+		Interval: 15 * time.Minute,
+		Run:      organizations.RefreshUsage,
+	})
+
+	// PDFs grandes, enfileirados pelas rotas de exportação
+	pdfExports := services.NewTaskPDFService(
+		repositories.NewTodoRepository(db),
+		repositories.NewProjectRepository(db),
+		repositories.NewUserRepository(db),
+		repositories.NewExportJobRepository(db),
+	)
+	sched.Register(scheduler.Job{
+		Name:     "pdf-exports",
+		Interval: 15 * time.Second,
+		Run:      pdfExports.ProcessPending,
+	})
+
+	if cfg.ArchiveEnabled {
+		archiveJob := jobs.NewArchiveJob(repositories.NewTaskArchiveRepository(db), cfg.ArchiveAfterMonths)
+		sched.Register(scheduler.Job{
+			Name:     "archive",
+			Interval: cfg.ArchiveInterval,
+			Timeout:  30 * time.Minute,
+			Run:      archiveJob.Run,
+		})
+	}
+
+	sched.Start(ctx)
+	return sched
+}
+
+// waitForShutdown bloqueia até receber SIGINT/SIGTERM ou até o servidor
+// falhar, retornando o código de saída do processo
+func waitForShutdown(serverErr <-chan error) int {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(quit)
 
-	go func() {
-		<-quit
-		log.Println("🔄 Iniciando graceful shutdown...")
-
-		// Para de aceitar novas conexões
-		if err := app.Shutdown(); err != nil {
-			log.Printf("❌ Erro durante shutdown do servidor: %v", err)
+	select {
+	case sig := <-quit:
+		slog.Info("iniciando graceful shutdown", "signal", sig.String())
+		return 0
+	case err := <-serverErr:
+		if err != nil {
+			slog.Error("erro ao iniciar servidor", "error", err)
+			return 1
 		}
-	}()
+		return 0
+	}
+}
+
+// shutdown encerra a aplicação na ordem em que as dependências são usadas
+func shutdown(app *fiber.App, cfg *config.Config, stopJobs context.CancelFunc, sched *scheduler.Scheduler, imports *importer.Runner, bus events.Bus, db database.Client) {
+	// Para de aceitar conexões e aguarda as requisições em andamento
+	if err := app.ShutdownWithTimeout(cfg.ShutdownTimeout); err != nil {
+		slog.Error("erro durante shutdown do servidor", "error", err)
+	}
+
+	// Cancela jobs e importações e aguarda os que estão em execução (leases e progresso gravados)
+	slog.Info("encerrando jobs em segundo plano")
+	stopJobs()
+	sched.Wait()
+	imports.Wait()
+
+	// Aguarda os handlers de eventos já publicados (notificações, etc.)
+	slog.Info("drenando eventos pendentes")
+	if err := bus.Close(); err != nil {
+		slog.Error("erro ao fechar barramento de eventos", "error", err)
+	}
+
+	// Envia os contadores da instância ainda não gravados
+	flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	metrics.Flush(flushCtx, repositories.NewPlatformMetricsRepository(db))
+	cancel()
+
+	slog.Info("fechando conexão com banco de dados")
+	if err := db.Close(); err != nil {
+		slog.Error("erro ao fechar conexão", "error", err)
+	}
+
+	slog.Info("aplicação encerrada")
 }
 
-// startServer inicia o servidor
-func startServer(app *fiber.App, port string) {
-	log.Printf("🚀 Servidor rodando na porta %s", port)
-	log.Printf("📊 Health check: http://localhost:%s/health", port)
-	log.Printf("📈 Status: http://localhost:%s/status", port)
-	log.Printf("📚 API Base: http://localhost:%s/api/v1", port)
+// startServer inicia o servidor (HTTPS quando configurado) e bloqueia até o encerramento
+func startServer(app *fiber.App, cfg *config.Config) error {
+	serverConfig := server.Config{
+		Port:             cfg.Port,
+		CertFile:         cfg.TLSCertFile,
+		KeyFile:          cfg.TLSKeyFile,
+		AutocertDomains:  cfg.TLSAutocertDomains,
+		AutocertEmail:    cfg.TLSAutocertEmail,
+		AutocertCacheDir: cfg.TLSAutocertCacheDir,
+		RedirectPort:     cfg.HTTPRedirectPort,
+	}
+
+	scheme := "http"
+	if serverConfig.TLSEnabled() {
+		scheme = "https"
+	}
+	baseURL := scheme + "://localhost:" + cfg.Port
+
+	slog.Info("servidor iniciado",
+		"port", cfg.Port,
+		"tls", serverConfig.TLSEnabled(),
+		"health", baseURL+"/health",
+		"status", baseURL+"/status",
+		"api", baseURL+"/api/v1",
+	)
+
+	return server.Serve(app, serverConfig)
+}
 
-	if err := app.Listen(":" + port); err != nil {
-		log.Fatalf("❌ Erro ao iniciar servidor: %v", err)
+// runCommand executa um subcomando da linha de comando
+func runCommand(cfg *config.Config, args []string) {
+	switch strings.Join(args, " ") {
+	case "config print":
+		if err := cfg.Print(os.Stdout); err != nil {
+			fatal("falha ao exibir configuração", err)
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "comando desconhecido: %s\n\nuso: app [--config arquivo] [config print]\n", strings.Join(args, " "))
+		os.Exit(2)
 	}
 }
+
+// fatal registra o erro e encerra a aplicação
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
@@ -0,0 +1,202 @@
+// Comando loadtest exercita a API em execução com usuários simultâneos:
+// cadastro, login, criação de tarefas, listagens com filtros, conclusão e
+// estatísticas. Ao final imprime a latência (p50/p90/p99) de cada operação e
+// encerra com erro se alguma requisição falhou ou passou do limite de p99.
+//
+//	go run ./cmd/loadtest -url http://localhost:8080/api/v1 -users 20 -tasks 100
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// options são os parâmetros da execução
+type options struct {
+	baseURL string
+	users   int
+	tasks   int
+	lists   int
+	timeout time.Duration
+	maxP99  time.Duration
+}
+
+func main() {
+	var opts options
+	flag.StringVar(&opts.baseURL, "url", "http://localhost:8080/api/v1", "URL base da API")
+	flag.IntVar(&opts.users, "users", 10, "usuários simultâneos (cada um com suas tarefas)")
+	flag.IntVar(&opts.tasks, "tasks", 50, "tarefas criadas por usuário")
+	flag.IntVar(&opts.lists, "lists", 10, "rodadas de listagens com filtros por usuário")
+	flag.DurationVar(&opts.timeout, "timeout", 10*time.Second, "timeout de cada requisição")
+	flag.DurationVar(&opts.maxP99, "max-p99", 0, "p99 máximo aceito por operação (0 desativa)")
+	flag.Parse()
+
+	if opts.users < 1 || opts.tasks < 1 {
+		fmt.Fprintln(os.Stderr, "users e tasks devem ser maiores que zero")
+		os.Exit(2)
+	}
+
+	rec := newRecorder()
+	client := &client{
+		http:    &http.Client{Timeout: opts.timeout},
+		baseURL: opts.baseURL,
+		rec:     rec,
+	}
+
+	started := time.Now()
+	runID := started.UnixNano()
+
+	var wg sync.WaitGroup
+	for i := 0; i < opts.users; i++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			if err := runUser(context.Background(), client, opts, fmt.Sprintf("%d-%d", runID, worker)); err != nil {
+				fmt.Fprintf(os.Stderr, "usuário %d interrompido: %v\n", worker, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	failed := rec.report(os.Stdout, time.Since(started), opts.maxP99)
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// runUser executa o roteiro completo de um usuário
+func runUser(ctx context.Context, c *client, opts options, suffix string) error {
+	email := fmt.Sprintf("loadtest-%s@example.com", suffix)
+	password := "loadtest-senha"
+
+	if _, err := c.do(ctx, "register", http.MethodPost, "/auth/register", "", map[string]string{
+		"name":     "Load Test",
+		"email":    email,
+		"password": password,
+	}); err != nil {
+		return err
+	}
+
+	var login struct {
+		Data struct {
+			Token string `json:"token"`
+		} `json:"data"`
+	}
+	body, err := c.do(ctx, "login", http.MethodPost, "/auth/login", "", map[string]string{
+		"email":    email,
+		"password": password,
+	})
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, &login); err != nil || login.Data.Token == "" {
+		return fmt.Errorf("resposta de login sem token")
+	}
+	token := login.Data.Token
+
+	priorities := []string{"low", "medium", "high", "urgent"}
+	ids := make([]string, 0, opts.tasks)
+	for i := 0; i < opts.tasks; i++ {
+		body, err := c.do(ctx, "create", http.MethodPost, "/todos", token, map[string]interface{}{
+			"title":    fmt.Sprintf("Tarefa de carga %d", i),
+			"priority": priorities[i%len(priorities)],
+			"tags":     []string{"loadtest", fmt.Sprintf("grupo-%d", i%5)},
+			"due_date": time.Now().AddDate(0, 0, i%30-10).Format(time.RFC3339),
+		})
+		if err != nil {
+			continue
+		}
+
+		var created struct {
+			Data struct {
+				ID string `json:"id"`
+			} `json:"data"`
+		}
+		if json.Unmarshal(body, &created) == nil && created.Data.ID != "" {
+			ids = append(ids, created.Data.ID)
+		}
+	}
+
+	lists := []struct{ name, query string }{
+		{"list", "/todos?page=1&limit=20"},
+		{"list_last_page", fmt.Sprintf("/todos?page=%d&limit=20", max(opts.tasks/20, 1))},
+		{"list_filters", "/todos?priority=high&tags=loadtest&sort=due_date&order=asc"},
+		{"list_search", "/todos?search=carga&limit=50"},
+		{"list_compact", "/todos?view=compact&limit=100"},
+	}
+	for round := 0; round < opts.lists; round++ {
+		for _, list := range lists {
+			c.do(ctx, list.name, http.MethodGet, list.query, token, nil)
+		}
+	}
+
+	// Conclui metade das tarefas, alternando entre cache e recálculo das estatísticas
+	for i, id := range ids {
+		if i%2 != 0 {
+			continue
+		}
+		c.do(ctx, "complete", http.MethodPatch, "/todos/"+id+"/status", token, map[string]string{"status": "completed"})
+		if i%10 == 0 {
+			c.do(ctx, "stats", http.MethodGet, "/todos/stats", token, nil)
+			c.do(ctx, "stats_fresh", http.MethodGet, "/todos/stats?fresh=true", token, nil)
+		}
+	}
+
+	return nil
+}
+
+// client faz as requisições e registra a latência de cada uma
+type client struct {
+	http    *http.Client
+	baseURL string
+	rec     *recorder
+}
+
+// do executa a requisição e retorna o corpo. Status fora de 2xx é registrado
+// como erro da operação.
+func (c *client) do(ctx context.Context, op, method, path, token string, payload interface{}) ([]byte, error) {
+	var reader io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	start := time.Now()
+	resp, err := c.http.Do(req)
+	if err != nil {
+		c.rec.record(op, time.Since(start), err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	elapsed := time.Since(start)
+	if err == nil && (resp.StatusCode < 200 || resp.StatusCode > 299) {
+		err = fmt.Errorf("%s %s: status %d", method, path, resp.StatusCode)
+	}
+	c.rec.record(op, elapsed, err)
+
+	return body, err
+}
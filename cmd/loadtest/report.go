@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// maxErrorSamples é quantos erros distintos por operação aparecem no relatório
+const maxErrorSamples = 3
+
+// operationStats acumula as latências e erros de uma operação
+type operationStats struct {
+	latencies []time.Duration
+	errors    int
+	samples   []string
+}
+
+// recorder registra as medições de todas as goroutines
+type recorder struct {
+	mu  sync.Mutex
+	ops map[string]*operationStats
+}
+
+func newRecorder() *recorder {
+	return &recorder{ops: make(map[string]*operationStats)}
+}
+
+// record registra uma requisição da operação
+func (r *recorder) record(op string, elapsed time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats, ok := r.ops[op]
+	if !ok {
+		stats = &operationStats{}
+		r.ops[op] = stats
+	}
+
+	stats.latencies = append(stats.latencies, elapsed)
+	if err != nil {
+		stats.errors++
+		if len(stats.samples) < maxErrorSamples {
+			stats.samples = append(stats.samples, err.Error())
+		}
+	}
+}
+
+// report imprime a tabela de latências e retorna se a execução falhou (erros
+// ou p99 acima de maxP99)
+func (r *recorder) report(w io.Writer, total time.Duration, maxP99 time.Duration) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	names := make([]string, 0, len(r.ops))
+	requests := 0
+	for name, stats := range r.ops {
+		names = append(names, name)
+		requests += len(stats.latencies)
+	}
+	sort.Strings(names)
+
+	failed := false
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "operação\treqs\terros\tp50\tp90\tp99\tmáx\t")
+	for _, name := range names {
+		stats := r.ops[name]
+		sort.Slice(stats.latencies, func(i, j int) bool { return stats.latencies[i] < stats.latencies[j] })
+
+		p99 := percentile(stats.latencies, 99)
+		if stats.errors > 0 || (maxP99 > 0 && p99 > maxP99) {
+			failed = true
+		}
+
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\t%s\t%s\t%s\t\n", name, len(stats.latencies), stats.errors,
+			round(percentile(stats.latencies, 50)), round(percentile(stats.latencies, 90)),
+			round(p99), round(stats.latencies[len(stats.latencies)-1]))
+	}
+	tw.Flush()
+
+	fmt.Fprintf(w, "\n%d requisições em %s (%.1f req/s)\n", requests, round(total), float64(requests)/total.Seconds())
+
+	for _, name := range names {
+		for _, sample := range r.ops[name].samples {
+			fmt.Fprintf(w, "erro em %s: %s\n", name, sample)
+		}
+	}
+	if maxP99 > 0 {
+		fmt.Fprintf(w, "p99 máximo aceito: %s\n", maxP99)
+	}
+
+	return failed
+}
+
+// percentile retorna o percentil p (0-100) das latências já ordenadas
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	index := (len(sorted)*p + 99) / 100
+	return sorted[min(max(index-1, 0), len(sorted)-1)]
+}
+
+// round arredonda a duração para exibição
+func round(d time.Duration) time.Duration {
+	if d >= time.Second {
+		return d.Round(time.Millisecond)
+	}
+	return d.Round(10 * time.Microsecond)
+}
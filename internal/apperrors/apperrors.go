@@ -0,0 +1,61 @@
+// Package apperrors define as categorias de erro de domínio compartilhadas
+// por repositórios e serviços. O handler de erros global converte cada
+// categoria no status HTTP correspondente.
+package apperrors
+
+import "errors"
+
+var (
+	// ErrNotFound indica registro inexistente ou inacessível ao usuário (404)
+	ErrNotFound = errors.New("registro não encontrado")
+	// ErrDuplicate indica registro que já existe (409)
+	ErrDuplicate = errors.New("registro já existe")
+	// ErrConflict indica operação incompatível com o estado atual (409)
+	ErrConflict = errors.New("operação em conflito com o estado atual")
+	// ErrForbidden indica registro visível ao usuário, mas sem permissão para a operação (403)
+	ErrForbidden = errors.New("operação não permitida")
+	// ErrUnavailable indica dependência temporariamente indisponível, como o
+	// banco durante a eleição de um novo primário (503)
+	ErrUnavailable = errors.New("serviço temporariamente indisponível")
+)
+
+// Error é um erro de domínio com mensagem própria, pertencente a uma das
+// categorias acima (errors.Is(err, ErrNotFound), por exemplo)
+type Error struct {
+	kind    error
+	message string
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+// Unwrap retorna a categoria do erro
+func (e *Error) Unwrap() error {
+	return e.kind
+}
+
+// NotFound cria um erro da categoria ErrNotFound
+func NotFound(message string) error {
+	return &Error{kind: ErrNotFound, message: message}
+}
+
+// Duplicate cria um erro da categoria ErrDuplicate
+func Duplicate(message string) error {
+	return &Error{kind: ErrDuplicate, message: message}
+}
+
+// Conflict cria um erro da categoria ErrConflict
+func Conflict(message string) error {
+	return &Error{kind: ErrConflict, message: message}
+}
+
+// Forbidden cria um erro da categoria ErrForbidden
+func Forbidden(message string) error {
+	return &Error{kind: ErrForbidden, message: message}
+}
+
+// Unavailable cria um erro da categoria ErrUnavailable
+func Unavailable(message string) error {
+	return &Error{kind: ErrUnavailable, message: message}
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"time"
+)
+
+// ErrInvalidSignedURL indica URL assinada adulterada ou expirada
+var ErrInvalidSignedURL = errors.New("link inválido ou expirado")
+
+// URLSigner assina URLs de download de curta duração (HMAC SHA-256 do ID do
+// arquivo e do vencimento), dispensando o header Authorization no navegador
+type URLSigner struct {
+	key []byte
+	ttl time.Duration
+}
+
+// NewURLSigner cria o assinador. A chave é derivada de secret (o JWT_SECRET),
+// então um link não serve como token de acesso nem o contrário.
+func NewURLSigner(secret string, ttl time.Duration) *URLSigner {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("signed-file-urls"))
+
+	return &URLSigner{key: mac.Sum(nil), ttl: ttl}
+}
+
+// Sign assina o ID do arquivo e retorna o vencimento e a assinatura (exp e sig da URL)
+func (s *URLSigner) Sign(id string) (time.Time, string) {
+	expiresAt := time.Now().Add(s.ttl).Truncate(time.Second)
+	return expiresAt, hex.EncodeToString(s.signature(id, expiresAt.Unix()))
+}
+
+// Verify confere a assinatura de id e o vencimento exp (segundos Unix)
+func (s *URLSigner) Verify(id, exp, sig string) error {
+	expiresAt, err := strconv.ParseInt(exp, 10, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		return ErrInvalidSignedURL
+	}
+
+	provided, err := hex.DecodeString(sig)
+	if err != nil || !hmac.Equal(s.signature(id, expiresAt), provided) {
+		return ErrInvalidSignedURL
+	}
+
+	return nil
+}
+
+// signature calcula a assinatura de id com vencimento exp
+func (s *URLSigner) signature(id string, exp int64) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(id + ":" + strconv.FormatInt(exp, 10)))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrInvalidToken indica token ausente, expirado ou com assinatura inválida
+var ErrInvalidToken = errors.New("token inválido")
+
+// Claims representa as claims do token de acesso
+type Claims struct {
+	UserID string `json:"uid"`
+	jwt.RegisteredClaims
+}
+
+// TokenManager gera e valida tokens JWT (HS256)
+type TokenManager struct {
+	secret     []byte
+	expiration time.Duration
+}
+
+// NewTokenManager cria um novo gerenciador de tokens
+func NewTokenManager(secret string, expiration time.Duration) *TokenManager {
+	return &TokenManager{
+		secret:     []byte(secret),
+		expiration: expiration,
+	}
+}
+
+// Generate gera um token de acesso para o usuário
+func (m *TokenManager) Generate(userID primitive.ObjectID) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(m.expiration)
+
+	claims := Claims{
+		UserID: userID.Hex(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID.Hex(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secret)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("erro ao assinar token: %w", err)
+	}
+
+	return token, expiresAt, nil
+}
+
+// Session identifica o usuário de um token de acesso válido e quando o token foi emitido
+type Session struct {
+	UserID   primitive.ObjectID
+	IssuedAt time.Time
+}
+
+// Parse valida o token e retorna o ID do usuário
+func (m *TokenManager) Parse(tokenString string) (primitive.ObjectID, error) {
+	session, err := m.ParseSession(tokenString)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return session.UserID, nil
+}
+
+// ParseSession valida o token e retorna o usuário e o instante de emissão
+func (m *TokenManager) ParseSession(tokenString string) (*Session, error) {
+	claims := &Claims{}
+
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		return m.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidToken
+	}
+
+	userID, err := primitive.ObjectIDFromHex(claims.UserID)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	session := &Session{UserID: userID}
+	if claims.IssuedAt != nil {
+		session.IssuedAt = claims.IssuedAt.Time
+	}
+
+	return session, nil
+}
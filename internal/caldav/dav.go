@@ -0,0 +1,260 @@
+package caldav
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Namespaces usados nas propriedades
+const (
+	NamespaceDAV    = "DAV:"
+	NamespaceCalDAV = "urn:ietf:params:xml:ns:caldav"
+	// NamespaceCalendarServer é usado pelo getctag, que clientes como o
+	// Thunderbird consultam antes de listar a coleção
+	NamespaceCalendarServer = "http://calendarserver.org/ns/"
+)
+
+// Name identifica uma propriedade (namespace + nome local)
+type Name = xml.Name
+
+// Propriedades conhecidas
+var (
+	PropResourceType          = Name{Space: NamespaceDAV, Local: "resourcetype"}
+	PropDisplayName           = Name{Space: NamespaceDAV, Local: "displayname"}
+	PropCurrentUserPrincipal  = Name{Space: NamespaceDAV, Local: "current-user-principal"}
+	PropPrincipalURL          = Name{Space: NamespaceDAV, Local: "principal-URL"}
+	PropOwner                 = Name{Space: NamespaceDAV, Local: "owner"}
+	PropCurrentUserPrivileges = Name{Space: NamespaceDAV, Local: "current-user-privilege-set"}
+	PropSupportedReportSet    = Name{Space: NamespaceDAV, Local: "supported-report-set"}
+	PropGetETag               = Name{Space: NamespaceDAV, Local: "getetag"}
+	PropGetContentType        = Name{Space: NamespaceDAV, Local: "getcontenttype"}
+	PropGetLastModified       = Name{Space: NamespaceDAV, Local: "getlastmodified"}
+	PropCalendarHomeSet       = Name{Space: NamespaceCalDAV, Local: "calendar-home-set"}
+	PropCalendarUserAddress   = Name{Space: NamespaceCalDAV, Local: "calendar-user-address-set"}
+	PropSupportedComponents   = Name{Space: NamespaceCalDAV, Local: "supported-calendar-component-set"}
+	PropCalendarData          = Name{Space: NamespaceCalDAV, Local: "calendar-data"}
+	PropGetCTag               = Name{Space: NamespaceCalendarServer, Local: "getctag"}
+)
+
+// anyElement captura o nome de um elemento qualquer
+type anyElement struct {
+	XMLName xml.Name
+}
+
+// propList é o conteúdo de <prop> nas requisições
+type propList struct {
+	Names []anyElement `xml:",any"`
+}
+
+func (p *propList) names() []Name {
+	names := make([]Name, 0, len(p.Names))
+	for _, element := range p.Names {
+		names = append(names, element.XMLName)
+	}
+	return names
+}
+
+// PropFind é o corpo do PROPFIND. AllProp também vale para corpo vazio.
+type PropFind struct {
+	AllProp bool
+	Props   []Name
+}
+
+// ParsePropFind lê o corpo do PROPFIND
+func ParsePropFind(body []byte) (*PropFind, error) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return &PropFind{AllProp: true}, nil
+	}
+
+	var req struct {
+		XMLName  xml.Name  `xml:"DAV: propfind"`
+		AllProp  *struct{} `xml:"DAV: allprop"`
+		PropName *struct{} `xml:"DAV: propname"`
+		Prop     *propList `xml:"DAV: prop"`
+	}
+	if err := xml.Unmarshal(body, &req); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+	}
+
+	if req.Prop == nil {
+		return &PropFind{AllProp: true}, nil
+	}
+
+	return &PropFind{Props: req.Prop.names()}, nil
+}
+
+// Report é o corpo de um REPORT suportado (calendar-query ou calendar-multiget)
+type Report struct {
+	Props []Name
+	// Hrefs é preenchido no calendar-multiget
+	Hrefs []string
+	// Multiget indica calendar-multiget (caso contrário, calendar-query)
+	Multiget bool
+	// TodoOnly é falso quando o filtro do calendar-query pede outro componente
+	// (ex.: VEVENT), que esta coleção não tem
+	TodoOnly bool
+}
+
+// ErrInvalidRequest indica corpo XML inválido
+var ErrInvalidRequest = errors.New("requisição WebDAV inválida")
+
+// ErrUnsupportedReport indica REPORT não suportado (ex.: sync-collection)
+var ErrUnsupportedReport = errors.New("REPORT não suportado")
+
+// compFilter é o filtro de componentes do calendar-query
+type compFilter struct {
+	Name    string       `xml:"name,attr"`
+	Filters []compFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+}
+
+// ParseReport lê o corpo do REPORT
+func ParseReport(body []byte) (*Report, error) {
+	var root anyElement
+	if err := xml.Unmarshal(body, &root); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+	}
+
+	switch root.XMLName {
+	case Name{Space: NamespaceCalDAV, Local: "calendar-multiget"}:
+		var req struct {
+			Prop  propList `xml:"DAV: prop"`
+			Hrefs []string `xml:"DAV: href"`
+		}
+		if err := xml.Unmarshal(body, &req); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+		}
+		return &Report{Props: req.Prop.names(), Hrefs: req.Hrefs, Multiget: true, TodoOnly: true}, nil
+
+	case Name{Space: NamespaceCalDAV, Local: "calendar-query"}:
+		var req struct {
+			Prop   propList `xml:"DAV: prop"`
+			Filter struct {
+				Calendar *compFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+			} `xml:"urn:ietf:params:xml:ns:caldav filter"`
+		}
+		if err := xml.Unmarshal(body, &req); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+		}
+
+		todoOnly := true
+		if calendar := req.Filter.Calendar; calendar != nil {
+			for _, filter := range calendar.Filters {
+				if !strings.EqualFold(filter.Name, "VTODO") {
+					todoOnly = false
+				}
+			}
+		}
+
+		return &Report{Props: req.Prop.names(), TodoOnly: todoOnly}, nil
+
+	default:
+		return nil, ErrUnsupportedReport
+	}
+}
+
+// Property é uma propriedade encontrada, com o conteúdo já em XML
+type Property struct {
+	Name Name
+	// InnerXML é o conteúdo do elemento (texto escapado ou elementos filhos)
+	InnerXML string
+}
+
+// Text cria uma propriedade de texto simples
+func Text(name Name, value string) Property {
+	return Property{Name: name, InnerXML: escapeXML(value)}
+}
+
+// Href cria uma propriedade cujo conteúdo é <d:href>
+func Href(name Name, href string) Property {
+	return Property{Name: name, InnerXML: "<d:href>" + escapeXML(href) + "</d:href>"}
+}
+
+// Raw cria uma propriedade com conteúdo XML já montado (prefixos d:, c: e cs:)
+func Raw(name Name, innerXML string) Property {
+	return Property{Name: name, InnerXML: innerXML}
+}
+
+// Multistatus monta a resposta 207 do PROPFIND e do REPORT
+type Multistatus struct {
+	buf bytes.Buffer
+}
+
+// NewMultistatus inicia a resposta declarando os prefixos usados nas propriedades
+func NewMultistatus() *Multistatus {
+	m := &Multistatus{}
+	m.buf.WriteString(xml.Header)
+	fmt.Fprintf(&m.buf, `<d:multistatus xmlns:d=%q xmlns:c=%q xmlns:cs=%q>`, NamespaceDAV, NamespaceCalDAV, NamespaceCalendarServer)
+	return m
+}
+
+// Add inclui um recurso com as propriedades encontradas e as inexistentes (404)
+func (m *Multistatus) Add(href string, found []Property, missing []Name) {
+	m.buf.WriteString("<d:response><d:href>" + escapeXML(href) + "</d:href>")
+
+	if len(found) > 0 {
+		m.buf.WriteString("<d:propstat><d:prop>")
+		for _, prop := range found {
+			open, close := elementTags(prop.Name)
+			m.buf.WriteString(open + prop.InnerXML + close)
+		}
+		m.buf.WriteString("</d:prop><d:status>" + statusLine(http.StatusOK) + "</d:status></d:propstat>")
+	}
+
+	if len(missing) > 0 {
+		m.buf.WriteString("<d:propstat><d:prop>")
+		for _, name := range missing {
+			open, close := elementTags(name)
+			m.buf.WriteString(open + close)
+		}
+		m.buf.WriteString("</d:prop><d:status>" + statusLine(http.StatusNotFound) + "</d:status></d:propstat>")
+	}
+
+	m.buf.WriteString("</d:response>")
+}
+
+// AddStatus inclui um recurso apenas com o status (ex.: 404 no calendar-multiget)
+func (m *Multistatus) AddStatus(href string, status int) {
+	m.buf.WriteString("<d:response><d:href>" + escapeXML(href) + "</d:href><d:status>" + statusLine(status) + "</d:status></d:response>")
+}
+
+// Bytes finaliza e retorna o XML
+func (m *Multistatus) Bytes() []byte {
+	m.buf.WriteString("</d:multistatus>")
+	return m.buf.Bytes()
+}
+
+// elementTags monta as tags da propriedade com o prefixo do namespace conhecido
+// ou, para namespaces desconhecidos, com a declaração no próprio elemento
+func elementTags(name Name) (string, string) {
+	prefix := ""
+	switch name.Space {
+	case NamespaceDAV:
+		prefix = "d:"
+	case NamespaceCalDAV:
+		prefix = "c:"
+	case NamespaceCalendarServer:
+		prefix = "cs:"
+	}
+
+	if prefix != "" || name.Space == "" {
+		return "<" + prefix + name.Local + ">", "</" + prefix + name.Local + ">"
+	}
+
+	return fmt.Sprintf("<x:%s xmlns:x=%q>", name.Local, name.Space), "</x:" + name.Local + ">"
+}
+
+// statusLine monta a linha de status usada nos propstat
+func statusLine(status int) string {
+	return fmt.Sprintf("HTTP/1.1 %d %s", status, http.StatusText(status))
+}
+
+// escapeXML escapa texto para uso em conteúdo XML
+func escapeXML(text string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(text))
+	return buf.String()
+}
@@ -0,0 +1,459 @@
+package caldav
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+)
+
+const (
+	// productID identifica o servidor no PRODID dos calendários gerados
+	productID = "-//todo-it//CalDAV//PT-BR"
+	// Limites aplicados ao receber tarefas (os mesmos da API)
+	maxTitleLength       = 200
+	maxDescriptionLength = 1000
+
+	icalUTCLayout      = "20060102T150405Z"
+	icalLocalLayout    = "20060102T150405"
+	icalDateLayout     = "20060102"
+	maxICalLineOctets  = 75
+	icalContentType    = "text/calendar; charset=utf-8; component=vtodo"
+	defaultTodoSummary = "Sem título"
+)
+
+var (
+	// ErrInvalidCalendar indica corpo que não é um iCalendar válido
+	ErrInvalidCalendar = errors.New("iCalendar inválido")
+	// ErrUnsupportedComponent indica calendário sem VTODO (ex.: apenas VEVENT)
+	ErrUnsupportedComponent = errors.New("apenas VTODO é suportado")
+)
+
+// Todo são os campos de um VTODO que têm correspondência na tarefa
+type Todo struct {
+	UID         string
+	Summary     string
+	Description string
+	Status      enums.TaskStatus
+	Priority    enums.TaskPriority
+	Due         *time.Time
+	Completed   *time.Time
+	Categories  []string
+}
+
+// EncodeTask gera o VCALENDAR com o VTODO da tarefa. Datas vão em UTC.
+func EncodeTask(task *entities.Task) []byte {
+	var buf bytes.Buffer
+	line := func(name, value string) {
+		writeFolded(&buf, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", productID)
+	line("BEGIN", "VTODO")
+	line("UID", taskUID(task))
+	line("DTSTAMP", task.UpdatedAt.UTC().Format(icalUTCLayout))
+	line("CREATED", task.CreatedAt.UTC().Format(icalUTCLayout))
+	line("LAST-MODIFIED", task.UpdatedAt.UTC().Format(icalUTCLayout))
+	line("SUMMARY", escapeText(task.Title))
+	if task.Description != "" {
+		line("DESCRIPTION", escapeText(task.Description))
+	}
+	line("STATUS", icalStatus(task.Status))
+	line("PRIORITY", strconv.Itoa(icalPriority(task.Priority)))
+	if task.DueDate != nil {
+		line("DUE", task.DueDate.UTC().Format(icalUTCLayout))
+	}
+	if task.Status == enums.StatusCompleted {
+		line("PERCENT-COMPLETE", "100")
+		if task.CompletedAt != nil {
+			line("COMPLETED", task.CompletedAt.UTC().Format(icalUTCLayout))
+		}
+	}
+	if len(task.Tags) > 0 {
+		tags := make([]string, 0, len(task.Tags))
+		for _, tag := range task.Tags {
+			tags = append(tags, escapeText(tag))
+		}
+		line("CATEGORIES", strings.Join(tags, ","))
+	}
+	if task.External != nil && task.External.URL != "" {
+		line("URL", task.External.URL)
+	}
+	line("END", "VTODO")
+	line("END", "VCALENDAR")
+
+	return buf.Bytes()
+}
+
+// ParseTodo lê o primeiro VTODO do calendário. Datas sem fuso (ou com TZID
+// desconhecido) usam loc; datas sem horário vencem no fim do dia em loc.
+func ParseTodo(data []byte, loc *time.Location) (*Todo, error) {
+	lines, err := unfold(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lines) == 0 || !strings.EqualFold(lines[0], "BEGIN:VCALENDAR") {
+		return nil, ErrInvalidCalendar
+	}
+
+	var (
+		todo    *Todo
+		inTodo  bool
+		nested  int
+		percent int
+	)
+
+	for _, raw := range lines {
+		name, params, value, ok := parseLine(raw)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VTODO") && todo == nil:
+			todo = &Todo{}
+			inTodo = true
+			continue
+		case name == "END" && strings.EqualFold(value, "VTODO") && inTodo && nested == 0:
+			inTodo = false
+			continue
+		}
+
+		if !inTodo {
+			continue
+		}
+
+		// Componentes aninhados (ex.: VALARM) são ignorados
+		if name == "BEGIN" {
+			nested++
+			continue
+		}
+		if name == "END" {
+			nested--
+			continue
+		}
+		if nested > 0 {
+			continue
+		}
+
+		switch name {
+		case "UID":
+			todo.UID = value
+		case "SUMMARY":
+			todo.Summary = unescapeText(value)
+		case "DESCRIPTION":
+			todo.Description = unescapeText(value)
+		case "STATUS":
+			todo.Status = taskStatus(value)
+		case "PRIORITY":
+			if priority, err := strconv.Atoi(value); err == nil {
+				todo.Priority = taskPriority(priority)
+			}
+		case "DUE":
+			todo.Due = parseICalTime(value, params, loc)
+		case "COMPLETED":
+			todo.Completed = parseICalTime(value, params, loc)
+		case "PERCENT-COMPLETE":
+			percent, _ = strconv.Atoi(value)
+		case "CATEGORIES":
+			for _, category := range splitEscaped(value) {
+				if category = strings.TrimSpace(unescapeText(category)); category != "" {
+					todo.Categories = append(todo.Categories, category)
+				}
+			}
+		}
+	}
+
+	if todo == nil {
+		return nil, ErrUnsupportedComponent
+	}
+
+	// Clientes que não enviam STATUS indicam a conclusão por COMPLETED ou 100%
+	if todo.Status == "" {
+		todo.Status = enums.StatusPending
+		if todo.Completed != nil || percent == 100 {
+			todo.Status = enums.StatusCompleted
+		}
+	}
+
+	return todo, nil
+}
+
+// Apply copia os campos do VTODO para a tarefa, respeitando os limites da API.
+// Campos sem equivalente no VTODO (projeto, checklist, anexos) são mantidos.
+// now é a hora atual usada na troca de status.
+func (t *Todo) Apply(task *entities.Task, now time.Time) {
+	task.Title = truncate(strings.TrimSpace(t.Summary), maxTitleLength)
+	if task.Title == "" {
+		task.Title = defaultTodoSummary
+	}
+	task.Description = truncate(strings.TrimSpace(t.Description), maxDescriptionLength)
+
+	if t.Priority != "" {
+		task.Priority = t.Priority
+	} else if task.Priority == "" {
+		task.Priority = enums.PriorityMedium
+	}
+
+	task.DueDate = t.Due
+
+	task.Tags = entities.NormalizeTags(t.Categories)
+
+	if t.Status == task.Status {
+		return
+	}
+
+	// A troca de status pelo cliente tira a tarefa do status personalizado do projeto
+	task.StatusID = ""
+	task.CustomStatus = nil
+	if t.Status == enums.StatusCompleted {
+		task.MarkAsCompletedAt(now)
+		if t.Completed != nil {
+			completed := *t.Completed
+			task.CompletedAt = &completed
+		}
+		return
+	}
+
+	task.SetStatusAt(t.Status, now)
+}
+
+// taskUID é o UID do VTODO: o do cliente que criou a tarefa ou um derivado do ID
+func taskUID(task *entities.Task) string {
+	if task.CalDAV != nil && task.CalDAV.UID != "" {
+		return task.CalDAV.UID
+	}
+	return task.ID.Hex() + "@todo-it"
+}
+
+// icalStatus converte o status da tarefa para o STATUS do VTODO
+func icalStatus(status enums.TaskStatus) string {
+	switch status {
+	case enums.StatusInProgress:
+		return "IN-PROCESS"
+	case enums.StatusCompleted:
+		return "COMPLETED"
+	case enums.StatusCancelled:
+		return "CANCELLED"
+	default:
+		return "NEEDS-ACTION"
+	}
+}
+
+// taskStatus converte o STATUS do VTODO para o status da tarefa
+func taskStatus(value string) enums.TaskStatus {
+	switch strings.ToUpper(value) {
+	case "IN-PROCESS":
+		return enums.StatusInProgress
+	case "COMPLETED":
+		return enums.StatusCompleted
+	case "CANCELLED":
+		return enums.StatusCancelled
+	default:
+		return enums.StatusPending
+	}
+}
+
+// icalPriority converte a prioridade para a escala do iCalendar (1 = mais alta)
+func icalPriority(priority enums.TaskPriority) int {
+	switch priority {
+	case enums.PriorityUrgent:
+		return 1
+	case enums.PriorityHigh:
+		return 3
+	case enums.PriorityLow:
+		return 9
+	default:
+		return 5
+	}
+}
+
+// taskPriority converte a escala do iCalendar; 0 (indefinida) mantém a atual
+func taskPriority(priority int) enums.TaskPriority {
+	switch {
+	case priority == 1:
+		return enums.PriorityUrgent
+	case priority >= 2 && priority <= 4:
+		return enums.PriorityHigh
+	case priority == 5:
+		return enums.PriorityMedium
+	case priority >= 6 && priority <= 9:
+		return enums.PriorityLow
+	default:
+		return ""
+	}
+}
+
+// parseICalTime converte DATE-TIME (UTC, local ou com TZID) e DATE
+func parseICalTime(value string, params map[string]string, loc *time.Location) *time.Time {
+	if tzid, ok := params["TZID"]; ok {
+		if tz, err := time.LoadLocation(tzid); err == nil {
+			loc = tz
+		}
+	}
+
+	if strings.EqualFold(params["VALUE"], "DATE") || len(value) == len(icalDateLayout) {
+		date, err := time.ParseInLocation(icalDateLayout, value, loc)
+		if err != nil {
+			return nil
+		}
+		end := date.AddDate(0, 0, 1).Add(-time.Second)
+		return &end
+	}
+
+	if parsed, err := time.Parse(icalUTCLayout, value); err == nil {
+		return &parsed
+	}
+
+	if parsed, err := time.ParseInLocation(icalLocalLayout, value, loc); err == nil {
+		return &parsed
+	}
+
+	return nil
+}
+
+// unfold junta as linhas continuadas (iniciadas por espaço ou tab)
+func unfold(data []byte) ([]string, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCalendar, err)
+	}
+
+	return lines, nil
+}
+
+// parseLine separa "NOME;PARAM=VALOR:conteúdo"
+func parseLine(line string) (string, map[string]string, string, bool) {
+	// O ":" pode aparecer entre aspas nos parâmetros
+	index := -1
+	inQuotes := false
+	for i, r := range line {
+		if r == '"' {
+			inQuotes = !inQuotes
+		}
+		if r == ':' && !inQuotes {
+			index = i
+			break
+		}
+	}
+	if index <= 0 {
+		return "", nil, "", false
+	}
+
+	head, value := line[:index], line[index+1:]
+	parts := strings.Split(head, ";")
+
+	params := make(map[string]string, len(parts)-1)
+	for _, param := range parts[1:] {
+		if key, val, ok := strings.Cut(param, "="); ok {
+			params[strings.ToUpper(key)] = strings.Trim(val, `"`)
+		}
+	}
+
+	return strings.ToUpper(parts[0]), params, value, true
+}
+
+// writeFolded grava a linha dobrada em 75 octetos, sem partir caracteres UTF-8
+func writeFolded(buf *bytes.Buffer, line string) {
+	limit := maxICalLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		// A continuação já começa com o espaço
+		limit = maxICalLineOctets - 1
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+// escapeText escapa barras, vírgulas, ponto e vírgula e quebras de linha
+func escapeText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(text)
+}
+
+// unescapeText desfaz escapeText
+func unescapeText(text string) string {
+	var sb strings.Builder
+	escaped := false
+	for _, r := range text {
+		if escaped {
+			switch r {
+			case 'n', 'N':
+				sb.WriteRune('\n')
+			default:
+				sb.WriteRune(r)
+			}
+			escaped = false
+			continue
+		}
+		if r == '\\' {
+			escaped = true
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// splitEscaped separa valores por vírgula, ignorando vírgulas escapadas
+func splitEscaped(value string) []string {
+	var (
+		parts   []string
+		start   int
+		escaped bool
+	)
+	for i := 0; i < len(value); i++ {
+		switch {
+		case escaped:
+			escaped = false
+		case value[i] == '\\':
+			escaped = true
+		case value[i] == ',':
+			parts = append(parts, value[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, value[start:])
+}
+
+// truncate corta o texto em max caracteres
+func truncate(text string, max int) string {
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	return string(runes[:max])
+}
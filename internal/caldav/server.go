@@ -0,0 +1,501 @@
+// Package caldav expõe as tarefas do usuário como VTODO em uma coleção CalDAV,
+// para sincronização nativa com clientes como Thunderbird, Tasks.org e Apple
+// Lembretes. A autenticação (senhas de aplicativo) fica a cargo do handler HTTP.
+//
+// Estrutura de URLs, relativa ao caminho base:
+//
+//	/                       raiz (descoberta do principal)
+//	/{usuário}/             principal e calendar home
+//	/{usuário}/tasks/       coleção com as tarefas não arquivadas
+//	/{usuário}/tasks/{nome} tarefa (nome do cliente ou {id}.ics)
+package caldav
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devgugga/todo-it/internal/clock"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// calendarSegment é o nome da coleção de tarefas dentro do calendar home
+	calendarSegment = "tasks"
+	// calendarDisplayName é o nome exibido da coleção nos clientes
+	calendarDisplayName = "Todo It"
+	// maxResourceName limita o nome de recurso escolhido pelo cliente
+	maxResourceName = 255
+)
+
+var (
+	// ErrNotFound indica recurso inexistente ou de outro usuário
+	ErrNotFound = errors.New("recurso não encontrado")
+	// ErrPreconditionFailed indica If-Match/If-None-Match não atendido
+	ErrPreconditionFailed = errors.New("pré-condição não atendida")
+	// ErrMethodNotAllowed indica operação sobre uma coleção (ex.: PUT na raiz)
+	ErrMethodNotAllowed = errors.New("operação não permitida neste recurso")
+)
+
+// resourceKind identifica o tipo de recurso do caminho
+type resourceKind int
+
+const (
+	kindRoot resourceKind = iota
+	kindHome
+	kindCalendar
+	kindObject
+)
+
+// resource é o recurso resolvido a partir do caminho da requisição
+type resource struct {
+	kind resourceKind
+	// name é o nome da tarefa, em kindObject
+	name string
+}
+
+// Server implementa os métodos CalDAV sobre as tarefas
+type Server struct {
+	basePath string
+	tasks    services.TaskService
+	todos    repositories.TodoRepository
+	clock    clock.Clock
+}
+
+// NewServer cria o servidor CalDAV. basePath é o caminho público da raiz
+// (ex.: /api/v1/caldav), usado nos hrefs das respostas.
+func NewServer(basePath string, tasks services.TaskService, todos repositories.TodoRepository) *Server {
+	return &Server{
+		basePath: strings.TrimRight(basePath, "/"),
+		tasks:    tasks,
+		todos:    todos,
+		clock:    clock.System(),
+	}
+}
+
+// PropFind responde ao PROPFIND. depth 0 lista apenas o recurso; qualquer outro
+// valor inclui os filhos diretos.
+func (s *Server) PropFind(ctx context.Context, user *entities.User, requestPath string, depth int, body []byte) (*Multistatus, error) {
+	req, err := ParsePropFind(body)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.resolve(user, requestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	ms := NewMultistatus()
+
+	switch res.kind {
+	case kindRoot:
+		s.addResponse(ms, s.basePath+"/", s.rootProps(user), req.AllProp, req.Props)
+		if depth != 0 {
+			s.addResponse(ms, s.homeHref(user), s.homeProps(user), req.AllProp, req.Props)
+		}
+
+	case kindHome:
+		s.addResponse(ms, s.homeHref(user), s.homeProps(user), req.AllProp, req.Props)
+		if depth != 0 {
+			tasks, err := s.list(ctx, user.ID)
+			if err != nil {
+				return nil, err
+			}
+			s.addResponse(ms, s.calendarHref(user), s.calendarProps(user, tasks), req.AllProp, req.Props)
+		}
+
+	case kindCalendar:
+		tasks, err := s.list(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		s.addResponse(ms, s.calendarHref(user), s.calendarProps(user, tasks), req.AllProp, req.Props)
+		if depth != 0 {
+			for _, task := range tasks {
+				s.addResponse(ms, s.objectHref(user, task), s.objectProps(user, task, false), req.AllProp, req.Props)
+			}
+		}
+
+	case kindObject:
+		task, err := s.get(ctx, user.ID, res.name)
+		if err != nil {
+			return nil, err
+		}
+		s.addResponse(ms, s.objectHref(user, task), s.objectProps(user, task, false), req.AllProp, req.Props)
+	}
+
+	return ms, nil
+}
+
+// Report responde ao calendar-query (todas as tarefas) e ao calendar-multiget
+func (s *Server) Report(ctx context.Context, user *entities.User, requestPath string, body []byte) (*Multistatus, error) {
+	report, err := ParseReport(body)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.resolve(user, requestPath)
+	if err != nil {
+		return nil, err
+	}
+	if res.kind != kindCalendar {
+		return nil, ErrMethodNotAllowed
+	}
+
+	withData := wants(report.Props, PropCalendarData)
+	ms := NewMultistatus()
+
+	if report.Multiget {
+		for _, href := range report.Hrefs {
+			name := resourceNameFromHref(href)
+			task, err := s.get(ctx, user.ID, name)
+			if err != nil {
+				if errors.Is(err, ErrNotFound) {
+					ms.AddStatus(href, http.StatusNotFound)
+					continue
+				}
+				return nil, err
+			}
+			s.addResponse(ms, s.objectHref(user, task), s.objectProps(user, task, withData), false, report.Props)
+		}
+		return ms, nil
+	}
+
+	if !report.TodoOnly {
+		return ms, nil
+	}
+
+	tasks, err := s.list(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		s.addResponse(ms, s.objectHref(user, task), s.objectProps(user, task, withData), false, report.Props)
+	}
+
+	return ms, nil
+}
+
+// Get retorna o VTODO da tarefa e o ETag
+func (s *Server) Get(ctx context.Context, user *entities.User, requestPath string) ([]byte, string, error) {
+	res, err := s.resolve(user, requestPath)
+	if err != nil {
+		return nil, "", err
+	}
+	if res.kind != kindObject {
+		return nil, "", ErrMethodNotAllowed
+	}
+
+	task, err := s.get(ctx, user.ID, res.name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return EncodeTask(task), ETag(task), nil
+}
+
+// Put cria ou substitui a tarefa com o VTODO recebido. ifMatch e ifNoneMatch
+// são os headers da requisição; retorna o novo ETag e se a tarefa foi criada.
+func (s *Server) Put(ctx context.Context, user *entities.User, requestPath string, body []byte, ifMatch, ifNoneMatch string) (string, bool, error) {
+	res, err := s.resolve(user, requestPath)
+	if err != nil {
+		return "", false, err
+	}
+	if res.kind != kindObject {
+		return "", false, ErrMethodNotAllowed
+	}
+
+	todo, err := ParseTodo(body, user.Preferences.Location())
+	if err != nil {
+		return "", false, err
+	}
+
+	task, err := s.get(ctx, user.ID, res.name)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return "", false, err
+	}
+
+	created := task == nil
+	if created {
+		if ifMatch != "" {
+			return "", false, ErrPreconditionFailed
+		}
+
+		task = &entities.Task{CalDAV: &entities.CalDAVRef{Name: res.name, UID: todo.UID}}
+	} else if ifNoneMatch == "*" || (ifMatch != "" && ifMatch != "*" && ifMatch != ETag(task)) {
+		return "", false, ErrPreconditionFailed
+	}
+
+	todo.Apply(task, s.clock.Now())
+
+	if err := s.tasks.Save(ctx, user.ID, task); err != nil {
+		// Outra requisição criou o mesmo recurso ao mesmo tempo
+		if errors.Is(err, repositories.ErrExternalTaskExists) {
+			return "", false, ErrPreconditionFailed
+		}
+		if errors.Is(err, services.ErrTaskNotFound) {
+			return "", false, ErrNotFound
+		}
+		return "", false, err
+	}
+
+	return ETag(task), created, nil
+}
+
+// Delete remove a tarefa
+func (s *Server) Delete(ctx context.Context, user *entities.User, requestPath, ifMatch string) error {
+	res, err := s.resolve(user, requestPath)
+	if err != nil {
+		return err
+	}
+	if res.kind != kindObject {
+		return ErrMethodNotAllowed
+	}
+
+	task, err := s.get(ctx, user.ID, res.name)
+	if err != nil {
+		return err
+	}
+
+	if ifMatch != "" && ifMatch != "*" && ifMatch != ETag(task) {
+		return ErrPreconditionFailed
+	}
+
+	if err := s.tasks.Delete(ctx, user.ID, task.ID); err != nil {
+		if errors.Is(err, services.ErrTaskNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ETag identifica a versão da tarefa. Usa milissegundos, a precisão das datas no MongoDB.
+func ETag(task *entities.Task) string {
+	return `"` + task.ID.Hex() + "-" + strconv.FormatInt(task.UpdatedAt.UnixMilli(), 36) + `"`
+}
+
+// ResourceName é o nome da tarefa na coleção
+func ResourceName(task *entities.Task) string {
+	if task.CalDAV != nil && task.CalDAV.Name != "" {
+		return task.CalDAV.Name
+	}
+	return task.ID.Hex() + ".ics"
+}
+
+// ctag muda sempre que uma tarefa da coleção é criada, alterada ou removida
+func ctag(tasks []*entities.Task) string {
+	etags := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		etags = append(etags, ETag(task))
+	}
+	sort.Strings(etags)
+
+	sum := sha256.Sum256([]byte(strings.Join(etags, ",")))
+	return hex.EncodeToString(sum[:16])
+}
+
+// resolve identifica o recurso do caminho. Caminhos de outro usuário são tratados
+// como inexistentes.
+func (s *Server) resolve(user *entities.User, requestPath string) (*resource, error) {
+	rel, ok := strings.CutPrefix(requestPath, s.basePath)
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	var segments []string
+	for _, segment := range strings.Split(rel, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	if len(segments) == 0 {
+		return &resource{kind: kindRoot}, nil
+	}
+	if segments[0] != user.ID.Hex() {
+		return nil, ErrNotFound
+	}
+
+	switch {
+	case len(segments) == 1:
+		return &resource{kind: kindHome}, nil
+	case len(segments) == 2 && segments[1] == calendarSegment:
+		return &resource{kind: kindCalendar}, nil
+	case len(segments) == 3 && segments[1] == calendarSegment:
+		name, err := url.PathUnescape(segments[2])
+		if err != nil || name == "" || len(name) > maxResourceName {
+			return nil, ErrNotFound
+		}
+		return &resource{kind: kindObject, name: name}, nil
+	default:
+		return nil, ErrNotFound
+	}
+}
+
+// list retorna as tarefas expostas na coleção (as arquivadas ficam de fora)
+func (s *Server) list(ctx context.Context, userID primitive.ObjectID) ([]*entities.Task, error) {
+	all, err := s.todos.GetAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*entities.Task, 0, len(all))
+	for _, task := range all {
+		if !task.IsArchived {
+			tasks = append(tasks, task)
+		}
+	}
+
+	return tasks, nil
+}
+
+// get busca a tarefa pelo nome do recurso: {id}.ics ou o nome escolhido pelo cliente
+func (s *Server) get(ctx context.Context, userID primitive.ObjectID, name string) (*entities.Task, error) {
+	if id, err := primitive.ObjectIDFromHex(strings.TrimSuffix(name, ".ics")); err == nil {
+		task, err := s.todos.GetByID(ctx, userID, id)
+		if err == nil {
+			return task, nil
+		}
+		if !errors.Is(err, repositories.ErrTodoNotFound) {
+			return nil, err
+		}
+	}
+
+	task, err := s.todos.GetByCalDAVName(ctx, userID, name)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTodoNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return task, nil
+}
+
+func (s *Server) homeHref(user *entities.User) string {
+	return s.basePath + "/" + user.ID.Hex() + "/"
+}
+
+func (s *Server) calendarHref(user *entities.User) string {
+	return s.homeHref(user) + calendarSegment + "/"
+}
+
+func (s *Server) objectHref(user *entities.User, task *entities.Task) string {
+	return s.calendarHref(user) + url.PathEscape(ResourceName(task))
+}
+
+// privilegesXML concede ao dono todas as operações usadas pelos clientes
+const privilegesXML = "<d:privilege><d:all/></d:privilege><d:privilege><d:read/></d:privilege>" +
+	"<d:privilege><d:write/></d:privilege><d:privilege><d:write-content/></d:privilege>" +
+	"<d:privilege><d:bind/></d:privilege><d:privilege><d:unbind/></d:privilege>"
+
+// commonProps são as propriedades de descoberta válidas em qualquer recurso
+func (s *Server) commonProps(user *entities.User) []Property {
+	return []Property{
+		Href(PropCurrentUserPrincipal, s.homeHref(user)),
+		Raw(PropCurrentUserPrivileges, privilegesXML),
+	}
+}
+
+func (s *Server) rootProps(user *entities.User) []Property {
+	return append(s.commonProps(user),
+		Raw(PropResourceType, "<d:collection/>"),
+	)
+}
+
+func (s *Server) homeProps(user *entities.User) []Property {
+	return append(s.commonProps(user),
+		Raw(PropResourceType, "<d:collection/><d:principal/>"),
+		Text(PropDisplayName, user.Name),
+		Href(PropPrincipalURL, s.homeHref(user)),
+		Href(PropOwner, s.homeHref(user)),
+		Href(PropCalendarHomeSet, s.homeHref(user)),
+		Href(PropCalendarUserAddress, "mailto:"+user.Email),
+	)
+}
+
+func (s *Server) calendarProps(user *entities.User, tasks []*entities.Task) []Property {
+	tag := ctag(tasks)
+	return append(s.commonProps(user),
+		Raw(PropResourceType, "<d:collection/><c:calendar/>"),
+		Text(PropDisplayName, calendarDisplayName),
+		Href(PropOwner, s.homeHref(user)),
+		Raw(PropSupportedComponents, `<c:comp name="VTODO"/>`),
+		Raw(PropSupportedReportSet, "<d:supported-report><d:report><c:calendar-query/></d:report></d:supported-report>"+
+			"<d:supported-report><d:report><c:calendar-multiget/></d:report></d:supported-report>"),
+		Text(PropGetCTag, tag),
+		Text(PropGetETag, `"`+tag+`"`),
+	)
+}
+
+func (s *Server) objectProps(user *entities.User, task *entities.Task, withData bool) []Property {
+	props := append(s.commonProps(user),
+		Raw(PropResourceType, ""),
+		Text(PropGetETag, ETag(task)),
+		Text(PropGetContentType, icalContentType),
+		Text(PropGetLastModified, task.UpdatedAt.UTC().Format(http.TimeFormat)),
+	)
+	if withData {
+		props = append(props, Text(PropCalendarData, string(EncodeTask(task))))
+	}
+	return props
+}
+
+// addResponse inclui o recurso com as propriedades pedidas (ou todas, em allprop)
+func (s *Server) addResponse(ms *Multistatus, href string, available []Property, allProp bool, requested []Name) {
+	if allProp {
+		ms.Add(href, available, nil)
+		return
+	}
+
+	index := make(map[Name]Property, len(available))
+	for _, prop := range available {
+		index[prop.Name] = prop
+	}
+
+	var (
+		found   []Property
+		missing []Name
+	)
+	for _, name := range requested {
+		if prop, ok := index[name]; ok {
+			found = append(found, prop)
+		} else {
+			missing = append(missing, name)
+		}
+	}
+
+	ms.Add(href, found, missing)
+}
+
+// wants indica se a propriedade foi pedida
+func wants(props []Name, name Name) bool {
+	for _, prop := range props {
+		if prop == name {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceNameFromHref extrai o nome do recurso do href do calendar-multiget
+func resourceNameFromHref(href string) string {
+	if parsed, err := url.Parse(href); err == nil {
+		href = parsed.Path
+	}
+	return path.Base(href)
+}
@@ -0,0 +1,133 @@
+// Package calendar define os dias úteis: os dias da semana que são fim de
+// semana e os feriados de cada localidade (ex.: "pt-BR"), usados nos adiamentos
+// de tarefas e na expansão das recorrências.
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// ErrInvalidCalendar indica dia da semana ou feriado em formato não reconhecido
+var ErrInvalidCalendar = errors.New("calendário inválido")
+
+// weekdays são os nomes aceitos na configuração dos dias de fim de semana
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// Calendar é um calendário de dias úteis, imutável depois de criado
+type Calendar struct {
+	weekend [7]bool
+	// holidays guarda, por localidade, as datas YYYY-MM-DD (feriados de um ano)
+	// e MM-DD (feriados fixos, repetidos todo ano)
+	holidays map[string]map[string]bool
+}
+
+// current é o calendário usado pela aplicação (BUSINESS_WEEKEND_DAYS e BUSINESS_HOLIDAYS)
+var current atomic.Pointer[Calendar]
+
+func init() {
+	current.Store(&Calendar{weekend: [7]bool{time.Saturday: true, time.Sunday: true}})
+}
+
+// SetDefault substitui o calendário da aplicação
+func SetDefault(c *Calendar) {
+	if c != nil {
+		current.Store(c)
+	}
+}
+
+// Default retorna o calendário da aplicação (sábado e domingo sem feriados,
+// se não configurado)
+func Default() *Calendar {
+	return current.Load()
+}
+
+// New cria o calendário com os dias de fim de semana (ex.: "saturday") e os
+// feriados no formato "localidade:YYYY-MM-DD" ou "localidade:MM-DD" (todo ano)
+func New(weekend []string, holidays []string) (*Calendar, error) {
+	c := &Calendar{holidays: make(map[string]map[string]bool)}
+
+	for _, name := range weekend {
+		day, ok := weekdays[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return nil, fmt.Errorf("%w: dia da semana %q", ErrInvalidCalendar, name)
+		}
+		c.weekend[day] = true
+	}
+	if c.weekend == [7]bool{true, true, true, true, true, true, true} {
+		return nil, fmt.Errorf("%w: a semana precisa de ao menos um dia útil", ErrInvalidCalendar)
+	}
+
+	for _, holiday := range holidays {
+		locale, date, ok := strings.Cut(holiday, ":")
+		if !ok || locale == "" || !validHoliday(date) {
+			return nil, fmt.Errorf("%w: feriado %q (use localidade:YYYY-MM-DD ou localidade:MM-DD)", ErrInvalidCalendar, holiday)
+		}
+
+		locale = normalizeLocale(locale)
+		if c.holidays[locale] == nil {
+			c.holidays[locale] = make(map[string]bool)
+		}
+		c.holidays[locale][date] = true
+	}
+
+	return c, nil
+}
+
+// IsBusinessDay verifica se a data (no fuso de t) não é fim de semana nem
+// feriado da localidade ("" considera apenas o fim de semana)
+func (c *Calendar) IsBusinessDay(t time.Time, locale string) bool {
+	if c.weekend[t.Weekday()] {
+		return false
+	}
+
+	dates := c.holidays[normalizeLocale(locale)]
+	return !dates[t.Format("2006-01-02")] && !dates[t.Format("01-02")]
+}
+
+// NextBusinessDay retorna o primeiro dia útil depois da data de t, mantendo
+// a hora de t no mesmo fuso
+func (c *Calendar) NextBusinessDay(t time.Time, locale string) time.Time {
+	return c.Roll(addDays(t, 1), locale)
+}
+
+// Roll retorna t se a data for dia útil, senão o próximo dia útil na mesma hora
+func (c *Calendar) Roll(t time.Time, locale string) time.Time {
+	// O limite evita laço infinito se os feriados cobrirem todos os dias úteis
+	for i := 0; i < 366*2 && !c.IsBusinessDay(t, locale); i++ {
+		t = addDays(t, 1)
+	}
+	return t
+}
+
+// addDays soma dias de calendário, preservando a hora local nas mudanças de
+// horário de verão
+func addDays(t time.Time, days int) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day()+days, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// validHoliday aceita YYYY-MM-DD ou MM-DD
+func validHoliday(date string) bool {
+	if _, err := time.Parse("2006-01-02", date); err == nil {
+		return true
+	}
+	// Ano bissexto para aceitar 02-29
+	_, err := time.Parse("2006-01-02", "2000-"+date)
+	return err == nil && len(date) == len("01-02")
+}
+
+// normalizeLocale compara localidades sem diferenciar maiúsculas e "_" de "-"
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
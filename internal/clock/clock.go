@@ -0,0 +1,56 @@
+// Package clock abstrai a hora atual, para que regras que dependem do tempo
+// (conclusão, atraso, cache) possam ser exercitadas com um horário fixo.
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock fornece a hora atual
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock usa a hora do sistema
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// System retorna o relógio do sistema
+func System() Clock {
+	return systemClock{}
+}
+
+// Frozen é um relógio parado, que só avança quando alterado. Usado em testes.
+type Frozen struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFrozen cria um relógio parado no instante informado
+func NewFrozen(now time.Time) *Frozen {
+	return &Frozen{now: now}
+}
+
+func (f *Frozen) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Set altera o instante do relógio
+func (f *Frozen) Set(now time.Time) {
+	f.mu.Lock()
+	f.now = now
+	f.mu.Unlock()
+}
+
+// Advance avança o relógio pela duração informada
+func (f *Frozen) Advance(d time.Duration) {
+	f.mu.Lock()
+	f.now = f.now.Add(d)
+	f.mu.Unlock()
+}
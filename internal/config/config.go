@@ -1,36 +1,699 @@
 package config
 
 import (
-	"log"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/mail"
 	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/devgugga/todo-it/internal/calendar"
+	"github.com/devgugga/todo-it/internal/fieldcrypt"
 	"github.com/joho/godotenv"
 )
 
+// secretLookupTimeout limita a consulta de cada valor aos secret providers
+const secretLookupTimeout = 10 * time.Second
+
+// telegramSecretPattern são os caracteres aceitos pelo Telegram no secret_token do webhook
+var telegramSecretPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,256}$`)
+
 type Config struct {
-	MongoURI    string
-	MongoDBName string
-	Port        string
+	// Servidor HTTP
+	Port                 string
+	ServerReadTimeout    time.Duration
+	ServerWriteTimeout   time.Duration
+	ServerIdleTimeout    time.Duration
+	BodyLimit            int
+	JSONBodyLimit        int
+	UploadBodyLimit      int
+	RequestTimeout       time.Duration
+	ExportRequestTimeout time.Duration
+	PaginationMaxLimit   int
+	BulkMaxIDs           int
+	CORSAllowOrigins     []string
+	CORSAllowMethods     []string
+	CORSAllowHeaders     []string
+	CORSAllowCredentials bool
+	TLSCertFile          string
+	TLSKeyFile           string
+	TLSAutocertDomains   []string
+	TLSAutocertEmail     string
+	TLSAutocertCacheDir  string
+	HTTPRedirectPort     string
+	ShutdownTimeout      time.Duration
+	WebUIEnabled         bool
+	StaticDir            string
+	StaticSPAFallback    bool
+	StaticImmutablePaths []string
+	ReadinessRequired    []string
+
+	// Limite de requisições por cliente: requisições por minuto e rajada de
+	// cada classe de rota (autenticação, leitura, escrita e lote)
+	RateLimitEnabled        bool
+	RateLimitAuthPerMinute  int
+	RateLimitAuthBurst      int
+	RateLimitReadPerMinute  int
+	RateLimitReadBurst      int
+	RateLimitWritePerMinute int
+	RateLimitWriteBurst     int
+	RateLimitBulkPerMinute  int
+	RateLimitBulkBurst      int
+
+	// MongoDB
+	MongoURI                    string
+	MongoDBName                 string
+	MongoMaxPoolSize            int
+	MongoMinPoolSize            int
+	MongoMaxConnIdleTime        time.Duration
+	MongoConnectTimeout         time.Duration
+	MongoPingTimeout            time.Duration
+	MongoServerSelectionTimeout time.Duration
+	MongoReadTimeout            time.Duration
+	MongoWriteTimeout           time.Duration
+	MongoAggregateTimeout       time.Duration
+
+	// Índice do Atlas Search usado na busca de tarefas ("" = índice de texto
+	// padrão). Sem o índice pronto no cluster a busca também usa o padrão.
+	AtlasSearchIndex string
+
+	RedisURL              string
+	LogLevel              string
+	LogFormat             string
+	DebugBodyLogging      bool
+	DebugBodySampleRate   float64
+	DebugBodyMaxBytes     int
+	ErrorReportURL        string
+	AdminToken            string
+	MetricsToken          string
+	JWTSecret             string
+	JWTExpiration         time.Duration
+	SignedURLTTL          time.Duration
+	ArchiveEnabled        bool
+	ArchiveAfterMonths    int
+	ArchiveInterval       time.Duration
+	NotificationsInterval time.Duration
+	PushGatewayURL        string
+	SMTPHost              string
+	SMTPPort              int
+	SMTPUsername          string
+	SMTPPassword          string
+	SMTPTLS               string
+	MailFrom              string
+	MailFromName          string
+	MailDryRun            bool
+	AuditRetentionDays    int
+	AccountClosureMode    string
+	MaintenanceMode       string
+	MaintenanceRetryAfter time.Duration
+	TelegramBotToken      string
+	TelegramBotUsername   string
+	TelegramWebhookURL    string
+	TelegramWebhookSecret string
+
+	// Email de entrada (criação de tarefas por email)
+	InboundEmailAddress            string
+	InboundEmailWebhookToken       string
+	InboundEmailMaxAttachmentBytes int
+	IMAPAddr                       string
+	IMAPUsername                   string
+	IMAPPassword                   string
+	IMAPMailbox                    string
+	IMAPTLS                        bool
+	IMAPPollInterval               time.Duration
+
+	// Sincronização de issues do GitHub
+	GitHubClientID      string
+	GitHubClientSecret  string
+	GitHubRedirectURL   string
+	GitHubWebhookURL    string
+	GitHubWebhookSecret string
+
+	// Lembretes por SMS via Twilio
+	TwilioAccountSID string
+	TwilioAuthToken  string
+	TwilioFromNumber string
+	// SMSMonthlyLimit é a cota do plano free e ProSMSMonthlyLimit a do plano pro
+	SMSMonthlyLimit    int
+	ProSMSMonthlyLimit int
+
+	// Assinatura do plano pro via Stripe (desativada sem STRIPE_SECRET_KEY)
+	StripeSecretKey     string
+	StripeWebhookSecret string
+	StripeProPriceID    string
+	StripeSuccessURL    string
+	StripeCancelURL     string
+
+	// Retenção de dados em dias (0 mantém para sempre), aplicada pelo job
+	// retention junto com AUDIT_RETENTION_DAYS
+	RetentionCompletedTasksDays int
+	RetentionNotificationsDays  int
+	RetentionInterval           time.Duration
+
+	// Eleição de líder entre réplicas: só a líder executa os jobs agendados, e
+	// outra assume quando o lease não é renovado dentro deste prazo
+	LeaderLeaseTTL time.Duration
+
+	// Lembretes de vencimento: tarefas que vencem dentro de DueSoonWindow geram
+	// uma notificação task.due_soon, verificadas a cada DueSoonInterval
+	DueSoonWindow   time.Duration
+	DueSoonInterval time.Duration
+
+	// Resumo diário das tarefas atrasadas (opt-in nas preferências), enviado a
+	// partir de OverdueSummaryHour no fuso de cada usuário
+	OverdueSummaryHour     int
+	OverdueSummaryInterval time.Duration
+
+	// Dias úteis usados no adiamento para o próximo dia útil e nas recorrências
+	// que pulam fins de semana: dias de fim de semana e feriados no formato
+	// localidade:YYYY-MM-DD ou localidade:MM-DD (todo ano)
+	BusinessWeekendDays []string
+	BusinessHolidays    []string
+
+	// Criptografia da descrição e dos anexos das tarefas (chaves AES-256 em
+	// base64; as anteriores só decifram, durante a rotação)
+	FieldEncryptionKey          string
+	FieldEncryptionPreviousKeys []string
+
+	// effective guarda os valores efetivos por variável (usado em "config print")
+	effective map[string]interface{}
 }
 
-func LoadConfig() *Config {
+// LoadConfig carrega as configurações do arquivo informado (YAML ou TOML,
+// opcional) sobrepostas pelas variáveis de ambiente (e do .env).
+// Valores que não podem ser interpretados ou fora dos limites aceitos
+// resultam em erro, para que a aplicação não suba com configuração inválida.
+func LoadConfig(path string) (*Config, error) {
 	err := godotenv.Load(".env")
 	if err != nil {
-		log.Println("Arquivo .env não encontrado, usando variáveis de ambiente do sistema")
+		slog.Info("arquivo .env não encontrado, usando variáveis de ambiente do sistema")
+	}
+
+	secrets, err := secretProviders()
+	if err != nil {
+		return nil, err
+	}
+
+	env := &envLoader{
+		secrets:   secrets,
+		effective: make(map[string]interface{}),
+	}
+
+	if path != "" {
+		if env.file, err = readConfigFile(path); err != nil {
+			return nil, err
+		}
 	}
 
 	config := &Config{
-		MongoURI:    getEnv("MONGO_URI", "mongodb://localhost:27017"),
-		MongoDBName: getEnv("MONGO_DB_NAME", "todo_db"),
-		Port:        getEnv("PORT", "8080"),
+		Port:                 env.getEnv("PORT", "8080"),
+		ServerReadTimeout:    env.getEnvDuration("SERVER_READ_TIMEOUT", 15*time.Second),
+		ServerWriteTimeout:   env.getEnvDuration("SERVER_WRITE_TIMEOUT", 15*time.Second),
+		ServerIdleTimeout:    env.getEnvDuration("SERVER_IDLE_TIMEOUT", 60*time.Second),
+		BodyLimit:            env.getEnvInt("BODY_LIMIT", 2*1024*1024),
+		JSONBodyLimit:        env.getEnvInt("JSON_BODY_LIMIT", 512*1024),
+		UploadBodyLimit:      env.getEnvInt("UPLOAD_BODY_LIMIT", 25*1024*1024),
+		RequestTimeout:       env.getEnvDuration("REQUEST_TIMEOUT", 15*time.Second),
+		ExportRequestTimeout: env.getEnvDuration("EXPORT_REQUEST_TIMEOUT", 2*time.Minute),
+		PaginationMaxLimit:   env.getEnvInt("PAGINATION_MAX_LIMIT", 100),
+		BulkMaxIDs:           env.getEnvInt("BULK_MAX_IDS", 100),
+		CORSAllowOrigins:     env.getEnvList("CORS_ALLOW_ORIGINS", []string{"*"}),
+		CORSAllowMethods:     env.getEnvList("CORS_ALLOW_METHODS", []string{"GET", "POST", "HEAD", "PUT", "DELETE", "PATCH", "OPTIONS"}),
+		CORSAllowHeaders:     env.getEnvList("CORS_ALLOW_HEADERS", []string{"Origin", "Content-Type", "Accept", "Authorization"}),
+		CORSAllowCredentials: env.getEnvBool("CORS_ALLOW_CREDENTIALS", false),
+		TLSCertFile:          env.getEnv("TLS_CERT_FILE", ""),
+		TLSKeyFile:           env.getEnv("TLS_KEY_FILE", ""),
+		TLSAutocertDomains:   env.getEnvList("TLS_AUTOCERT_DOMAINS", nil),
+		TLSAutocertEmail:     env.getEnv("TLS_AUTOCERT_EMAIL", ""),
+		TLSAutocertCacheDir:  env.getEnv("TLS_AUTOCERT_CACHE_DIR", "./certs"),
+		HTTPRedirectPort:     env.getEnv("HTTP_REDIRECT_PORT", ""),
+		ShutdownTimeout:      env.getEnvDuration("SHUTDOWN_TIMEOUT", 30*time.Second),
+		WebUIEnabled:         env.getEnvBool("WEB_UI_ENABLED", true),
+		StaticDir:            env.getEnv("STATIC_DIR", ""),
+		StaticSPAFallback:    env.getEnvBool("STATIC_SPA_FALLBACK", true),
+		StaticImmutablePaths: env.getEnvList("STATIC_IMMUTABLE_PATHS", []string{"/assets/", "/static/"}),
+		ReadinessRequired:    env.getEnvList("READINESS_REQUIRED", []string{"mongo"}),
+
+		RateLimitEnabled:        env.getEnvBool("RATE_LIMIT_ENABLED", true),
+		RateLimitAuthPerMinute:  env.getEnvInt("RATE_LIMIT_AUTH_PER_MINUTE", 10),
+		RateLimitAuthBurst:      env.getEnvInt("RATE_LIMIT_AUTH_BURST", 5),
+		RateLimitReadPerMinute:  env.getEnvInt("RATE_LIMIT_READ_PER_MINUTE", 600),
+		RateLimitReadBurst:      env.getEnvInt("RATE_LIMIT_READ_BURST", 120),
+		RateLimitWritePerMinute: env.getEnvInt("RATE_LIMIT_WRITE_PER_MINUTE", 120),
+		RateLimitWriteBurst:     env.getEnvInt("RATE_LIMIT_WRITE_BURST", 30),
+		RateLimitBulkPerMinute:  env.getEnvInt("RATE_LIMIT_BULK_PER_MINUTE", 6),
+		RateLimitBulkBurst:      env.getEnvInt("RATE_LIMIT_BULK_BURST", 3),
+
+		MongoURI:                    env.getEnv("MONGO_URI", "mongodb://localhost:27017"),
+		MongoDBName:                 env.getEnv("MONGO_DB_NAME", "todo_db"),
+		MongoMaxPoolSize:            env.getEnvInt("MONGO_MAX_POOL_SIZE", 20),
+		MongoMinPoolSize:            env.getEnvInt("MONGO_MIN_POOL_SIZE", 1),
+		MongoMaxConnIdleTime:        env.getEnvDuration("MONGO_MAX_CONN_IDLE_TIME", 30*time.Second),
+		MongoConnectTimeout:         env.getEnvDuration("MONGO_CONNECT_TIMEOUT", 10*time.Second),
+		MongoPingTimeout:            env.getEnvDuration("MONGO_PING_TIMEOUT", 5*time.Second),
+		MongoServerSelectionTimeout: env.getEnvDuration("MONGO_SERVER_SELECTION_TIMEOUT", 5*time.Second),
+		MongoReadTimeout:            env.getEnvDuration("MONGO_READ_TIMEOUT", 5*time.Second),
+		MongoWriteTimeout:           env.getEnvDuration("MONGO_WRITE_TIMEOUT", 5*time.Second),
+		MongoAggregateTimeout:       env.getEnvDuration("MONGO_AGGREGATE_TIMEOUT", 10*time.Second),
+
+		AtlasSearchIndex: env.getEnv("ATLAS_SEARCH_INDEX", ""),
+
+		RedisURL:              env.getEnv("REDIS_URL", ""),
+		LogLevel:              env.getEnv("LOG_LEVEL", "info"),
+		LogFormat:             env.getEnv("LOG_FORMAT", "json"),
+		DebugBodyLogging:      env.getEnvBool("DEBUG_BODY_LOGGING", false),
+		DebugBodySampleRate:   env.getEnvFloat("DEBUG_BODY_SAMPLE_RATE", 0.1),
+		DebugBodyMaxBytes:     env.getEnvInt("DEBUG_BODY_MAX_BYTES", 4096),
+		ErrorReportURL:        env.getEnv("ERROR_REPORT_URL", ""),
+		AdminToken:            env.getEnv("ADMIN_TOKEN", ""),
+		MetricsToken:          env.getEnv("METRICS_TOKEN", ""),
+		JWTSecret:             env.getEnv("JWT_SECRET", ""),
+		JWTExpiration:         env.getEnvDuration("JWT_EXPIRATION", 24*time.Hour),
+		SignedURLTTL:          env.getEnvDuration("SIGNED_URL_TTL", 5*time.Minute),
+		ArchiveEnabled:        env.getEnvBool("ARCHIVE_ENABLED", true),
+		ArchiveAfterMonths:    env.getEnvInt("ARCHIVE_AFTER_MONTHS", 6),
+		ArchiveInterval:       env.getEnvDuration("ARCHIVE_INTERVAL", 24*time.Hour),
+		NotificationsInterval: env.getEnvDuration("NOTIFICATIONS_DISPATCH_INTERVAL", time.Minute),
+		PushGatewayURL:        env.getEnv("PUSH_GATEWAY_URL", "https://ntfy.sh"),
+		SMTPHost:              env.getEnv("SMTP_HOST", ""),
+		SMTPPort:              env.getEnvInt("SMTP_PORT", 587),
+		SMTPUsername:          env.getEnv("SMTP_USERNAME", ""),
+		SMTPPassword:          env.getEnv("SMTP_PASSWORD", ""),
+		SMTPTLS:               env.getEnv("SMTP_TLS", "starttls"),
+		MailFrom:              env.getEnv("MAIL_FROM", "no-reply@todo-it.local"),
+		MailFromName:          env.getEnv("MAIL_FROM_NAME", "Todo It"),
+		MailDryRun:            env.getEnvBool("MAIL_DRY_RUN", false),
+		AuditRetentionDays:    env.getEnvInt("AUDIT_RETENTION_DAYS", 365),
+		AccountClosureMode:    env.getEnv("ACCOUNT_CLOSURE_MODE", "deactivate"),
+		MaintenanceMode:       env.getEnv("MAINTENANCE_MODE", "off"),
+		MaintenanceRetryAfter: env.getEnvDuration("MAINTENANCE_RETRY_AFTER", 5*time.Minute),
+		TelegramBotToken:      env.getEnv("TELEGRAM_BOT_TOKEN", ""),
+		TelegramBotUsername:   env.getEnv("TELEGRAM_BOT_USERNAME", ""),
+		TelegramWebhookURL:    env.getEnv("TELEGRAM_WEBHOOK_URL", ""),
+		TelegramWebhookSecret: env.getEnv("TELEGRAM_WEBHOOK_SECRET", ""),
+
+		InboundEmailAddress:            env.getEnv("INBOUND_EMAIL_ADDRESS", ""),
+		InboundEmailWebhookToken:       env.getEnv("INBOUND_EMAIL_WEBHOOK_TOKEN", ""),
+		InboundEmailMaxAttachmentBytes: env.getEnvInt("INBOUND_EMAIL_MAX_ATTACHMENT_BYTES", 10*1024*1024),
+		IMAPAddr:                       env.getEnv("IMAP_ADDR", ""),
+		IMAPUsername:                   env.getEnv("IMAP_USERNAME", ""),
+		IMAPPassword:                   env.getEnv("IMAP_PASSWORD", ""),
+		IMAPMailbox:                    env.getEnv("IMAP_MAILBOX", "INBOX"),
+		IMAPTLS:                        env.getEnvBool("IMAP_TLS", true),
+		IMAPPollInterval:               env.getEnvDuration("IMAP_POLL_INTERVAL", time.Minute),
+
+		GitHubClientID:      env.getEnv("GITHUB_CLIENT_ID", ""),
+		GitHubClientSecret:  env.getEnv("GITHUB_CLIENT_SECRET", ""),
+		GitHubRedirectURL:   env.getEnv("GITHUB_REDIRECT_URL", ""),
+		GitHubWebhookURL:    env.getEnv("GITHUB_WEBHOOK_URL", ""),
+		GitHubWebhookSecret: env.getEnv("GITHUB_WEBHOOK_SECRET", ""),
+
+		TwilioAccountSID:   env.getEnv("TWILIO_ACCOUNT_SID", ""),
+		TwilioAuthToken:    env.getEnv("TWILIO_AUTH_TOKEN", ""),
+		TwilioFromNumber:   env.getEnv("TWILIO_FROM_NUMBER", ""),
+		SMSMonthlyLimit:    env.getEnvInt("SMS_MONTHLY_LIMIT", 30),
+		ProSMSMonthlyLimit: env.getEnvInt("PRO_SMS_MONTHLY_LIMIT", 300),
+
+		StripeSecretKey:     env.getEnv("STRIPE_SECRET_KEY", ""),
+		StripeWebhookSecret: env.getEnv("STRIPE_WEBHOOK_SECRET", ""),
+		StripeProPriceID:    env.getEnv("STRIPE_PRO_PRICE_ID", ""),
+		StripeSuccessURL:    env.getEnv("STRIPE_SUCCESS_URL", ""),
+		StripeCancelURL:     env.getEnv("STRIPE_CANCEL_URL", ""),
+
+		RetentionCompletedTasksDays: env.getEnvInt("RETENTION_COMPLETED_TASKS_DAYS", 0),
+		RetentionNotificationsDays:  env.getEnvInt("RETENTION_NOTIFICATIONS_DAYS", 0),
+		RetentionInterval:           env.getEnvDuration("RETENTION_INTERVAL", 24*time.Hour),
+
+		LeaderLeaseTTL: env.getEnvDuration("LEADER_LEASE_TTL", 15*time.Second),
+
+		DueSoonWindow:   env.getEnvDuration("DUE_SOON_WINDOW", 24*time.Hour),
+		DueSoonInterval: env.getEnvDuration("DUE_SOON_INTERVAL", 15*time.Minute),
+
+		OverdueSummaryHour:     env.getEnvInt("OVERDUE_SUMMARY_HOUR", 8),
+		OverdueSummaryInterval: env.getEnvDuration("OVERDUE_SUMMARY_INTERVAL", 15*time.Minute),
+
+		BusinessWeekendDays: env.getEnvList("BUSINESS_WEEKEND_DAYS", []string{"saturday", "sunday"}),
+		BusinessHolidays:    env.getEnvList("BUSINESS_HOLIDAYS", nil),
+
+		FieldEncryptionKey:          env.getEnv("FIELD_ENCRYPTION_KEY", ""),
+		FieldEncryptionPreviousKeys: env.getEnvList("FIELD_ENCRYPTION_PREVIOUS_KEYS", nil),
+	}
+
+	config.effective = env.effective
+
+	if err := errors.Join(append(env.errs, config.validate()...)...); err != nil {
+		return nil, fmt.Errorf("configuração inválida:\n%w", err)
+	}
+
+	if config.JWTSecret == "" {
+		slog.Warn("JWT_SECRET não definido, gerando segredo temporário (tokens serão invalidados ao reiniciar)")
+		config.JWTSecret = randomSecret()
+	}
+
+	return config, nil
+}
+
+// validate verifica limites e combinações de valores
+func (c *Config) validate() []error {
+	var errs []error
+
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	port, err := strconv.Atoi(c.Port)
+	check(err == nil && port > 0 && port <= 65535, "PORT deve ser uma porta válida (recebido %q)", c.Port)
+
+	positiveDurations := []struct {
+		key   string
+		value time.Duration
+	}{
+		{"SERVER_READ_TIMEOUT", c.ServerReadTimeout},
+		{"SERVER_WRITE_TIMEOUT", c.ServerWriteTimeout},
+		{"SERVER_IDLE_TIMEOUT", c.ServerIdleTimeout},
+		{"REQUEST_TIMEOUT", c.RequestTimeout},
+		{"EXPORT_REQUEST_TIMEOUT", c.ExportRequestTimeout},
+		{"SHUTDOWN_TIMEOUT", c.ShutdownTimeout},
+		{"MONGO_MAX_CONN_IDLE_TIME", c.MongoMaxConnIdleTime},
+		{"MONGO_CONNECT_TIMEOUT", c.MongoConnectTimeout},
+		{"MONGO_PING_TIMEOUT", c.MongoPingTimeout},
+		{"MONGO_SERVER_SELECTION_TIMEOUT", c.MongoServerSelectionTimeout},
+		{"MONGO_READ_TIMEOUT", c.MongoReadTimeout},
+		{"MONGO_WRITE_TIMEOUT", c.MongoWriteTimeout},
+		{"MONGO_AGGREGATE_TIMEOUT", c.MongoAggregateTimeout},
+		{"JWT_EXPIRATION", c.JWTExpiration},
+		{"SIGNED_URL_TTL", c.SignedURLTTL},
+		{"ARCHIVE_INTERVAL", c.ArchiveInterval},
+		{"NOTIFICATIONS_DISPATCH_INTERVAL", c.NotificationsInterval},
+		{"IMAP_POLL_INTERVAL", c.IMAPPollInterval},
+		{"RETENTION_INTERVAL", c.RetentionInterval},
+		{"LEADER_LEASE_TTL", c.LeaderLeaseTTL},
+		{"DUE_SOON_WINDOW", c.DueSoonWindow},
+		{"DUE_SOON_INTERVAL", c.DueSoonInterval},
+		{"OVERDUE_SUMMARY_INTERVAL", c.OverdueSummaryInterval},
+	}
+	for _, d := range positiveDurations {
+		check(d.value > 0, "%s deve ser maior que zero", d.key)
+	}
+
+	check(c.BodyLimit > 0, "BODY_LIMIT deve ser maior que zero")
+	check(c.JSONBodyLimit > 0, "JSON_BODY_LIMIT deve ser maior que zero")
+	check(c.UploadBodyLimit > 0, "UPLOAD_BODY_LIMIT deve ser maior que zero")
+	check(c.MongoMaxPoolSize > 0, "MONGO_MAX_POOL_SIZE deve ser maior que zero")
+	check(c.MongoMinPoolSize >= 0 && c.MongoMinPoolSize <= c.MongoMaxPoolSize,
+		"MONGO_MIN_POOL_SIZE deve estar entre 0 e MONGO_MAX_POOL_SIZE")
+	check(c.MongoURI != "", "MONGO_URI é obrigatório")
+	check(c.MongoDBName != "", "MONGO_DB_NAME é obrigatório")
+
+	check(len(c.CORSAllowOrigins) > 0, "CORS_ALLOW_ORIGINS não pode ser vazio")
+	check(!c.CORSAllowCredentials || !contains(c.CORSAllowOrigins, "*"),
+		"CORS_ALLOW_CREDENTIALS não pode ser usado com CORS_ALLOW_ORIGINS=*")
+
+	check((c.TLSCertFile == "") == (c.TLSKeyFile == ""), "TLS_CERT_FILE e TLS_KEY_FILE devem ser definidos juntos")
+	check(c.TLSCertFile == "" || len(c.TLSAutocertDomains) == 0,
+		"use TLS_CERT_FILE/TLS_KEY_FILE ou TLS_AUTOCERT_DOMAINS, não ambos")
+	check(len(c.TLSAutocertDomains) == 0 || c.TLSAutocertCacheDir != "", "TLS_AUTOCERT_CACHE_DIR é obrigatório com autocert")
+	if c.HTTPRedirectPort != "" {
+		redirectPort, err := strconv.Atoi(c.HTTPRedirectPort)
+		check(err == nil && redirectPort > 0 && redirectPort <= 65535 && c.HTTPRedirectPort != c.Port,
+			"HTTP_REDIRECT_PORT deve ser uma porta válida diferente de PORT")
+	}
+
+	if c.StaticDir != "" {
+		info, err := os.Stat(c.StaticDir)
+		check(err == nil && info.IsDir(), "STATIC_DIR deve ser um diretório existente (recebido %q)", c.StaticDir)
+	}
+	for _, prefix := range c.StaticImmutablePaths {
+		check(strings.HasPrefix(prefix, "/"), "STATIC_IMMUTABLE_PATHS deve conter caminhos iniciados por / (recebido %q)", prefix)
+	}
+
+	for _, dependency := range c.ReadinessRequired {
+		switch dependency {
+		case "mongo", "storage", "jobs":
+		case "redis":
+			check(c.RedisURL != "", "READINESS_REQUIRED com redis requer REDIS_URL")
+		case "smtp":
+			check(c.SMTPHost != "" && !c.MailDryRun, "READINESS_REQUIRED com smtp requer SMTP_HOST (sem MAIL_DRY_RUN)")
+		default:
+			check(false, "READINESS_REQUIRED aceita mongo, redis, smtp, storage e jobs (recebido %q)", dependency)
+		}
+	}
+
+	check(c.PaginationMaxLimit >= 1 && c.PaginationMaxLimit <= 1000, "PAGINATION_MAX_LIMIT deve estar entre 1 e 1000")
+	check(c.BulkMaxIDs >= 1 && c.BulkMaxIDs <= 1000, "BULK_MAX_IDS deve estar entre 1 e 1000")
+
+	rateLimits := []struct {
+		key   string
+		value int
+	}{
+		{"RATE_LIMIT_AUTH_PER_MINUTE", c.RateLimitAuthPerMinute},
+		{"RATE_LIMIT_AUTH_BURST", c.RateLimitAuthBurst},
+		{"RATE_LIMIT_READ_PER_MINUTE", c.RateLimitReadPerMinute},
+		{"RATE_LIMIT_READ_BURST", c.RateLimitReadBurst},
+		{"RATE_LIMIT_WRITE_PER_MINUTE", c.RateLimitWritePerMinute},
+		{"RATE_LIMIT_WRITE_BURST", c.RateLimitWriteBurst},
+		{"RATE_LIMIT_BULK_PER_MINUTE", c.RateLimitBulkPerMinute},
+		{"RATE_LIMIT_BULK_BURST", c.RateLimitBulkBurst},
+	}
+	for _, limit := range rateLimits {
+		check(limit.value > 0, "%s deve ser maior que zero", limit.key)
+	}
+
+	check(c.LogFormat == "json" || c.LogFormat == "text", "LOG_FORMAT deve ser json ou text")
+	check(c.DebugBodySampleRate >= 0 && c.DebugBodySampleRate <= 1, "DEBUG_BODY_SAMPLE_RATE deve estar entre 0 e 1")
+	check(c.ArchiveAfterMonths > 0, "ARCHIVE_AFTER_MONTHS deve ser maior que zero")
+	check(c.OverdueSummaryHour >= 0 && c.OverdueSummaryHour <= 23, "OVERDUE_SUMMARY_HOUR deve estar entre 0 e 23")
+	_, err = c.BusinessCalendar()
+	check(err == nil, "BUSINESS_WEEKEND_DAYS/BUSINESS_HOLIDAYS: %v", err)
+	check(c.AuditRetentionDays >= 0, "AUDIT_RETENTION_DAYS não pode ser negativo")
+	check(c.AccountClosureMode == "deactivate" || c.AccountClosureMode == "anonymize",
+		"ACCOUNT_CLOSURE_MODE deve ser deactivate ou anonymize")
+	check(c.RetentionCompletedTasksDays >= 0, "RETENTION_COMPLETED_TASKS_DAYS não pode ser negativo")
+	check(c.RetentionNotificationsDays >= 0, "RETENTION_NOTIFICATIONS_DAYS não pode ser negativo")
+	check(c.MaintenanceMode == "off" || c.MaintenanceMode == "read_only" || c.MaintenanceMode == "full",
+		"MAINTENANCE_MODE deve ser off, read_only ou full")
+	check(c.MaintenanceRetryAfter >= 0, "MAINTENANCE_RETRY_AFTER não pode ser negativo")
+	check(c.TelegramBotToken == "" || telegramSecretPattern.MatchString(c.TelegramWebhookSecret),
+		"TELEGRAM_WEBHOOK_SECRET é obrigatório com TELEGRAM_BOT_TOKEN (1 a 256 caracteres: letras, números, _ ou -)")
+	check(c.TelegramWebhookURL == "" || strings.HasPrefix(c.TelegramWebhookURL, "https://"),
+		"TELEGRAM_WEBHOOK_URL deve usar https")
+	if c.InboundEmailAddress != "" {
+		address, err := mail.ParseAddress(c.InboundEmailAddress)
+		check(err == nil && address.Name == "" && !strings.Contains(address.Address, "+"),
+			"INBOUND_EMAIL_ADDRESS deve ser um endereço simples, sem \"+\" (recebido %q)", c.InboundEmailAddress)
+	}
+	check(c.InboundEmailMaxAttachmentBytes > 0, "INBOUND_EMAIL_MAX_ATTACHMENT_BYTES deve ser maior que zero")
+	check(c.IMAPAddr == "" || c.InboundEmailAddress != "", "IMAP_ADDR requer INBOUND_EMAIL_ADDRESS")
+	check(c.IMAPAddr == "" || c.IMAPUsername != "", "IMAP_ADDR requer IMAP_USERNAME")
+	if c.GitHubClientID != "" {
+		check(c.GitHubClientSecret != "", "GITHUB_CLIENT_SECRET é obrigatório com GITHUB_CLIENT_ID")
+		check(c.GitHubRedirectURL != "", "GITHUB_REDIRECT_URL é obrigatório com GITHUB_CLIENT_ID")
+	}
+	check(c.ErrorReportURL == "" || strings.HasPrefix(c.ErrorReportURL, "https://") || strings.HasPrefix(c.ErrorReportURL, "http://"),
+		"ERROR_REPORT_URL deve ser uma URL http(s)")
+	check(c.GitHubWebhookURL == "" || strings.HasPrefix(c.GitHubWebhookURL, "https://"),
+		"GITHUB_WEBHOOK_URL deve usar https")
+	check(c.GitHubWebhookURL == "" || len(c.GitHubWebhookSecret) >= 16,
+		"GITHUB_WEBHOOK_SECRET deve ter ao menos 16 caracteres quando GITHUB_WEBHOOK_URL é definido")
+	if c.TwilioAccountSID != "" {
+		check(c.TwilioAuthToken != "", "TWILIO_AUTH_TOKEN é obrigatório com TWILIO_ACCOUNT_SID")
+		check(c.TwilioFromNumber != "", "TWILIO_FROM_NUMBER é obrigatório com TWILIO_ACCOUNT_SID")
+	}
+	check(c.SMSMonthlyLimit > 0, "SMS_MONTHLY_LIMIT deve ser maior que zero")
+	check(c.ProSMSMonthlyLimit > 0, "PRO_SMS_MONTHLY_LIMIT deve ser maior que zero")
+	if c.StripeSecretKey != "" {
+		check(c.StripeWebhookSecret != "", "STRIPE_WEBHOOK_SECRET é obrigatório com STRIPE_SECRET_KEY")
+		check(c.StripeProPriceID != "", "STRIPE_PRO_PRICE_ID é obrigatório com STRIPE_SECRET_KEY")
+		check(strings.HasPrefix(c.StripeSuccessURL, "https://") || strings.HasPrefix(c.StripeSuccessURL, "http://"),
+			"STRIPE_SUCCESS_URL deve ser uma URL http(s) quando STRIPE_SECRET_KEY é definido")
+		check(strings.HasPrefix(c.StripeCancelURL, "https://") || strings.HasPrefix(c.StripeCancelURL, "http://"),
+			"STRIPE_CANCEL_URL deve ser uma URL http(s) quando STRIPE_SECRET_KEY é definido")
+	}
+	check(c.SMTPPort > 0 && c.SMTPPort <= 65535, "SMTP_PORT deve ser uma porta válida")
+	check(c.SMTPTLS == "starttls" || c.SMTPTLS == "tls" || c.SMTPTLS == "none", "SMTP_TLS deve ser starttls, tls ou none")
+	if c.FieldEncryptionKey != "" {
+		_, err := fieldcrypt.ParseKey(c.FieldEncryptionKey)
+		check(err == nil, "FIELD_ENCRYPTION_KEY: %v", err)
+	}
+	check(c.FieldEncryptionKey != "" || len(c.FieldEncryptionPreviousKeys) == 0,
+		"FIELD_ENCRYPTION_PREVIOUS_KEYS requer FIELD_ENCRYPTION_KEY")
+	for _, key := range c.FieldEncryptionPreviousKeys {
+		_, err := fieldcrypt.ParseKey(key)
+		check(err == nil, "FIELD_ENCRYPTION_PREVIOUS_KEYS: %v", err)
 	}
 
-	return config
+	return errs
 }
 
-func getEnv(key, defaultValue string) string {
+// envLoader lê variáveis de ambiente (com fallback para os secret providers
+// e o arquivo de configuração) acumulando os erros de conversão
+type envLoader struct {
+	secrets   []SecretProvider
+	file      map[string]string
+	effective map[string]interface{}
+	errs      []error
+}
+
+// lookup retorna o valor da variável. Prioridade: ambiente, secret providers
+// (KEY_FILE, Vault) e, por fim, o arquivo de configuração.
+func (l *envLoader) lookup(key string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
 	}
-	return defaultValue
+
+	ctx, cancel := context.WithTimeout(context.Background(), secretLookupTimeout)
+	defer cancel()
+
+	for _, provider := range l.secrets {
+		value, ok, err := provider.Secret(ctx, key)
+		if err != nil {
+			l.errs = append(l.errs, err)
+			return ""
+		}
+		if ok {
+			return value
+		}
+	}
+
+	return l.file[key]
+}
+
+func (l *envLoader) invalid(key, value, expected string) {
+	l.errs = append(l.errs, fmt.Errorf("%s: valor inválido %q (esperado %s)", key, value, expected))
+}
+
+func (l *envLoader) getEnv(key, defaultValue string) string {
+	value := l.lookup(key)
+	if value == "" {
+		value = defaultValue
+	}
+
+	l.effective[key] = value
+	return value
+}
+
+func (l *envLoader) getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	value := l.lookup(key)
+	if value == "" {
+		l.effective[key] = defaultValue
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		l.invalid(key, value, "duração, ex.: 30s, 5m, 1h")
+		return defaultValue
+	}
+
+	l.effective[key] = duration
+	return duration
+}
+
+func (l *envLoader) getEnvInt(key string, defaultValue int) int {
+	value := l.lookup(key)
+	if value == "" {
+		l.effective[key] = defaultValue
+		return defaultValue
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		l.invalid(key, value, "número inteiro")
+		return defaultValue
+	}
+
+	l.effective[key] = parsed
+	return parsed
+}
+
+func (l *envLoader) getEnvFloat(key string, defaultValue float64) float64 {
+	value := l.lookup(key)
+	if value == "" {
+		l.effective[key] = defaultValue
+		return defaultValue
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		l.invalid(key, value, "número")
+		return defaultValue
+	}
+
+	l.effective[key] = parsed
+	return parsed
+}
+
+func (l *envLoader) getEnvBool(key string, defaultValue bool) bool {
+	value := l.lookup(key)
+	if value == "" {
+		l.effective[key] = defaultValue
+		return defaultValue
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		l.invalid(key, value, "true ou false")
+		return defaultValue
+	}
+
+	l.effective[key] = parsed
+	return parsed
+}
+
+// getEnvList lê uma lista separada por vírgulas, ignorando itens vazios
+func (l *envLoader) getEnvList(key string, defaultValue []string) []string {
+	value := l.lookup(key)
+	if value == "" {
+		l.effective[key] = defaultValue
+		return defaultValue
+	}
+
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	l.effective[key] = items
+	return items
+}
+
+func contains(items []string, value string) bool {
+	for _, item := range items {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func randomSecret() string {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		slog.Error("falha ao gerar segredo JWT", "error", err)
+		os.Exit(1)
+	}
+	return hex.EncodeToString(buf)
+}
+
+// MaxBodyLimit retorna o maior limite de corpo configurado, aplicado pelo
+// servidor antes do roteamento; os limites de cada grupo de rotas são
+// aplicados depois pelo middleware.BodyLimit
+func (c *Config) MaxBodyLimit() int {
+	return max(c.BodyLimit, c.JSONBodyLimit, c.UploadBodyLimit)
+}
+
+// BusinessCalendar monta o calendário de dias úteis configurado
+func (c *Config) BusinessCalendar() (*calendar.Calendar, error) {
+	return calendar.New(c.BusinessWeekendDays, c.BusinessHolidays)
 }
@@ -0,0 +1,246 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// fileKeys associa as chaves do arquivo de configuração (seção.chave) às
+// variáveis de ambiente equivalentes. Variáveis definidas no ambiente têm
+// prioridade sobre o arquivo.
+var fileKeys = map[string]string{
+	"server.port":                   "PORT",
+	"server.read_timeout":           "SERVER_READ_TIMEOUT",
+	"server.write_timeout":          "SERVER_WRITE_TIMEOUT",
+	"server.idle_timeout":           "SERVER_IDLE_TIMEOUT",
+	"server.body_limit":             "BODY_LIMIT",
+	"server.json_body_limit":        "JSON_BODY_LIMIT",
+	"server.upload_body_limit":      "UPLOAD_BODY_LIMIT",
+	"server.request_timeout":        "REQUEST_TIMEOUT",
+	"server.export_request_timeout": "EXPORT_REQUEST_TIMEOUT",
+	"server.pagination_max_limit":   "PAGINATION_MAX_LIMIT",
+	"server.bulk_max_ids":           "BULK_MAX_IDS",
+	"server.shutdown_timeout":       "SHUTDOWN_TIMEOUT",
+	"server.web_ui":                 "WEB_UI_ENABLED",
+	"server.static.dir":             "STATIC_DIR",
+	"server.static.spa_fallback":    "STATIC_SPA_FALLBACK",
+	"server.static.immutable_paths": "STATIC_IMMUTABLE_PATHS",
+	"server.readiness_required":     "READINESS_REQUIRED",
+	"server.cors.allow_origins":     "CORS_ALLOW_ORIGINS",
+	"server.cors.allow_methods":     "CORS_ALLOW_METHODS",
+	"server.cors.allow_headers":     "CORS_ALLOW_HEADERS",
+	"server.cors.allow_credentials": "CORS_ALLOW_CREDENTIALS",
+	"server.tls.cert_file":          "TLS_CERT_FILE",
+	"server.tls.key_file":           "TLS_KEY_FILE",
+	"server.tls.autocert_domains":   "TLS_AUTOCERT_DOMAINS",
+	"server.tls.autocert_email":     "TLS_AUTOCERT_EMAIL",
+	"server.tls.autocert_cache_dir": "TLS_AUTOCERT_CACHE_DIR",
+	"server.tls.redirect_port":      "HTTP_REDIRECT_PORT",
+
+	"rate_limit.enabled":          "RATE_LIMIT_ENABLED",
+	"rate_limit.auth.per_minute":  "RATE_LIMIT_AUTH_PER_MINUTE",
+	"rate_limit.auth.burst":       "RATE_LIMIT_AUTH_BURST",
+	"rate_limit.read.per_minute":  "RATE_LIMIT_READ_PER_MINUTE",
+	"rate_limit.read.burst":       "RATE_LIMIT_READ_BURST",
+	"rate_limit.write.per_minute": "RATE_LIMIT_WRITE_PER_MINUTE",
+	"rate_limit.write.burst":      "RATE_LIMIT_WRITE_BURST",
+	"rate_limit.bulk.per_minute":  "RATE_LIMIT_BULK_PER_MINUTE",
+	"rate_limit.bulk.burst":       "RATE_LIMIT_BULK_BURST",
+
+	"mongo.uri":                      "MONGO_URI",
+	"mongo.database":                 "MONGO_DB_NAME",
+	"mongo.max_pool_size":            "MONGO_MAX_POOL_SIZE",
+	"mongo.min_pool_size":            "MONGO_MIN_POOL_SIZE",
+	"mongo.max_conn_idle_time":       "MONGO_MAX_CONN_IDLE_TIME",
+	"mongo.connect_timeout":          "MONGO_CONNECT_TIMEOUT",
+	"mongo.ping_timeout":             "MONGO_PING_TIMEOUT",
+	"mongo.server_selection_timeout": "MONGO_SERVER_SELECTION_TIMEOUT",
+	"mongo.read_timeout":             "MONGO_READ_TIMEOUT",
+	"mongo.write_timeout":            "MONGO_WRITE_TIMEOUT",
+	"mongo.aggregate_timeout":        "MONGO_AGGREGATE_TIMEOUT",
+	"mongo.atlas_search_index":       "ATLAS_SEARCH_INDEX",
+
+	"redis.url": "REDIS_URL",
+
+	"smtp.host":      "SMTP_HOST",
+	"smtp.port":      "SMTP_PORT",
+	"smtp.username":  "SMTP_USERNAME",
+	"smtp.password":  "SMTP_PASSWORD",
+	"smtp.tls":       "SMTP_TLS",
+	"smtp.from":      "MAIL_FROM",
+	"smtp.from_name": "MAIL_FROM_NAME",
+	"smtp.dry_run":   "MAIL_DRY_RUN",
+
+	"auth.jwt_secret":     "JWT_SECRET",
+	"auth.jwt_expiration": "JWT_EXPIRATION",
+	"auth.admin_token":    "ADMIN_TOKEN",
+	"auth.metrics_token":  "METRICS_TOKEN",
+	"auth.signed_url_ttl": "SIGNED_URL_TTL",
+
+	"logging.level":                  "LOG_LEVEL",
+	"logging.format":                 "LOG_FORMAT",
+	"logging.debug_body":             "DEBUG_BODY_LOGGING",
+	"logging.debug_body_sample_rate": "DEBUG_BODY_SAMPLE_RATE",
+	"logging.debug_body_max_bytes":   "DEBUG_BODY_MAX_BYTES",
+	"logging.error_report_url":       "ERROR_REPORT_URL",
+
+	"features.archive.enabled":                 "ARCHIVE_ENABLED",
+	"features.archive.after_months":            "ARCHIVE_AFTER_MONTHS",
+	"features.archive.interval":                "ARCHIVE_INTERVAL",
+	"features.notifications.dispatch_interval": "NOTIFICATIONS_DISPATCH_INTERVAL",
+	"features.notifications.due_soon_window":   "DUE_SOON_WINDOW",
+	"features.notifications.due_soon_interval": "DUE_SOON_INTERVAL",
+	"features.overdue_summary.hour":            "OVERDUE_SUMMARY_HOUR",
+	"features.overdue_summary.interval":        "OVERDUE_SUMMARY_INTERVAL",
+	"features.calendar.weekend_days":           "BUSINESS_WEEKEND_DAYS",
+	"features.calendar.holidays":               "BUSINESS_HOLIDAYS",
+	"features.notifications.push_gateway_url":  "PUSH_GATEWAY_URL",
+	"features.audit.retention_days":            "AUDIT_RETENTION_DAYS",
+	"features.account.closure_mode":            "ACCOUNT_CLOSURE_MODE",
+	"features.retention.completed_tasks_days":  "RETENTION_COMPLETED_TASKS_DAYS",
+	"features.retention.notifications_days":    "RETENTION_NOTIFICATIONS_DAYS",
+	"features.retention.interval":              "RETENTION_INTERVAL",
+	"features.scheduler.leader_lease_ttl":      "LEADER_LEASE_TTL",
+	"features.maintenance.mode":                "MAINTENANCE_MODE",
+	"features.maintenance.retry_after":         "MAINTENANCE_RETRY_AFTER",
+
+	"integrations.telegram.bot_token":      "TELEGRAM_BOT_TOKEN",
+	"integrations.telegram.bot_username":   "TELEGRAM_BOT_USERNAME",
+	"integrations.telegram.webhook_url":    "TELEGRAM_WEBHOOK_URL",
+	"integrations.telegram.webhook_secret": "TELEGRAM_WEBHOOK_SECRET",
+
+	"integrations.email.address":              "INBOUND_EMAIL_ADDRESS",
+	"integrations.email.webhook_token":        "INBOUND_EMAIL_WEBHOOK_TOKEN",
+	"integrations.email.max_attachment_bytes": "INBOUND_EMAIL_MAX_ATTACHMENT_BYTES",
+	"integrations.email.imap.addr":            "IMAP_ADDR",
+	"integrations.email.imap.username":        "IMAP_USERNAME",
+	"integrations.email.imap.password":        "IMAP_PASSWORD",
+	"integrations.email.imap.mailbox":         "IMAP_MAILBOX",
+	"integrations.email.imap.tls":             "IMAP_TLS",
+	"integrations.email.imap.poll_interval":   "IMAP_POLL_INTERVAL",
+
+	"integrations.github.client_id":      "GITHUB_CLIENT_ID",
+	"integrations.github.client_secret":  "GITHUB_CLIENT_SECRET",
+	"integrations.github.redirect_url":   "GITHUB_REDIRECT_URL",
+	"integrations.github.webhook_url":    "GITHUB_WEBHOOK_URL",
+	"integrations.github.webhook_secret": "GITHUB_WEBHOOK_SECRET",
+
+	"integrations.twilio.account_sid":           "TWILIO_ACCOUNT_SID",
+	"integrations.twilio.auth_token":            "TWILIO_AUTH_TOKEN",
+	"integrations.twilio.from_number":           "TWILIO_FROM_NUMBER",
+	"integrations.twilio.sms_monthly_limit":     "SMS_MONTHLY_LIMIT",
+	"integrations.twilio.pro_sms_monthly_limit": "PRO_SMS_MONTHLY_LIMIT",
+
+	"billing.stripe.secret_key":     "STRIPE_SECRET_KEY",
+	"billing.stripe.webhook_secret": "STRIPE_WEBHOOK_SECRET",
+	"billing.stripe.pro_price_id":   "STRIPE_PRO_PRICE_ID",
+	"billing.stripe.success_url":    "STRIPE_SUCCESS_URL",
+	"billing.stripe.cancel_url":     "STRIPE_CANCEL_URL",
+
+	"encryption.key":           "FIELD_ENCRYPTION_KEY",
+	"encryption.previous_keys": "FIELD_ENCRYPTION_PREVIOUS_KEYS",
+}
+
+// sensitiveKeys lista as variáveis ocultadas em "config print"
+var sensitiveKeys = map[string]bool{
+	"JWT_SECRET":    true,
+	"ADMIN_TOKEN":   true,
+	"METRICS_TOKEN": true,
+	"SMTP_PASSWORD": true,
+
+	// A URL do serviço de erros costuma conter o token de ingestão
+	"ERROR_REPORT_URL": true,
+
+	"TELEGRAM_BOT_TOKEN":      true,
+	"TELEGRAM_WEBHOOK_SECRET": true,
+
+	"INBOUND_EMAIL_WEBHOOK_TOKEN": true,
+	"IMAP_PASSWORD":               true,
+
+	"GITHUB_CLIENT_SECRET":  true,
+	"GITHUB_WEBHOOK_SECRET": true,
+
+	"TWILIO_AUTH_TOKEN": true,
+
+	"STRIPE_SECRET_KEY":     true,
+	"STRIPE_WEBHOOK_SECRET": true,
+
+	"FIELD_ENCRYPTION_KEY":           true,
+	"FIELD_ENCRYPTION_PREVIOUS_KEYS": true,
+}
+
+// readConfigFile lê um arquivo YAML ou TOML (pela extensão) e retorna os
+// valores indexados pelo nome da variável de ambiente equivalente
+func readConfigFile(path string) (map[string]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler arquivo de configuração: %w", err)
+	}
+
+	var raw map[string]interface{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(content, &raw)
+	case ".toml":
+		err = toml.Unmarshal(content, &raw)
+	default:
+		return nil, fmt.Errorf("formato de configuração não suportado: %s (use .yaml, .yml ou .toml)", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erro ao interpretar %s: %w", path, err)
+	}
+
+	values := make(map[string]string)
+	var unknown []string
+	flattenConfig("", raw, values, &unknown)
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("chaves desconhecidas em %s: %s", path, strings.Join(unknown, ", "))
+	}
+
+	return values, nil
+}
+
+// flattenConfig percorre as seções do arquivo convertendo cada valor para o
+// formato aceito pela variável de ambiente correspondente
+func flattenConfig(prefix string, section map[string]interface{}, values map[string]string, unknown *[]string) {
+	for key, value := range section {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		if nested, ok := value.(map[string]interface{}); ok {
+			flattenConfig(path, nested, values, unknown)
+			continue
+		}
+
+		envKey, ok := fileKeys[path]
+		if !ok {
+			*unknown = append(*unknown, path)
+			continue
+		}
+
+		values[envKey] = formatFileValue(value)
+	}
+}
+
+func formatFileValue(value interface{}) string {
+	list, ok := value.([]interface{})
+	if !ok {
+		return fmt.Sprint(value)
+	}
+
+	items := make([]string, 0, len(list))
+	for _, item := range list {
+		items = append(items, fmt.Sprint(item))
+	}
+	return strings.Join(items, ",")
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// redacted substitui valores sensíveis na saída de "config print"
+const redacted = "******"
+
+// Print escreve a configuração efetiva em YAML, agrupada nas mesmas seções do
+// arquivo de configuração, com segredos e credenciais ocultados
+func (c *Config) Print(w io.Writer) error {
+	sections := make(map[string]interface{})
+
+	for path, envKey := range fileKeys {
+		value, ok := c.effective[envKey]
+		if !ok {
+			continue
+		}
+		setNested(sections, strings.Split(path, "."), redactValue(envKey, value))
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(sections); err != nil {
+		return fmt.Errorf("erro ao gerar configuração: %w", err)
+	}
+	return encoder.Close()
+}
+
+// setNested define o valor no mapa aninhado seguindo o caminho informado
+func setNested(root map[string]interface{}, path []string, value interface{}) {
+	current := root
+	for _, key := range path[:len(path)-1] {
+		next, ok := current[key].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			current[key] = next
+		}
+		current = next
+	}
+	current[path[len(path)-1]] = value
+}
+
+// redactValue oculta segredos e senhas embutidas em URLs de conexão
+func redactValue(envKey string, value interface{}) interface{} {
+	if duration, ok := value.(time.Duration); ok {
+		return duration.String()
+	}
+
+	// Listas sensíveis (ex.: chaves anteriores) são ocultadas por inteiro
+	if list, ok := value.([]string); ok && sensitiveKeys[envKey] && len(list) > 0 {
+		return redacted
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return value
+	}
+
+	if sensitiveKeys[envKey] {
+		if str == "" {
+			return ""
+		}
+		return redacted
+	}
+
+	if parsed, err := url.Parse(str); err == nil && parsed.User != nil {
+		return parsed.Redacted()
+	}
+
+	return str
+}
@@ -0,0 +1,129 @@
+package config
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// HotReloadKeys são as configurações aplicadas sem reiniciar (SIGHUP ou
+// POST /api/v1/admin/config/reload). Todas as demais exigem reinício.
+var HotReloadKeys = map[string]bool{
+	"LOG_LEVEL":                   true,
+	"CORS_ALLOW_ORIGINS":          true,
+	"CORS_ALLOW_METHODS":          true,
+	"CORS_ALLOW_HEADERS":          true,
+	"CORS_ALLOW_CREDENTIALS":      true,
+	"DEBUG_BODY_LOGGING":          true,
+	"DEBUG_BODY_SAMPLE_RATE":      true,
+	"DEBUG_BODY_MAX_BYTES":        true,
+	"PAGINATION_MAX_LIMIT":        true,
+	"BULK_MAX_IDS":                true,
+	"RATE_LIMIT_ENABLED":          true,
+	"RATE_LIMIT_AUTH_PER_MINUTE":  true,
+	"RATE_LIMIT_AUTH_BURST":       true,
+	"RATE_LIMIT_READ_PER_MINUTE":  true,
+	"RATE_LIMIT_READ_BURST":       true,
+	"RATE_LIMIT_WRITE_PER_MINUTE": true,
+	"RATE_LIMIT_WRITE_BURST":      true,
+	"RATE_LIMIT_BULK_PER_MINUTE":  true,
+	"RATE_LIMIT_BULK_BURST":       true,
+	"BUSINESS_WEEKEND_DAYS":       true,
+	"BUSINESS_HOLIDAYS":           true,
+}
+
+// ReloadResult descreve o que mudou em uma recarga da configuração
+type ReloadResult struct {
+	// Applied lista as configurações alteradas e já aplicadas
+	Applied []string `json:"applied"`
+	// RestartRequired lista as configurações que diferem dos valores com que
+	// o processo foi iniciado e só valem após reiniciar
+	RestartRequired []string `json:"restart_required"`
+}
+
+// ReloadableKeys separa as variáveis conhecidas entre as que podem ser
+// recarregadas e as que exigem reinício, em ordem alfabética
+func (c *Config) ReloadableKeys() (hot, restart []string) {
+	hot, restart = []string{}, []string{}
+	for key := range c.effective {
+		if HotReloadKeys[key] {
+			hot = append(hot, key)
+		} else {
+			restart = append(restart, key)
+		}
+	}
+	sort.Strings(hot)
+	sort.Strings(restart)
+	return hot, restart
+}
+
+// Reloader relê a configuração (arquivo, .env ainda não carregado e secret
+// providers) e aplica as configurações quentes por meio de apply.
+// Variáveis de ambiente do processo não mudam após o início.
+type Reloader struct {
+	path    string
+	apply   func(*Config)
+	started *Config
+
+	mu      sync.Mutex
+	current *Config
+}
+
+// NewReloader cria um Reloader a partir da configuração em uso
+func NewReloader(path string, current *Config, apply func(*Config)) *Reloader {
+	return &Reloader{path: path, apply: apply, started: current, current: current}
+}
+
+// Current retorna a configuração carregada mais recentemente
+func (r *Reloader) Current() *Config {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.current
+}
+
+// Reload carrega a configuração novamente e aplica as mudanças quentes.
+// Configuração inválida é rejeitada por inteiro, mantendo a atual.
+func (r *Reloader) Reload() (*ReloadResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	next, err := LoadConfig(r.path)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao recarregar configuração: %w", err)
+	}
+
+	result := &ReloadResult{Applied: []string{}, RestartRequired: []string{}}
+	for _, key := range r.current.changedKeys(next) {
+		if HotReloadKeys[key] {
+			result.Applied = append(result.Applied, key)
+		}
+	}
+	for _, key := range r.started.changedKeys(next) {
+		if !HotReloadKeys[key] {
+			result.RestartRequired = append(result.RestartRequired, key)
+		}
+	}
+
+	if len(result.Applied) > 0 {
+		r.apply(next)
+	}
+	r.current = next
+
+	slog.Info("configuração recarregada", "applied", result.Applied, "restart_required", result.RestartRequired)
+	return result, nil
+}
+
+// changedKeys retorna, em ordem alfabética, as variáveis cujo valor efetivo
+// difere entre as duas configurações
+func (c *Config) changedKeys(next *Config) []string {
+	var keys []string
+	for key, value := range next.effective {
+		if !reflect.DeepEqual(c.effective[key], value) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
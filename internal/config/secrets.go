@@ -0,0 +1,209 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SecretProvider fornece valores sensíveis de fontes externas às variáveis
+// de ambiente. ok=false indica que o provider não possui o valor.
+type SecretProvider interface {
+	Name() string
+	Secret(ctx context.Context, key string) (value string, ok bool, err error)
+}
+
+// fileSecretProvider lê o valor de KEY do arquivo indicado em KEY_FILE
+// (formato usado por Docker secrets e volumes de secrets do Kubernetes)
+type fileSecretProvider struct{}
+
+// NewFileSecretProvider cria o provider de secrets em arquivo
+func NewFileSecretProvider() SecretProvider {
+	return fileSecretProvider{}
+}
+
+func (fileSecretProvider) Name() string {
+	return "file"
+}
+
+func (fileSecretProvider) Secret(ctx context.Context, key string) (string, bool, error) {
+	path := os.Getenv(key + "_FILE")
+	if path == "" {
+		return "", false, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", false, fmt.Errorf("%s_FILE: erro ao ler %s: %w", key, path, err)
+	}
+
+	return strings.TrimRight(string(content), "\r\n"), true, nil
+}
+
+// vaultSecretKeys lista as variáveis que podem vir do Vault
+var vaultSecretKeys = map[string]bool{
+	"JWT_SECRET":    true,
+	"SMTP_USERNAME": true,
+	"SMTP_PASSWORD": true,
+
+	"TELEGRAM_BOT_TOKEN":      true,
+	"TELEGRAM_WEBHOOK_SECRET": true,
+
+	"INBOUND_EMAIL_WEBHOOK_TOKEN": true,
+	"IMAP_USERNAME":               true,
+	"IMAP_PASSWORD":               true,
+
+	"GITHUB_CLIENT_SECRET":  true,
+	"GITHUB_WEBHOOK_SECRET": true,
+
+	"TWILIO_ACCOUNT_SID": true,
+	"TWILIO_AUTH_TOKEN":  true,
+
+	"STRIPE_SECRET_KEY":     true,
+	"STRIPE_WEBHOOK_SECRET": true,
+
+	"FIELD_ENCRYPTION_KEY":           true,
+	"FIELD_ENCRYPTION_PREVIOUS_KEYS": true,
+}
+
+// VaultConfig define o acesso ao HashiCorp Vault
+type VaultConfig struct {
+	Address   string
+	Token     string
+	Namespace string
+	// SecretPath é o caminho do secret na API, ex.: secret/data/todo-it (KV v2)
+	SecretPath string
+	Timeout    time.Duration
+}
+
+// vaultSecretProvider lê a chave de assinatura JWT e as credenciais SMTP de
+// um secret do Vault. O secret é lido uma única vez e mantido em memória.
+type vaultSecretProvider struct {
+	config VaultConfig
+	client *http.Client
+
+	once sync.Once
+	data map[string]string
+	err  error
+}
+
+// NewVaultSecretProvider cria o provider do Vault
+func NewVaultSecretProvider(cfg VaultConfig) SecretProvider {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+
+	return &vaultSecretProvider{
+		config: cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+func (p *vaultSecretProvider) Name() string {
+	return "vault"
+}
+
+func (p *vaultSecretProvider) Secret(ctx context.Context, key string) (string, bool, error) {
+	if !vaultSecretKeys[key] {
+		return "", false, nil
+	}
+
+	p.once.Do(func() {
+		p.data, p.err = p.read(ctx)
+	})
+	if p.err != nil {
+		return "", false, p.err
+	}
+
+	// Aceita o nome da variável ou a versão em minúsculas como campo do secret
+	for _, field := range []string{key, strings.ToLower(key)} {
+		if value, ok := p.data[field]; ok && value != "" {
+			return value, true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+// read busca o secret na API HTTP do Vault (KV v1 ou v2)
+func (p *vaultSecretProvider) read(ctx context.Context) (map[string]string, error) {
+	url := strings.TrimRight(p.config.Address, "/") + "/v1/" + strings.TrimLeft(p.config.SecretPath, "/")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("vault: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", p.config.Token)
+	if p.config.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.config.Namespace)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("vault: erro ao ler %s: %w", p.config.SecretPath, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("vault: leitura de %s retornou status %d", p.config.SecretPath, resp.StatusCode)
+	}
+
+	var body struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("vault: resposta inválida: %w", err)
+	}
+
+	// KV v2 aninha os valores em data.data
+	fields := body.Data
+	if nested, ok := body.Data["data"].(map[string]interface{}); ok {
+		fields = nested
+	}
+
+	data := make(map[string]string, len(fields))
+	for field, value := range fields {
+		if str, ok := value.(string); ok {
+			data[field] = str
+		}
+	}
+
+	return data, nil
+}
+
+// secretProviders monta os providers configurados: arquivos (sempre) e
+// Vault (quando VAULT_ADDR estiver definido)
+func secretProviders() ([]SecretProvider, error) {
+	providers := []SecretProvider{NewFileSecretProvider()}
+
+	address := os.Getenv("VAULT_ADDR")
+	if address == "" {
+		return providers, nil
+	}
+
+	files := NewFileSecretProvider()
+	token, ok, err := files.Secret(context.Background(), "VAULT_TOKEN")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		token = os.Getenv("VAULT_TOKEN")
+	}
+
+	path := os.Getenv("VAULT_SECRET_PATH")
+	if token == "" || path == "" {
+		return nil, fmt.Errorf("VAULT_ADDR definido: VAULT_TOKEN (ou VAULT_TOKEN_FILE) e VAULT_SECRET_PATH são obrigatórios")
+	}
+
+	return append(providers, NewVaultSecretProvider(VaultConfig{
+		Address:    address,
+		Token:      token,
+		Namespace:  os.Getenv("VAULT_NAMESPACE"),
+		SecretPath: path,
+	})), nil
+}
@@ -3,174 +3,730 @@ package database
 import (
 	"context"
 	"fmt"
+	"log/slog"
 
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // CollectionNames define os nomes das collections
 type CollectionNames struct {
-	Users string
-	Tasks string
+	Users          string
+	Tasks          string
+	TasksArchive   string
+	SchedulerLocks string
+	Notifications  string
+	AuditLogs      string
+	TelegramLinks  string
+	InboundEmails  string
+	Projects       string
+	ImportJobs     string
+	GitHubAccounts string
+	AppPasswords   string
+	TaskChanges    string
+	UsageCounters  string
+	// PlatformMetrics guarda os contadores diários das instâncias e o painel administrativo
+	PlatformMetrics string
+	// RetentionOverrides guarda a retenção de dados específica de cada usuário
+	RetentionOverrides string
+	// LeaderLeases guarda os leases das eleições de líder entre réplicas
+	LeaderLeases string
+	// Tombstones registra as exclusões de tarefas e projetos (sincronização e polling)
+	Tombstones string
+	// FocusSessions guarda as sessões de foco (pomodoro) dedicadas às tarefas
+	FocusSessions string
+	// ViewSettings guarda o layout das listas e quadros de cada usuário, por projeto
+	ViewSettings string
+	// ExportJobs acompanha as exportações geradas em segundo plano (ex.: PDF) e guarda o arquivo
+	ExportJobs string
+	// Labels guarda as etiquetas gerenciadas (nome, cor e ícone) de cada usuário
+	Labels string
+	// Subscriptions guarda a assinatura (plano) de cada usuário
+	Subscriptions string
+	// WebhookDeliveries registra as tentativas de entrega nos webhooks de notificações
+	WebhookDeliveries string
+	// Organizations guarda as organizações, com os assentos e os membros
+	Organizations string
+	// OrgUsage guarda o uso de cada organização calculado pelo job org-usage
+	OrgUsage string
+	// Attachments é o prefixo do bucket GridFS (attachments.files e attachments.chunks)
+	Attachments string
 }
 
 // GetCollectionNames retorna os nomes das collections
 func GetCollectionNames() *CollectionNames {
 	return &CollectionNames{
-		Users: "users",
-		Tasks: "tasks",
+		Users:              "users",
+		Tasks:              "tasks",
+		TasksArchive:       "tasks_archive",
+		SchedulerLocks:     "scheduler_locks",
+		Notifications:      "notifications",
+		AuditLogs:          "audit_logs",
+		TelegramLinks:      "telegram_links",
+		InboundEmails:      "inbound_emails",
+		Projects:           "projects",
+		ImportJobs:         "import_jobs",
+		GitHubAccounts:     "github_accounts",
+		AppPasswords:       "app_passwords",
+		TaskChanges:        "task_changes",
+		UsageCounters:      "usage_counters",
+		PlatformMetrics:    "platform_metrics",
+		RetentionOverrides: "retention_overrides",
+		LeaderLeases:       "leader_leases",
+		Tombstones:         "tombstones",
+		FocusSessions:      "focus_sessions",
+		ViewSettings:       "view_settings",
+		ExportJobs:         "export_jobs",
+		Labels:             "labels",
+		Subscriptions:      "subscriptions",
+		WebhookDeliveries:  "webhook_deliveries",
+		Organizations:      "organizations",
+		OrgUsage:           "org_usage",
+		Attachments:        "attachments",
 	}
 }
 
+// All retorna os nomes de todas as collections da aplicação
+func (n *CollectionNames) All() []string {
+	return []string{n.Users, n.Tasks, n.TasksArchive, n.SchedulerLocks, n.Notifications, n.AuditLogs, n.TelegramLinks, n.InboundEmails, n.Projects, n.ImportJobs, n.GitHubAccounts, n.AppPasswords, n.TaskChanges, n.UsageCounters, n.PlatformMetrics, n.RetentionOverrides, n.LeaderLeases, n.Tombstones, n.FocusSessions, n.ViewSettings, n.ExportJobs, n.Labels, n.Subscriptions, n.WebhookDeliveries, n.Organizations, n.OrgUsage}
+}
+
 // Collections agrupa todas as collections do banco
 type Collections struct {
-	Users *mongo.Collection
-	Tasks *mongo.Collection
+	Users              *mongo.Collection
+	Tasks              *mongo.Collection
+	TasksArchive       *mongo.Collection
+	SchedulerLocks     *mongo.Collection
+	Notifications      *mongo.Collection
+	AuditLogs          *mongo.Collection
+	TelegramLinks      *mongo.Collection
+	InboundEmails      *mongo.Collection
+	Projects           *mongo.Collection
+	ImportJobs         *mongo.Collection
+	GitHubAccounts     *mongo.Collection
+	AppPasswords       *mongo.Collection
+	TaskChanges        *mongo.Collection
+	UsageCounters      *mongo.Collection
+	PlatformMetrics    *mongo.Collection
+	RetentionOverrides *mongo.Collection
+	LeaderLeases       *mongo.Collection
+	Tombstones         *mongo.Collection
+	FocusSessions      *mongo.Collection
+	ViewSettings       *mongo.Collection
+	ExportJobs         *mongo.Collection
+	Labels             *mongo.Collection
+	Subscriptions      *mongo.Collection
+	WebhookDeliveries  *mongo.Collection
+	Organizations      *mongo.Collection
+	OrgUsage           *mongo.Collection
 }
 
-// GetCollections retorna todas as collections configuradas
-func (m *MongoDB) GetCollections() *Collections {
+// Collections retorna todas as collections configuradas
+func (m *MongoDB) Collections() *Collections {
 	names := GetCollectionNames()
 
 	return &Collections{
-		Users: m.GetCollection(names.Users),
-		Tasks: m.GetCollection(names.Tasks),
+		Users:              m.GetCollection(names.Users),
+		Tasks:              m.GetCollection(names.Tasks),
+		TasksArchive:       m.GetCollection(names.TasksArchive),
+		SchedulerLocks:     m.GetCollection(names.SchedulerLocks),
+		Notifications:      m.GetCollection(names.Notifications),
+		AuditLogs:          m.GetCollection(names.AuditLogs),
+		TelegramLinks:      m.GetCollection(names.TelegramLinks),
+		InboundEmails:      m.GetCollection(names.InboundEmails),
+		Projects:           m.GetCollection(names.Projects),
+		ImportJobs:         m.GetCollection(names.ImportJobs),
+		GitHubAccounts:     m.GetCollection(names.GitHubAccounts),
+		AppPasswords:       m.GetCollection(names.AppPasswords),
+		TaskChanges:        m.GetCollection(names.TaskChanges),
+		UsageCounters:      m.GetCollection(names.UsageCounters),
+		PlatformMetrics:    m.GetCollection(names.PlatformMetrics),
+		RetentionOverrides: m.GetCollection(names.RetentionOverrides),
+		LeaderLeases:       m.GetCollection(names.LeaderLeases),
+		Tombstones:         m.GetCollection(names.Tombstones),
+		FocusSessions:      m.GetCollection(names.FocusSessions),
+		ViewSettings:       m.GetCollection(names.ViewSettings),
+		ExportJobs:         m.GetCollection(names.ExportJobs),
+		Labels:             m.GetCollection(names.Labels),
+		Subscriptions:      m.GetCollection(names.Subscriptions),
+		WebhookDeliveries:  m.GetCollection(names.WebhookDeliveries),
+		Organizations:      m.GetCollection(names.Organizations),
+		OrgUsage:           m.GetCollection(names.OrgUsage),
 	}
 }
 
-// GetCollections método para a interface Client
-func GetCollections(client Client) *Collections {
-	mongoClient := client.(*MongoDB)
-	return mongoClient.GetCollections()
-}
-
-// CreateAllIndexes cria todos os índices necessários para as entidades
-func (m *MongoDB) CreateAllIndexes(ctx context.Context) error {
-	collections := m.GetCollections()
-
-	// Cria índices para Users
-	if err := m.createUsersIndexes(ctx, collections.Users); err != nil {
-		return fmt.Errorf("erro ao criar índices para users: %w", err)
-	}
-
-	// Cria índices para Todos
-	if err := m.createTodosIndexes(ctx, collections.Tasks); err != nil {
-		return fmt.Errorf("erro ao criar índices para todos: %w", err)
-	}
-
-	return nil
-}
-
-// CreateAllIndexes método para a interface Client
-func CreateAllIndexes(client Client, ctx context.Context) error {
-	mongoClient := client.(*MongoDB)
-	return mongoClient.CreateAllIndexes(ctx)
-}
-
-// createUsersIndexes cria índices específicos para a collection de users
-func (m *MongoDB) createUsersIndexes(ctx context.Context, collection *mongo.Collection) error {
-	indexes := []mongo.IndexModel{
+// usersIndexModels retorna os índices declarados para a collection de users
+func usersIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
 		{
-			Keys:    map[string]interface{}{"email": 1},
+			Keys:    bson.D{{Key: "email", Value: 1}},
 			Options: options.Index().SetUnique(true).SetName("unique_email_idx"),
 		},
 		{
-			Keys:    map[string]interface{}{"is_active": 1},
+			Keys: bson.D{{Key: "inbound_alias", Value: 1}},
+			Options: options.Index().SetUnique(true).SetName("unique_inbound_alias_idx").
+				SetPartialFilterExpression(bson.M{"inbound_alias": bson.M{"$exists": true}}),
+		},
+		{
+			Keys: bson.D{{Key: "feed_token_hash", Value: 1}},
+			Options: options.Index().SetUnique(true).SetName("unique_feed_token_hash_idx").
+				SetPartialFilterExpression(bson.M{"feed_token_hash": bson.M{"$exists": true}}),
+		},
+		{
+			Keys:    bson.D{{Key: "is_active", Value: 1}},
 			Options: options.Index().SetName("is_active_idx"),
 		},
 		{
-			Keys:    map[string]interface{}{"created_at": -1},
+			Keys:    bson.D{{Key: "created_at", Value: -1}},
 			Options: options.Index().SetName("created_at_desc_idx"),
 		},
 		{
-			Keys: map[string]interface{}{
-				"email":     1,
-				"is_active": 1,
+			Keys: bson.D{
+				{Key: "email", Value: 1},
+				{Key: "is_active", Value: 1},
 			},
 			Options: options.Index().SetName("email_active_compound_idx"),
 		},
 	}
-
-	_, err := collection.Indexes().CreateMany(ctx, indexes)
-	if err != nil {
-		return fmt.Errorf("falha ao criar índices para users: %w", err)
-	}
-
-	return nil
 }
 
-// createTodosIndexes cria índices específicos para a collection de todos
-func (m *MongoDB) createTodosIndexes(ctx context.Context, collection *mongo.Collection) error {
-	indexes := []mongo.IndexModel{
+// todosIndexModels retorna os índices declarados para a collection de todos.
+// As chaves usam bson.D porque a ordem dos campos importa em índices compostos.
+func todosIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
 		{
-			Keys:    map[string]interface{}{"user_id": 1},
+			Keys:    bson.D{{Key: "user_id", Value: 1}},
 			Options: options.Index().SetName("user_id_idx"),
 		},
 		{
-			Keys: map[string]interface{}{
-				"user_id": 1,
-				"status":  1,
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "status", Value: 1},
 			},
 			Options: options.Index().SetName("user_status_compound_idx"),
 		},
 		{
-			Keys: map[string]interface{}{
-				"user_id":    1,
-				"created_at": -1,
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "created_at", Value: -1},
 			},
 			Options: options.Index().SetName("user_created_desc_idx"),
 		},
 		{
-			Keys: map[string]interface{}{
-				"user_id":     1,
-				"is_archived": 1,
+			// Sincronização offline: alterações do usuário em ordem de updated_at
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "updated_at", Value: 1},
+				{Key: "_id", Value: 1},
+			},
+			Options: options.Index().SetName("user_updated_at_idx"),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "is_archived", Value: 1},
 			},
 			Options: options.Index().SetName("user_archived_idx"),
 		},
 		{
-			Keys:    map[string]interface{}{"status": 1},
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "project_id", Value: 1},
+			},
+			Options: options.Index().SetName("user_project_idx").
+				SetPartialFilterExpression(bson.M{"project_id": bson.M{"$exists": true}}),
+		},
+		{
+			// Uma tarefa por item de origem (ex.: issue do GitHub) e usuário
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "external.source", Value: 1},
+				{Key: "external.id", Value: 1},
+			},
+			Options: options.Index().SetName("unique_user_external_idx").SetUnique(true).
+				SetPartialFilterExpression(bson.M{"external": bson.M{"$exists": true}}),
+		},
+		{
+			// Nome do recurso criado por um cliente CalDAV, único por usuário
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "caldav.name", Value: 1},
+			},
+			Options: options.Index().SetName("unique_user_caldav_name_idx").SetUnique(true).
+				SetPartialFilterExpression(bson.M{"caldav": bson.M{"$exists": true}}),
+		},
+		{
+			// Tarefas de uma etiqueta gerenciada (renomeação e remoção da etiqueta)
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "label_ids", Value: 1},
+			},
+			Options: options.Index().SetName("user_label_ids_idx").
+				SetPartialFilterExpression(bson.M{"label_ids": bson.M{"$exists": true}}),
+		},
+		{
+			// Tarefas com um status personalizado (renomeação e remoção do status)
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "custom_status._id", Value: 1},
+			},
+			Options: options.Index().SetName("user_custom_status_idx").
+				SetPartialFilterExpression(bson.M{"custom_status": bson.M{"$exists": true}}),
+		},
+		{
+			// Tarefas próximas a um ponto ($geoNear); sem local a tarefa fica fora do índice
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "location.point", Value: "2dsphere"},
+			},
+			Options: options.Index().SetName("user_location_2dsphere_idx"),
+		},
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}},
 			Options: options.Index().SetName("status_idx"),
 		},
 		{
-			Keys:    map[string]interface{}{"priority": 1},
+			Keys:    bson.D{{Key: "priority", Value: 1}},
 			Options: options.Index().SetName("priority_idx"),
 		},
 		{
-			Keys:    map[string]interface{}{"due_date": 1},
+			Keys:    bson.D{{Key: "due_date", Value: 1}},
 			Options: options.Index().SetName("due_date_idx").SetSparse(true),
 		},
 		{
-			Keys:    map[string]interface{}{"tags": 1},
+			Keys:    bson.D{{Key: "tags", Value: 1}},
 			Options: options.Index().SetName("tags_idx"),
 		},
 		{
-			Keys: map[string]interface{}{
-				"user_id":  1,
-				"priority": -1,
-				"due_date": 1,
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "priority", Value: -1},
+				{Key: "due_date", Value: 1},
 			},
 			Options: options.Index().SetName("user_priority_due_idx").SetSparse(true),
 		},
+		// Ordenação por prioridade (urgentes primeiro) na listagem do usuário
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "priority_order", Value: -1},
+				{Key: "_id", Value: -1},
+			},
+			Options: options.Index().SetName("user_priority_order_idx"),
+		},
+		// Ordem manual das colunas (reordenação por arrastar e soltar)
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "position", Value: 1},
+				{Key: "_id", Value: 1},
+			},
+			Options: options.Index().SetName("user_position_idx"),
+		},
+		// Sugestões da busca: prefixo dos termos normalizados do título
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "title_tokens", Value: 1},
+			},
+			Options: options.Index().SetName("user_title_tokens_idx"),
+		},
 		// Índice de texto para busca
 		{
-			Keys: map[string]interface{}{
-				"title":       "text",
-				"description": "text",
+			Keys: bson.D{
+				{Key: "title", Value: "text"},
+				{Key: "description", Value: "text"},
 			},
 			Options: options.Index().SetName("text_search_idx"),
 		},
 	}
+}
 
-	_, err := collection.Indexes().CreateMany(ctx, indexes)
-	if err != nil {
-		return fmt.Errorf("falha ao criar índices para todos: %w", err)
+// tasksArchiveIndexModels retorna os índices declarados para o histórico de tarefas arquivadas
+func tasksArchiveIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "completed_at", Value: -1},
+			},
+			Options: options.Index().SetName("user_completed_desc_idx"),
+		},
 	}
+}
 
-	return nil
+// notificationsIndexModels retorna os índices declarados para a collection de notificações
+func notificationsIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "in_inbox", Value: 1},
+				{Key: "created_at", Value: -1},
+			},
+			Options: options.Index().SetName("user_inbox_created_idx"),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "event_id", Value: 1},
+			},
+			Options: options.Index().SetName("unique_user_event_idx").SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "pending_channels", Value: 1},
+				{Key: "created_at", Value: 1},
+			},
+			Options: options.Index().SetName("pending_channels_idx"),
+		},
+		{
+			// Remoção pela política de retenção
+			Keys:    bson.D{{Key: "created_at", Value: 1}},
+			Options: options.Index().SetName("created_at_idx"),
+		},
+	}
+}
+
+// auditLogsIndexModels retorna os índices declarados para a collection de auditoria
+func auditLogsIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("created_at_idx"),
+		},
+		{
+			Keys: bson.D{
+				{Key: "actor_id", Value: 1},
+				{Key: "created_at", Value: -1},
+			},
+			Options: options.Index().SetName("actor_created_idx"),
+		},
+		{
+			Keys: bson.D{
+				{Key: "action", Value: 1},
+				{Key: "created_at", Value: -1},
+			},
+			Options: options.Index().SetName("action_created_idx"),
+		},
+	}
+}
+
+// telegramLinksIndexModels retorna os índices declarados para os vínculos com o Telegram
+func telegramLinksIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}},
+			Options: options.Index().SetName("unique_user_idx").SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "chat_id", Value: 1}},
+			Options: options.Index().SetName("unique_chat_idx").SetUnique(true).
+				SetPartialFilterExpression(bson.M{"chat_id": bson.M{"$exists": true}}),
+		},
+		{
+			Keys: bson.D{{Key: "code_hash", Value: 1}},
+			Options: options.Index().SetName("code_hash_idx").
+				SetPartialFilterExpression(bson.M{"code_hash": bson.M{"$exists": true}}),
+		},
+	}
+}
+
+// projectsIndexModels retorna os índices declarados para a collection de projetos
+func projectsIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "name", Value: 1},
+			},
+			Options: options.Index().SetName("user_name_idx"),
+		},
+		{
+			// Projetos compartilhados com o usuário
+			Keys:    bson.D{{Key: "members.user_id", Value: 1}},
+			Options: options.Index().SetName("members_user_id_idx").SetSparse(true),
+		},
+	}
+}
+
+// githubAccountsIndexModels retorna os índices declarados para as conexões com o GitHub
+func githubAccountsIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}},
+			Options: options.Index().SetName("unique_user_id_idx").SetUnique(true),
+		},
+		{
+			// Webhooks localizam as contas vinculadas ao repositório
+			Keys:    bson.D{{Key: "repos.full_name", Value: 1}},
+			Options: options.Index().SetName("repos_full_name_idx"),
+		},
+	}
+}
+
+// appPasswordsIndexModels retorna os índices declarados para as senhas de aplicativo
+func appPasswordsIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "token_hash", Value: 1}},
+			Options: options.Index().SetName("unique_token_hash_idx").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}},
+			Options: options.Index().SetName("user_id_idx"),
+		},
+	}
 }
 
-// EnsureCollectionsExist garante que as collections existam com as configurações corretas
-func (m *MongoDB) EnsureCollectionsExist(ctx context.Context) error {
+// taskChangesIndexModels retorna os índices declarados para o feed de mudanças
+func taskChangesIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			// Leitura do feed: alterações do usuário a partir do cursor
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "_id", Value: 1},
+			},
+			Options: options.Index().SetName("user_cursor_idx"),
+		},
+		{
+			// Atividade do projeto: alterações das suas tarefas, das mais recentes
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "task.project_id", Value: 1},
+				{Key: "_id", Value: -1},
+			},
+			Options: options.Index().SetName("user_project_activity_idx"),
+		},
+		{
+			Keys:    bson.D{{Key: "event_id", Value: 1}},
+			Options: options.Index().SetName("unique_event_id_idx").SetUnique(true),
+		},
+		{
+			// O feed guarda 30 dias de alterações
+			Keys:    bson.D{{Key: "created_at", Value: 1}},
+			Options: options.Index().SetName("created_at_ttl_idx").SetExpireAfterSeconds(30 * 24 * 60 * 60),
+		},
+	}
+}
+
+// usageCountersIndexModels retorna os índices declarados para os contadores de cota
+func usageCountersIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			// Um contador por usuário, recurso e período (o upsert do consumo depende dele)
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "resource", Value: 1},
+				{Key: "period", Value: 1},
+			},
+			Options: options.Index().SetName("unique_user_resource_period_idx").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetName("expires_at_ttl_idx").SetExpireAfterSeconds(0),
+		},
+	}
+}
+
+// platformMetricsIndexModels retorna os índices declarados para a collection de métricas
+func platformMetricsIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			// Contadores diários expiram; o painel (sem expires_at) é mantido
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetName("expires_at_ttl_idx").SetExpireAfterSeconds(0),
+		},
+	}
+}
+
+// tombstonesIndexModels retorna os índices declarados para as exclusões registradas
+func tombstonesIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			// Leitura das exclusões do usuário por tipo a partir de uma data
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "entity_type", Value: 1},
+				{Key: "deleted_at", Value: 1},
+			},
+			Options: options.Index().SetName("user_type_deleted_at_idx"),
+		},
+		{
+			Keys:    bson.D{{Key: "deleted_at", Value: 1}},
+			Options: options.Index().SetName("deleted_at_ttl_idx").SetExpireAfterSeconds(int32(entities.TombstoneRetention.Seconds())),
+		},
+	}
+}
+
+// focusSessionsIndexModels retorna os índices declarados para as sessões de foco
+func focusSessionsIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			// No máximo uma sessão em andamento por usuário
+			Keys: bson.D{{Key: "user_id", Value: 1}},
+			Options: options.Index().SetName("unique_user_active_idx").SetUnique(true).
+				SetPartialFilterExpression(bson.M{"active": true}),
+		},
+		{
+			// Resumo diário: sessões do usuário a partir de uma data
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "started_at", Value: 1},
+			},
+			Options: options.Index().SetName("user_started_at_idx"),
+		},
+	}
+}
+
+// viewSettingsIndexModels retorna os índices declarados para as configurações de visualização
+func viewSettingsIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			// Uma configuração por usuário e projeto (project_id nulo = padrão do usuário)
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "project_id", Value: 1},
+			},
+			Options: options.Index().SetName("unique_user_project_idx").SetUnique(true),
+		},
+	}
+}
+
+// exportJobsIndexModels retorna os índices declarados para as exportações
+func exportJobsIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			// Fila do job de exportações: pendentes mais antigas primeiro
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "created_at", Value: 1},
+			},
+			Options: options.Index().SetName("status_created_at_idx"),
+		},
+		{
+			// Exportação em andamento do usuário
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "status", Value: 1},
+			},
+			Options: options.Index().SetName("user_status_idx"),
+		},
+		{
+			// Os arquivos ficam disponíveis por 24 horas (entities.ExportJobRetention)
+			Keys:    bson.D{{Key: "created_at", Value: 1}},
+			Options: options.Index().SetName("created_at_ttl_idx").SetExpireAfterSeconds(24 * 60 * 60),
+		},
+	}
+}
+
+// labelsIndexModels retorna os índices declarados para as etiquetas gerenciadas
+func labelsIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			// Um nome por usuário (o mesmo texto das etiquetas das tarefas)
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "name", Value: 1},
+			},
+			Options: options.Index().SetName("unique_user_name_idx").SetUnique(true),
+		},
+	}
+}
+
+// subscriptionsIndexModels retorna os índices declarados para as assinaturas
+func subscriptionsIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			// Localiza a assinatura pela referência do provedor de pagamentos
+			Keys: bson.D{
+				{Key: "provider", Value: 1},
+				{Key: "provider_ref", Value: 1},
+			},
+			Options: options.Index().SetUnique(true).SetName("unique_provider_ref_idx").
+				SetPartialFilterExpression(bson.M{"provider_ref": bson.M{"$exists": true}}),
+		},
+	}
+}
+
+// webhookDeliveriesIndexModels retorna os índices declarados para as entregas de webhook
+func webhookDeliveriesIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			// Entregas do usuário, das mais recentes para as mais antigas
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "created_at", Value: -1},
+			},
+			Options: options.Index().SetName("user_created_at_idx"),
+		},
+		{
+			// As tentativas ficam registradas por 30 dias (entities.WebhookDeliveryRetention)
+			Keys:    bson.D{{Key: "created_at", Value: 1}},
+			Options: options.Index().SetName("created_at_ttl_idx").SetExpireAfterSeconds(30 * 24 * 60 * 60),
+		},
+	}
+}
+
+// organizationsIndexModels retorna os índices declarados para as organizações
+func organizationsIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "owner_id", Value: 1}},
+			Options: options.Index().SetName("owner_id_idx"),
+		},
+		{
+			// Organizações de que o usuário é membro
+			Keys:    bson.D{{Key: "members.user_id", Value: 1}},
+			Options: options.Index().SetName("members_user_id_idx").SetSparse(true),
+		},
+		{
+			// Convites pendentes do usuário, pelo email
+			Keys:    bson.D{{Key: "invites.email", Value: 1}},
+			Options: options.Index().SetName("invites_email_idx").SetSparse(true),
+		},
+	}
+}
+
+// importJobsIndexModels retorna os índices declarados para as importações
+func importJobsIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "status", Value: 1},
+			},
+			Options: options.Index().SetName("user_status_idx"),
+		},
+		{
+			// O acompanhamento de importações expira após 30 dias
+			Keys:    bson.D{{Key: "created_at", Value: 1}},
+			Options: options.Index().SetName("created_at_ttl_idx").SetExpireAfterSeconds(30 * 24 * 60 * 60),
+		},
+	}
+}
+
+// inboundEmailsIndexModels retorna os índices declarados para os emails já convertidos em tarefa
+func inboundEmailsIndexModels() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "message_id", Value: 1}},
+			Options: options.Index().SetName("unique_message_id_idx").SetUnique(true),
+		},
+		{
+			// Registros de deduplicação expiram após 30 dias
+			Keys:    bson.D{{Key: "received_at", Value: 1}},
+			Options: options.Index().SetName("received_at_ttl_idx").SetExpireAfterSeconds(30 * 24 * 60 * 60),
+		},
+	}
+}
+
+// EnsureSchema garante que as collections existam com as configurações corretas
+func (m *MongoDB) EnsureSchema(ctx context.Context) error {
 	names := GetCollectionNames()
 
 	// Lista collections existentes
@@ -185,7 +741,7 @@ func (m *MongoDB) EnsureCollectionsExist(ctx context.Context) error {
 	}
 
 	// Cria collections que não existem
-	collectionsToCreate := []string{names.Users, names.Tasks}
+	collectionsToCreate := names.All()
 
 	for _, collectionName := range collectionsToCreate {
 		if !existingCollections[collectionName] {
@@ -202,10 +758,210 @@ func (m *MongoDB) EnsureCollectionsExist(ctx context.Context) error {
 				return fmt.Errorf("erro ao criar collection %s: %w", collectionName, err)
 			}
 
-			fmt.Printf("✅ Collection '%s' criada com sucesso\n", collectionName)
+			slog.Info("collection criada", "collection", collectionName)
 		}
 	}
 
+	if err := m.normalizeUserEmails(ctx); err != nil {
+		return err
+	}
+
+	if err := m.backfillTaskCompletion(ctx); err != nil {
+		return err
+	}
+
+	if err := m.backfillTaskPriorityOrder(ctx); err != nil {
+		return err
+	}
+
+	if err := m.backfillTaskTitleTokens(ctx); err != nil {
+		return err
+	}
+
+	return m.normalizeTaskTags(ctx)
+}
+
+// normalizeUserEmails converte para minúsculas emails gravados antes da normalização,
+// mantendo o índice único de email consistente com as buscas
+func (m *MongoDB) normalizeUserEmails(ctx context.Context) error {
+	users := m.database.Collection(GetCollectionNames().Users)
+
+	filter := bson.M{"email": bson.M{"$regex": "[A-Z]"}}
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{"email": bson.M{"$toLower": bson.M{"$trim": bson.M{"input": "$email"}}}}}},
+	}
+
+	result, err := users.UpdateMany(ctx, filter, update)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("emails duplicados após normalização, corrija manualmente: %w", err)
+		}
+		return fmt.Errorf("erro ao normalizar emails: %w", err)
+	}
+
+	if result.ModifiedCount > 0 {
+		slog.Info("emails de usuário normalizados", "count", result.ModifiedCount)
+	}
+
+	return nil
+}
+
+// backfillTaskCompletion corrige tarefas gravadas antes da regra de conclusão:
+// concluídas sem completed_at recebem a data da última alteração e as demais
+// perdem o completed_at
+func (m *MongoDB) backfillTaskCompletion(ctx context.Context) error {
+	tasks := m.database.Collection(GetCollectionNames().Tasks)
+
+	missing, err := tasks.UpdateMany(ctx,
+		bson.M{"status": enums.StatusCompleted, "completed_at": nil},
+		mongo.Pipeline{{{Key: "$set", Value: bson.M{"completed_at": "$updated_at"}}}},
+	)
+	if err != nil {
+		return fmt.Errorf("erro ao preencher completed_at: %w", err)
+	}
+
+	stale, err := tasks.UpdateMany(ctx,
+		bson.M{"status": bson.M{"$ne": enums.StatusCompleted}, "completed_at": bson.M{"$exists": true}},
+		bson.M{"$unset": bson.M{"completed_at": ""}},
+	)
+	if err != nil {
+		return fmt.Errorf("erro ao remover completed_at: %w", err)
+	}
+
+	if missing.ModifiedCount > 0 || stale.ModifiedCount > 0 {
+		slog.Info("completed_at de tarefas corrigido", "filled", missing.ModifiedCount, "removed", stale.ModifiedCount)
+	}
+
+	return nil
+}
+
+// backfillTaskPriorityOrder grava priority_order nas tarefas criadas antes do
+// campo, com a mesma ordem de enums.TaskPriority.GetPriorityOrder
+func (m *MongoDB) backfillTaskPriorityOrder(ctx context.Context) error {
+	tasks := m.database.Collection(GetCollectionNames().Tasks)
+
+	branches := bson.A{}
+	for _, priority := range enums.GetAllPriorities() {
+		branches = append(branches, bson.M{
+			"case": bson.M{"$eq": bson.A{"$priority", priority}},
+			"then": priority.GetPriorityOrder(),
+		})
+	}
+	order := bson.M{"$switch": bson.M{"branches": branches, "default": 0}}
+
+	result, err := tasks.UpdateMany(ctx,
+		bson.M{"priority_order": bson.M{"$exists": false}},
+		mongo.Pipeline{{{Key: "$set", Value: bson.M{"priority_order": order}}}},
+	)
+	if err != nil {
+		return fmt.Errorf("erro ao preencher priority_order: %w", err)
+	}
+
+	if result.ModifiedCount > 0 {
+		slog.Info("priority_order de tarefas preenchido", "count", result.ModifiedCount)
+	}
+
+	return nil
+}
+
+// titleTokensBatchSize é quantas tarefas cada escrita do preenchimento de
+// title_tokens atualiza
+const titleTokensBatchSize = 500
+
+// backfillTaskTitleTokens grava title_tokens nas tarefas criadas antes do
+// campo. Os termos dependem da normalização de entities.TitleTokens, então são
+// calculados aqui e gravados em lotes.
+func (m *MongoDB) backfillTaskTitleTokens(ctx context.Context) error {
+	tasks := m.database.Collection(GetCollectionNames().Tasks)
+
+	opts := options.Find().SetProjection(bson.M{"title": 1}).SetBatchSize(titleTokensBatchSize)
+	cursor, err := tasks.Find(ctx, bson.M{"title_tokens": bson.M{"$exists": false}}, opts)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar tarefas sem title_tokens: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var filled int64
+	writes := make([]mongo.WriteModel, 0, titleTokensBatchSize)
+	flush := func() error {
+		if len(writes) == 0 {
+			return nil
+		}
+		result, err := tasks.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+		if err != nil {
+			return fmt.Errorf("erro ao preencher title_tokens: %w", err)
+		}
+		filled += result.ModifiedCount
+		writes = writes[:0]
+		return nil
+	}
+
+	for cursor.Next(ctx) {
+		var task struct {
+			ID    interface{} `bson:"_id"`
+			Title string      `bson:"title"`
+		}
+		if err := cursor.Decode(&task); err != nil {
+			return fmt.Errorf("erro ao decodificar tarefa sem title_tokens: %w", err)
+		}
+
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": task.ID}).
+			SetUpdate(bson.M{"$set": bson.M{"title_tokens": entities.TitleTokens(task.Title)}}))
+		if len(writes) == titleTokensBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return fmt.Errorf("erro ao percorrer tarefas sem title_tokens: %w", err)
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	if filled > 0 {
+		slog.Info("title_tokens de tarefas preenchido", "count", filled)
+	}
+
+	return nil
+}
+
+// normalizeTaskTags converte para minúsculas (sem espaços nas pontas e sem
+// repetidas) as etiquetas gravadas antes da normalização, para que os filtros
+// por etiqueta encontrem as tarefas antigas
+func (m *MongoDB) normalizeTaskTags(ctx context.Context) error {
+	tasks := m.database.Collection(GetCollectionNames().Tasks)
+
+	filter := bson.M{"tags": bson.M{"$regex": `[A-Z]|^\s|\s$`}}
+	lowered := bson.M{"$map": bson.M{
+		"input": "$tags",
+		"in":    bson.M{"$toLower": bson.M{"$trim": bson.M{"input": "$$this"}}},
+	}}
+	deduped := bson.M{"$reduce": bson.M{
+		"input":        lowered,
+		"initialValue": bson.A{},
+		"in": bson.M{"$cond": bson.A{
+			bson.M{"$or": bson.A{
+				bson.M{"$eq": bson.A{"$$this", ""}},
+				bson.M{"$in": bson.A{"$$this", "$$value"}},
+			}},
+			"$$value",
+			bson.M{"$concatArrays": bson.A{"$$value", bson.A{"$$this"}}},
+		}},
+	}}
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{"tags": deduped}}}}
+
+	result, err := tasks.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("erro ao normalizar etiquetas: %w", err)
+	}
+
+	if result.ModifiedCount > 0 {
+		slog.Info("etiquetas de tarefas normalizadas", "count", result.ModifiedCount)
+	}
+
 	return nil
 }
 
@@ -241,6 +997,12 @@ func (m *MongoDB) getTodoValidator() map[string]interface{} {
 					"enum":        []string{"low", "medium", "high", "urgent"},
 					"description": "Prioridade da tarefa",
 				},
+				"priority_order": map[string]interface{}{
+					"bsonType":    []string{"int", "long"},
+					"minimum":     0,
+					"maximum":     4,
+					"description": "Ordem numérica da prioridade (urgent = 4)",
+				},
 				"due_date": map[string]interface{}{
 					"bsonType":    "date",
 					"description": "Data de vencimento",
@@ -255,6 +1017,11 @@ func (m *MongoDB) getTodoValidator() map[string]interface{} {
 					"maxItems":    10,
 					"description": "Tags da tarefa",
 				},
+				"estimate_minutes": map[string]interface{}{
+					"bsonType":    []string{"int", "long"},
+					"minimum":     0,
+					"description": "Esforço estimado em minutos",
+				},
 				"is_archived": map[string]interface{}{
 					"bsonType":    "bool",
 					"description": "Se a tarefa está arquivada",
@@ -0,0 +1,166 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Ações registradas para cada índice durante a reconstrução
+const (
+	IndexActionCreated   = "created"
+	IndexActionReplaced  = "replaced"
+	IndexActionRecreated = "recreated"
+	IndexActionUnchanged = "unchanged"
+	IndexActionFailed    = "failed"
+)
+
+// IndexRebuildOptions controla a reconstrução dos índices declarados
+type IndexRebuildOptions struct {
+	// Recreate remove e recria todos os índices declarados, mesmo os que já
+	// correspondem à declaração. Sem ele, apenas os ausentes são criados e os
+	// divergentes substituídos.
+	Recreate bool
+	// Collections restringe a reconstrução às collections informadas (vazio = todas)
+	Collections []string
+}
+
+// IndexRebuildResult descreve o que foi feito com um índice declarado
+type IndexRebuildResult struct {
+	Collection string `json:"collection"`
+	Name       string `json:"name"`
+	Action     string `json:"action"`
+	DurationMs int64  `json:"duration_ms"`
+	Error      string `json:"error,omitempty"`
+}
+
+// IndexRebuild agrupa o resultado da reconstrução de índices
+type IndexRebuild struct {
+	Recreate   bool                 `json:"recreate"`
+	Indexes    []IndexRebuildResult `json:"indexes"`
+	Failed     int                  `json:"failed"`
+	DurationMs int64                `json:"duration_ms"`
+	StartedAt  time.Time            `json:"started_at"`
+}
+
+// RebuildIndexes reconstrói online os índices registrados, um a um, com build
+// em background. Índices removidos para recriação (divergentes ou com Recreate)
+// deixam de existir até o novo build terminar, inclusive as restrições de
+// unicidade. Falhas num índice não interrompem os demais.
+func (m *MongoDB) RebuildIndexes(ctx context.Context, opts IndexRebuildOptions) (*IndexRebuild, error) {
+	wanted := make(map[string]bool, len(opts.Collections))
+	for _, name := range opts.Collections {
+		wanted[name] = true
+	}
+
+	rebuild := &IndexRebuild{
+		Recreate:  opts.Recreate,
+		Indexes:   []IndexRebuildResult{},
+		StartedAt: time.Now(),
+	}
+
+	for _, declared := range declaredIndexes() {
+		if len(wanted) > 0 && !wanted[declared.collection] {
+			continue
+		}
+
+		results, err := m.rebuildCollectionIndexes(ctx, declared, opts.Recreate)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, result := range results {
+			if result.Action == IndexActionFailed {
+				rebuild.Failed++
+			}
+		}
+		rebuild.Indexes = append(rebuild.Indexes, results...)
+	}
+
+	rebuild.DurationMs = time.Since(rebuild.StartedAt).Milliseconds()
+
+	// Atualiza a auditoria exibida em /status com o novo estado dos índices
+	if _, err := m.AuditIndexes(ctx, false); err != nil {
+		slog.Warn("erro ao auditar índices após reconstrução", "error", err)
+	}
+
+	return rebuild, nil
+}
+
+// rebuildCollectionIndexes reconstrói os índices declarados de uma collection
+func (m *MongoDB) rebuildCollectionIndexes(ctx context.Context, declared declaredCollectionIndexes, recreate bool) ([]IndexRebuildResult, error) {
+	collection := m.GetCollection(declared.collection)
+	if collection == nil {
+		return nil, fmt.Errorf("conexão fechada ao reconstruir índices de %s", declared.collection)
+	}
+
+	specs, err := collection.Indexes().ListSpecifications(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar índices de %s: %w", declared.collection, err)
+	}
+
+	existing := make(map[string]*mongo.IndexSpecification, len(specs))
+	for _, spec := range specs {
+		existing[spec.Name] = spec
+	}
+
+	results := make([]IndexRebuildResult, 0, len(declared.models))
+	for _, model := range declared.models {
+		name := indexModelName(model)
+		result := IndexRebuildResult{Collection: declared.collection, Name: name}
+		started := time.Now()
+
+		spec, exists := existing[name]
+		switch {
+		case !exists:
+			result.Action = IndexActionCreated
+		case recreate:
+			result.Action = IndexActionRecreated
+		case !indexMatchesSpec(model, spec):
+			result.Action = IndexActionReplaced
+		default:
+			result.Action = IndexActionUnchanged
+		}
+
+		if result.Action != IndexActionUnchanged {
+			if err := rebuildIndex(ctx, collection, model, exists); err != nil {
+				slog.Warn("erro ao reconstruir índice", "collection", declared.collection, "index", name, "error", err)
+				result.Action = IndexActionFailed
+				result.Error = err.Error()
+			}
+		}
+
+		result.DurationMs = time.Since(started).Milliseconds()
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// rebuildIndex remove o índice existente (quando houver) e o cria novamente em background
+func rebuildIndex(ctx context.Context, collection *mongo.Collection, model mongo.IndexModel, exists bool) error {
+	name := indexModelName(model)
+
+	if exists {
+		if _, err := collection.Indexes().DropOne(ctx, name); err != nil {
+			return fmt.Errorf("erro ao remover índice %s: %w", name, err)
+		}
+	}
+
+	indexOptions := options.Index()
+	if model.Options != nil {
+		copied := *model.Options
+		indexOptions = &copied
+	}
+	indexOptions.SetBackground(true)
+
+	if _, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{Keys: model.Keys, Options: indexOptions}); err != nil {
+		return fmt.Errorf("erro ao criar índice %s: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,322 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// IndexAuditReport representa o resultado da auditoria de índices de uma collection
+type IndexAuditReport struct {
+	Collection string   `json:"collection"`
+	Declared   []string `json:"declared"`
+	Present    []string `json:"present"`
+	Created    []string `json:"created"`
+	Missing    []string `json:"missing"`
+	Divergent  []string `json:"divergent"`
+	Unexpected []string `json:"unexpected"`
+	Unused     []string `json:"unused"`
+}
+
+// IndexAudit agrupa os relatórios de todas as collections auditadas
+type IndexAudit struct {
+	Collections []IndexAuditReport `json:"collections"`
+	HasDrift    bool               `json:"has_drift"`
+	CheckedAt   time.Time          `json:"checked_at"`
+}
+
+// declaredCollectionIndexes associa uma collection aos índices declarados para ela
+type declaredCollectionIndexes struct {
+	collection string
+	models     []mongo.IndexModel
+}
+
+var (
+	indexRegistryMu sync.RWMutex
+	indexRegistry   []declaredCollectionIndexes
+)
+
+func init() {
+	names := GetCollectionNames()
+
+	RegisterIndexes(names.Users, usersIndexModels()...)
+	RegisterIndexes(names.Tasks, todosIndexModels()...)
+	RegisterIndexes(names.TasksArchive, tasksArchiveIndexModels()...)
+	RegisterIndexes(names.Notifications, notificationsIndexModels()...)
+	RegisterIndexes(names.AuditLogs, auditLogsIndexModels()...)
+	RegisterIndexes(names.TelegramLinks, telegramLinksIndexModels()...)
+	RegisterIndexes(names.InboundEmails, inboundEmailsIndexModels()...)
+	RegisterIndexes(names.Projects, projectsIndexModels()...)
+	RegisterIndexes(names.ImportJobs, importJobsIndexModels()...)
+	RegisterIndexes(names.GitHubAccounts, githubAccountsIndexModels()...)
+	RegisterIndexes(names.AppPasswords, appPasswordsIndexModels()...)
+	RegisterIndexes(names.TaskChanges, taskChangesIndexModels()...)
+	RegisterIndexes(names.UsageCounters, usageCountersIndexModels()...)
+	RegisterIndexes(names.PlatformMetrics, platformMetricsIndexModels()...)
+	RegisterIndexes(names.Tombstones, tombstonesIndexModels()...)
+	RegisterIndexes(names.FocusSessions, focusSessionsIndexModels()...)
+	RegisterIndexes(names.ViewSettings, viewSettingsIndexModels()...)
+	RegisterIndexes(names.ExportJobs, exportJobsIndexModels()...)
+	RegisterIndexes(names.Labels, labelsIndexModels()...)
+	RegisterIndexes(names.Subscriptions, subscriptionsIndexModels()...)
+	RegisterIndexes(names.WebhookDeliveries, webhookDeliveriesIndexModels()...)
+	RegisterIndexes(names.Organizations, organizationsIndexModels()...)
+}
+
+// RegisterIndexes registra índices de uma collection para serem criados e auditados.
+// Deve ser chamado na inicialização do pacote (init) de quem declara a collection.
+func RegisterIndexes(collection string, models ...mongo.IndexModel) {
+	indexRegistryMu.Lock()
+	defer indexRegistryMu.Unlock()
+
+	for i := range indexRegistry {
+		if indexRegistry[i].collection == collection {
+			indexRegistry[i].models = append(indexRegistry[i].models, models...)
+			return
+		}
+	}
+
+	indexRegistry = append(indexRegistry, declaredCollectionIndexes{
+		collection: collection,
+		models:     models,
+	})
+}
+
+// declaredIndexes retorna os índices registrados, na ordem em que foram registrados
+func declaredIndexes() []declaredCollectionIndexes {
+	indexRegistryMu.RLock()
+	defer indexRegistryMu.RUnlock()
+
+	declared := make([]declaredCollectionIndexes, len(indexRegistry))
+	copy(declared, indexRegistry)
+	return declared
+}
+
+// IndexedCollections retorna as collections com índices registrados
+func IndexedCollections() []string {
+	declared := declaredIndexes()
+	names := make([]string, 0, len(declared))
+	for _, collection := range declared {
+		names = append(names, collection.collection)
+	}
+	return names
+}
+
+// CreateIndexes cria todos os índices registrados
+func (m *MongoDB) CreateIndexes(ctx context.Context) error {
+	for _, declared := range declaredIndexes() {
+		if len(declared.models) == 0 {
+			continue
+		}
+
+		collection := m.GetCollection(declared.collection)
+		if collection == nil {
+			return fmt.Errorf("conexão fechada ao criar índices de %s", declared.collection)
+		}
+
+		if _, err := collection.Indexes().CreateMany(ctx, declared.models); err != nil {
+			return fmt.Errorf("erro ao criar índices para %s: %w", declared.collection, err)
+		}
+	}
+
+	return nil
+}
+
+// AuditIndexes compara os índices declarados com os existentes no banco.
+// Quando fix é true, os índices ausentes são criados.
+func (m *MongoDB) AuditIndexes(ctx context.Context, fix bool) (*IndexAudit, error) {
+	audit := &IndexAudit{CheckedAt: time.Now()}
+
+	for _, declared := range declaredIndexes() {
+		report, err := m.auditCollectionIndexes(ctx, declared, fix)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(report.Missing) > 0 || len(report.Divergent) > 0 || len(report.Unexpected) > 0 {
+			audit.HasDrift = true
+		}
+
+		audit.Collections = append(audit.Collections, *report)
+	}
+
+	m.lastIndexAudit.Store(audit)
+	return audit, nil
+}
+
+// auditCollectionIndexes audita os índices de uma única collection
+func (m *MongoDB) auditCollectionIndexes(ctx context.Context, declared declaredCollectionIndexes, fix bool) (*IndexAuditReport, error) {
+	collection := m.GetCollection(declared.collection)
+	if collection == nil {
+		return nil, fmt.Errorf("conexão fechada ao auditar índices de %s", declared.collection)
+	}
+
+	report := &IndexAuditReport{
+		Collection: declared.collection,
+		Declared:   []string{},
+		Present:    []string{},
+		Created:    []string{},
+		Missing:    []string{},
+		Divergent:  []string{},
+		Unexpected: []string{},
+		Unused:     []string{},
+	}
+
+	specs, err := collection.Indexes().ListSpecifications(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar índices de %s: %w", declared.collection, err)
+	}
+
+	existing := make(map[string]*mongo.IndexSpecification, len(specs))
+	for _, spec := range specs {
+		existing[spec.Name] = spec
+	}
+
+	declaredNames := make(map[string]bool, len(declared.models))
+	var toCreate []mongo.IndexModel
+
+	for _, model := range declared.models {
+		name := indexModelName(model)
+		report.Declared = append(report.Declared, name)
+		declaredNames[name] = true
+
+		spec, ok := existing[name]
+		if !ok {
+			if fix {
+				toCreate = append(toCreate, model)
+			} else {
+				report.Missing = append(report.Missing, name)
+			}
+			continue
+		}
+
+		report.Present = append(report.Present, name)
+		if !indexMatchesSpec(model, spec) {
+			report.Divergent = append(report.Divergent, name)
+		}
+	}
+
+	for _, spec := range specs {
+		if spec.Name == "_id_" || declaredNames[spec.Name] {
+			continue
+		}
+		report.Unexpected = append(report.Unexpected, spec.Name)
+	}
+
+	if len(toCreate) > 0 {
+		created, err := collection.Indexes().CreateMany(ctx, toCreate)
+		if err != nil {
+			for _, model := range toCreate {
+				report.Missing = append(report.Missing, indexModelName(model))
+			}
+			slog.Warn("erro ao criar índices ausentes", "collection", declared.collection, "error", err)
+		} else {
+			report.Created = append(report.Created, created...)
+		}
+	}
+
+	unused, err := m.unusedIndexes(ctx, collection)
+	if err != nil {
+		slog.Warn("não foi possível obter uso dos índices", "collection", declared.collection, "error", err)
+	} else {
+		report.Unused = unused
+	}
+
+	return report, nil
+}
+
+// unusedIndexes retorna os índices sem nenhum acesso registrado em $indexStats.
+// Os contadores do MongoDB são zerados a cada reinício do servidor.
+func (m *MongoDB) unusedIndexes(ctx context.Context, collection *mongo.Collection) ([]string, error) {
+	cursor, err := collection.Aggregate(ctx, []bson.M{{"$indexStats": bson.M{}}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	unused := []string{}
+	for cursor.Next(ctx) {
+		var stat struct {
+			Name     string `bson:"name"`
+			Accesses struct {
+				Ops int64 `bson:"ops"`
+			} `bson:"accesses"`
+		}
+		if err := cursor.Decode(&stat); err != nil {
+			return nil, err
+		}
+		if stat.Name != "_id_" && stat.Accesses.Ops == 0 {
+			unused = append(unused, stat.Name)
+		}
+	}
+
+	return unused, cursor.Err()
+}
+
+// indexModelName retorna o nome configurado no índice declarado
+func indexModelName(model mongo.IndexModel) string {
+	if model.Options != nil && model.Options.Name != nil {
+		return *model.Options.Name
+	}
+	return ""
+}
+
+// indexMatchesSpec verifica se o índice existente corresponde ao declarado
+func indexMatchesSpec(model mongo.IndexModel, spec *mongo.IndexSpecification) bool {
+	wantUnique := model.Options != nil && model.Options.Unique != nil && *model.Options.Unique
+	wantSparse := model.Options != nil && model.Options.Sparse != nil && *model.Options.Sparse
+	gotUnique := spec.Unique != nil && *spec.Unique
+	gotSparse := spec.Sparse != nil && *spec.Sparse
+
+	if wantUnique != gotUnique || wantSparse != gotSparse {
+		return false
+	}
+
+	declaredKeys, err := bson.Marshal(model.Keys)
+	if err != nil {
+		return false
+	}
+
+	return indexKeySignature(declaredKeys) == indexKeySignature(spec.KeysDocument)
+}
+
+// indexKeySignature gera uma representação comparável das chaves de um índice.
+// Índices de texto são armazenados pelo MongoDB como _fts/_ftsx, então são
+// comparados apenas pelo tipo.
+func indexKeySignature(keys bson.Raw) string {
+	elements, err := keys.Elements()
+	if err != nil {
+		return ""
+	}
+
+	parts := make([]string, 0, len(elements))
+	for _, element := range elements {
+		value := element.Value()
+		if str, ok := value.StringValueOK(); ok {
+			if str == "text" {
+				return "text"
+			}
+			parts = append(parts, element.Key()+":"+str)
+			continue
+		}
+
+		var direction float64
+		switch {
+		case value.Type == bson.TypeInt32:
+			direction = float64(value.Int32())
+		case value.Type == bson.TypeInt64:
+			direction = float64(value.Int64())
+		case value.Type == bson.TypeDouble:
+			direction = value.Double()
+		}
+		parts = append(parts, fmt.Sprintf("%s:%g", element.Key(), direction))
+	}
+
+	return strings.Join(parts, ",")
+}
@@ -3,13 +3,13 @@ package database
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"time"
 )
 
 // InitializeDatabase inicializa completamente o banco de dados
 func InitializeDatabase(config *MongoConfig) (Client, error) {
-	log.Println("🚀 Inicializando banco de dados...")
+	slog.Info("inicializando banco de dados")
 
 	// Conecta ao MongoDB
 	client, err := NewMongoClient(config)
@@ -22,16 +22,24 @@ func InitializeDatabase(config *MongoConfig) (Client, error) {
 	defer cancel()
 
 	// Garante que as collections existam
-	log.Println("📦 Verificando/criando collections...")
-	if err := EnsureCollectionsExist(client, ctx); err != nil {
+	slog.Info("verificando/criando collections")
+	if err := client.EnsureSchema(ctx); err != nil {
 		client.Close()
 		return nil, fmt.Errorf("falha ao criar collections: %w", err)
 	}
 
-	// Cria todos os índices
-	log.Println("📊 Criando índices...")
-	if err := CreateAllIndexes(client, ctx); err != nil {
-		log.Printf("⚠️  Aviso: Erro ao criar índices: %v", err)
+	// Cria todos os índices registrados
+	slog.Info("criando índices")
+	if err := client.CreateIndexes(ctx); err != nil {
+		slog.Warn("erro ao criar índices", "error", err)
+	}
+
+	// Audita os índices para detectar divergências
+	audit, err := client.AuditIndexes(ctx, false)
+	if err != nil {
+		slog.Warn("erro ao auditar índices", "error", err)
+	} else {
+		logIndexAudit(audit)
 	}
 
 	// Verifica se tudo está funcionando
@@ -40,48 +48,24 @@ func InitializeDatabase(config *MongoConfig) (Client, error) {
 		return nil, fmt.Errorf("falha no health check: %w", err)
 	}
 
-	log.Println("✅ Banco de dados inicializado com sucesso!")
+	slog.Info("banco de dados inicializado")
 	return client, nil
 }
 
-// EnsureCollectionsExist garante que as collections existam com as configurações corretas
-func EnsureCollectionsExist(client Client, ctx context.Context) error {
-	mongoClient := client.(*MongoDB)
-	return mongoClient.EnsureCollectionsExist(ctx)
-}
-
-// DatabaseStats retorna estatísticas do banco
-func DatabaseStats(client Client) (*Stats, error) {
-	mongoClient := client.(*MongoDB)
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	collections := mongoClient.GetCollections()
-
-	// Conta documentos
-	usersCount, err := collections.Users.CountDocuments(ctx, map[string]interface{}{})
-	if err != nil {
-		return nil, fmt.Errorf("erro ao contar users: %w", err)
-	}
-
-	todosCount, err := collections.Tasks.CountDocuments(ctx, map[string]interface{}{})
-	if err != nil {
-		return nil, fmt.Errorf("erro ao contar todos: %w", err)
+// logIndexAudit registra no log o resultado da auditoria de índices
+func logIndexAudit(audit *IndexAudit) {
+	for _, report := range audit.Collections {
+		if len(report.Created) > 0 {
+			slog.Info("índices criados", "collection", report.Collection, "indexes", report.Created)
+		}
+		if len(report.Missing) > 0 {
+			slog.Warn("índices ausentes", "collection", report.Collection, "indexes", report.Missing)
+		}
+		if len(report.Divergent) > 0 {
+			slog.Warn("índices divergentes", "collection", report.Collection, "indexes", report.Divergent)
+		}
+		if len(report.Unexpected) > 0 {
+			slog.Warn("índices não declarados", "collection", report.Collection, "indexes", report.Unexpected)
+		}
 	}
-
-	return &Stats{
-		UsersCount: usersCount,
-		TodosCount: todosCount,
-		Collections: []string{
-			GetCollectionNames().Users,
-			GetCollectionNames().Tasks,
-		},
-	}, nil
-}
-
-// Stats representa estatísticas do banco
-type Stats struct {
-	UsersCount  int64    `json:"users_count"`
-	TodosCount  int64    `json:"todos_count"`
-	Collections []string `json:"collections"`
 }
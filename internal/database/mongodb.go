@@ -3,43 +3,73 @@ package database
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/devgugga/todo-it/internal/fieldcrypt"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// Client define as operações de banco usadas pela aplicação.
+// Implementações alternativas (ex.: dublês de teste) devem satisfazer toda a interface.
 type Client interface {
 	GetCollection(name string) *mongo.Collection
+	Collections() *Collections
 	Close() error
 	Health() error
+	Topology() TopologyStatus
+	Timeouts() OperationTimeouts
+	FieldCipher() fieldcrypt.Cipher
+	Stats(ctx context.Context) (*Stats, error)
+	EnsureSchema(ctx context.Context) error
+	CreateIndexes(ctx context.Context) error
+	AuditIndexes(ctx context.Context, fix bool) (*IndexAudit, error)
+	RebuildIndexes(ctx context.Context, opts IndexRebuildOptions) (*IndexRebuild, error)
 }
 
 type MongoDB struct {
 	client   *mongo.Client
 	database *mongo.Database
 	dbName   string
+	timeouts OperationTimeouts
+	cipher   fieldcrypt.Cipher
+	pool     *poolMonitor
+	topology *topologyMonitor
 	mu       sync.Mutex
 	closed   bool
+
+	// lastIndexAudit guarda a última auditoria de índices (exibida em /status)
+	lastIndexAudit atomic.Pointer[IndexAudit]
 }
 
 type MongoConfig struct {
-	URI            string
-	DBName         string
-	MaxPoolSize    uint64
-	ConnectTimeout time.Duration
-	PingTimeout    time.Duration
+	URI                    string
+	DBName                 string
+	MaxPoolSize            uint64
+	MinPoolSize            uint64
+	MaxConnIdleTime        time.Duration
+	ConnectTimeout         time.Duration
+	PingTimeout            time.Duration
+	ServerSelectionTimeout time.Duration
+	Timeouts               OperationTimeouts
+	// FieldCipher cifra os campos sensíveis das tarefas (nil grava em claro)
+	FieldCipher fieldcrypt.Cipher
 }
 
 func DefaultMongoConfig() *MongoConfig {
 	return &MongoConfig{
-		URI:            "mongodb://localhost:27017",
-		DBName:         "todo_db",
-		MaxPoolSize:    20,
-		ConnectTimeout: 10 * time.Second,
-		PingTimeout:    5 * time.Second,
+		URI:                    "mongodb://localhost:27017",
+		DBName:                 "todo_db",
+		MaxPoolSize:            20,
+		MinPoolSize:            1,
+		MaxConnIdleTime:        30 * time.Second,
+		ConnectTimeout:         10 * time.Second,
+		PingTimeout:            5 * time.Second,
+		ServerSelectionTimeout: 5 * time.Second,
+		Timeouts:               DefaultOperationTimeouts(),
 	}
 }
 
@@ -51,21 +81,26 @@ func NewMongoClient(config *MongoConfig) (*MongoDB, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), config.ConnectTimeout)
 	defer cancel()
 
+	pool := newPoolMonitor(config.MaxPoolSize)
+	topology := newTopologyMonitor()
+
 	clientOptions := options.Client().
 		ApplyURI(config.URI).
 		SetMaxPoolSize(config.MaxPoolSize).
-		SetMinPoolSize(1).
-		SetMaxConnIdleTime(30 * time.Second).
-		SetServerSelectionTimeout(5 * time.Second).
+		SetMinPoolSize(config.MinPoolSize).
+		SetMaxConnIdleTime(config.MaxConnIdleTime).
+		SetServerSelectionTimeout(config.ServerSelectionTimeout).
 		SetRetryWrites(true).
-		SetRetryReads(true)
+		SetRetryReads(true).
+		SetPoolMonitor(pool.monitor()).
+		SetServerMonitor(topology.monitor())
 
 	client, err := mongo.Connect(ctx, clientOptions)
 	if err != nil {
 		return nil, fmt.Errorf("falha ao conectar com MongoDB: %w", err)
 	}
 
-	pingCtx, pingCancel := context.WithTimeout(context.Background(), config.ConnectTimeout)
+	pingCtx, pingCancel := context.WithTimeout(context.Background(), config.PingTimeout)
 	defer pingCancel()
 
 	if err := client.Ping(pingCtx, nil); err != nil {
@@ -75,14 +110,23 @@ func NewMongoClient(config *MongoConfig) (*MongoDB, error) {
 
 	database := client.Database(config.DBName)
 
+	fieldCipher := config.FieldCipher
+	if fieldCipher == nil {
+		fieldCipher = fieldcrypt.Disabled()
+	}
+
 	mongoDB := &MongoDB{
 		client:   client,
 		database: database,
 		dbName:   config.DBName,
+		timeouts: config.Timeouts.withDefaults(),
+		cipher:   fieldCipher,
+		pool:     pool,
+		topology: topology,
 		closed:   false,
 	}
 
-	log.Printf("✅ Conectado ao MongoDB - Database: %s", config.DBName)
+	slog.Info("conectado ao MongoDB", "database", config.DBName)
 	return mongoDB, nil
 }
 
@@ -91,7 +135,7 @@ func (m *MongoDB) GetCollection(name string) *mongo.Collection {
 	defer m.mu.Unlock()
 
 	if m.closed {
-		log.Printf("⚠️  Tentativa de usar conexão fechada para coleção: %s", name)
+		slog.Warn("tentativa de usar conexão fechada", "collection", name)
 		return nil
 	}
 
@@ -115,7 +159,7 @@ func (m *MongoDB) Close() error {
 	}
 
 	m.closed = true
-	log.Println("🔌 Desconectado do MongoDB")
+	slog.Info("desconectado do MongoDB")
 	return nil
 }
 
@@ -127,8 +171,23 @@ func (m *MongoDB) Health() error {
 		return fmt.Errorf("conexão MongoDB está fechada")
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeouts.Read)
 	defer cancel()
 
 	return m.client.Ping(ctx, nil)
 }
+
+// Topology retorna a topologia atual do cluster, acompanhada pelos eventos do driver
+func (m *MongoDB) Topology() TopologyStatus {
+	return m.topology.current()
+}
+
+// Timeouts retorna os timeouts de operação configurados
+func (m *MongoDB) Timeouts() OperationTimeouts {
+	return m.timeouts
+}
+
+// FieldCipher retorna a cifra dos campos sensíveis
+func (m *MongoDB) FieldCipher() fieldcrypt.Cipher {
+	return m.cipher
+}
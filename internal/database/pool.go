@@ -0,0 +1,75 @@
+package database
+
+import (
+	"sync/atomic"
+
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// PoolStats representa o estado do pool de conexões com o MongoDB
+type PoolStats struct {
+	MaxSize          uint64 `json:"max_size"`
+	Open             int64  `json:"open"`
+	InUse            int64  `json:"in_use"`
+	Idle             int64  `json:"idle"`
+	Created          int64  `json:"created"`
+	Closed           int64  `json:"closed"`
+	CheckoutFailures int64  `json:"checkout_failures"`
+	Cleared          int64  `json:"cleared"`
+}
+
+// poolMonitor acompanha os eventos do pool de conexões do driver
+type poolMonitor struct {
+	maxSize          uint64
+	open             atomic.Int64
+	inUse            atomic.Int64
+	created          atomic.Int64
+	closed           atomic.Int64
+	checkoutFailures atomic.Int64
+	cleared          atomic.Int64
+}
+
+func newPoolMonitor(maxSize uint64) *poolMonitor {
+	return &poolMonitor{maxSize: maxSize}
+}
+
+// monitor retorna o PoolMonitor a ser registrado nas opções do cliente
+func (p *poolMonitor) monitor() *event.PoolMonitor {
+	return &event.PoolMonitor{Event: p.handle}
+}
+
+func (p *poolMonitor) handle(evt *event.PoolEvent) {
+	switch evt.Type {
+	case event.ConnectionCreated:
+		p.open.Add(1)
+		p.created.Add(1)
+	case event.ConnectionClosed:
+		p.open.Add(-1)
+		p.closed.Add(1)
+	case event.GetSucceeded:
+		p.inUse.Add(1)
+	case event.ConnectionReturned:
+		p.inUse.Add(-1)
+	case event.GetFailed:
+		p.checkoutFailures.Add(1)
+	case event.PoolCleared:
+		p.cleared.Add(1)
+	}
+}
+
+// stats retorna uma cópia dos contadores atuais
+func (p *poolMonitor) stats() PoolStats {
+	open := p.open.Load()
+	inUse := p.inUse.Load()
+
+	return PoolStats{
+		MaxSize:          p.maxSize,
+		Open:             open,
+		InUse:            inUse,
+		Idle:             max(open-inUse, 0),
+		Created:          p.created.Load(),
+		Closed:           p.closed.Load(),
+		CheckoutFailures: p.checkoutFailures.Load(),
+		Cleared:          p.cleared.Load(),
+	}
+}
@@ -0,0 +1,67 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Stats representa estatísticas do banco
+type Stats struct {
+	UsersCount  int64        `json:"users_count"`
+	TodosCount  int64        `json:"todos_count"`
+	Collections []string     `json:"collections"`
+	Pool        PoolStats    `json:"pool"`
+	Indexes     *IndexStatus `json:"indexes,omitempty"`
+}
+
+// IndexStatus resume a última auditoria de índices
+type IndexStatus struct {
+	HasDrift  bool      `json:"has_drift"`
+	Missing   int       `json:"missing"`
+	Divergent int       `json:"divergent"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// Stats retorna estatísticas do banco
+func (m *MongoDB) Stats(ctx context.Context) (*Stats, error) {
+	ctx, cancel := context.WithTimeout(ctx, m.timeouts.Aggregate)
+	defer cancel()
+
+	collections := m.Collections()
+
+	// Conta documentos
+	usersCount, err := collections.Users.CountDocuments(ctx, map[string]interface{}{})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao contar users: %w", err)
+	}
+
+	todosCount, err := collections.Tasks.CountDocuments(ctx, map[string]interface{}{})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao contar todos: %w", err)
+	}
+
+	return &Stats{
+		UsersCount:  usersCount,
+		TodosCount:  todosCount,
+		Collections: GetCollectionNames().All(),
+		Pool:        m.pool.stats(),
+		Indexes:     m.indexStatus(),
+	}, nil
+}
+
+// indexStatus resume a última auditoria de índices executada (nil se nenhuma)
+func (m *MongoDB) indexStatus() *IndexStatus {
+	audit := m.lastIndexAudit.Load()
+	if audit == nil {
+		return nil
+	}
+
+	status := &IndexStatus{HasDrift: audit.HasDrift, CheckedAt: audit.CheckedAt}
+	for _, report := range audit.Collections {
+		status.Missing += len(report.Missing)
+		status.Divergent += len(report.Divergent)
+	}
+
+	return status
+}
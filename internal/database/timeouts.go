@@ -0,0 +1,36 @@
+package database
+
+import "time"
+
+// OperationTimeouts define os timeouts aplicados por tipo de operação no banco
+type OperationTimeouts struct {
+	Read      time.Duration
+	Write     time.Duration
+	Aggregate time.Duration
+}
+
+// DefaultOperationTimeouts retorna os timeouts padrão de operação
+func DefaultOperationTimeouts() OperationTimeouts {
+	return OperationTimeouts{
+		Read:      5 * time.Second,
+		Write:     5 * time.Second,
+		Aggregate: 10 * time.Second,
+	}
+}
+
+// withDefaults preenche com o valor padrão os timeouts não configurados
+func (t OperationTimeouts) withDefaults() OperationTimeouts {
+	defaults := DefaultOperationTimeouts()
+
+	if t.Read <= 0 {
+		t.Read = defaults.Read
+	}
+	if t.Write <= 0 {
+		t.Write = defaults.Write
+	}
+	if t.Aggregate <= 0 {
+		t.Aggregate = defaults.Aggregate
+	}
+
+	return t
+}
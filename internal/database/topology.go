@@ -0,0 +1,157 @@
+package database
+
+import (
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/description"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/topology"
+)
+
+// unavailableErrorCodes são os códigos do servidor durante eleição de
+// primário ou encerramento: a operação pode ser repetida em instantes
+var unavailableErrorCodes = []int{
+	91,    // ShutdownInProgress
+	189,   // PrimarySteppedDown
+	10107, // NotWritablePrimary
+	11600, // InterruptedAtShutdown
+	11602, // InterruptedDueToReplStateChange
+	13435, // NotPrimaryNoSecondaryOk
+	13436, // NotPrimaryOrSecondary
+}
+
+// IsUnavailable indica se o erro vem da indisponibilidade temporária do
+// MongoDB (eleição de primário, falha de rede ou nenhum servidor elegível)
+func IsUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var selection topology.ServerSelectionError
+	if errors.As(err, &selection) || errors.Is(err, topology.ErrServerSelectionTimeout) {
+		return true
+	}
+	if mongo.IsNetworkError(err) {
+		return true
+	}
+
+	var serverErr mongo.ServerError
+	if errors.As(err, &serverErr) {
+		for _, code := range unavailableErrorCodes {
+			if serverErr.HasErrorCode(code) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// TopologyServer é a situação de um servidor do cluster
+type TopologyServer struct {
+	Address string `json:"address"`
+	Kind    string `json:"kind"`
+	Error   string `json:"error,omitempty"`
+}
+
+// TopologyStatus é a situação do cluster vista pelo driver
+type TopologyStatus struct {
+	Kind    string `json:"kind"`
+	SetName string `json:"set_name,omitempty"`
+	// Writable indica que há um servidor aceitando escritas (primário,
+	// mongos ou standalone); falso durante eleições
+	Writable bool             `json:"writable"`
+	Servers  []TopologyServer `json:"servers"`
+	// ChangedAt é a última mudança de topologia e UnwritableSince o início da
+	// indisponibilidade de escrita atual
+	ChangedAt       *time.Time `json:"changed_at,omitempty"`
+	UnwritableSince *time.Time `json:"unwritable_since,omitempty"`
+	// Changes conta as mudanças de topologia desde o início do processo
+	Changes int64 `json:"changes"`
+}
+
+// topologyMonitor acompanha os eventos de topologia do driver
+type topologyMonitor struct {
+	mu     sync.RWMutex
+	status TopologyStatus
+}
+
+func newTopologyMonitor() *topologyMonitor {
+	return &topologyMonitor{status: TopologyStatus{Kind: "Unknown", Servers: []TopologyServer{}}}
+}
+
+// monitor retorna o ServerMonitor a ser registrado nas opções do cliente
+func (t *topologyMonitor) monitor() *event.ServerMonitor {
+	return &event.ServerMonitor{TopologyDescriptionChanged: t.handle}
+}
+
+// handle registra a nova topologia. É chamado com a topologia travada pelo
+// driver, então não executa operações no banco.
+func (t *topologyMonitor) handle(evt *event.TopologyDescriptionChangedEvent) {
+	now := time.Now()
+	desc := evt.NewDescription
+
+	status := TopologyStatus{
+		Kind:     desc.Kind.String(),
+		SetName:  desc.SetName,
+		Writable: isWritable(desc),
+		Servers:  make([]TopologyServer, 0, len(desc.Servers)),
+	}
+	for _, server := range desc.Servers {
+		item := TopologyServer{Address: server.Addr.String(), Kind: server.Kind.String()}
+		if server.LastError != nil {
+			item.Error = server.LastError.Error()
+		}
+		status.Servers = append(status.Servers, item)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	previous := t.status
+	status.ChangedAt = &now
+	status.Changes = previous.Changes + 1
+	if !status.Writable {
+		status.UnwritableSince = previous.UnwritableSince
+		if status.UnwritableSince == nil {
+			status.UnwritableSince = &now
+		}
+	}
+	t.status = status
+
+	// O primeiro evento (descoberta inicial) não é uma mudança relevante
+	if previous.Changes == 0 {
+		return
+	}
+	switch {
+	case previous.Writable && !status.Writable:
+		slog.Warn("MongoDB sem servidor para escrita (eleição de primário ou falha de rede)", "topology", status.Kind)
+	case !previous.Writable && status.Writable && previous.UnwritableSince != nil:
+		slog.Info("MongoDB novamente disponível para escrita", "topology", status.Kind, "unavailable_ms", now.Sub(*previous.UnwritableSince).Milliseconds())
+	}
+}
+
+// current retorna uma cópia da topologia atual
+func (t *topologyMonitor) current() TopologyStatus {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	status := t.status
+	status.Servers = append([]TopologyServer(nil), status.Servers...)
+	return status
+}
+
+// isWritable indica se a topologia tem um servidor que aceita escritas
+func isWritable(desc description.Topology) bool {
+	for _, server := range desc.Servers {
+		switch server.Kind {
+		case description.RSPrimary, description.Standalone, description.Mongos, description.LoadBalancer:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,10 @@
+package admin
+
+import "time"
+
+// AssignPlanRequest atribui um plano ao usuário sem cobrança, até
+// current_period_end (omitido não vence)
+type AssignPlanRequest struct {
+	Plan             string     `json:"plan" validate:"required,oneof=free pro"`
+	CurrentPeriodEnd *time.Time `json:"current_period_end,omitempty"`
+}
@@ -0,0 +1,15 @@
+package admin
+
+// Modos de reconstrução de índices
+const (
+	IndexRebuildModeRebuild  = "rebuild"
+	IndexRebuildModeRecreate = "recreate"
+)
+
+// RebuildIndexesRequest escolhe como reconstruir os índices declarados: rebuild
+// (padrão) cria os ausentes e substitui os divergentes; recreate remove e recria
+// todos. Collections restringe a reconstrução (vazio = todas).
+type RebuildIndexesRequest struct {
+	Mode        string   `json:"mode" validate:"omitempty,oneof=rebuild recreate"`
+	Collections []string `json:"collections" validate:"omitempty,max=50,dive,required"`
+}
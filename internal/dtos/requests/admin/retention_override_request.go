@@ -0,0 +1,7 @@
+package admin
+
+// RetentionOverrideRequest define a retenção própria de um usuário: dias por
+// recurso (0 mantém para sempre); recursos omitidos seguem a política padrão
+type RetentionOverrideRequest struct {
+	Days map[string]int `json:"days" validate:"required,min=1,dive,keys,oneof=completed_tasks audit_logs notifications,endkeys,min=0,max=36500"`
+}
@@ -0,0 +1,6 @@
+package admin
+
+// SuspendUserRequest informa o motivo da suspensão, registrado na conta e na auditoria
+type SuspendUserRequest struct {
+	Reason string `json:"reason" validate:"omitempty,max=500"`
+}
@@ -0,0 +1,7 @@
+package admin
+
+type UpdateMaintenanceRequest struct {
+	Mode              string `json:"mode" validate:"required,oneof=off read_only full"`
+	RetryAfterSeconds *int   `json:"retry_after_seconds,omitempty" validate:"omitempty,min=0,max=86400"`
+	Message           string `json:"message" validate:"omitempty,max=200"`
+}
@@ -0,0 +1,6 @@
+package billing
+
+// CheckoutRequest inicia a contratação de um plano pago
+type CheckoutRequest struct {
+	Plan string `json:"plan" validate:"required,oneof=pro"`
+}
@@ -0,0 +1,7 @@
+package focus
+
+type StartFocusRequest struct {
+	TaskID string `json:"task_id" validate:"required,mongodb"`
+	// PlannedMinutes é a duração do ciclo escolhida no cliente (ex.: 25 no pomodoro)
+	PlannedMinutes int `json:"planned_minutes,omitempty" validate:"omitempty,min=1,max=240"`
+}
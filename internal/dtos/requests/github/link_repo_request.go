@@ -0,0 +1,7 @@
+package github
+
+type LinkRepoRequest struct {
+	// Repo é o nome completo do repositório (owner/nome)
+	Repo            string `json:"repo" validate:"required,max=140"`
+	CloseOnComplete bool   `json:"close_on_complete"`
+}
@@ -0,0 +1,6 @@
+package github
+
+type OAuthCallbackRequest struct {
+	Code  string `json:"code" validate:"required,max=100"`
+	State string `json:"state" validate:"required,len=64,hexadecimal"`
+}
@@ -0,0 +1,6 @@
+package importer
+
+type MicrosoftTodoImportRequest struct {
+	// AccessToken é um token delegado da Microsoft Graph com o escopo Tasks.Read
+	AccessToken string `json:"access_token" validate:"required,min=20,max=8192"`
+}
@@ -0,0 +1,5 @@
+package importer
+
+type TodoistImportRequest struct {
+	APIToken string `json:"api_token" validate:"required,min=10,max=100"`
+}
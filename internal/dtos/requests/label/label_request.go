@@ -0,0 +1,40 @@
+package label
+
+import "github.com/devgugga/todo-it/internal/entities"
+
+// CreateLabelRequest cria uma etiqueta gerenciada. O nome segue as regras das
+// etiquetas das tarefas (minúsculas, até 50 caracteres).
+type CreateLabelRequest struct {
+	Name  string `json:"name" validate:"required,min=1,max=50"`
+	Color string `json:"color,omitempty" validate:"omitempty,hexcolor"`
+	// Icon é um emoji ou o nome de um ícone do cliente
+	Icon string `json:"icon,omitempty" validate:"omitempty,max=50"`
+}
+
+func (r *CreateLabelRequest) ToEntity() *entities.Label {
+	return &entities.Label{
+		Name:  r.Name,
+		Color: r.Color,
+		Icon:  r.Icon,
+	}
+}
+
+// UpdateLabelRequest altera os campos enviados; color ou icon vazios ("") são removidos.
+// Renomear a etiqueta também renomeia a etiqueta nas tarefas associadas.
+type UpdateLabelRequest struct {
+	Name  *string `json:"name,omitempty" validate:"omitempty,min=1,max=50"`
+	Color *string `json:"color,omitempty" validate:"omitempty,len=0|hexcolor"`
+	Icon  *string `json:"icon,omitempty" validate:"omitempty,max=50"`
+}
+
+func (r *UpdateLabelRequest) ApplyToEntity(label *entities.Label) {
+	if r.Name != nil {
+		label.Name = entities.NormalizeTag(*r.Name)
+	}
+	if r.Color != nil {
+		label.Color = *r.Color
+	}
+	if r.Icon != nil {
+		label.Icon = *r.Icon
+	}
+}
@@ -0,0 +1,23 @@
+package organization
+
+import "github.com/devgugga/todo-it/internal/entities"
+
+// CreateOrganizationRequest cria uma organização com o usuário como dono. O dono
+// ocupa um dos assentos.
+type CreateOrganizationRequest struct {
+	Name  string `json:"name" validate:"required,min=1,max=100"`
+	Seats int    `json:"seats" validate:"required,min=1,max=10000"`
+}
+
+func (r *CreateOrganizationRequest) ToEntity() *entities.Organization {
+	return &entities.Organization{
+		Name:  r.Name,
+		Seats: r.Seats,
+	}
+}
+
+// InviteOrganizationMemberRequest convida o email para ocupar um assento. O
+// assento só é ocupado quando o convidado aceita.
+type InviteOrganizationMemberRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
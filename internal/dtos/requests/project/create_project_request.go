@@ -0,0 +1,22 @@
+package project
+
+import (
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type CreateProjectRequest struct {
+	Name  string `json:"name" validate:"required,min=1,max=100"`
+	Color string `json:"color,omitempty" validate:"omitempty,hexcolor"`
+}
+
+func (r *CreateProjectRequest) ToEntity(userID primitive.ObjectID) *entities.Project {
+	project := &entities.Project{
+		Name:  r.Name,
+		Color: r.Color,
+	}
+
+	project.PrepareForCreate(userID)
+
+	return project
+}
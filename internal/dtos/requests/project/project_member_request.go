@@ -0,0 +1,13 @@
+package project
+
+import "github.com/devgugga/todo-it/internal/enums"
+
+// AddProjectMemberRequest compartilha o projeto com o usuário do email informado
+type AddProjectMemberRequest struct {
+	Email string            `json:"email" validate:"required,email"`
+	Role  enums.ProjectRole `json:"role" validate:"required,oneof=editor viewer"`
+}
+
+type UpdateProjectMemberRequest struct {
+	Role enums.ProjectRole `json:"role" validate:"required,oneof=editor viewer"`
+}
@@ -0,0 +1,18 @@
+package project
+
+import "github.com/devgugga/todo-it/internal/entities"
+
+type UpdateProjectRequest struct {
+	Name  string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	Color string `json:"color,omitempty" validate:"omitempty,hexcolor"`
+}
+
+func (r *UpdateProjectRequest) ApplyToEntity(project *entities.Project) {
+	if r.Name != "" {
+		project.Name = r.Name
+	}
+	if r.Color != "" {
+		project.Color = r.Color
+	}
+	project.PrepareForUpdate()
+}
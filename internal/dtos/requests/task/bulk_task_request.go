@@ -0,0 +1,54 @@
+package task
+
+import (
+	"github.com/devgugga/todo-it/internal/enums"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// BulkDeleteRequest seleciona as tarefas removidas em lote. O limite de IDs
+// por requisição é configurável (BULK_MAX_IDS) e verificado pelo handler.
+type BulkDeleteRequest struct {
+	IDs []string `json:"ids" validate:"required,min=1,dive,mongodb"`
+}
+
+// ObjectIDs converte os IDs, sem repetidos
+func (r *BulkDeleteRequest) ObjectIDs() []primitive.ObjectID {
+	return uniqueObjectIDs(r.IDs)
+}
+
+// BulkUpdateStatusRequest altera o status das tarefas em lote
+type BulkUpdateStatusRequest struct {
+	IDs    []string         `json:"ids" validate:"required,min=1,dive,mongodb"`
+	Status enums.TaskStatus `json:"status" validate:"required,oneof=pending in_progress completed cancelled"`
+}
+
+// ObjectIDs converte os IDs, sem repetidos
+func (r *BulkUpdateStatusRequest) ObjectIDs() []primitive.ObjectID {
+	return uniqueObjectIDs(r.IDs)
+}
+
+// ReorderTasksRequest define a nova ordem das tarefas de uma coluna (mesmo
+// projeto e status): a primeira tarefa fica na posição 1
+type ReorderTasksRequest struct {
+	IDs []string `json:"ids" validate:"required,min=1,unique,dive,mongodb"`
+}
+
+// ObjectIDs converte os IDs na ordem enviada
+func (r *ReorderTasksRequest) ObjectIDs() []primitive.ObjectID {
+	return uniqueObjectIDs(r.IDs)
+}
+
+// uniqueObjectIDs converte IDs já validados (tag mongodb), ignorando repetidos
+func uniqueObjectIDs(hexes []string) []primitive.ObjectID {
+	seen := make(map[primitive.ObjectID]bool, len(hexes))
+	ids := make([]primitive.ObjectID, 0, len(hexes))
+	for _, hex := range hexes {
+		id, err := primitive.ObjectIDFromHex(hex)
+		if err != nil || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids
+}
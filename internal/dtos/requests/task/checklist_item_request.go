@@ -0,0 +1,33 @@
+package task
+
+import (
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ChecklistItemRequest struct {
+	Text string `json:"text" validate:"required,min=1,max=200"`
+	Done bool   `json:"done"`
+}
+
+// UpdateChecklistItemRequest marca (done = true) ou desmarca um item da lista de verificação
+type UpdateChecklistItemRequest struct {
+	Done *bool `json:"done" validate:"required"`
+}
+
+// NewChecklist converte os itens da requisição (a lista enviada substitui a anterior)
+func NewChecklist(items []ChecklistItemRequest) []entities.ChecklistItem {
+	if len(items) == 0 {
+		return nil
+	}
+
+	checklist := make([]entities.ChecklistItem, 0, len(items))
+	for _, item := range items {
+		checklist = append(checklist, entities.ChecklistItem{
+			ID:   primitive.NewObjectID(),
+			Text: item.Text,
+			Done: item.Done,
+		})
+	}
+	return checklist
+}
@@ -0,0 +1,69 @@
+package task
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CreateTaskRequest cria uma tarefa. Os nomes das etiquetas gerenciadas de
+// label_ids se juntam a tags.
+type CreateTaskRequest struct {
+	Title       string                 `json:"title" validate:"required,min=1,max=200"`
+	Description string                 `json:"description,omitempty" validate:"omitempty,max=1000"`
+	Status      enums.TaskStatus       `json:"status,omitempty" validate:"omitempty,oneof=pending in_progress completed cancelled"`
+	Priority    enums.TaskPriority     `json:"priority,omitempty" validate:"omitempty,oneof=low medium high urgent"`
+	DueDate     *DueDate               `json:"due_date,omitempty"`
+	Tags        []string               `json:"tags,omitempty" validate:"omitempty,max=10,dive,min=1,max=50"`
+	LabelIDs    []string               `json:"label_ids,omitempty" validate:"omitempty,max=10,dive,mongodb"`
+	ProjectID   string                 `json:"project_id,omitempty" validate:"omitempty,mongodb"`
+	Checklist   []ChecklistItemRequest `json:"checklist,omitempty" validate:"omitempty,max=100,dive"`
+	Location    *LocationRequest       `json:"location,omitempty"`
+	// EstimateMinutes é o esforço estimado, em minutos (máximo de 1000 horas)
+	EstimateMinutes int `json:"estimate_minutes,omitempty" validate:"omitempty,min=1,max=60000"`
+	// CustomStatusID é um status personalizado do usuário; substitui status
+	CustomStatusID string `json:"custom_status_id,omitempty" validate:"omitempty,mongodb"`
+}
+
+// ToEntity converte a requisição. preferences são as do usuário: o fuso é
+// usado no vencimento só com a data e os padrões de tarefa preenchem os campos
+// omitidos. now é a hora de criação.
+func (r *CreateTaskRequest) ToEntity(userID primitive.ObjectID, preferences *entities.UserPreferences, now time.Time) *entities.Task {
+	task := &entities.Task{
+		Title:       r.Title,
+		Description: r.Description,
+		Status:      r.Status,
+		Priority:    r.Priority,
+		Checklist:   NewChecklist(r.Checklist),
+
+		EstimateMinutes: r.EstimateMinutes,
+	}
+
+	// Uma lista vazia enviada explicitamente não recebe as tags padrão
+	if r.Tags != nil {
+		task.Tags = entities.NormalizeTags(r.Tags)
+	}
+
+	if r.DueDate != nil {
+		dueDate := r.DueDate.In(preferences.Location())
+		task.DueDate = &dueDate
+	}
+
+	if projectID, err := primitive.ObjectIDFromHex(r.ProjectID); err == nil {
+		task.ProjectID = &projectID
+	}
+
+	if r.Location != nil {
+		task.Location = r.Location.ToEntity()
+	}
+
+	preferences.TaskDefaults.ApplyTo(task)
+
+	// Tarefas criadas já concluídas recebem completed_at = now; sem prioridade
+	// informada nem padrão do usuário, vale a do sistema
+	task.PrepareForCreateAt(userID, now)
+
+	return task
+}
@@ -0,0 +1,50 @@
+package task
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// dueDateLayout é o formato do vencimento só com a data
+const dueDateLayout = "2006-01-02"
+
+// errInvalidDueDate indica vencimento fora dos formatos aceitos
+var errInvalidDueDate = errors.New("due_date deve ser uma data (AAAA-MM-DD) ou data e hora RFC 3339")
+
+// DueDate é o vencimento enviado pelo cliente: um instante (RFC 3339) ou só a
+// data ("2006-01-02"), que vence no fim do dia no fuso do usuário
+type DueDate struct {
+	Time     time.Time
+	DateOnly bool
+}
+
+func (d *DueDate) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return errInvalidDueDate
+	}
+
+	if date, err := time.Parse(dueDateLayout, raw); err == nil {
+		*d = DueDate{Time: date, DateOnly: true}
+		return nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return errInvalidDueDate
+	}
+	*d = DueDate{Time: parsed}
+	return nil
+}
+
+// In retorna o vencimento em UTC. Para datas sem horário, é o fim do dia
+// (23:59:59) no fuso informado, como nas importações e no CalDAV.
+func (d DueDate) In(loc *time.Location) time.Time {
+	if !d.DateOnly {
+		return d.Time.UTC()
+	}
+
+	year, month, day := d.Time.Date()
+	return time.Date(year, month, day, 23, 59, 59, 0, loc).UTC()
+}
@@ -0,0 +1,17 @@
+package task
+
+import "github.com/devgugga/todo-it/internal/entities"
+
+type LocationRequest struct {
+	Lat   *float64 `json:"lat" validate:"required,gte=-90,lte=90"`
+	Lng   *float64 `json:"lng" validate:"required,gte=-180,lte=180"`
+	Label string   `json:"label,omitempty" validate:"omitempty,max=100"`
+}
+
+// ToEntity converte a requisição no local da tarefa
+func (r *LocationRequest) ToEntity() *entities.TaskLocation {
+	return &entities.TaskLocation{
+		Point: entities.NewGeoPoint(*r.Lat, *r.Lng),
+		Label: r.Label,
+	}
+}
@@ -0,0 +1,41 @@
+package task
+
+import "encoding/json"
+
+// Nullable distingue, no JSON, o campo ausente (não altera) do campo enviado
+// como null (remove o valor)
+type Nullable[T any] struct {
+	// Set indica que o campo estava presente no corpo, mesmo que null
+	Set bool
+	// Value é nil quando o campo foi enviado como null
+	Value *T
+}
+
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	n.Set = true
+	if string(data) == "null" {
+		n.Value = nil
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	n.Value = &value
+	return nil
+}
+
+// IsNull indica que o campo foi enviado como null
+func (n Nullable[T]) IsNull() bool {
+	return n.Set && n.Value == nil
+}
+
+// ValidationValue retorna o valor validado pelas regras do campo (nil se
+// ausente ou null, ignorado por omitempty)
+func (n Nullable[T]) ValidationValue() interface{} {
+	if n.Value == nil {
+		return nil
+	}
+	return *n.Value
+}
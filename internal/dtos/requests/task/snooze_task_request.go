@@ -0,0 +1,6 @@
+package task
+
+// SnoozeTaskRequest adia o vencimento da tarefa por um atalho
+type SnoozeTaskRequest struct {
+	Preset string `json:"preset" validate:"required,oneof=tomorrow next_business_day next_week"`
+}
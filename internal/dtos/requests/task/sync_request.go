@@ -0,0 +1,35 @@
+package task
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/enums"
+)
+
+// Operações aceitas na sincronização
+const (
+	SyncOpCreate = "create"
+	SyncOpUpdate = "update"
+	SyncOpDelete = "delete"
+)
+
+// SyncRequest envia as alterações feitas offline, na ordem em que ocorreram
+type SyncRequest struct {
+	Changes []SyncChangeRequest `json:"changes" validate:"required,min=1,max=100,dive"`
+}
+
+// SyncChangeRequest é uma alteração de tarefa feita pelo cliente
+type SyncChangeRequest struct {
+	Op string `json:"op" validate:"required,oneof=create update delete"`
+	// ID da tarefa; na criação é gerado pelo cliente (ObjectID), o que torna o reenvio idempotente
+	ID string `json:"id" validate:"required,mongodb"`
+	// BaseUpdatedAt é o updated_at da versão do servidor que o cliente alterou (update e delete)
+	BaseUpdatedAt *time.Time `json:"base_updated_at" validate:"required_unless=Op create"`
+	// ModifiedAt é quando a alteração foi feita no cliente; decide os conflitos
+	ModifiedAt time.Time `json:"modified_at" validate:"required"`
+	// Task são os dados da tarefa criada (op create)
+	Task *CreateTaskRequest `json:"task,omitempty" validate:"required_if=Op create"`
+	// Update são os campos alterados e Status o novo status (op update; um ou ambos)
+	Update *UpdateTaskRequest `json:"update,omitempty"`
+	Status *enums.TaskStatus  `json:"status,omitempty" validate:"omitempty,oneof=pending in_progress completed cancelled"`
+}
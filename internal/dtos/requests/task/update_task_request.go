@@ -0,0 +1,121 @@
+package task
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UpdateTaskRequest altera apenas os campos enviados. Campos Nullable enviados
+// como null removem o valor (ex.: "due_date": null tira o vencimento).
+// label_ids substitui as etiquetas gerenciadas da tarefa, mantendo as demais.
+type UpdateTaskRequest struct {
+	Title       *string                `json:"title,omitempty" validate:"omitempty,min=1,max=200"`
+	Description Nullable[string]       `json:"description" validate:"omitempty,max=1000"`
+	Priority    *enums.TaskPriority    `json:"priority,omitempty" validate:"omitempty,oneof=low medium high urgent"`
+	DueDate     Nullable[DueDate]      `json:"due_date"`
+	Tags        *[]string              `json:"tags,omitempty" validate:"omitempty,max=10,dive,min=1,max=50"`
+	LabelIDs    *[]string              `json:"label_ids,omitempty" validate:"omitempty,max=10,dive,mongodb"`
+	IsArchived  *bool                  `json:"is_archived,omitempty"`
+	Checklist   []ChecklistItemRequest `json:"checklist,omitempty" validate:"omitempty,max=100,dive"`
+	// ProjectID null ou vazio ("") remove a tarefa do projeto
+	ProjectID Nullable[string]          `json:"project_id" validate:"omitempty,len=0|mongodb"`
+	Location  Nullable[LocationRequest] `json:"location"`
+	// EstimateMinutes null remove a estimativa de esforço
+	EstimateMinutes Nullable[int] `json:"estimate_minutes" validate:"omitempty,min=1,max=60000"`
+}
+
+// ApplyToEntity aplica os campos enviados à tarefa e retorna as alterações no
+// formato de TodoRepository.PatchFields (valores nil são removidos). loc é o
+// fuso do usuário, usado no vencimento só com a data, e now é a hora da alteração.
+func (r *UpdateTaskRequest) ApplyToEntity(task *entities.Task, loc *time.Location, now time.Time) bson.M {
+	fields := bson.M{}
+
+	if r.Title != nil {
+		task.Title = *r.Title
+		fields["title"] = task.Title
+		fields["title_tokens"] = entities.TitleTokens(task.Title)
+	}
+	if r.Description.Set {
+		task.Description = ""
+		if r.Description.Value != nil {
+			task.Description = *r.Description.Value
+		}
+		fields["description"] = nilIfEmpty(task.Description)
+	}
+	if r.Priority != nil {
+		task.Priority = *r.Priority
+		fields["priority"] = task.Priority
+		fields["priority_order"] = task.Priority.GetPriorityOrder()
+	}
+	if r.DueDate.Set {
+		task.DueDate = nil
+		fields["due_date"] = nil
+		if r.DueDate.Value != nil {
+			dueDate := r.DueDate.Value.In(loc)
+			task.DueDate = &dueDate
+			fields["due_date"] = dueDate
+		}
+	}
+	if r.Tags != nil {
+		task.Tags = entities.NormalizeTags(*r.Tags)
+		fields["tags"] = task.Tags
+	}
+	if r.IsArchived != nil {
+		task.IsArchived = *r.IsArchived
+		fields["is_archived"] = task.IsArchived
+	}
+	if r.ProjectID.Set {
+		previous := task.ProjectID
+		task.ProjectID = nil
+		fields["project_id"] = nil
+		if r.ProjectID.Value != nil {
+			if projectID, err := primitive.ObjectIDFromHex(*r.ProjectID.Value); err == nil {
+				task.ProjectID = &projectID
+				fields["project_id"] = projectID
+			}
+		}
+		// Status personalizados pertencem ao projeto anterior
+		if previous == nil || task.ProjectID == nil || *previous != *task.ProjectID {
+			task.StatusID = ""
+			fields["status_id"] = nil
+		}
+	}
+	if r.Location.Set {
+		task.Location = nil
+		fields["location"] = nil
+		if r.Location.Value != nil {
+			task.Location = r.Location.Value.ToEntity()
+			fields["location"] = task.Location
+		}
+	}
+	if r.EstimateMinutes.Set {
+		task.EstimateMinutes = 0
+		fields["estimate_minutes"] = nil
+		if r.EstimateMinutes.Value != nil {
+			task.EstimateMinutes = *r.EstimateMinutes.Value
+			fields["estimate_minutes"] = task.EstimateMinutes
+		}
+	}
+	if r.Checklist != nil {
+		task.Checklist = NewChecklist(r.Checklist)
+		fields["checklist"] = nil
+		if task.Checklist != nil {
+			fields["checklist"] = task.Checklist
+		}
+	}
+	task.PrepareForUpdateAt(now)
+
+	return fields
+}
+
+// nilIfEmpty converte texto vazio em nil, para remover o campo
+func nilIfEmpty(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
@@ -0,0 +1,10 @@
+package task
+
+import "github.com/devgugga/todo-it/internal/enums"
+
+// UpdateTaskStatusRequest altera o status padrão (status) ou atribui um status
+// personalizado do usuário (custom_status_id), que grava o status padrão associado a ele
+type UpdateTaskStatusRequest struct {
+	Status         enums.TaskStatus `json:"status,omitempty" validate:"required_without=CustomStatusID,excluded_with=CustomStatusID,omitempty,oneof=pending in_progress completed cancelled"`
+	CustomStatusID string           `json:"custom_status_id,omitempty" validate:"omitempty,mongodb"`
+}
@@ -0,0 +1,6 @@
+package user
+
+type CreateAppPasswordRequest struct {
+	// Name identifica o dispositivo ou cliente (ex.: "Thunderbird do notebook")
+	Name string `json:"name" validate:"required,min=1,max=50"`
+}
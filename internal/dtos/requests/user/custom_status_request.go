@@ -0,0 +1,38 @@
+package user
+
+import (
+	"strings"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+)
+
+type CreateCustomStatusRequest struct {
+	Name  string `json:"name" validate:"required,min=1,max=50"`
+	Color string `json:"color,omitempty" validate:"omitempty,hexcolor"`
+	// MapsTo é o status padrão gravado nas tarefas; não pode ser alterado depois
+	MapsTo enums.TaskStatus `json:"maps_to" validate:"required,oneof=pending in_progress completed cancelled"`
+}
+
+func (r *CreateCustomStatusRequest) ToEntity() entities.CustomStatus {
+	return entities.CustomStatus{
+		Name:   strings.TrimSpace(r.Name),
+		Color:  r.Color,
+		MapsTo: r.MapsTo,
+	}
+}
+
+// UpdateCustomStatusRequest altera o nome e a cor; enviar color vazio ("") remove a cor
+type UpdateCustomStatusRequest struct {
+	Name  *string `json:"name,omitempty" validate:"omitempty,min=1,max=50"`
+	Color *string `json:"color,omitempty" validate:"omitempty,len=0|hexcolor"`
+}
+
+func (r *UpdateCustomStatusRequest) ApplyToEntity(status *entities.CustomStatus) {
+	if r.Name != nil {
+		status.Name = strings.TrimSpace(*r.Name)
+	}
+	if r.Color != nil {
+		status.Color = *r.Color
+	}
+}
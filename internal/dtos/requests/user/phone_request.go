@@ -0,0 +1,10 @@
+package user
+
+type StartPhoneVerificationRequest struct {
+	// Phone no formato E.164 (ex.: +5511999998888)
+	Phone string `json:"phone" validate:"required,e164"`
+}
+
+type ConfirmPhoneVerificationRequest struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
@@ -0,0 +1,83 @@
+package user
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type UpdatePreferencesRequest struct {
+	Timezone      *string                               `json:"timezone,omitempty" validate:"omitempty,timezone"`
+	Notifications *UpdateNotificationPreferencesRequest `json:"notifications,omitempty"`
+	TaskDefaults  *UpdateTaskDefaultsRequest            `json:"task_defaults,omitempty"`
+	Calendar      *UpdateCalendarPreferencesRequest     `json:"calendar,omitempty"`
+}
+
+// UpdateCalendarPreferencesRequest substitui as preferências de dias úteis
+type UpdateCalendarPreferencesRequest struct {
+	SkipWeekends  bool   `json:"skip_weekends"`
+	HolidayLocale string `json:"holiday_locale" validate:"omitempty,bcp47_language_tag"`
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	Channels        []string `json:"channels" validate:"omitempty,dive,oneof=inbox email push webhook sms"`
+	MutedEvents     []string `json:"muted_events" validate:"omitempty,max=20,dive,min=1,max=50"`
+	QuietHoursStart string   `json:"quiet_hours_start" validate:"omitempty,datetime=15:04"`
+	QuietHoursEnd   string   `json:"quiet_hours_end" validate:"omitempty,datetime=15:04"`
+	WebhookURL      string   `json:"webhook_url" validate:"omitempty,url,startswith=https://"`
+	PushTopic       string   `json:"push_topic" validate:"omitempty,min=8,max=64,alphanum"`
+	OverdueSummary  bool     `json:"overdue_summary"`
+}
+
+// UpdateTaskDefaultsRequest substitui os padrões aplicados às novas tarefas
+type UpdateTaskDefaultsRequest struct {
+	Priority  enums.TaskPriority `json:"priority" validate:"omitempty,oneof=low medium high urgent"`
+	ProjectID string             `json:"project_id" validate:"omitempty,mongodb"`
+	Tags      []string           `json:"tags" validate:"omitempty,max=10,dive,min=1,max=50"`
+	// ReminderOffsetMinutes é a antecedência do lembrete em relação ao
+	// vencimento (0 = sem lembrete; máximo de 7 dias)
+	ReminderOffsetMinutes int `json:"reminder_offset_minutes" validate:"min=0,max=10080"`
+	// PastDueDatePolicy trata o vencimento no passado na criação de tarefas
+	PastDueDatePolicy string `json:"past_due_date_policy" validate:"omitempty,oneof=allow warn reject"`
+}
+
+func (r *UpdatePreferencesRequest) ApplyToEntity(preferences *entities.UserPreferences) {
+	if r.Timezone != nil {
+		preferences.Timezone = *r.Timezone
+	}
+
+	if r.Notifications != nil {
+		preferences.Notifications = entities.NotificationPreferences{
+			Channels:        r.Notifications.Channels,
+			MutedEvents:     r.Notifications.MutedEvents,
+			QuietHoursStart: r.Notifications.QuietHoursStart,
+			QuietHoursEnd:   r.Notifications.QuietHoursEnd,
+			WebhookURL:      r.Notifications.WebhookURL,
+			PushTopic:       r.Notifications.PushTopic,
+			OverdueSummary:  r.Notifications.OverdueSummary,
+		}
+	}
+
+	if r.Calendar != nil {
+		preferences.Calendar = entities.CalendarPreferences{
+			SkipWeekends:  r.Calendar.SkipWeekends,
+			HolidayLocale: r.Calendar.HolidayLocale,
+		}
+	}
+
+	if r.TaskDefaults != nil {
+		preferences.TaskDefaults = entities.TaskDefaults{
+			Priority:       r.TaskDefaults.Priority,
+			Tags:           entities.NormalizeTags(r.TaskDefaults.Tags),
+			ReminderOffset: time.Duration(r.TaskDefaults.ReminderOffsetMinutes) * time.Minute,
+		}
+		if r.TaskDefaults.PastDueDatePolicy != entities.DueDatePolicyAllow {
+			preferences.TaskDefaults.PastDueDatePolicy = r.TaskDefaults.PastDueDatePolicy
+		}
+		if projectID, err := primitive.ObjectIDFromHex(r.TaskDefaults.ProjectID); err == nil {
+			preferences.TaskDefaults.ProjectID = &projectID
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package view
+
+import (
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UpdateViewSettingsRequest substitui a configuração de visualização
+type UpdateViewSettingsRequest struct {
+	Layout          string   `json:"layout" validate:"required,oneof=list board"`
+	ColumnOrder     []string `json:"column_order,omitempty" validate:"max=50,dive,min=1,max=64"`
+	CollapsedGroups []string `json:"collapsed_groups,omitempty" validate:"max=50,dive,min=1,max=64"`
+	SortBy          string   `json:"sort_by,omitempty" validate:"omitempty,oneof=created_at updated_at due_date title priority position relevance"`
+	SortOrder       string   `json:"sort_order,omitempty" validate:"omitempty,oneof=asc desc"`
+}
+
+func (r *UpdateViewSettingsRequest) ToEntity(userID primitive.ObjectID, projectID *primitive.ObjectID) *entities.ViewSettings {
+	return &entities.ViewSettings{
+		UserID:          userID,
+		ProjectID:       projectID,
+		Layout:          r.Layout,
+		ColumnOrder:     r.ColumnOrder,
+		CollapsedGroups: r.CollapsedGroups,
+		SortBy:          r.SortBy,
+		SortOrder:       r.SortOrder,
+	}
+}
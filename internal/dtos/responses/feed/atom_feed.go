@@ -0,0 +1,116 @@
+package feed
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/events"
+)
+
+// AtomFeed é o feed Atom (RFC 4287) de atividades do usuário
+type AtomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Author  AtomPerson  `xml:"author"`
+	Link    AtomLink    `xml:"link"`
+	Entries []AtomEntry `xml:"entry"`
+}
+
+type AtomPerson struct {
+	Name string `xml:"name"`
+}
+
+type AtomLink struct {
+	Rel  string `xml:"rel,attr"`
+	Href string `xml:"href,attr"`
+}
+
+type AtomCategory struct {
+	Term  string `xml:"term,attr"`
+	Label string `xml:"label,attr,omitempty"`
+}
+
+type AtomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+type AtomEntry struct {
+	// ID usa o ID da alteração, estável entre consultas
+	ID        string       `xml:"id"`
+	Title     string       `xml:"title"`
+	Updated   string       `xml:"updated"`
+	Published string       `xml:"published"`
+	Category  AtomCategory `xml:"category"`
+	Content   AtomContent  `xml:"content"`
+}
+
+// NewActivityFeed monta o feed a partir das alterações (da mais nova para a mais antiga)
+func NewActivityFeed(user *entities.User, changes []*entities.TaskChange, selfURL string) *AtomFeed {
+	updated := user.CreatedAt
+	if len(changes) > 0 {
+		updated = changes[0].OccurredAt
+	}
+
+	feed := &AtomFeed{
+		ID:      "urn:todo-it:feed:" + user.ID.Hex(),
+		Title:   "Atividades de " + user.Name,
+		Updated: formatAtomTime(updated),
+		Author:  AtomPerson{Name: user.Name},
+		Link:    AtomLink{Rel: "self", Href: selfURL},
+		Entries: make([]AtomEntry, 0, len(changes)),
+	}
+
+	for _, change := range changes {
+		label, prefix := "Tarefa criada", "Nova tarefa"
+		if change.Type == events.TaskCompleted {
+			label, prefix = "Tarefa concluída", "Concluída"
+		}
+
+		occurred := formatAtomTime(change.OccurredAt)
+		feed.Entries = append(feed.Entries, AtomEntry{
+			ID:        "urn:todo-it:change:" + change.ID.Hex(),
+			Title:     prefix + ": " + change.Task.Title,
+			Updated:   occurred,
+			Published: occurred,
+			Category:  AtomCategory{Term: change.Type, Label: label},
+			Content:   AtomContent{Type: "text", Body: activitySummary(&change.Task)},
+		})
+	}
+
+	return feed
+}
+
+// Bytes serializa o feed com a declaração XML
+func (f *AtomFeed) Bytes() ([]byte, error) {
+	body, err := xml.Marshal(f)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao gerar feed: %w", err)
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+// activitySummary descreve a tarefa no conteúdo da entrada
+func activitySummary(task *entities.Task) string {
+	lines := []string{"Prioridade: " + string(task.Priority)}
+	if task.DueDate != nil {
+		lines = append(lines, "Vencimento: "+task.DueDate.UTC().Format("2006-01-02 15:04")+" UTC")
+	}
+	if len(task.Tags) > 0 {
+		lines = append(lines, "Etiquetas: "+strings.Join(task.Tags, ", "))
+	}
+	if task.Description != "" {
+		lines = append(lines, "", task.Description)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatAtomTime formata datas no padrão exigido pelo Atom (RFC 3339)
+func formatAtomTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
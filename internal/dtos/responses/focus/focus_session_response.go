@@ -0,0 +1,41 @@
+package focus
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+)
+
+type FocusSessionResponse struct {
+	ID             string     `json:"id"`
+	TaskID         string     `json:"task_id"`
+	Active         bool       `json:"active"`
+	PlannedSeconds int64      `json:"planned_seconds,omitempty"`
+	ElapsedSeconds int64      `json:"elapsed_seconds"`
+	StartedAt      time.Time  `json:"started_at"`
+	EndsAt         *time.Time `json:"ends_at,omitempty"` // fim previsto, para retomar o cronômetro
+	EndedAt        *time.Time `json:"ended_at,omitempty"`
+	ServerTime     time.Time  `json:"server_time"`
+}
+
+// NewFocusSessionResponse monta a resposta da sessão; now é a hora do servidor,
+// base do tempo decorrido das sessões em andamento
+func NewFocusSessionResponse(session *entities.FocusSession, now time.Time) *FocusSessionResponse {
+	response := &FocusSessionResponse{
+		ID:             session.ID.Hex(),
+		TaskID:         session.TaskID.Hex(),
+		Active:         session.Active,
+		PlannedSeconds: session.PlannedSeconds,
+		ElapsedSeconds: int64(session.Elapsed(now) / time.Second),
+		StartedAt:      session.StartedAt,
+		EndedAt:        session.EndedAt,
+		ServerTime:     now,
+	}
+
+	if session.PlannedSeconds > 0 {
+		endsAt := session.StartedAt.Add(time.Duration(session.PlannedSeconds) * time.Second)
+		response.EndsAt = &endsAt
+	}
+
+	return response
+}
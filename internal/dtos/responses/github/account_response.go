@@ -0,0 +1,47 @@
+package github
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+)
+
+type AccountResponse struct {
+	Connected   bool           `json:"connected"`
+	Login       string         `json:"login,omitempty"`
+	Scopes      string         `json:"scopes,omitempty"`
+	ConnectedAt *time.Time     `json:"connected_at,omitempty"`
+	Repos       []RepoResponse `json:"repos"`
+}
+
+type RepoResponse struct {
+	FullName        string    `json:"full_name"`
+	Webhook         bool      `json:"webhook"`
+	CloseOnComplete bool      `json:"close_on_complete"`
+	LinkedAt        time.Time `json:"linked_at"`
+}
+
+func NewAccountResponse(account *entities.GitHubAccount) *AccountResponse {
+	if !account.IsConnected() {
+		return &AccountResponse{Connected: false, Repos: []RepoResponse{}}
+	}
+
+	response := &AccountResponse{
+		Connected:   true,
+		Login:       account.Login,
+		Scopes:      account.Scopes,
+		ConnectedAt: account.ConnectedAt,
+		Repos:       make([]RepoResponse, 0, len(account.Repos)),
+	}
+
+	for _, repo := range account.Repos {
+		response.Repos = append(response.Repos, RepoResponse{
+			FullName:        repo.FullName,
+			Webhook:         repo.HookID != 0,
+			CloseOnComplete: repo.CloseOnComplete,
+			LinkedAt:        repo.LinkedAt,
+		})
+	}
+
+	return response
+}
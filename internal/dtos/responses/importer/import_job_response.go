@@ -0,0 +1,55 @@
+package importer
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+)
+
+type ImportJobResponse struct {
+	ID              string                   `json:"id"`
+	Source          string                   `json:"source"`
+	Status          entities.ImportJobStatus `json:"status"`
+	Total           int                      `json:"total"`
+	Processed       int                      `json:"processed"`
+	Percent         int                      `json:"percent"`
+	ProjectsCreated int                      `json:"projects_created"`
+	TasksCreated    int                      `json:"tasks_created"`
+	Warnings        []string                 `json:"warnings"`
+	WarningsOmitted int                      `json:"warnings_omitted,omitempty"`
+	Error           string                   `json:"error,omitempty"`
+	CreatedAt       time.Time                `json:"created_at"`
+	StartedAt       *time.Time               `json:"started_at,omitempty"`
+	FinishedAt      *time.Time               `json:"finished_at,omitempty"`
+}
+
+func NewImportJobResponse(job *entities.ImportJob) *ImportJobResponse {
+	response := &ImportJobResponse{
+		ID:              job.ID.Hex(),
+		Source:          job.Source,
+		Status:          job.Status,
+		Total:           job.Total,
+		Processed:       job.Processed,
+		ProjectsCreated: job.ProjectsCreated,
+		TasksCreated:    job.TasksCreated,
+		Warnings:        job.Warnings,
+		WarningsOmitted: job.WarningsOmitted,
+		Error:           job.Error,
+		CreatedAt:       job.CreatedAt,
+		StartedAt:       job.StartedAt,
+		FinishedAt:      job.FinishedAt,
+	}
+
+	if response.Warnings == nil {
+		response.Warnings = []string{}
+	}
+
+	switch {
+	case job.Status == entities.ImportJobCompleted:
+		response.Percent = 100
+	case job.Total > 0:
+		response.Percent = job.Processed * 100 / job.Total
+	}
+
+	return response
+}
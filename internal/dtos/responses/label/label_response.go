@@ -0,0 +1,54 @@
+package label
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+)
+
+type LabelResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color,omitempty"`
+	Icon      string    `json:"icon,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewLabelResponse(label *entities.Label) *LabelResponse {
+	return &LabelResponse{
+		ID:        label.ID.Hex(),
+		Name:      label.Name,
+		Color:     label.Color,
+		Icon:      label.Icon,
+		CreatedAt: label.CreatedAt,
+		UpdatedAt: label.UpdatedAt,
+	}
+}
+
+func NewLabelResponses(labels []*entities.Label) []LabelResponse {
+	responses := make([]LabelResponse, 0, len(labels))
+	for _, label := range labels {
+		responses = append(responses, *NewLabelResponse(label))
+	}
+	return responses
+}
+
+// MigrationResponse resume a conversão das etiquetas das tarefas em etiquetas
+// gerenciadas. Skipped são as que ficaram de fora pelo limite de etiquetas.
+type MigrationResponse struct {
+	Created     []LabelResponse `json:"created"`
+	Skipped     []string        `json:"skipped"`
+	LinkedTasks int64           `json:"linked_tasks"`
+}
+
+func NewMigrationResponse(created []*entities.Label, skipped []string, linkedTasks int64) *MigrationResponse {
+	if skipped == nil {
+		skipped = []string{}
+	}
+	return &MigrationResponse{
+		Created:     NewLabelResponses(created),
+		Skipped:     skipped,
+		LinkedTasks: linkedTasks,
+	}
+}
@@ -0,0 +1,52 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+)
+
+type NotificationResponse struct {
+	ID        string                 `json:"id"`
+	Type      string                 `json:"type"`
+	Title     string                 `json:"title"`
+	Body      string                 `json:"body"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+	Read      bool                   `json:"read"`
+	ReadAt    *time.Time             `json:"read_at,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+func NewNotificationResponse(notification *entities.Notification) *NotificationResponse {
+	return &NotificationResponse{
+		ID:        notification.ID.Hex(),
+		Type:      notification.Type,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		Data:      notification.Data,
+		Read:      notification.ReadAt != nil,
+		ReadAt:    notification.ReadAt,
+		CreatedAt: notification.CreatedAt,
+	}
+}
+
+type NotificationListResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	Total         int64                  `json:"total"`
+	Page          int64                  `json:"page"`
+	Limit         int64                  `json:"limit"`
+}
+
+func NewNotificationListResponse(notifications []*entities.Notification, total, page, limit int64) *NotificationListResponse {
+	items := make([]NotificationResponse, 0, len(notifications))
+	for _, notification := range notifications {
+		items = append(items, *NewNotificationResponse(notification))
+	}
+
+	return &NotificationListResponse{
+		Notifications: items,
+		Total:         total,
+		Page:          page,
+		Limit:         limit,
+	}
+}
@@ -0,0 +1,65 @@
+package notification
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+)
+
+type WebhookDeliveryResponse struct {
+	ID              string          `json:"id"`
+	EventID         string          `json:"event_id"`
+	RedeliveryOf    string          `json:"redelivery_of,omitempty"`
+	URL             string          `json:"url"`
+	Success         bool            `json:"success"`
+	StatusCode      int             `json:"status_code,omitempty"`
+	LatencyMs       int64           `json:"latency_ms"`
+	ResponseSnippet string          `json:"response_snippet,omitempty"`
+	Error           string          `json:"error,omitempty"`
+	Payload         json.RawMessage `json:"payload,omitempty"`
+	CreatedAt       time.Time       `json:"created_at"`
+}
+
+func NewWebhookDeliveryResponse(delivery *entities.WebhookDelivery) *WebhookDeliveryResponse {
+	response := &WebhookDeliveryResponse{
+		ID:              delivery.ID.Hex(),
+		EventID:         delivery.EventID,
+		URL:             delivery.URL,
+		Success:         delivery.Success,
+		StatusCode:      delivery.StatusCode,
+		LatencyMs:       delivery.LatencyMs,
+		ResponseSnippet: delivery.ResponseSnippet,
+		Error:           delivery.Error,
+		CreatedAt:       delivery.CreatedAt,
+	}
+	if delivery.RedeliveryOf != nil {
+		response.RedeliveryOf = delivery.RedeliveryOf.Hex()
+	}
+	if delivery.Payload != "" {
+		response.Payload = json.RawMessage(delivery.Payload)
+	}
+
+	return response
+}
+
+type WebhookDeliveryListResponse struct {
+	Deliveries []WebhookDeliveryResponse `json:"deliveries"`
+	Total      int64                     `json:"total"`
+	Page       int64                     `json:"page"`
+	Limit      int64                     `json:"limit"`
+}
+
+func NewWebhookDeliveryListResponse(deliveries []*entities.WebhookDelivery, total, page, limit int64) *WebhookDeliveryListResponse {
+	items := make([]WebhookDeliveryResponse, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		items = append(items, *NewWebhookDeliveryResponse(delivery))
+	}
+
+	return &WebhookDeliveryListResponse{
+		Deliveries: items,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+	}
+}
@@ -0,0 +1,121 @@
+package organization
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+)
+
+type OrganizationResponse struct {
+	ID        string           `json:"id"`
+	Name      string           `json:"name"`
+	OwnerID   string           `json:"owner_id"`
+	Seats     int              `json:"seats"`
+	SeatsUsed int              `json:"seats_used"`
+	Members   []MemberResponse `json:"members"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+type MemberResponse struct {
+	UserID  string    `json:"user_id"`
+	AddedAt time.Time `json:"added_at"`
+}
+
+func NewOrganizationResponse(organization *entities.Organization) *OrganizationResponse {
+	members := make([]MemberResponse, 0, len(organization.Members))
+	for _, member := range organization.Members {
+		members = append(members, NewMemberResponse(&member))
+	}
+
+	return &OrganizationResponse{
+		ID:        organization.ID.Hex(),
+		Name:      organization.Name,
+		OwnerID:   organization.OwnerID.Hex(),
+		Seats:     organization.Seats,
+		SeatsUsed: organization.SeatsUsed(),
+		Members:   members,
+		CreatedAt: organization.CreatedAt,
+		UpdatedAt: organization.UpdatedAt,
+	}
+}
+
+func NewOrganizationResponses(organizations []*entities.Organization) []OrganizationResponse {
+	responses := make([]OrganizationResponse, 0, len(organizations))
+	for _, organization := range organizations {
+		responses = append(responses, *NewOrganizationResponse(organization))
+	}
+	return responses
+}
+
+func NewMemberResponse(member *entities.OrganizationMember) MemberResponse {
+	return MemberResponse{
+		UserID:  member.UserID.Hex(),
+		AddedAt: member.AddedAt,
+	}
+}
+
+// InviteResponse é um convite pendente para o usuário autenticado
+type InviteResponse struct {
+	OrganizationID   string    `json:"organization_id"`
+	OrganizationName string    `json:"organization_name"`
+	InvitedAt        time.Time `json:"invited_at"`
+	ExpiresAt        time.Time `json:"expires_at"`
+}
+
+// NewInviteResponses monta os convites para o email nas organizações listadas
+// (já filtradas pelos convites pendentes)
+func NewInviteResponses(organizations []*entities.Organization, email string) []InviteResponse {
+	responses := make([]InviteResponse, 0, len(organizations))
+	for _, organization := range organizations {
+		for _, invite := range organization.Invites {
+			if invite.Email != email {
+				continue
+			}
+			responses = append(responses, InviteResponse{
+				OrganizationID:   organization.ID.Hex(),
+				OrganizationName: organization.Name,
+				InvitedAt:        invite.InvitedAt,
+				ExpiresAt:        invite.ExpiresAt,
+			})
+		}
+	}
+	return responses
+}
+
+// UsageResponse é o uso da organização calculado pelo job org-usage
+type UsageResponse struct {
+	Seats        int                   `json:"seats"`
+	SeatsUsed    int                   `json:"seats_used"`
+	TasksCreated int64                 `json:"tasks_created"`
+	StorageBytes int64                 `json:"storage_bytes"`
+	Members      []MemberUsageResponse `json:"members"`
+	ComputedAt   time.Time             `json:"computed_at"`
+}
+
+// MemberUsageResponse é o uso de um membro (inclusive o dono)
+type MemberUsageResponse struct {
+	UserID       string `json:"user_id"`
+	TasksCreated int64  `json:"tasks_created"`
+	StorageBytes int64  `json:"storage_bytes"`
+}
+
+func NewUsageResponse(usage *entities.OrgUsage) *UsageResponse {
+	members := make([]MemberUsageResponse, 0, len(usage.Members))
+	for _, member := range usage.Members {
+		members = append(members, MemberUsageResponse{
+			UserID:       member.UserID.Hex(),
+			TasksCreated: member.TasksCreated,
+			StorageBytes: member.StorageBytes,
+		})
+	}
+
+	return &UsageResponse{
+		Seats:        usage.Seats,
+		SeatsUsed:    usage.SeatsUsed,
+		TasksCreated: usage.TasksCreated,
+		StorageBytes: usage.StorageBytes,
+		Members:      members,
+		ComputedAt:   usage.ComputedAt,
+	}
+}
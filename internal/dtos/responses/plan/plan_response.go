@@ -0,0 +1,91 @@
+package plan
+
+import (
+	"sort"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/plans"
+	"github.com/devgugga/todo-it/internal/quota"
+)
+
+type PlanResponse struct {
+	ID     string           `json:"id"`
+	Name   string           `json:"name"`
+	Limits map[string]int64 `json:"limits"`
+}
+
+// CurrentPlanResponse é o plano efetivo do usuário com a assinatura e o uso de cada cota
+type CurrentPlanResponse struct {
+	Plan         PlanResponse          `json:"plan"`
+	Subscription *SubscriptionResponse `json:"subscription"`
+	Usage        []UsageResponse       `json:"usage"`
+}
+
+type SubscriptionResponse struct {
+	PlanID           string     `json:"plan_id"`
+	Status           string     `json:"status"`
+	Provider         string     `json:"provider"`
+	CurrentPeriodEnd *time.Time `json:"current_period_end,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+type UsageResponse struct {
+	Resource string    `json:"resource"`
+	Used     int64     `json:"used"`
+	Limit    int64     `json:"limit"`
+	ResetsAt time.Time `json:"resets_at"`
+}
+
+func NewPlanResponse(plan *plans.Plan) PlanResponse {
+	limits := make(map[string]int64, len(plan.Limits))
+	for resource, limit := range plan.Limits {
+		limits[string(resource)] = limit
+	}
+
+	return PlanResponse{ID: string(plan.ID), Name: plan.Name, Limits: limits}
+}
+
+func NewPlanListResponse(catalog []*plans.Plan) []PlanResponse {
+	responses := make([]PlanResponse, 0, len(catalog))
+	for _, plan := range catalog {
+		responses = append(responses, NewPlanResponse(plan))
+	}
+	return responses
+}
+
+func NewSubscriptionResponse(subscription *entities.Subscription) *SubscriptionResponse {
+	if subscription == nil {
+		return nil
+	}
+
+	return &SubscriptionResponse{
+		PlanID:           subscription.PlanID,
+		Status:           subscription.Status,
+		Provider:         subscription.Provider,
+		CurrentPeriodEnd: subscription.CurrentPeriodEnd,
+		CreatedAt:        subscription.CreatedAt,
+		UpdatedAt:        subscription.UpdatedAt,
+	}
+}
+
+func NewCurrentPlanResponse(plan *plans.Plan, subscription *entities.Subscription, usage []*quota.Usage) *CurrentPlanResponse {
+	response := &CurrentPlanResponse{
+		Plan:         NewPlanResponse(plan),
+		Subscription: NewSubscriptionResponse(subscription),
+		Usage:        make([]UsageResponse, 0, len(usage)),
+	}
+
+	for _, item := range usage {
+		response.Usage = append(response.Usage, UsageResponse{
+			Resource: string(item.Resource),
+			Used:     item.Used,
+			Limit:    item.Limit,
+			ResetsAt: item.ResetsAt,
+		})
+	}
+	sort.Slice(response.Usage, func(i, j int) bool { return response.Usage[i].Resource < response.Usage[j].Resource })
+
+	return response
+}
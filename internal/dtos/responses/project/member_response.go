@@ -0,0 +1,38 @@
+package project
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+)
+
+type MemberResponse struct {
+	UserID  string            `json:"user_id"`
+	Role    enums.ProjectRole `json:"role"`
+	AddedAt time.Time         `json:"added_at"`
+}
+
+func NewMemberResponse(member *entities.ProjectMember) MemberResponse {
+	return MemberResponse{
+		UserID:  member.UserID.Hex(),
+		Role:    member.Role,
+		AddedAt: member.AddedAt,
+	}
+}
+
+// NewMemberResponses lista quem tem acesso ao projeto: o dono seguido dos membros
+func NewMemberResponses(project *entities.Project) []MemberResponse {
+	responses := make([]MemberResponse, 0, len(project.Members)+1)
+	responses = append(responses, MemberResponse{
+		UserID:  project.UserID.Hex(),
+		Role:    enums.RoleOwner,
+		AddedAt: project.CreatedAt,
+	})
+
+	for _, member := range project.Members {
+		responses = append(responses, NewMemberResponse(&member))
+	}
+
+	return responses
+}
@@ -0,0 +1,88 @@
+package project
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+)
+
+type ProjectResponse struct {
+	ID        string           `json:"id"`
+	OwnerID   string           `json:"owner_id"`
+	Name      string           `json:"name"`
+	Color     string           `json:"color,omitempty"`
+	Statuses  []StatusResponse `json:"statuses"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+
+	Members []MemberResponse `json:"members,omitempty"`
+	// Rollup é o último resumo calculado das tarefas (ausente até a primeira alteração)
+	Rollup *RollupResponse `json:"rollup,omitempty"`
+}
+
+type RollupResponse struct {
+	TotalTasks               int64     `json:"total_tasks"`
+	OpenTasks                int64     `json:"open_tasks"`
+	CompletedTasks           int64     `json:"completed_tasks"`
+	OverdueTasks             int64     `json:"overdue_tasks"`
+	RemainingEstimateMinutes int64     `json:"remaining_estimate_minutes"`
+	PercentComplete          int       `json:"percent_complete"`
+	ComputedAt               time.Time `json:"computed_at"`
+}
+
+type StatusResponse struct {
+	ID       string           `json:"id"`
+	Name     string           `json:"name"`
+	Category enums.TaskStatus `json:"category"`
+}
+
+func NewProjectResponse(project *entities.Project) *ProjectResponse {
+	response := &ProjectResponse{
+		ID:        project.ID.Hex(),
+		OwnerID:   project.UserID.Hex(),
+		Name:      project.Name,
+		Color:     project.Color,
+		Statuses:  make([]StatusResponse, 0, len(project.Statuses)),
+		CreatedAt: project.CreatedAt,
+		UpdatedAt: project.UpdatedAt,
+	}
+
+	for _, member := range project.Members {
+		response.Members = append(response.Members, NewMemberResponse(&member))
+	}
+
+	if project.Rollup != nil {
+		response.Rollup = NewRollupResponse(project.Rollup)
+	}
+
+	for _, status := range project.Statuses {
+		response.Statuses = append(response.Statuses, StatusResponse{
+			ID:       status.ID,
+			Name:     status.Name,
+			Category: status.Category,
+		})
+	}
+
+	return response
+}
+
+func NewProjectResponses(projects []*entities.Project) []ProjectResponse {
+	responses := make([]ProjectResponse, 0, len(projects))
+	for _, project := range projects {
+		responses = append(responses, *NewProjectResponse(project))
+	}
+	return responses
+}
+
+func NewRollupResponse(rollup *entities.ProjectRollup) *RollupResponse {
+	return &RollupResponse{
+		TotalTasks:               rollup.TotalTasks,
+		OpenTasks:                rollup.OpenTasks,
+		CompletedTasks:           rollup.CompletedTasks,
+		OverdueTasks:             rollup.OverdueTasks,
+		RemainingEstimateMinutes: rollup.RemainingEstimateMinutes,
+		PercentComplete:          rollup.PercentComplete,
+		ComputedAt:               rollup.ComputedAt,
+	}
+}
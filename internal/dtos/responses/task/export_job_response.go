@@ -0,0 +1,34 @@
+package task
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+)
+
+type ExportJobResponse struct {
+	ID         string                   `json:"id"`
+	Format     string                   `json:"format"`
+	Status     entities.ExportJobStatus `json:"status"`
+	Tasks      int                      `json:"tasks"`
+	FileName   string                   `json:"file_name,omitempty"`
+	Error      string                   `json:"error,omitempty"`
+	CreatedAt  time.Time                `json:"created_at"`
+	FinishedAt *time.Time               `json:"finished_at,omitempty"`
+	// ExpiresAt é até quando o arquivo gerado fica disponível para download
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func NewExportJobResponse(job *entities.ExportJob) *ExportJobResponse {
+	return &ExportJobResponse{
+		ID:         job.ID.Hex(),
+		Format:     job.Format,
+		Status:     job.Status,
+		Tasks:      job.Tasks,
+		FileName:   job.FileName,
+		Error:      job.Error,
+		CreatedAt:  job.CreatedAt,
+		FinishedAt: job.FinishedAt,
+		ExpiresAt:  job.CreatedAt.Add(entities.ExportJobRetention),
+	}
+}
@@ -0,0 +1,18 @@
+package task
+
+import (
+	"math"
+
+	"github.com/devgugga/todo-it/internal/entities"
+)
+
+// NearbyTaskResponse é uma tarefa próxima ao ponto buscado
+type NearbyTaskResponse struct {
+	TaskResponse
+	// Distance é a distância em metros até o ponto buscado
+	Distance float64 `json:"distance"`
+}
+
+func NewNearbyTaskResponse(task *entities.Task, distance float64) *NearbyTaskResponse {
+	return &NearbyTaskResponse{TaskResponse: *NewTaskResponse(task), Distance: math.Round(distance)}
+}
@@ -0,0 +1,84 @@
+package task
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SyncPullResponse traz as tarefas alteradas e excluídas desde o cursor.
+// Com Reset, o cliente deve descartar as tarefas locais já sincronizadas e
+// ficar apenas com as recebidas (até HasMore ser false).
+type SyncPullResponse struct {
+	Tasks          []TaskResponse `json:"tasks"`
+	DeletedTaskIDs []string       `json:"deleted_task_ids"`
+	Reset          bool           `json:"reset"`
+	// Cursor deve ser enviado como since na próxima consulta
+	Cursor     string    `json:"cursor"`
+	HasMore    bool      `json:"has_more"`
+	ServerTime time.Time `json:"server_time"`
+}
+
+func NewSyncPullResponse(tasks []*entities.Task, deleted []primitive.ObjectID, reset bool, cursor string, hasMore bool, serverTime time.Time) *SyncPullResponse {
+	response := &SyncPullResponse{
+		Tasks:          NewTaskResponses(tasks),
+		DeletedTaskIDs: make([]string, 0, len(deleted)),
+		Reset:          reset,
+		Cursor:         cursor,
+		HasMore:        hasMore,
+		ServerTime:     serverTime,
+	}
+
+	for _, id := range deleted {
+		response.DeletedTaskIDs = append(response.DeletedTaskIDs, id.Hex())
+	}
+
+	return response
+}
+
+// SyncResultResponse é o resultado de uma alteração enviada pelo cliente.
+// Task é a versão do servidor após a alteração (ou a que prevaleceu no
+// conflito), que substitui a local.
+type SyncResultResponse struct {
+	ID     string        `json:"id"`
+	Op     string        `json:"op"`
+	Status string        `json:"status"`
+	Error  string        `json:"error,omitempty"`
+	Task   *TaskResponse `json:"task,omitempty"`
+}
+
+// TombstoneResponse é a exclusão de uma tarefa ou projeto
+type TombstoneResponse struct {
+	EntityType string    `json:"entity_type"`
+	EntityID   string    `json:"entity_id"`
+	DeletedAt  time.Time `json:"deleted_at"`
+}
+
+// TombstonesResponse são as exclusões desde o cursor. Com Reset, exclusões
+// podem ter sido perdidas (cursor mais antigo que o registro).
+type TombstonesResponse struct {
+	Deleted    []TombstoneResponse `json:"deleted"`
+	Reset      bool                `json:"reset"`
+	Cursor     string              `json:"cursor"`
+	ServerTime time.Time           `json:"server_time"`
+}
+
+func NewTombstonesResponse(tombstones []*entities.Tombstone, reset bool, cursor string, serverTime time.Time) *TombstonesResponse {
+	response := &TombstonesResponse{
+		Deleted:    make([]TombstoneResponse, 0, len(tombstones)),
+		Reset:      reset,
+		Cursor:     cursor,
+		ServerTime: serverTime,
+	}
+
+	for _, tombstone := range tombstones {
+		response.Deleted = append(response.Deleted, TombstoneResponse{
+			EntityType: tombstone.EntityType,
+			EntityID:   tombstone.EntityID.Hex(),
+			DeletedAt:  tombstone.DeletedAt,
+		})
+	}
+
+	return response
+}
@@ -0,0 +1,84 @@
+package task
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+)
+
+// TaskChangeResponse é uma alteração do feed de mudanças. ID é único e estável,
+// podendo ser usado para deduplicar alterações entregues mais de uma vez.
+type TaskChangeResponse struct {
+	ID         string       `json:"id"`
+	Type       string       `json:"type"`
+	TaskID     string       `json:"task_id"`
+	OccurredAt time.Time    `json:"occurred_at"`
+	Task       TaskResponse `json:"task"`
+}
+
+// TaskChangesResponse é uma página do feed; Cursor deve ser enviado como since
+// na próxima consulta
+type TaskChangesResponse struct {
+	Changes []TaskChangeResponse `json:"changes"`
+	Cursor  string               `json:"cursor"`
+	HasMore bool                 `json:"has_more"`
+}
+
+// TaskActivityResponse é uma página do histórico de tarefas (ex.: atividade de um projeto)
+type TaskActivityResponse struct {
+	Entries    []TaskChangeResponse `json:"entries"`
+	Total      int64                `json:"total"`
+	Page       int64                `json:"page"`
+	Limit      int64                `json:"limit"`
+	TotalPages int64                `json:"total_pages"`
+	HasNext    bool                 `json:"has_next"`
+	HasPrev    bool                 `json:"has_prev"`
+}
+
+func NewTaskChangesResponse(changes []*entities.TaskChange, cursor string, hasMore bool) *TaskChangesResponse {
+	response := &TaskChangesResponse{
+		Changes: make([]TaskChangeResponse, 0, len(changes)),
+		Cursor:  cursor,
+		HasMore: hasMore,
+	}
+
+	for _, change := range changes {
+		response.Changes = append(response.Changes, newTaskChangeResponse(change))
+	}
+
+	return response
+}
+
+func NewTaskActivityResponse(changes []*entities.TaskChange, total, page, limit int64) *TaskActivityResponse {
+	var totalPages int64
+	if limit > 0 {
+		totalPages = (total + limit - 1) / limit
+	}
+
+	response := &TaskActivityResponse{
+		Entries:    make([]TaskChangeResponse, 0, len(changes)),
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+		HasNext:    page < totalPages,
+		HasPrev:    page > 1,
+	}
+
+	for _, change := range changes {
+		response.Entries = append(response.Entries, newTaskChangeResponse(change))
+	}
+
+	return response
+}
+
+func newTaskChangeResponse(change *entities.TaskChange) TaskChangeResponse {
+	item := TaskChangeResponse{
+		ID:         change.ID.Hex(),
+		Type:       change.Type,
+		TaskID:     change.TaskID.Hex(),
+		OccurredAt: change.OccurredAt,
+	}
+	item.Task.FromEntity(&change.Task)
+	return item
+}
@@ -0,0 +1,30 @@
+package task
+
+import "github.com/devgugga/todo-it/internal/entities"
+
+type TaskListResponse struct {
+	Tasks      []TaskResponse `json:"tasks"`
+	Total      int64          `json:"total"`
+	Page       int64          `json:"page"`
+	Limit      int64          `json:"limit"`
+	TotalPages int64          `json:"total_pages"`
+	HasNext    bool           `json:"has_next"`
+	HasPrev    bool           `json:"has_prev"`
+}
+
+func NewTaskListResponse(tasks []*entities.Task, total, page, limit int64) *TaskListResponse {
+	var totalPages int64
+	if limit > 0 {
+		totalPages = (total + limit - 1) / limit
+	}
+
+	return &TaskListResponse{
+		Tasks:      NewTaskResponses(tasks),
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+		HasNext:    page < totalPages,
+		HasPrev:    page > 1,
+	}
+}
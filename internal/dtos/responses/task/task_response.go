@@ -0,0 +1,184 @@
+package task
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+)
+
+type TaskResponse struct {
+	ID              string                  `json:"id"`
+	Title           string                  `json:"title"`
+	Description     string                  `json:"description,omitempty"`
+	Status          enums.TaskStatus        `json:"status"`
+	StatusID        string                  `json:"status_id,omitempty"`
+	CustomStatus    *CustomStatusResponse   `json:"custom_status,omitempty"`
+	Priority        enums.TaskPriority      `json:"priority"`
+	DueDate         *time.Time              `json:"due_date,omitempty"`
+	Recurrence      string                  `json:"recurrence,omitempty"`
+	ReminderAt      *time.Time              `json:"reminder_at,omitempty"`
+	Tags            []string                `json:"tags"`
+	LabelIDs        []string                `json:"label_ids"`
+	ProjectID       string                  `json:"project_id,omitempty"`
+	Checklist       []ChecklistItemResponse `json:"checklist"`
+	Attachments     []AttachmentResponse    `json:"attachments"`
+	Location        *LocationResponse       `json:"location,omitempty"`
+	External        *ExternalResponse       `json:"external,omitempty"`
+	Position        int64                   `json:"position,omitempty"`
+	EstimateMinutes int                     `json:"estimate_minutes,omitempty"`
+	IsArchived      bool                    `json:"is_archived"`
+	IsOverdue       bool                    `json:"is_overdue"`
+	CreatedAt       time.Time               `json:"created_at"`
+	UpdatedAt       time.Time               `json:"updated_at"`
+	CompletedAt     *time.Time              `json:"completed_at,omitempty"`
+	Highlights      []HighlightResponse     `json:"highlights,omitempty"`
+	Warnings        []string                `json:"warnings,omitempty"`
+}
+
+func (r *TaskResponse) FromEntity(task *entities.Task) {
+	r.ID = task.ID.Hex()
+	r.Title = task.Title
+	r.Description = task.Description
+	r.Status = task.Status
+	r.StatusID = task.StatusID
+	r.Priority = task.Priority
+	r.DueDate = task.DueDate
+	r.Recurrence = task.Recurrence
+	r.ReminderAt = task.ReminderAt
+	r.Tags = task.Tags
+	r.IsArchived = task.IsArchived
+	r.IsOverdue = task.IsOverdue()
+	if task.ProjectID != nil {
+		r.ProjectID = task.ProjectID.Hex()
+	}
+	r.CreatedAt = task.CreatedAt
+	r.UpdatedAt = task.UpdatedAt
+	r.CompletedAt = task.CompletedAt
+
+	if r.Tags == nil {
+		r.Tags = []string{}
+	}
+
+	r.LabelIDs = make([]string, 0, len(task.LabelIDs))
+	for _, id := range task.LabelIDs {
+		r.LabelIDs = append(r.LabelIDs, id.Hex())
+	}
+
+	if task.External != nil {
+		r.External = &ExternalResponse{
+			Source: task.External.Source,
+			ID:     task.External.ID,
+			URL:    task.External.URL,
+		}
+	}
+
+	if task.CustomStatus != nil {
+		r.CustomStatus = &CustomStatusResponse{
+			ID:     task.CustomStatus.ID.Hex(),
+			Name:   task.CustomStatus.Name,
+			Color:  task.CustomStatus.Color,
+			MapsTo: task.Status,
+		}
+	}
+
+	if task.Location != nil {
+		r.Location = &LocationResponse{
+			Lat:   task.Location.Point.Latitude(),
+			Lng:   task.Location.Point.Longitude(),
+			Label: task.Location.Label,
+		}
+	}
+
+	r.Checklist = make([]ChecklistItemResponse, 0, len(task.Checklist))
+	for _, item := range task.Checklist {
+		r.Checklist = append(r.Checklist, ChecklistItemResponse{
+			ID:   item.ID.Hex(),
+			Text: item.Text,
+			Done: item.Done,
+		})
+	}
+
+	r.Position = task.Position
+	r.EstimateMinutes = task.EstimateMinutes
+	r.Warnings = task.Warnings
+
+	for _, highlight := range task.SearchHighlights {
+		item := HighlightResponse{Field: highlight.Path, Fragments: make([]HighlightFragment, 0, len(highlight.Texts))}
+		for _, text := range highlight.Texts {
+			item.Fragments = append(item.Fragments, HighlightFragment{Text: text.Value, Hit: text.Type == "hit"})
+		}
+		r.Highlights = append(r.Highlights, item)
+	}
+
+	r.Attachments = make([]AttachmentResponse, 0, len(task.Attachments))
+	for _, attachment := range task.Attachments {
+		r.Attachments = append(r.Attachments, AttachmentResponse{
+			ID:          attachment.ID.Hex(),
+			Filename:    attachment.Filename,
+			ContentType: attachment.ContentType,
+			Size:        attachment.Size,
+			CreatedAt:   attachment.CreatedAt,
+		})
+	}
+}
+
+type ExternalResponse struct {
+	Source string `json:"source"`
+	ID     string `json:"id"`
+	URL    string `json:"url,omitempty"`
+}
+
+// CustomStatusResponse é o status personalizado da tarefa e o status padrão associado a ele
+type CustomStatusResponse struct {
+	ID     string           `json:"id"`
+	Name   string           `json:"name"`
+	Color  string           `json:"color,omitempty"`
+	MapsTo enums.TaskStatus `json:"maps_to"`
+}
+
+// LocationResponse é o local da tarefa
+type LocationResponse struct {
+	Lat   float64 `json:"lat"`
+	Lng   float64 `json:"lng"`
+	Label string  `json:"label,omitempty"`
+}
+
+// HighlightResponse é um campo da tarefa com os trechos que casaram com a busca
+type HighlightResponse struct {
+	Field     string              `json:"field"`
+	Fragments []HighlightFragment `json:"fragments"`
+}
+
+type HighlightFragment struct {
+	Text string `json:"text"`
+	Hit  bool   `json:"hit"`
+}
+
+type ChecklistItemResponse struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
+	Done bool   `json:"done"`
+}
+
+type AttachmentResponse struct {
+	ID          string    `json:"id"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func NewTaskResponse(task *entities.Task) *TaskResponse {
+	response := &TaskResponse{}
+	response.FromEntity(task)
+	return response
+}
+
+func NewTaskResponses(tasks []*entities.Task) []TaskResponse {
+	responses := make([]TaskResponse, 0, len(tasks))
+	for _, task := range tasks {
+		responses = append(responses, *NewTaskResponse(task))
+	}
+	return responses
+}
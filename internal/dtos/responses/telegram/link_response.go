@@ -0,0 +1,25 @@
+package telegram
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+)
+
+type LinkResponse struct {
+	Linked   bool       `json:"linked"`
+	Username string     `json:"username,omitempty"`
+	LinkedAt *time.Time `json:"linked_at,omitempty"`
+}
+
+func NewLinkResponse(link *entities.TelegramLink) *LinkResponse {
+	if link == nil || !link.IsLinked() {
+		return &LinkResponse{Linked: false}
+	}
+
+	return &LinkResponse{
+		Linked:   true,
+		Username: link.Username,
+		LinkedAt: link.LinkedAt,
+	}
+}
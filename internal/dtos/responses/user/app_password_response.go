@@ -0,0 +1,33 @@
+package user
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+)
+
+type AppPasswordResponse struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	// Password só é preenchida na criação; não pode ser consultada depois
+	Password string `json:"password,omitempty"`
+}
+
+func NewAppPasswordResponse(password *entities.AppPassword) *AppPasswordResponse {
+	return &AppPasswordResponse{
+		ID:         password.ID.Hex(),
+		Name:       password.Name,
+		LastUsedAt: password.LastUsedAt,
+		CreatedAt:  password.CreatedAt,
+	}
+}
+
+func NewAppPasswordResponses(passwords []*entities.AppPassword) []AppPasswordResponse {
+	responses := make([]AppPasswordResponse, 0, len(passwords))
+	for _, password := range passwords {
+		responses = append(responses, *NewAppPasswordResponse(password))
+	}
+	return responses
+}
@@ -0,0 +1,34 @@
+package user
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+)
+
+type CustomStatusResponse struct {
+	ID        string           `json:"id"`
+	Name      string           `json:"name"`
+	Color     string           `json:"color,omitempty"`
+	MapsTo    enums.TaskStatus `json:"maps_to"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+func NewCustomStatusResponse(status *entities.CustomStatus) *CustomStatusResponse {
+	return &CustomStatusResponse{
+		ID:        status.ID.Hex(),
+		Name:      status.Name,
+		Color:     status.Color,
+		MapsTo:    status.MapsTo,
+		CreatedAt: status.CreatedAt,
+	}
+}
+
+func NewCustomStatusResponses(statuses []entities.CustomStatus) []CustomStatusResponse {
+	responses := make([]CustomStatusResponse, 0, len(statuses))
+	for i := range statuses {
+		responses = append(responses, *NewCustomStatusResponse(&statuses[i]))
+	}
+	return responses
+}
@@ -0,0 +1,41 @@
+package user
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/quota"
+)
+
+type PhoneResponse struct {
+	Phone      string     `json:"phone"`
+	Verified   bool       `json:"verified"`
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+	// CodeExpiresAt é preenchido enquanto há um código de verificação pendente
+	CodeExpiresAt *time.Time        `json:"code_expires_at,omitempty"`
+	SMSUsage      *SMSUsageResponse `json:"sms_usage,omitempty"`
+}
+
+type SMSUsageResponse struct {
+	Used     int64     `json:"used"`
+	Limit    int64     `json:"limit"`
+	ResetsAt time.Time `json:"resets_at"`
+}
+
+func NewPhoneResponse(phone *entities.PhoneNumber, usage *quota.Usage) *PhoneResponse {
+	response := &PhoneResponse{
+		Phone:      phone.Number,
+		Verified:   phone.IsVerified(),
+		VerifiedAt: phone.VerifiedAt,
+	}
+
+	if !phone.IsVerified() {
+		response.CodeExpiresAt = phone.CodeExpiresAt
+	}
+
+	if usage != nil {
+		response.SMSUsage = &SMSUsageResponse{Used: usage.Used, Limit: usage.Limit, ResetsAt: usage.ResetsAt}
+	}
+
+	return response
+}
@@ -0,0 +1,81 @@
+package user
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+)
+
+type PreferencesResponse struct {
+	Timezone      string                          `json:"timezone"`
+	Notifications NotificationPreferencesResponse `json:"notifications"`
+	TaskDefaults  TaskDefaultsResponse            `json:"task_defaults"`
+	Calendar      CalendarPreferencesResponse     `json:"calendar"`
+}
+
+type CalendarPreferencesResponse struct {
+	SkipWeekends  bool   `json:"skip_weekends"`
+	HolidayLocale string `json:"holiday_locale,omitempty"`
+}
+
+type NotificationPreferencesResponse struct {
+	Channels        []string `json:"channels"`
+	MutedEvents     []string `json:"muted_events"`
+	QuietHoursStart string   `json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   string   `json:"quiet_hours_end,omitempty"`
+	WebhookURL      string   `json:"webhook_url,omitempty"`
+	PushTopic       string   `json:"push_topic,omitempty"`
+	OverdueSummary  bool     `json:"overdue_summary"`
+}
+
+type TaskDefaultsResponse struct {
+	Priority              string   `json:"priority,omitempty"`
+	ProjectID             string   `json:"project_id,omitempty"`
+	Tags                  []string `json:"tags"`
+	ReminderOffsetMinutes int      `json:"reminder_offset_minutes"`
+	PastDueDatePolicy     string   `json:"past_due_date_policy"`
+}
+
+func NewPreferencesResponse(preferences *entities.UserPreferences) *PreferencesResponse {
+	mutedEvents := preferences.Notifications.MutedEvents
+	if mutedEvents == nil {
+		mutedEvents = []string{}
+	}
+
+	timezone := preferences.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	defaults := preferences.TaskDefaults
+	taskDefaults := TaskDefaultsResponse{
+		Priority:              string(defaults.Priority),
+		Tags:                  defaults.Tags,
+		ReminderOffsetMinutes: int(defaults.ReminderOffset / time.Minute),
+		PastDueDatePolicy:     defaults.DueDatePolicy(),
+	}
+	if taskDefaults.Tags == nil {
+		taskDefaults.Tags = []string{}
+	}
+	if defaults.ProjectID != nil {
+		taskDefaults.ProjectID = defaults.ProjectID.Hex()
+	}
+
+	return &PreferencesResponse{
+		Timezone: timezone,
+		Notifications: NotificationPreferencesResponse{
+			Channels:        preferences.Notifications.EnabledChannels(),
+			MutedEvents:     mutedEvents,
+			QuietHoursStart: preferences.Notifications.QuietHoursStart,
+			QuietHoursEnd:   preferences.Notifications.QuietHoursEnd,
+			WebhookURL:      preferences.Notifications.WebhookURL,
+			PushTopic:       preferences.Notifications.PushTopic,
+			OverdueSummary:  preferences.Notifications.OverdueSummary,
+		},
+		TaskDefaults: taskDefaults,
+		Calendar: CalendarPreferencesResponse{
+			SkipWeekends:  preferences.Calendar.SkipWeekends,
+			HolidayLocale: preferences.Calendar.HolidayLocale,
+		},
+	}
+}
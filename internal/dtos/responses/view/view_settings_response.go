@@ -0,0 +1,47 @@
+package view
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+)
+
+type ViewSettingsResponse struct {
+	ProjectID       *string  `json:"project_id"`
+	Layout          string   `json:"layout"`
+	ColumnOrder     []string `json:"column_order"`
+	CollapsedGroups []string `json:"collapsed_groups"`
+	SortBy          string   `json:"sort_by,omitempty"`
+	SortOrder       string   `json:"sort_order,omitempty"`
+	// Inherited indica que não há configuração salva para o projeto (ou padrão):
+	// a resposta vem da padrão do usuário ou do sistema
+	Inherited bool       `json:"inherited"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+func NewViewSettingsResponse(settings *entities.ViewSettings) *ViewSettingsResponse {
+	response := &ViewSettingsResponse{
+		Layout:          settings.Layout,
+		ColumnOrder:     settings.ColumnOrder,
+		CollapsedGroups: settings.CollapsedGroups,
+		SortBy:          settings.SortBy,
+		SortOrder:       settings.SortOrder,
+		Inherited:       settings.ID.IsZero(),
+	}
+
+	if settings.ProjectID != nil {
+		projectID := settings.ProjectID.Hex()
+		response.ProjectID = &projectID
+	}
+	if response.ColumnOrder == nil {
+		response.ColumnOrder = []string{}
+	}
+	if response.CollapsedGroups == nil {
+		response.CollapsedGroups = []string{}
+	}
+	if !settings.UpdatedAt.IsZero() {
+		response.UpdatedAt = &settings.UpdatedAt
+	}
+
+	return response
+}
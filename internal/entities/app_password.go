@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AppPassword é uma senha de aplicativo usada por clientes que só suportam
+// autenticação básica (ex.: CalDAV). Apenas o hash do token é persistido.
+type AppPassword struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	UserID     primitive.ObjectID `bson:"user_id"`
+	Name       string             `bson:"name"`
+	TokenHash  string             `bson:"token_hash"`
+	LastUsedAt *time.Time         `bson:"last_used_at,omitempty"`
+	CreatedAt  time.Time          `bson:"created_at"`
+}
+
+func (p *AppPassword) PrepareForCreate(userID primitive.ObjectID) {
+	p.ID = primitive.NewObjectID()
+	p.UserID = userID
+	p.CreatedAt = time.Now()
+}
+
+func (p *AppPassword) GetCollectionName() string {
+	return "app_passwords"
+}
@@ -0,0 +1,67 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Ações registradas no log de auditoria
+const (
+	AuditActionLogin          = "auth.login"
+	AuditActionPasswordChange = "user.password_change"
+	AuditActionAccountDelete  = "user.delete"
+	AuditActionDataExport     = "task.export"
+	AuditActionAdminRequest   = "admin.request"
+	// Suspensão de contas por um administrador
+	AuditActionUserSuspend   = "admin.user_suspend"
+	AuditActionUserUnsuspend = "admin.user_unsuspend"
+	// Senhas de aplicativo (CalDAV e outros clientes com autenticação básica)
+	AuditActionAppPasswordCreate = "user.app_password_create"
+	AuditActionAppPasswordRevoke = "user.app_password_revoke"
+	// Telefone para lembretes por SMS
+	AuditActionPhoneVerify = "user.phone_verify"
+	AuditActionPhoneRemove = "user.phone_remove"
+	// Token do feed Atom de atividades
+	AuditActionFeedTokenRotate = "user.feed_token_rotate"
+	AuditActionFeedTokenRevoke = "user.feed_token_revoke"
+)
+
+// Tipos de ator
+const (
+	AuditActorUser      = "user"
+	AuditActorAdmin     = "admin"
+	AuditActorAnonymous = "anonymous"
+)
+
+// Resultados possíveis de uma ação auditada
+const (
+	AuditOutcomeSuccess = "success"
+	AuditOutcomeFailure = "failure"
+)
+
+type AuditLog struct {
+	ID        primitive.ObjectID     `bson:"_id,omitempty" json:"id"`
+	Action    string                 `bson:"action" json:"action"`
+	ActorType string                 `bson:"actor_type" json:"actor_type"`
+	ActorID   *primitive.ObjectID    `bson:"actor_id,omitempty" json:"actor_id,omitempty"`
+	Outcome   string                 `bson:"outcome" json:"outcome"`
+	IP        string                 `bson:"ip" json:"ip"`
+	UserAgent string                 `bson:"user_agent" json:"user_agent"`
+	RequestID string                 `bson:"request_id,omitempty" json:"request_id,omitempty"`
+	Details   map[string]interface{} `bson:"details,omitempty" json:"details,omitempty"`
+	CreatedAt time.Time              `bson:"created_at" json:"created_at"`
+}
+
+func (a *AuditLog) PrepareForCreate() {
+	a.ID = primitive.NewObjectID()
+	a.CreatedAt = time.Now()
+
+	if a.ActorType == "" {
+		a.ActorType = AuditActorAnonymous
+	}
+}
+
+func (a *AuditLog) GetCollectionName() string {
+	return "audit_logs"
+}
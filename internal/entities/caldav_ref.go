@@ -0,0 +1,9 @@
+package entities
+
+// CalDAVRef guarda o recurso criado por um cliente CalDAV, para que a tarefa
+// continue acessível pelo nome e UID que o cliente escolheu
+type CalDAVRef struct {
+	// Name é o nome do recurso na coleção (ex.: "6F1C...-A2.ics")
+	Name string `bson:"name"`
+	UID  string `bson:"uid"`
+}
@@ -0,0 +1,65 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/enums"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MaxCustomStatuses limita os status personalizados de um usuário
+const MaxCustomStatuses = 20
+
+// CustomStatus é um status de exibição definido pelo usuário (ex.: "Aguardando
+// cliente"). As tarefas continuam gravando um dos status padrão (MapsTo), usado
+// em filtros, estatísticas e notificações.
+type CustomStatus struct {
+	ID        primitive.ObjectID `bson:"_id"`
+	Name      string             `bson:"name"`
+	Color     string             `bson:"color,omitempty"`
+	MapsTo    enums.TaskStatus   `bson:"maps_to"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+// TaskCustomStatus é a cópia, na tarefa, do status personalizado atribuído a
+// ela. Nome e cor são atualizados quando o status é alterado.
+type TaskCustomStatus struct {
+	ID    primitive.ObjectID `bson:"_id"`
+	Name  string             `bson:"name"`
+	Color string             `bson:"color,omitempty"`
+}
+
+// Snapshot retorna a cópia do status gravada nas tarefas
+func (s *CustomStatus) Snapshot() *TaskCustomStatus {
+	return &TaskCustomStatus{ID: s.ID, Name: s.Name, Color: s.Color}
+}
+
+// SetCustomStatusAt atribui à tarefa o status personalizado, com o status
+// padrão associado a ele, usando now como hora atual
+func (t *Task) SetCustomStatusAt(status *CustomStatus, now time.Time) {
+	t.SetStatusAt(status.MapsTo, now)
+	t.StatusID = ""
+	t.CustomStatus = status.Snapshot()
+}
+
+// CustomStatus retorna o status personalizado do usuário com o ID informado (nil se não existir)
+func (u *User) CustomStatus(id primitive.ObjectID) *CustomStatus {
+	for i := range u.CustomStatuses {
+		if u.CustomStatuses[i].ID == id {
+			return &u.CustomStatuses[i]
+		}
+	}
+	return nil
+}
+
+// HasCustomStatusNamed indica se o usuário já tem um status com o nome
+// informado (sem diferenciar maiúsculas), ignorando o status except
+func (u *User) HasCustomStatusNamed(name string, except primitive.ObjectID) bool {
+	for _, status := range u.CustomStatuses {
+		if status.ID != except && strings.EqualFold(status.Name, name) {
+			return true
+		}
+	}
+	return false
+}
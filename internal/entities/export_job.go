@@ -0,0 +1,70 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/enums"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ExportJobStatus representa a etapa de uma exportação assíncrona
+type ExportJobStatus string
+
+const (
+	ExportJobPending   ExportJobStatus = "pending"
+	ExportJobRunning   ExportJobStatus = "running"
+	ExportJobCompleted ExportJobStatus = "completed"
+	ExportJobFailed    ExportJobStatus = "failed"
+)
+
+// ExportJobRetention é por quanto tempo o arquivo gerado fica disponível para download
+const ExportJobRetention = 24 * time.Hour
+
+// ExportQuery são as tarefas exportadas: as de um projeto ou as da lista do
+// usuário com os filtros informados
+type ExportQuery struct {
+	ProjectID *primitive.ObjectID `bson:"project_id,omitempty"`
+	Status    enums.TaskStatus    `bson:"status,omitempty"`
+	Priority  enums.TaskPriority  `bson:"priority,omitempty"`
+	Tags      []string            `bson:"tags,omitempty"`
+	Search    string              `bson:"search,omitempty"`
+}
+
+// ExportJob acompanha a geração em segundo plano de uma exportação grande
+// (ex.: PDF de um projeto), processada pelo job agendado de exportações
+type ExportJob struct {
+	ID     primitive.ObjectID `bson:"_id,omitempty"`
+	UserID primitive.ObjectID `bson:"user_id"`
+	Format string             `bson:"format"`
+	Query  ExportQuery        `bson:"query"`
+	Status ExportJobStatus    `bson:"status"`
+	// Tasks é a quantidade de tarefas exportadas
+	Tasks    int    `bson:"tasks"`
+	FileName string `bson:"file_name,omitempty"`
+	// File é o arquivo gerado, lido apenas no download
+	File       []byte     `bson:"file,omitempty"`
+	Error      string     `bson:"error,omitempty"`
+	CreatedAt  time.Time  `bson:"created_at"`
+	UpdatedAt  time.Time  `bson:"updated_at"`
+	FinishedAt *time.Time `bson:"finished_at,omitempty"`
+}
+
+func (j *ExportJob) PrepareForCreate(userID primitive.ObjectID, format string, query ExportQuery) {
+	now := time.Now()
+	j.ID = primitive.NewObjectID()
+	j.UserID = userID
+	j.Format = format
+	j.Query = query
+	j.Status = ExportJobPending
+	j.CreatedAt = now
+	j.UpdatedAt = now
+}
+
+// IsFinished indica se a exportação terminou (com sucesso ou não)
+func (j *ExportJob) IsFinished() bool {
+	return j.Status == ExportJobCompleted || j.Status == ExportJobFailed
+}
+
+func (j *ExportJob) GetCollectionName() string {
+	return "export_jobs"
+}
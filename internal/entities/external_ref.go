@@ -0,0 +1,13 @@
+package entities
+
+// Origens de tarefas sincronizadas com outros serviços
+const (
+	ExternalSourceGitHub = "github"
+)
+
+// ExternalRef identifica o item de origem de uma tarefa sincronizada com outro serviço
+type ExternalRef struct {
+	Source string `bson:"source"`
+	ID     string `bson:"id"`
+	URL    string `bson:"url,omitempty"`
+}
@@ -0,0 +1,55 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MaxFocusSession limita a duração contabilizada de uma sessão de foco, para
+// que um cronômetro esquecido não distorça as estatísticas
+const MaxFocusSession = 4 * time.Hour
+
+// FocusSession é um período de foco (ex.: pomodoro) dedicado a uma tarefa
+type FocusSession struct {
+	ID     primitive.ObjectID `bson:"_id,omitempty"`
+	UserID primitive.ObjectID `bson:"user_id"`
+	TaskID primitive.ObjectID `bson:"task_id"`
+	// Active marca a sessão em andamento (no máximo uma por usuário)
+	Active bool `bson:"active"`
+	// PlannedSeconds é a duração escolhida no cliente (0 = sem tempo definido)
+	PlannedSeconds  int64      `bson:"planned_seconds,omitempty"`
+	DurationSeconds int64      `bson:"duration_seconds"`
+	StartedAt       time.Time  `bson:"started_at"`
+	EndedAt         *time.Time `bson:"ended_at,omitempty"`
+}
+
+func (s *FocusSession) PrepareForCreate(userID primitive.ObjectID, now time.Time) {
+	s.ID = primitive.NewObjectID()
+	s.UserID = userID
+	s.Active = true
+	s.StartedAt = now
+	s.EndedAt = nil
+	s.DurationSeconds = 0
+}
+
+// Elapsed retorna o tempo de foco até now, limitado a MaxFocusSession
+func (s *FocusSession) Elapsed(now time.Time) time.Duration {
+	end := now
+	if s.EndedAt != nil {
+		end = *s.EndedAt
+	}
+
+	return min(max(end.Sub(s.StartedAt), 0), MaxFocusSession)
+}
+
+// Finish encerra a sessão em now, registrando a duração
+func (s *FocusSession) Finish(now time.Time) {
+	s.Active = false
+	s.EndedAt = &now
+	s.DurationSeconds = int64(s.Elapsed(now) / time.Second)
+}
+
+func (s *FocusSession) GetCollectionName() string {
+	return "focus_sessions"
+}
@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// GitHubAccount guarda a conexão OAuth do usuário com o GitHub e os repositórios
+// cujas issues atribuídas a ele são sincronizadas como tarefas
+type GitHubAccount struct {
+	ID     primitive.ObjectID `bson:"_id,omitempty"`
+	UserID primitive.ObjectID `bson:"user_id"`
+	Login  string             `bson:"login,omitempty"`
+	// AccessToken nunca é exposto pela API
+	AccessToken    string       `bson:"access_token,omitempty"`
+	Scopes         string       `bson:"scopes,omitempty"`
+	StateHash      string       `bson:"state_hash,omitempty"`
+	StateExpiresAt *time.Time   `bson:"state_expires_at,omitempty"`
+	Repos          []GitHubRepo `bson:"repos,omitempty"`
+	ConnectedAt    *time.Time   `bson:"connected_at,omitempty"`
+	CreatedAt      time.Time    `bson:"created_at"`
+	UpdatedAt      time.Time    `bson:"updated_at"`
+}
+
+// GitHubRepo é um repositório vinculado (FullName em minúsculas: owner/nome)
+type GitHubRepo struct {
+	FullName        string    `bson:"full_name"`
+	HookID          int64     `bson:"hook_id,omitempty"`
+	CloseOnComplete bool      `bson:"close_on_complete"`
+	LinkedAt        time.Time `bson:"linked_at"`
+}
+
+// IsConnected indica se a conexão OAuth foi concluída
+func (a *GitHubAccount) IsConnected() bool {
+	return a != nil && a.AccessToken != ""
+}
+
+// Repo retorna o repositório vinculado pelo nome completo
+func (a *GitHubAccount) Repo(fullName string) (*GitHubRepo, bool) {
+	for i := range a.Repos {
+		if strings.EqualFold(a.Repos[i].FullName, fullName) {
+			return &a.Repos[i], true
+		}
+	}
+	return nil, false
+}
+
+func (a *GitHubAccount) GetCollectionName() string {
+	return "github_accounts"
+}
@@ -0,0 +1,67 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ImportJobStatus representa a etapa de uma importação assíncrona
+type ImportJobStatus string
+
+const (
+	ImportJobPending   ImportJobStatus = "pending"
+	ImportJobRunning   ImportJobStatus = "running"
+	ImportJobCompleted ImportJobStatus = "completed"
+	ImportJobFailed    ImportJobStatus = "failed"
+)
+
+// MaxImportWarnings limita os avisos guardados por importação
+const MaxImportWarnings = 100
+
+// ImportJob acompanha a importação de dados de outra ferramenta (ex.: Todoist)
+type ImportJob struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty"`
+	UserID          primitive.ObjectID `bson:"user_id"`
+	Source          string             `bson:"source"`
+	Status          ImportJobStatus    `bson:"status"`
+	Total           int                `bson:"total"`
+	Processed       int                `bson:"processed"`
+	ProjectsCreated int                `bson:"projects_created"`
+	TasksCreated    int                `bson:"tasks_created"`
+	Warnings        []string           `bson:"warnings,omitempty"`
+	WarningsOmitted int                `bson:"warnings_omitted,omitempty"`
+	Error           string             `bson:"error,omitempty"`
+	CreatedAt       time.Time          `bson:"created_at"`
+	UpdatedAt       time.Time          `bson:"updated_at"`
+	StartedAt       *time.Time         `bson:"started_at,omitempty"`
+	FinishedAt      *time.Time         `bson:"finished_at,omitempty"`
+}
+
+func (j *ImportJob) PrepareForCreate(userID primitive.ObjectID, source string) {
+	now := time.Now()
+	j.ID = primitive.NewObjectID()
+	j.UserID = userID
+	j.Source = source
+	j.Status = ImportJobPending
+	j.CreatedAt = now
+	j.UpdatedAt = now
+}
+
+// AddWarning registra um item que não pôde ser convertido integralmente
+func (j *ImportJob) AddWarning(message string) {
+	if len(j.Warnings) >= MaxImportWarnings {
+		j.WarningsOmitted++
+		return
+	}
+	j.Warnings = append(j.Warnings, message)
+}
+
+// IsFinished indica se a importação terminou (com sucesso ou não)
+func (j *ImportJob) IsFinished() bool {
+	return j.Status == ImportJobCompleted || j.Status == ImportJobFailed
+}
+
+func (j *ImportJob) GetCollectionName() string {
+	return "import_jobs"
+}
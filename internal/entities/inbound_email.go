@@ -0,0 +1,21 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// InboundEmail registra um email já convertido em tarefa, evitando duplicatas
+// quando o mesmo Message-ID chega de novo (reenvio do webhook, nova leitura IMAP)
+type InboundEmail struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	MessageID  string             `bson:"message_id"`
+	UserID     primitive.ObjectID `bson:"user_id"`
+	TaskID     primitive.ObjectID `bson:"task_id,omitempty"`
+	ReceivedAt time.Time          `bson:"received_at"`
+}
+
+func (e *InboundEmail) GetCollectionName() string {
+	return "inbound_emails"
+}
@@ -0,0 +1,28 @@
+package entities
+
+// Verificações de integridade referencial dos dados
+const (
+	// IntegrityOrphanTasks são tarefas cujo dono não existe mais
+	IntegrityOrphanTasks = "orphan_tasks"
+	// IntegrityMissingProject são tarefas ligadas a um projeto inexistente
+	IntegrityMissingProject = "missing_project"
+	// IntegrityCompletedWithoutDate são tarefas concluídas sem completed_at
+	IntegrityCompletedWithoutDate = "completed_without_completed_at"
+	// IntegrityDateWithoutCompletion são tarefas não concluídas com completed_at
+	IntegrityDateWithoutCompletion = "completed_at_without_completion"
+	// IntegrityArchivedDuplicates são tarefas do histórico que continuam entre as ativas
+	IntegrityArchivedDuplicates = "archived_duplicates"
+	// IntegrityArchivedNotCompleted são tarefas do histórico que não estão concluídas
+	IntegrityArchivedNotCompleted = "archived_not_completed"
+)
+
+// IntegrityChecks lista as verificações na ordem em que são executadas (e
+// reparadas): duplicatas do histórico são resolvidas antes das restaurações
+var IntegrityChecks = []string{
+	IntegrityOrphanTasks,
+	IntegrityMissingProject,
+	IntegrityCompletedWithoutDate,
+	IntegrityDateWithoutCompletion,
+	IntegrityArchivedDuplicates,
+	IntegrityArchivedNotCompleted,
+}
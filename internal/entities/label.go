@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MaxLabels limita as etiquetas gerenciadas de um usuário
+const MaxLabels = 200
+
+// Label é uma etiqueta gerenciada, com cor e ícone. O nome é o mesmo texto
+// gravado em Task.Tags (normalizado por NormalizeTag), o que mantém filtros e
+// clientes antigos funcionando; Task.LabelIDs associa a tarefa pelo ID.
+type Label struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UserID    primitive.ObjectID `bson:"user_id"`
+	Name      string             `bson:"name"`
+	Color     string             `bson:"color,omitempty"`
+	Icon      string             `bson:"icon,omitempty"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+func (l *Label) PrepareForCreate(userID primitive.ObjectID) {
+	now := time.Now()
+	l.ID = primitive.NewObjectID()
+	l.UserID = userID
+	l.Name = NormalizeTag(l.Name)
+	l.CreatedAt = now
+	l.UpdatedAt = now
+}
+
+func (l *Label) PrepareForUpdate() {
+	l.Name = NormalizeTag(l.Name)
+	l.UpdatedAt = time.Now()
+}
+
+func (l *Label) GetCollectionName() string {
+	return "labels"
+}
+
+// LabelIDsForTags retorna, na ordem das etiquetas, os IDs das etiquetas
+// gerenciadas com os nomes informados
+func LabelIDsForTags(labels []*Label, tags []string) []primitive.ObjectID {
+	byName := make(map[string]primitive.ObjectID, len(labels))
+	for _, label := range labels {
+		byName[label.Name] = label.ID
+	}
+
+	var ids []primitive.ObjectID
+	for _, tag := range tags {
+		if id, ok := byName[tag]; ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
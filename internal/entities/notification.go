@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Notification struct {
+	ID      primitive.ObjectID     `bson:"_id,omitempty"`
+	UserID  primitive.ObjectID     `bson:"user_id"`
+	EventID string                 `bson:"event_id"`
+	Type    string                 `bson:"type"`
+	Title   string                 `bson:"title"`
+	Body    string                 `bson:"body"`
+	Data    map[string]interface{} `bson:"data,omitempty"`
+	InInbox bool                   `bson:"in_inbox"`
+	ReadAt  *time.Time             `bson:"read_at,omitempty"`
+	// PendingChannels lista os canais externos que ainda não receberam a notificação
+	PendingChannels []string  `bson:"pending_channels"`
+	Attempts        int       `bson:"attempts"`
+	LastError       string    `bson:"last_error,omitempty"`
+	CreatedAt       time.Time `bson:"created_at"`
+}
+
+func (n *Notification) PrepareForCreate() {
+	n.ID = primitive.NewObjectID()
+	n.CreatedAt = time.Now()
+
+	if n.PendingChannels == nil {
+		n.PendingChannels = []string{}
+	}
+}
+
+func (n *Notification) GetCollectionName() string {
+	return "notifications"
+}
@@ -0,0 +1,119 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// OrganizationInviteTTL é por quanto tempo um convite pode ser aceito
+const OrganizationInviteTTL = 7 * 24 * time.Hour
+
+// MaxOrganizationInvites limita os convites pendentes de uma organização
+const MaxOrganizationInvites = 100
+
+// Organization agrupa usuários de um mesmo contrato, limitado a Seats assentos.
+// O dono (OwnerID) ocupa um assento e não faz parte de Members.
+type Organization struct {
+	ID        primitive.ObjectID   `bson:"_id,omitempty"`
+	OwnerID   primitive.ObjectID   `bson:"owner_id"`
+	Name      string               `bson:"name"`
+	Seats     int                  `bson:"seats"`
+	Members   []OrganizationMember `bson:"members,omitempty"`
+	CreatedAt time.Time            `bson:"created_at"`
+	UpdatedAt time.Time            `bson:"updated_at"`
+
+	// Invites são os convites pendentes; o assento só é ocupado quando o
+	// convidado aceita
+	Invites []OrganizationInvite `bson:"invites,omitempty"`
+}
+
+// OrganizationInvite é o convite para o email (normalizado por NormalizeEmail)
+// ocupar um assento da organização
+type OrganizationInvite struct {
+	Email     string    `bson:"email"`
+	InvitedAt time.Time `bson:"invited_at"`
+	ExpiresAt time.Time `bson:"expires_at"`
+}
+
+// OrganizationMember é um usuário que ocupa um assento da organização
+type OrganizationMember struct {
+	UserID  primitive.ObjectID `bson:"user_id"`
+	AddedAt time.Time          `bson:"added_at"`
+}
+
+// OrgUsage é o uso da organização, recalculado periodicamente pelo job org-usage
+type OrgUsage struct {
+	OrganizationID primitive.ObjectID `bson:"_id"`
+	Seats          int                `bson:"seats"`
+	SeatsUsed      int                `bson:"seats_used"`
+	// TasksCreated e StorageBytes somam os valores de todos os membros, inclusive o dono
+	TasksCreated int64         `bson:"tasks_created"`
+	StorageBytes int64         `bson:"storage_bytes"`
+	Members      []MemberUsage `bson:"members"`
+	ComputedAt   time.Time     `bson:"computed_at"`
+}
+
+// MemberUsage é o uso de um membro: as tarefas criadas por ele (não removidas,
+// inclusive as arquivadas) e o espaço ocupado pelos seus anexos, em bytes
+type MemberUsage struct {
+	UserID       primitive.ObjectID `bson:"user_id"`
+	TasksCreated int64              `bson:"tasks_created"`
+	StorageBytes int64              `bson:"storage_bytes"`
+}
+
+// HasMember verifica se o usuário é o dono ou membro da organização
+func (o *Organization) HasMember(userID primitive.ObjectID) bool {
+	if o.OwnerID == userID {
+		return true
+	}
+
+	for _, member := range o.Members {
+		if member.UserID == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Invite retorna o convite pendente (não expirado em now) para o email
+func (o *Organization) Invite(email string, now time.Time) (*OrganizationInvite, bool) {
+	email = NormalizeEmail(email)
+	for _, invite := range o.Invites {
+		if invite.Email == email && invite.ExpiresAt.After(now) {
+			return &invite, true
+		}
+	}
+	return nil, false
+}
+
+// SeatsUsed conta os assentos ocupados: o dono e os membros
+func (o *Organization) SeatsUsed() int {
+	return 1 + len(o.Members)
+}
+
+// UserIDs retorna o dono seguido dos membros
+func (o *Organization) UserIDs() []primitive.ObjectID {
+	ids := make([]primitive.ObjectID, 0, o.SeatsUsed())
+	ids = append(ids, o.OwnerID)
+	for _, member := range o.Members {
+		ids = append(ids, member.UserID)
+	}
+	return ids
+}
+
+func (o *Organization) PrepareForCreateAt(ownerID primitive.ObjectID, now time.Time) {
+	o.ID = primitive.NewObjectID()
+	o.OwnerID = ownerID
+	o.CreatedAt = now
+	o.UpdatedAt = now
+}
+
+func (o *Organization) GetCollectionName() string {
+	return "organizations"
+}
+
+func (u *OrgUsage) GetCollectionName() string {
+	return "org_usage"
+}
@@ -0,0 +1,21 @@
+package entities
+
+import "time"
+
+// PhoneNumber é o telefone do usuário para lembretes por SMS. Enquanto a
+// verificação está pendente, guarda apenas o hash do código enviado.
+type PhoneNumber struct {
+	// Number no formato E.164 (ex.: +5511999998888)
+	Number        string     `bson:"number"`
+	VerifiedAt    *time.Time `bson:"verified_at,omitempty"`
+	CodeHash      string     `bson:"code_hash,omitempty"`
+	CodeSentAt    *time.Time `bson:"code_sent_at,omitempty"`
+	CodeExpiresAt *time.Time `bson:"code_expires_at,omitempty"`
+	// Attempts conta as tentativas de confirmação do código atual
+	Attempts int `bson:"attempts,omitempty"`
+}
+
+// IsVerified indica se o número foi confirmado pelo usuário
+func (p *PhoneNumber) IsVerified() bool {
+	return p != nil && p.VerifiedAt != nil
+}
@@ -0,0 +1,85 @@
+package entities
+
+import "time"
+
+// PlatformMetricsSnapshotID é o _id do documento com o painel administrativo
+const PlatformMetricsSnapshotID = "snapshot"
+
+// BusinessKPIsID é o _id do documento com os indicadores exportados em /metrics
+const BusinessKPIsID = "kpis"
+
+// MetricsCounter soma, por dia (UTC), os contadores enviados pelas instâncias
+// (ex.: respostas de erro por status, entregas de notificação por canal)
+type MetricsCounter struct {
+	// ID é "<dia>:<nome>", ex.: "2026-10-18:http_errors"
+	ID        string           `bson:"_id"`
+	Day       string           `bson:"day"`
+	Name      string           `bson:"name"`
+	Counts    map[string]int64 `bson:"counts"`
+	ExpiresAt time.Time        `bson:"expires_at"`
+}
+
+// PlatformMetrics é o painel de métricas globais da plataforma, recalculado
+// periodicamente
+type PlatformMetrics struct {
+	ActiveUsers7d  int64 `bson:"active_users_7d" json:"active_users_7d"`
+	ActiveUsers30d int64 `bson:"active_users_30d" json:"active_users_30d"`
+	// Tasks conta tarefas criadas e concluídas por dia (UTC), do mais antigo ao mais recente
+	Tasks []DailyTaskCount `bson:"tasks" json:"tasks"`
+	// TopErrorCodes são os status HTTP de erro mais frequentes no período
+	TopErrorCodes []ErrorCodeCount `bson:"top_error_codes" json:"top_error_codes"`
+	Webhooks      DeliveryRate     `bson:"webhooks" json:"webhooks"`
+	Storage       StorageUsage     `bson:"storage" json:"storage"`
+	// WindowDays é o período, em dias, das séries e contadores
+	WindowDays int       `bson:"window_days" json:"window_days"`
+	ComputedAt time.Time `bson:"computed_at" json:"computed_at"`
+}
+
+// BusinessKPIs são os indicadores de negócio exportados em /metrics,
+// recalculados a cada minuto pelo job business-kpis
+type BusinessKPIs struct {
+	// DailyActiveUsers conta os usuários ativos desde o início do dia (UTC)
+	DailyActiveUsers    int64 `bson:"daily_active_users" json:"daily_active_users"`
+	TasksCompletedToday int64 `bson:"tasks_completed_today" json:"tasks_completed_today"`
+	// OpenTasks conta as tarefas não concluídas e não arquivadas, e OverdueTasks
+	// as que entre elas já venceram
+	OpenTasks    int64 `bson:"open_tasks" json:"open_tasks"`
+	OverdueTasks int64 `bson:"overdue_tasks" json:"overdue_tasks"`
+	// OverdueRatio é OverdueTasks / OpenTasks, entre 0 e 1 (0 sem tarefas abertas)
+	OverdueRatio float64   `bson:"overdue_ratio" json:"overdue_ratio"`
+	ComputedAt   time.Time `bson:"computed_at" json:"computed_at"`
+}
+
+// DailyTaskCount é o número de tarefas criadas e concluídas em um dia
+type DailyTaskCount struct {
+	Date      string `bson:"date" json:"date"`
+	Created   int64  `bson:"created" json:"created"`
+	Completed int64  `bson:"completed" json:"completed"`
+}
+
+// ErrorCodeCount é o número de respostas com um status HTTP de erro
+type ErrorCodeCount struct {
+	Status string `bson:"status" json:"status"`
+	Count  int64  `bson:"count" json:"count"`
+}
+
+// DeliveryRate resume as entregas de um canal de notificação
+type DeliveryRate struct {
+	Delivered int64 `bson:"delivered" json:"delivered"`
+	Failed    int64 `bson:"failed" json:"failed"`
+	// SuccessRate é Delivered / (Delivered + Failed), entre 0 e 1 (1 sem entregas)
+	SuccessRate float64 `bson:"success_rate" json:"success_rate"`
+}
+
+// StorageUsage é o espaço ocupado pelo banco, em bytes
+type StorageUsage struct {
+	DataBytes        int64 `bson:"data_bytes" json:"data_bytes"`
+	StorageBytes     int64 `bson:"storage_bytes" json:"storage_bytes"`
+	IndexBytes       int64 `bson:"index_bytes" json:"index_bytes"`
+	AttachmentBytes  int64 `bson:"attachment_bytes" json:"attachment_bytes"`
+	AttachmentsCount int64 `bson:"attachments_count" json:"attachments_count"`
+}
+
+func (m *MetricsCounter) GetCollectionName() string {
+	return "platform_metrics"
+}
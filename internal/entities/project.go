@@ -0,0 +1,85 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/enums"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Project struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UserID    primitive.ObjectID `bson:"user_id"`
+	Name      string             `bson:"name"`
+	Color     string             `bson:"color,omitempty"`
+	Statuses  []ProjectStatus    `bson:"statuses,omitempty"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+
+	// Members são os usuários com quem o projeto foi compartilhado (o dono,
+	// UserID, não faz parte da lista)
+	Members []ProjectMember `bson:"members,omitempty"`
+
+	// Rollup é o resumo das tarefas gravado a cada alteração de tarefa do
+	// projeto (nil até a primeira). As atrasadas são as do momento do cálculo.
+	Rollup *ProjectRollup `bson:"rollup,omitempty"`
+}
+
+// ProjectRollup resume o andamento das tarefas não arquivadas do projeto
+type ProjectRollup struct {
+	TotalTasks     int64 `bson:"total_tasks"`
+	OpenTasks      int64 `bson:"open_tasks"`
+	CompletedTasks int64 `bson:"completed_tasks"`
+	OverdueTasks   int64 `bson:"overdue_tasks"`
+	// RemainingEstimateMinutes soma as estimativas das tarefas em aberto
+	RemainingEstimateMinutes int64 `bson:"remaining_estimate_minutes"`
+	// PercentComplete é a parcela concluída das tarefas não canceladas (0 a 100)
+	PercentComplete int       `bson:"percent_complete"`
+	ComputedAt      time.Time `bson:"computed_at"`
+}
+
+// ProjectMember é um usuário com acesso ao projeto e o seu papel (editor ou viewer)
+type ProjectMember struct {
+	UserID  primitive.ObjectID `bson:"user_id"`
+	Role    enums.ProjectRole  `bson:"role"`
+	AddedAt time.Time          `bson:"added_at"`
+}
+
+// ProjectStatus é um status personalizado do projeto (ex.: coluna de um quadro),
+// associado a um dos status padrão para filtros, estatísticas e notificações
+type ProjectStatus struct {
+	ID       string           `bson:"id"`
+	Name     string           `bson:"name"`
+	Category enums.TaskStatus `bson:"category"`
+}
+
+// Role retorna o papel do usuário no projeto ("" se ele não tiver acesso)
+func (p *Project) Role(userID primitive.ObjectID) enums.ProjectRole {
+	if p.UserID == userID {
+		return enums.RoleOwner
+	}
+
+	for _, member := range p.Members {
+		if member.UserID == userID {
+			return member.Role
+		}
+	}
+
+	return ""
+}
+
+func (p *Project) PrepareForCreate(userID primitive.ObjectID) {
+	now := time.Now()
+	p.ID = primitive.NewObjectID()
+	p.UserID = userID
+	p.CreatedAt = now
+	p.UpdatedAt = now
+}
+
+func (p *Project) PrepareForUpdate() {
+	p.UpdatedAt = time.Now()
+}
+
+func (p *Project) GetCollectionName() string {
+	return "projects"
+}
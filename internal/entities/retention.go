@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Recursos sujeitos à política de retenção
+const (
+	// RetentionCompletedTasks são as tarefas concluídas (inclusive no histórico), pela data de conclusão
+	RetentionCompletedTasks = "completed_tasks"
+	// RetentionAuditLogs são as entradas de auditoria, pelo ator
+	RetentionAuditLogs = "audit_logs"
+	// RetentionNotifications são as notificações, lidas ou não
+	RetentionNotifications = "notifications"
+)
+
+// RetentionResources lista os recursos na ordem em que são avaliados
+var RetentionResources = []string{RetentionCompletedTasks, RetentionAuditLogs, RetentionNotifications}
+
+// IsRetentionResource verifica se o recurso tem política de retenção
+func IsRetentionResource(resource string) bool {
+	for _, r := range RetentionResources {
+		if r == resource {
+			return true
+		}
+	}
+	return false
+}
+
+// RetentionOverride substitui, para um usuário, a retenção padrão dos recursos
+// informados. Days é o número de dias por recurso; 0 mantém os dados para sempre.
+type RetentionOverride struct {
+	UserID    primitive.ObjectID `bson:"_id" json:"user_id"`
+	Days      map[string]int     `bson:"days" json:"days"`
+	UpdatedAt time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+func (o *RetentionOverride) GetCollectionName() string {
+	return "retention_overrides"
+}
@@ -0,0 +1,55 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Situações de uma assinatura
+const (
+	SubscriptionActive   = "active"
+	SubscriptionTrialing = "trialing"
+	// SubscriptionPastDue é a cobrança em atraso: o plano continua valendo até
+	// o provedor cancelar a assinatura
+	SubscriptionPastDue  = "past_due"
+	SubscriptionCanceled = "canceled"
+)
+
+// SubscriptionProviderManual identifica assinaturas atribuídas pelo administrador
+const SubscriptionProviderManual = "manual"
+
+// Subscription é a assinatura de um plano pelo usuário. Sem assinatura (ou com
+// ela cancelada ou vencida), vale o plano gratuito.
+type Subscription struct {
+	UserID primitive.ObjectID `bson:"_id" json:"user_id"`
+	PlanID string             `bson:"plan_id" json:"plan_id"`
+	Status string             `bson:"status" json:"status"`
+	// Provider é o provedor de pagamentos (ou manual) e ProviderRef o ID da assinatura nele
+	Provider    string `bson:"provider" json:"provider"`
+	ProviderRef string `bson:"provider_ref,omitempty" json:"provider_ref,omitempty"`
+	// CurrentPeriodEnd é o fim do período pago (nil não vence)
+	CurrentPeriodEnd *time.Time `bson:"current_period_end,omitempty" json:"current_period_end,omitempty"`
+	// PreviousPlanID é o plano efetivo antes da última mudança, em PlanChangedAt
+	// (base do cálculo proporcional das cotas no mês da mudança)
+	PreviousPlanID string     `bson:"previous_plan_id,omitempty" json:"previous_plan_id,omitempty"`
+	PlanChangedAt  *time.Time `bson:"plan_changed_at,omitempty" json:"plan_changed_at,omitempty"`
+	// ProviderEventAt é o instante da última notificação do provedor aplicada
+	ProviderEventAt *time.Time `bson:"provider_event_at,omitempty" json:"-"`
+	CreatedAt       time.Time  `bson:"created_at" json:"created_at"`
+	UpdatedAt       time.Time  `bson:"updated_at" json:"updated_at"`
+}
+
+// GrantsPlan indica se a assinatura dá acesso ao plano no instante now
+func (s *Subscription) GrantsPlan(now time.Time) bool {
+	switch s.Status {
+	case SubscriptionActive, SubscriptionTrialing, SubscriptionPastDue:
+		return s.CurrentPeriodEnd == nil || s.CurrentPeriodEnd.After(now)
+	default:
+		return false
+	}
+}
+
+func (s *Subscription) GetCollectionName() string {
+	return "subscriptions"
+}
@@ -8,22 +8,68 @@ import (
 )
 
 type Task struct {
-	ID          primitive.ObjectID `bson:"_id,omitempty"`
-	UserID      primitive.ObjectID `bson:"user_id"`
-	Title       string             `bson:"title"`
-	Description string             `bson:"description,omitempty"`
-	Status      enums.TaskStatus   `bson:"status"`
-	Priority    enums.TaskPriority `bson:"priority"`
-	DueDate     *time.Time         `bson:"due_date,omitempty"`
-	Tags        []string           `bson:"tags,omitempty"`
-	IsArchived  bool               `bson:"is_archived"`
-	CreatedAt   time.Time          `bson:"created_at"`
-	UpdatedAt   time.Time          `bson:"updated_at"`
-	CompletedAt *time.Time         `bson:"completed_at,omitempty"`
+	ID           primitive.ObjectID   `bson:"_id,omitempty"`
+	UserID       primitive.ObjectID   `bson:"user_id"`
+	ProjectID    *primitive.ObjectID  `bson:"project_id,omitempty"`
+	Title        string               `bson:"title"`
+	Description  string               `bson:"description,omitempty"`
+	Status       enums.TaskStatus     `bson:"status"`
+	StatusID     string               `bson:"status_id,omitempty"`
+	CustomStatus *TaskCustomStatus    `bson:"custom_status,omitempty"`
+	Priority     enums.TaskPriority   `bson:"priority"`
+	DueDate      *time.Time           `bson:"due_date,omitempty"`
+	Recurrence   string               `bson:"recurrence,omitempty"` // RRULE (RFC 5545), sem o prefixo "RRULE:"
+	ReminderAt   *time.Time           `bson:"reminder_at,omitempty"`
+	Tags         []string             `bson:"tags,omitempty"`
+	LabelIDs     []primitive.ObjectID `bson:"label_ids,omitempty"`
+	Checklist    []ChecklistItem      `bson:"checklist,omitempty"`
+	Attachments  []TaskAttachment     `bson:"attachments,omitempty"`
+	Location     *TaskLocation        `bson:"location,omitempty"`
+	External     *ExternalRef         `bson:"external,omitempty"`
+	CalDAV       *CalDAVRef           `bson:"caldav,omitempty"`
+	IsArchived   bool                 `bson:"is_archived"`
+	CreatedAt    time.Time            `bson:"created_at"`
+	UpdatedAt    time.Time            `bson:"updated_at"`
+	CompletedAt  *time.Time           `bson:"completed_at,omitempty"`
+
+	// PriorityOrder é a ordem numérica de Priority (urgent = 4), gravada junto
+	// para ordenar por prioridade sem $switch nas consultas
+	PriorityOrder int `bson:"priority_order"`
+	// TitleTokens são os termos normalizados do título (TitleTokens), mantidos
+	// a cada gravação para as sugestões por prefixo
+	TitleTokens []string `bson:"title_tokens,omitempty"`
+	// Position é a ordem manual da tarefa na sua coluna (projeto e status),
+	// a partir de 1; zero quando nunca reordenada
+	Position int64 `bson:"position,omitempty"`
+	// EstimateMinutes é o esforço estimado da tarefa (0 = sem estimativa)
+	EstimateMinutes int `bson:"estimate_minutes,omitempty"`
+
+	// SearchHighlights são os trechos que casaram com a busca (Atlas Search).
+	// Só existem nos resultados de busca e nunca são gravados.
+	SearchHighlights []SearchHighlight `bson:"-"`
+	// Warnings são os avisos da criação (ex.: vencimento no passado com a
+	// política warn). Só existem na resposta e nunca são gravados.
+	Warnings []string `bson:"-"`
+}
+
+// SearchHighlight é um campo da tarefa com os trechos destacados pela busca
+type SearchHighlight struct {
+	Path  string          `bson:"path"`
+	Texts []HighlightText `bson:"texts"`
+}
+
+// HighlightText é um trecho do campo: Type "hit" para termos buscados e "text" para o contexto
+type HighlightText struct {
+	Value string `bson:"value"`
+	Type  string `bson:"type"`
 }
 
 func (t *Task) PrepareForCreate(userID primitive.ObjectID) {
-	now := time.Now()
+	t.PrepareForCreateAt(userID, time.Now())
+}
+
+// PrepareForCreateAt prepara a tarefa para inserção usando now como hora atual
+func (t *Task) PrepareForCreateAt(userID primitive.ObjectID, now time.Time) {
 	t.ID = primitive.NewObjectID()
 	t.UserID = userID
 	t.CreatedAt = now
@@ -37,30 +83,78 @@ func (t *Task) PrepareForCreate(userID primitive.ObjectID) {
 	if t.Priority == "" {
 		t.Priority = enums.PriorityMedium
 	}
+
+	t.PriorityOrder = t.Priority.GetPriorityOrder()
+	t.TitleTokens = TitleTokens(t.Title)
+	t.normalizeCompletion(now)
 }
 
 func (t *Task) PrepareForUpdate() {
-	t.UpdatedAt = time.Now()
+	t.PrepareForUpdateAt(time.Now())
+}
+
+// PrepareForUpdateAt prepara a tarefa para atualização usando now como hora atual
+func (t *Task) PrepareForUpdateAt(now time.Time) {
+	t.UpdatedAt = now
+	t.PriorityOrder = t.Priority.GetPriorityOrder()
+	t.TitleTokens = TitleTokens(t.Title)
+	t.normalizeCompletion(now)
+}
+
+// SetStatus altera o status mantendo completed_at coerente com ele
+func (t *Task) SetStatus(status enums.TaskStatus) {
+	t.SetStatusAt(status, time.Now())
+}
+
+// SetStatusAt altera o status usando now como hora atual
+func (t *Task) SetStatusAt(status enums.TaskStatus, now time.Time) {
+	t.Status = status
+	t.PrepareForUpdateAt(now)
+}
+
+// normalizeCompletion garante que apenas tarefas concluídas tenham completed_at
+// e que toda tarefa concluída tenha (now quando faltar)
+func (t *Task) normalizeCompletion(now time.Time) {
+	switch {
+	case t.Status != enums.StatusCompleted:
+		t.CompletedAt = nil
+	case t.CompletedAt == nil:
+		t.CompletedAt = &now
+	}
 }
 
 func (t *Task) MarkAsCompleted() {
-	now := time.Now()
+	t.MarkAsCompletedAt(time.Now())
+}
+
+// MarkAsCompletedAt conclui a tarefa usando now como hora atual
+func (t *Task) MarkAsCompletedAt(now time.Time) {
 	t.Status = enums.StatusCompleted
 	t.CompletedAt = &now
 	t.UpdatedAt = now
 }
 
 func (t *Task) MarkAsPending() {
+	t.MarkAsPendingAt(time.Now())
+}
+
+// MarkAsPendingAt reabre a tarefa usando now como hora atual
+func (t *Task) MarkAsPendingAt(now time.Time) {
 	t.Status = enums.StatusPending
 	t.CompletedAt = nil
-	t.UpdatedAt = time.Now()
+	t.UpdatedAt = now
 }
 
 func (t *Task) IsOverdue() bool {
+	return t.IsOverdueAt(time.Now())
+}
+
+// IsOverdueAt indica se a tarefa está atrasada no instante now
+func (t *Task) IsOverdueAt(now time.Time) bool {
 	if t.DueDate == nil || t.Status == enums.StatusCompleted {
 		return false
 	}
-	return t.DueDate.Before(time.Now())
+	return t.DueDate.Before(now)
 }
 
 func (t *Task) GetCollectionName() string {
@@ -0,0 +1,17 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// TaskAttachment descreve um arquivo anexado à tarefa. O conteúdo fica no
+// GridFS (bucket "attachments") sob o mesmo ID.
+type TaskAttachment struct {
+	ID          primitive.ObjectID `bson:"_id"`
+	Filename    string             `bson:"filename"`
+	ContentType string             `bson:"content_type"`
+	Size        int64              `bson:"size"`
+	CreatedAt   time.Time          `bson:"created_at"`
+}
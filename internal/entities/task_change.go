@@ -0,0 +1,32 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// TaskChange registra uma alteração de tarefa para o feed de mudanças consultado
+// por integrações sem webhook. O _id (ObjectID) é o cursor do feed.
+type TaskChange struct {
+	ID primitive.ObjectID `bson:"_id"`
+	// EventID é o ID do evento de domínio de origem, usado para não gravar duplicatas
+	EventID string             `bson:"event_id"`
+	UserID  primitive.ObjectID `bson:"user_id"`
+	Type    string             `bson:"type"`
+	TaskID  primitive.ObjectID `bson:"task_id"`
+	// Task é o estado da tarefa quando a alteração foi registrada. Em exclusões,
+	// traz apenas os campos presentes no evento (título, status e prioridade).
+	Task       Task      `bson:"task"`
+	OccurredAt time.Time `bson:"occurred_at"`
+	CreatedAt  time.Time `bson:"created_at"`
+}
+
+func (c *TaskChange) PrepareForCreate() {
+	c.ID = primitive.NewObjectID()
+	c.CreatedAt = time.Now()
+}
+
+func (c *TaskChange) GetCollectionName() string {
+	return "task_changes"
+}
@@ -0,0 +1,66 @@
+package entities
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// ChecklistItem é um item da lista de verificação da tarefa
+type ChecklistItem struct {
+	ID   primitive.ObjectID `bson:"_id"`
+	Text string             `bson:"text"`
+	Done bool               `bson:"done"`
+}
+
+// ProgressThresholds são os percentuais de conclusão da lista de verificação
+// que, quando cruzados, são destacados no evento de progresso
+var ProgressThresholds = []int{25, 50, 75, 100}
+
+// ChecklistProgress é o andamento da lista de verificação da tarefa
+type ChecklistProgress struct {
+	Done  int
+	Total int
+}
+
+// ChecklistProgress conta os itens concluídos da lista de verificação
+func (t *Task) ChecklistProgress() ChecklistProgress {
+	progress := ChecklistProgress{Total: len(t.Checklist)}
+	for _, item := range t.Checklist {
+		if item.Done {
+			progress.Done++
+		}
+	}
+	return progress
+}
+
+// ChecklistItem retorna o item da lista de verificação com o ID informado (nil se não existir)
+func (t *Task) ChecklistItem(id primitive.ObjectID) *ChecklistItem {
+	for i := range t.Checklist {
+		if t.Checklist[i].ID == id {
+			return &t.Checklist[i]
+		}
+	}
+	return nil
+}
+
+// Percent retorna o percentual concluído, arredondado para baixo (0 sem itens)
+func (p ChecklistProgress) Percent() int {
+	if p.Total == 0 {
+		return 0
+	}
+	return p.Done * 100 / p.Total
+}
+
+// CrossedThresholds retorna os ProgressThresholds cruzados desde previous, em
+// qualquer sentido (itens desmarcados também cruzam os limites)
+func (p ChecklistProgress) CrossedThresholds(previous ChecklistProgress) []int {
+	from, to := previous.Percent(), p.Percent()
+	if from > to {
+		from, to = to, from
+	}
+
+	crossed := []int{}
+	for _, threshold := range ProgressThresholds {
+		if from < threshold && threshold <= to {
+			crossed = append(crossed, threshold)
+		}
+	}
+	return crossed
+}
@@ -0,0 +1,39 @@
+package entities
+
+// GeoPointType é o tipo GeoJSON dos locais das tarefas
+const GeoPointType = "Point"
+
+// TaskLocation é o local associado à tarefa (ex.: o mercado de uma compra),
+// usado nos lembretes por localização e na listagem de tarefas próximas
+type TaskLocation struct {
+	Point GeoPoint `bson:"point"`
+	Label string   `bson:"label,omitempty"`
+}
+
+// GeoPoint é um ponto GeoJSON, indexado com 2dsphere. Coordinates segue a
+// ordem do GeoJSON: [longitude, latitude].
+type GeoPoint struct {
+	Type        string    `bson:"type"`
+	Coordinates []float64 `bson:"coordinates"`
+}
+
+// NewGeoPoint cria o ponto a partir da latitude e longitude
+func NewGeoPoint(lat, lng float64) GeoPoint {
+	return GeoPoint{Type: GeoPointType, Coordinates: []float64{lng, lat}}
+}
+
+// Latitude retorna a latitude do ponto
+func (p GeoPoint) Latitude() float64 {
+	if len(p.Coordinates) < 2 {
+		return 0
+	}
+	return p.Coordinates[1]
+}
+
+// Longitude retorna a longitude do ponto
+func (p GeoPoint) Longitude() float64 {
+	if len(p.Coordinates) < 2 {
+		return 0
+	}
+	return p.Coordinates[0]
+}
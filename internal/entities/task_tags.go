@@ -0,0 +1,41 @@
+package entities
+
+import "strings"
+
+// Limites de etiquetas por tarefa (os mesmos do validator da collection tasks)
+const (
+	MaxTaskTags  = 10
+	MaxTagLength = 50
+)
+
+// NormalizeTag padroniza a etiqueta (sem espaços nas pontas, em minúsculas e com
+// no máximo MaxTagLength caracteres), para que "Casa" e "casa " sejam a mesma
+func NormalizeTag(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if runes := []rune(tag); len(runes) > MaxTagLength {
+		tag = strings.TrimSpace(string(runes[:MaxTagLength]))
+	}
+	return tag
+}
+
+// NormalizeTags aplica NormalizeTag, remove vazias e repetidas (mantendo a
+// ordem) e limita a lista a MaxTaskTags etiquetas
+func NormalizeTags(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		tag = NormalizeTag(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+
+		if len(normalized) == MaxTaskTags {
+			break
+		}
+	}
+
+	return normalized
+}
@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"strings"
+	"unicode"
+)
+
+// accentFolding remove os acentos das letras já em minúsculas, para que
+// "reuniao" sugira "Reunião"
+var accentFolding = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+// NormalizeSearchText padroniza o texto para comparação com os termos do
+// título: minúsculas e sem acentos
+func NormalizeSearchText(text string) string {
+	return accentFolding.Replace(strings.ToLower(text))
+}
+
+// TitleTokens divide o título nos termos usados pela busca por prefixo das
+// sugestões: normalizados, sem pontuação e sem repetidos (mantendo a ordem)
+func TitleTokens(title string) []string {
+	words := strings.FieldsFunc(NormalizeSearchText(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	seen := make(map[string]bool, len(words))
+	tokens := make([]string, 0, len(words))
+	for _, word := range words {
+		if seen[word] {
+			continue
+		}
+		seen[word] = true
+		tokens = append(tokens, word)
+	}
+
+	return tokens
+}
@@ -0,0 +1,32 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// TelegramLink vincula um usuário a um chat do bot do Telegram.
+// Enquanto o vínculo não é confirmado, guarda apenas o código de uso único.
+type TelegramLink struct {
+	ID     primitive.ObjectID `bson:"_id,omitempty"`
+	UserID primitive.ObjectID `bson:"user_id"`
+	// ChatID é zero até o usuário enviar o código ao bot
+	ChatID   int64      `bson:"chat_id,omitempty"`
+	Username string     `bson:"username,omitempty"`
+	LinkedAt *time.Time `bson:"linked_at,omitempty"`
+	// CodeHash é o SHA-256 do código de vinculação pendente
+	CodeHash      string     `bson:"code_hash,omitempty"`
+	CodeExpiresAt *time.Time `bson:"code_expires_at,omitempty"`
+	CreatedAt     time.Time  `bson:"created_at"`
+	UpdatedAt     time.Time  `bson:"updated_at"`
+}
+
+// IsLinked indica se o usuário já confirmou o vínculo com um chat
+func (l *TelegramLink) IsLinked() bool {
+	return l.ChatID != 0
+}
+
+func (l *TelegramLink) GetCollectionName() string {
+	return "telegram_links"
+}
@@ -0,0 +1,31 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Tipos de entidade com exclusões registradas
+const (
+	TombstoneTask    = "task"
+	TombstoneProject = "project"
+)
+
+// TombstoneRetention é por quanto tempo as exclusões ficam registradas.
+// Clientes que não sincronizam há mais tempo precisam de uma sincronização completa.
+const TombstoneRetention = 90 * 24 * time.Hour
+
+// Tombstone registra a remoção de um documento, para que clientes de
+// sincronização e integrações por polling saibam das exclusões que perderam
+type Tombstone struct {
+	ID         primitive.ObjectID `bson:"_id"`
+	UserID     primitive.ObjectID `bson:"user_id"`
+	EntityType string             `bson:"entity_type"`
+	EntityID   primitive.ObjectID `bson:"entity_id"`
+	DeletedAt  time.Time          `bson:"deleted_at"`
+}
+
+func (t *Tombstone) GetCollectionName() string {
+	return "tombstones"
+}
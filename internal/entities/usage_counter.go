@@ -0,0 +1,23 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UsageCounter conta o uso de um recurso com cota (ex.: SMS) por usuário no período
+type UsageCounter struct {
+	ID       primitive.ObjectID `bson:"_id,omitempty"`
+	UserID   primitive.ObjectID `bson:"user_id"`
+	Resource string             `bson:"resource"`
+	// Period identifica a janela de contagem (mês em UTC, formato 2006-01)
+	Period    string    `bson:"period"`
+	Count     int64     `bson:"count"`
+	ExpiresAt time.Time `bson:"expires_at"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+func (c *UsageCounter) GetCollectionName() string {
+	return "usage_counters"
+}
@@ -1,20 +1,38 @@
 package entities
 
 import (
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type User struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty"`
-	Name      string             `bson:"name"`
-	Email     string             `bson:"email"`
-	Password  string             `bson:"password"`
-	Avatar    string             `bson:"avatar,omitempty"`
-	IsActive  bool               `bson:"is_active"`
-	CreatedAt time.Time          `bson:"created_at"`
-	UpdatedAt time.Time          `bson:"updated_at"`
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	Name          string             `bson:"name"`
+	Email         string             `bson:"email"`
+	Password      string             `bson:"password"`
+	Avatar        string             `bson:"avatar,omitempty"`
+	IsActive      bool               `bson:"is_active"`
+	Preferences   UserPreferences    `bson:"preferences"`
+	InboundAlias  string             `bson:"inbound_alias,omitempty"`
+	Phone         *PhoneNumber       `bson:"phone,omitempty"`
+	FeedTokenHash string             `bson:"feed_token_hash,omitempty"`
+	// CustomStatuses são os status de exibição definidos pelo usuário
+	CustomStatuses []CustomStatus `bson:"custom_statuses,omitempty"`
+	AnonymizedAt   *time.Time     `bson:"anonymized_at,omitempty"` // conta encerrada com os dados pessoais removidos
+	CreatedAt      time.Time      `bson:"created_at"`
+	UpdatedAt      time.Time      `bson:"updated_at"`
+
+	// SuspendedAt marca a conta suspensa por um administrador: sem acesso à API
+	// até ser reativada, mas com os dados preservados (diferente da exclusão)
+	SuspendedAt      *time.Time `bson:"suspended_at,omitempty"`
+	SuspensionReason string     `bson:"suspension_reason,omitempty"`
+	// SessionsRevokedAt invalida os tokens de acesso emitidos antes dele
+	SessionsRevokedAt *time.Time `bson:"sessions_revoked_at,omitempty"`
+
+	// OverdueSummarySentOn é o dia local (AAAA-MM-DD) do último resumo de tarefas atrasadas
+	OverdueSummarySentOn string `bson:"overdue_summary_sent_on,omitempty"`
 }
 
 func (u *User) PrepareForCreate() {
@@ -23,12 +41,29 @@ func (u *User) PrepareForCreate() {
 	u.CreatedAt = now
 	u.UpdatedAt = now
 	u.IsActive = true
+	u.Email = NormalizeEmail(u.Email)
 }
 
 func (u *User) PrepareForUpdate() {
 	u.UpdatedAt = time.Now()
 }
 
+// IsSuspended indica se a conta está suspensa
+func (u *User) IsSuspended() bool {
+	return u.SuspendedAt != nil
+}
+
+// SessionRevoked indica se um token emitido em issuedAt foi invalidado
+func (u *User) SessionRevoked(issuedAt time.Time) bool {
+	return u.SessionsRevokedAt != nil && issuedAt.Before(*u.SessionsRevokedAt)
+}
+
 func (u *User) GetCollectionName() string {
 	return "users"
 }
+
+// NormalizeEmail padroniza o email (sem espaços e em minúsculas) para que
+// User@x.com e user@x.com sejam tratados como o mesmo endereço
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,157 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/enums"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Canais de notificação disponíveis
+const (
+	ChannelInbox   = "inbox"
+	ChannelEmail   = "email"
+	ChannelPush    = "push"
+	ChannelWebhook = "webhook"
+	ChannelSMS     = "sms"
+)
+
+// Políticas para tarefas criadas com vencimento no passado
+const (
+	DueDatePolicyAllow  = "allow"
+	DueDatePolicyWarn   = "warn"
+	DueDatePolicyReject = "reject"
+)
+
+type UserPreferences struct {
+	Timezone      string                  `bson:"timezone,omitempty"`
+	Notifications NotificationPreferences `bson:"notifications"`
+	TaskDefaults  TaskDefaults            `bson:"task_defaults,omitempty"`
+	Calendar      CalendarPreferences     `bson:"calendar,omitempty"`
+}
+
+// CalendarPreferences ajusta as datas calculadas aos dias úteis do usuário
+type CalendarPreferences struct {
+	// SkipWeekends move as ocorrências das tarefas recorrentes que caem em fim
+	// de semana ou feriado para o próximo dia útil
+	SkipWeekends bool `bson:"skip_weekends,omitempty"`
+	// HolidayLocale escolhe a lista de feriados (ex.: "pt-BR"; vazio = nenhum)
+	HolidayLocale string `bson:"holiday_locale,omitempty"`
+}
+
+// TaskDefaults são os valores aplicados às tarefas criadas sem os campos
+// correspondentes
+type TaskDefaults struct {
+	Priority  enums.TaskPriority  `bson:"priority,omitempty"`
+	ProjectID *primitive.ObjectID `bson:"project_id,omitempty"`
+	Tags      []string            `bson:"tags,omitempty"`
+	// ReminderOffset é a antecedência do lembrete em relação ao vencimento
+	// (0 = sem lembrete automático)
+	ReminderOffset time.Duration `bson:"reminder_offset,omitempty"`
+	// PastDueDatePolicy define a criação de tarefas com vencimento no passado:
+	// allow (padrão), warn (aviso na resposta) ou reject (recusada)
+	PastDueDatePolicy string `bson:"past_due_date_policy,omitempty"`
+}
+
+type NotificationPreferences struct {
+	// Channels lista os canais habilitados; vazio equivale a apenas inbox
+	Channels []string `bson:"channels,omitempty"`
+	// MutedEvents lista tipos de evento que não devem gerar notificação
+	MutedEvents []string `bson:"muted_events,omitempty"`
+	// QuietHoursStart e QuietHoursEnd no formato HH:MM, no fuso do usuário
+	QuietHoursStart string `bson:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   string `bson:"quiet_hours_end,omitempty"`
+	WebhookURL      string `bson:"webhook_url,omitempty"`
+	PushTopic       string `bson:"push_topic,omitempty"`
+	// OverdueSummary habilita o resumo diário das tarefas atrasadas, enviado
+	// pela manhã no fuso do usuário
+	OverdueSummary bool `bson:"overdue_summary,omitempty"`
+}
+
+// Location retorna o fuso horário do usuário (UTC se não configurado ou inválido)
+func (p *UserPreferences) Location() *time.Location {
+	if p.Timezone == "" {
+		return time.UTC
+	}
+
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// EnabledChannels retorna os canais habilitados, com inbox como padrão
+func (p *NotificationPreferences) EnabledChannels() []string {
+	if len(p.Channels) == 0 {
+		return []string{ChannelInbox}
+	}
+	return p.Channels
+}
+
+// IsMuted verifica se o usuário silenciou o tipo de evento
+func (p *NotificationPreferences) IsMuted(eventType string) bool {
+	for _, muted := range p.MutedEvents {
+		if muted == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// InQuietHours verifica se o instante informado está no horário de silêncio do usuário.
+// Intervalos que cruzam a meia-noite (ex.: 22:00-07:00) são suportados.
+func (p *UserPreferences) InQuietHours(now time.Time) bool {
+	start, okStart := parseClock(p.Notifications.QuietHoursStart)
+	end, okEnd := parseClock(p.Notifications.QuietHoursEnd)
+	if !okStart || !okEnd || start == end {
+		return false
+	}
+
+	local := now.In(p.Location())
+	minutes := local.Hour()*60 + local.Minute()
+
+	if start < end {
+		return minutes >= start && minutes < end
+	}
+	return minutes >= start || minutes < end
+}
+
+// parseClock converte HH:MM em minutos desde a meia-noite
+func parseClock(value string) (int, bool) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, false
+	}
+	return t.Hour()*60 + t.Minute(), true
+}
+
+// DueDatePolicy retorna a política de vencimento no passado (allow se não configurada)
+func (d *TaskDefaults) DueDatePolicy() string {
+	if d.PastDueDatePolicy == "" {
+		return DueDatePolicyAllow
+	}
+	return d.PastDueDatePolicy
+}
+
+// ApplyTo preenche os campos vazios da tarefa com os valores padrão. O
+// lembrete só é definido para tarefas com vencimento.
+func (d *TaskDefaults) ApplyTo(task *Task) {
+	if task.Priority == "" {
+		task.Priority = d.Priority
+	}
+
+	if task.ProjectID == nil && d.ProjectID != nil {
+		projectID := *d.ProjectID
+		task.ProjectID = &projectID
+	}
+
+	if task.Tags == nil && len(d.Tags) > 0 {
+		task.Tags = append([]string(nil), d.Tags...)
+	}
+
+	if task.ReminderAt == nil && task.DueDate != nil && d.ReminderOffset > 0 {
+		reminder := task.DueDate.Add(-d.ReminderOffset)
+		task.ReminderAt = &reminder
+	}
+}
@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Layouts de exibição das tarefas
+const (
+	ViewLayoutList  = "list"
+	ViewLayoutBoard = "board"
+)
+
+// ViewSettings é o layout com que o usuário vê as tarefas de um projeto,
+// compartilhado entre os seus dispositivos
+type ViewSettings struct {
+	ID     primitive.ObjectID `bson:"_id,omitempty"`
+	UserID primitive.ObjectID `bson:"user_id"`
+	// ProjectID nil é a configuração padrão do usuário, usada nos projetos sem
+	// configuração própria
+	ProjectID *primitive.ObjectID `bson:"project_id"`
+	Layout    string              `bson:"layout"`
+	// ColumnOrder é a ordem das colunas do quadro (status ou status do projeto)
+	ColumnOrder []string `bson:"column_order,omitempty"`
+	// CollapsedGroups são os grupos (colunas ou seções da lista) recolhidos
+	CollapsedGroups []string  `bson:"collapsed_groups,omitempty"`
+	SortBy          string    `bson:"sort_by,omitempty"`
+	SortOrder       string    `bson:"sort_order,omitempty"`
+	UpdatedAt       time.Time `bson:"updated_at"`
+}
+
+// DefaultViewSettings é a configuração de quem nunca salvou uma: lista, mais recentes primeiro
+func DefaultViewSettings(userID primitive.ObjectID, projectID *primitive.ObjectID) *ViewSettings {
+	return &ViewSettings{UserID: userID, ProjectID: projectID, Layout: ViewLayoutList}
+}
+
+func (v *ViewSettings) GetCollectionName() string {
+	return "view_settings"
+}
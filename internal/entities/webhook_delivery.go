@@ -0,0 +1,49 @@
+package entities
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// WebhookDeliveryRetention é por quanto tempo as tentativas de entrega ficam registradas
+const WebhookDeliveryRetention = 30 * 24 * time.Hour
+
+// WebhookResponseSnippetBytes é quanto da resposta do destino é guardado em cada tentativa
+const WebhookResponseSnippetBytes = 1024
+
+// WebhookDelivery registra uma tentativa de entrega no webhook de notificações
+// do usuário, para depuração pelo integrador
+type WebhookDelivery struct {
+	ID     primitive.ObjectID `bson:"_id,omitempty"`
+	UserID primitive.ObjectID `bson:"user_id"`
+	// EventID identifica o conteúdo enviado e se repete nas reentregas, para o
+	// destino descartar o que já processou
+	EventID string `bson:"event_id"`
+	// RedeliveryOf é a entrega original quando esta é uma reentrega manual
+	RedeliveryOf *primitive.ObjectID `bson:"redelivery_of,omitempty"`
+	URL          string              `bson:"url"`
+	Payload      string              `bson:"payload"`
+	// StatusCode é zero quando o destino não respondeu (Error explica)
+	StatusCode      int       `bson:"status_code,omitempty"`
+	LatencyMs       int64     `bson:"latency_ms"`
+	ResponseSnippet string    `bson:"response_snippet,omitempty"`
+	Error           string    `bson:"error,omitempty"`
+	Success         bool      `bson:"success"`
+	CreatedAt       time.Time `bson:"created_at"`
+}
+
+// PrepareForCreate preserva o ID já atribuído: ele vai no header da entrega,
+// antes de a tentativa ser registrada
+func (d *WebhookDelivery) PrepareForCreate() {
+	if d.ID.IsZero() {
+		d.ID = primitive.NewObjectID()
+	}
+	if d.CreatedAt.IsZero() {
+		d.CreatedAt = time.Now()
+	}
+}
+
+func (d *WebhookDelivery) GetCollectionName() string {
+	return "webhook_deliveries"
+}
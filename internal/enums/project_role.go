@@ -0,0 +1,25 @@
+package enums
+
+type ProjectRole string
+
+const (
+	// RoleOwner é o dono do projeto: além de editar, gerencia o projeto e os membros
+	RoleOwner ProjectRole = "owner"
+	// RoleEditor vê e altera as tarefas do projeto
+	RoleEditor ProjectRole = "editor"
+	// RoleViewer apenas vê o projeto e as suas tarefas
+	RoleViewer ProjectRole = "viewer"
+)
+
+func (r ProjectRole) IsValid() bool {
+	switch r {
+	case RoleOwner, RoleEditor, RoleViewer:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r ProjectRole) String() string {
+	return string(r)
+}
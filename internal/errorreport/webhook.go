@@ -0,0 +1,71 @@
+// Package errorreport envia erros inesperados da API (panics) a um serviço
+// externo de erros.
+package errorreport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/middleware"
+)
+
+// reportTimeout limita o envio de cada relatório
+const reportTimeout = 10 * time.Second
+
+// WebhookReporter envia cada panic como JSON (middleware.PanicReport) por
+// POST à URL configurada em ERROR_REPORT_URL
+type WebhookReporter struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookReporter cria o reporter
+func NewWebhookReporter(url string) *WebhookReporter {
+	return &WebhookReporter{
+		url:    url,
+		client: &http.Client{Timeout: reportTimeout},
+	}
+}
+
+// ReportPanic envia o relatório em segundo plano, sem atrasar a resposta da
+// requisição; falhas no envio são apenas registradas no log
+func (r *WebhookReporter) ReportPanic(ctx context.Context, report middleware.PanicReport) {
+	go func() {
+		ctx, cancel := context.WithTimeout(ctx, reportTimeout)
+		defer cancel()
+
+		if err := r.send(ctx, report); err != nil {
+			logging.FromContext(ctx).Warn("erro ao enviar relatório de panic", "error", err)
+		}
+	}()
+}
+
+func (r *WebhookReporter) send(ctx context.Context, report middleware.PanicReport) error {
+	body, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("erro ao serializar relatório: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("erro ao montar relatório: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("erro ao enviar relatório: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("serviço de erros respondeu %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Tipos de eventos de domínio
+const (
+	TaskCreated    = "task.created"
+	TaskUpdated    = "task.updated"
+	TaskCompleted  = "task.completed"
+	TaskDeleted    = "task.deleted"
+	TaskDueSoon    = "task.due_soon"
+	TaskOverdue    = "task.overdue"
+	UserRegistered = "user.registered"
+
+	// TaskOverdueSummary é o resumo diário das tarefas atrasadas do usuário
+	TaskOverdueSummary = "task.overdue_summary"
+
+	// TaskProgressChanged indica mudança no andamento da lista de verificação
+	// (item marcado ou desmarcado, itens incluídos ou removidos)
+	TaskProgressChanged = "task.progress_changed"
+)
+
+// AllEvents é o tipo usado para assinar todos os eventos
+const AllEvents = "*"
+
+// Event representa um evento de domínio
+type Event struct {
+	ID         string                 `json:"id"`
+	Type       string                 `json:"type"`
+	UserID     primitive.ObjectID     `json:"user_id"`
+	Data       map[string]interface{} `json:"data,omitempty"`
+	OccurredAt time.Time              `json:"occurred_at"`
+	// Origin identifica a instância que publicou o evento
+	Origin string `json:"origin"`
+}
+
+// New cria um evento com ID e data preenchidos
+func New(eventType string, userID primitive.ObjectID, data map[string]interface{}) Event {
+	return Event{
+		ID:         primitive.NewObjectID().Hex(),
+		Type:       eventType,
+		UserID:     userID,
+		Data:       data,
+		OccurredAt: time.Now(),
+	}
+}
+
+// Handler processa um evento. Handlers são executados de forma assíncrona.
+type Handler func(ctx context.Context, event Event)
+
+// Bus define o publicador/assinante de eventos de domínio
+type Bus interface {
+	// Publish publica o evento sem bloquear o fluxo principal
+	Publish(ctx context.Context, event Event) error
+	// Subscribe registra um handler para o tipo de evento (ou AllEvents)
+	Subscribe(eventType string, handler Handler, opts ...SubscribeOption) (unsubscribe func())
+	// Close aguarda os handlers em execução e libera recursos
+	Close() error
+}
+
+// subscribeOptions configura uma assinatura
+type subscribeOptions struct {
+	includeRemote bool
+}
+
+// SubscribeOption altera o comportamento de uma assinatura
+type SubscribeOption func(*subscribeOptions)
+
+// IncludeRemote faz o handler receber também eventos publicados por outras
+// instâncias (ex.: fanout de websockets). Sem esta opção, apenas eventos da
+// própria instância são entregues, evitando processamento duplicado.
+func IncludeRemote() SubscribeOption {
+	return func(o *subscribeOptions) {
+		o.includeRemote = true
+	}
+}
@@ -0,0 +1,118 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/devgugga/todo-it/internal/instance"
+)
+
+// subscription representa um handler registrado
+type subscription struct {
+	id            uint64
+	eventType     string
+	handler       Handler
+	includeRemote bool
+}
+
+// LocalBus é o barramento de eventos em memória (uma única instância)
+type LocalBus struct {
+	mu     sync.RWMutex
+	subs   []subscription
+	nextID uint64
+	origin string
+	wg     sync.WaitGroup
+	closed bool
+}
+
+// NewLocalBus cria um barramento em memória
+func NewLocalBus() *LocalBus {
+	return &LocalBus{origin: instance.ID()}
+}
+
+// Publish entrega o evento aos handlers locais
+func (b *LocalBus) Publish(ctx context.Context, event Event) error {
+	if event.Origin == "" {
+		event.Origin = b.origin
+	}
+	b.dispatch(event, false)
+	return nil
+}
+
+// Subscribe registra um handler
+func (b *LocalBus) Subscribe(eventType string, handler Handler, opts ...SubscribeOption) func() {
+	options := &subscribeOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.nextID++
+	id := b.nextID
+	b.subs = append(b.subs, subscription{
+		id:            id,
+		eventType:     eventType,
+		handler:       handler,
+		includeRemote: options.includeRemote,
+	})
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		for i, sub := range b.subs {
+			if sub.id == id {
+				b.subs = append(b.subs[:i], b.subs[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Close aguarda a conclusão dos handlers em execução
+func (b *LocalBus) Close() error {
+	b.mu.Lock()
+	b.closed = true
+	b.mu.Unlock()
+
+	b.wg.Wait()
+	return nil
+}
+
+// dispatch executa de forma assíncrona os handlers interessados no evento
+func (b *LocalBus) dispatch(event Event, remote bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.closed {
+		return
+	}
+
+	for _, sub := range b.subs {
+		if sub.eventType != AllEvents && sub.eventType != event.Type {
+			continue
+		}
+		if remote && !sub.includeRemote {
+			continue
+		}
+
+		b.wg.Add(1)
+		go b.invoke(sub.handler, event)
+	}
+}
+
+// invoke executa um handler isolando panics
+func (b *LocalBus) invoke(handler Handler, event Event) {
+	defer b.wg.Done()
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error("panic no handler de evento", "event", event.Type, "panic", r)
+		}
+	}()
+
+	// Handlers não herdam o contexto da requisição, que é cancelado ao responder
+	handler(context.Background(), event)
+}
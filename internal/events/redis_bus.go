@@ -0,0 +1,100 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisChannel é o canal pub/sub usado para distribuir eventos entre instâncias
+const redisChannel = "todo-it:events"
+
+// RedisBus distribui eventos entre instâncias via Redis pub/sub.
+// Eventos locais são entregues imediatamente; eventos de outras instâncias
+// só chegam aos handlers registrados com IncludeRemote.
+type RedisBus struct {
+	*LocalBus
+	client *redis.Client
+	pubsub *redis.PubSub
+	done   chan struct{}
+}
+
+// NewRedisBus conecta ao Redis e passa a receber eventos das outras instâncias
+func NewRedisBus(redisURL string) (*RedisBus, error) {
+	opts, err := redis.ParseURL(redisURL)
+	if err != nil {
+		return nil, fmt.Errorf("URL do Redis inválida: %w", err)
+	}
+
+	client := redis.NewClient(opts)
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("falha ao conectar no Redis: %w", err)
+	}
+
+	bus := &RedisBus{
+		LocalBus: NewLocalBus(),
+		client:   client,
+		pubsub:   client.Subscribe(context.Background(), redisChannel),
+		done:     make(chan struct{}),
+	}
+
+	go bus.receive()
+	return bus, nil
+}
+
+// Publish entrega o evento localmente e o replica para as demais instâncias
+func (b *RedisBus) Publish(ctx context.Context, event Event) error {
+	if event.Origin == "" {
+		event.Origin = b.origin
+	}
+
+	b.dispatch(event, false)
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("erro ao serializar evento: %w", err)
+	}
+
+	if err := b.client.Publish(ctx, redisChannel, payload).Err(); err != nil {
+		return fmt.Errorf("erro ao publicar evento no Redis: %w", err)
+	}
+
+	return nil
+}
+
+// Ping verifica a conexão com o Redis
+func (b *RedisBus) Ping(ctx context.Context) error {
+	return b.client.Ping(ctx).Err()
+}
+
+// Close encerra a assinatura, aguarda os handlers e fecha a conexão
+func (b *RedisBus) Close() error {
+	b.pubsub.Close()
+	<-b.done
+
+	b.LocalBus.Close()
+	return b.client.Close()
+}
+
+// receive entrega aos handlers os eventos publicados por outras instâncias
+func (b *RedisBus) receive() {
+	defer close(b.done)
+
+	for msg := range b.pubsub.Channel() {
+		var event Event
+		if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+			slog.Warn("evento inválido recebido do Redis", "error", err)
+			continue
+		}
+
+		if event.Origin == b.origin {
+			continue
+		}
+
+		b.dispatch(event, true)
+	}
+}
@@ -0,0 +1,307 @@
+// Package exporter gera exportações das tarefas em formatos de outras
+// ferramentas. O formato Markdown segue as convenções do Obsidian (front matter
+// YAML e o formato de emojis do plugin Tasks) e também é legível em editores comuns.
+package exporter
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// markdownFolder é a pasta raiz dentro do ZIP
+const markdownFolder = "todo-it"
+
+// noProjectName é o arquivo das tarefas sem projeto
+const noProjectName = "Sem projeto"
+
+// priorityMarkers são os marcadores de prioridade do plugin Tasks do Obsidian
+var priorityMarkers = map[enums.TaskPriority]string{
+	enums.PriorityUrgent: "🔺",
+	enums.PriorityHigh:   "⏫",
+	enums.PriorityMedium: "🔼",
+	enums.PriorityLow:    "🔽",
+}
+
+// fileNameReplacer remove caracteres inválidos em nomes de arquivo (Windows incluso)
+var fileNameReplacer = strings.NewReplacer(
+	"/", "-", "\\", "-", ":", "-", "*", "-", "?", "", "\"", "'", "<", "", ">", "", "|", "-",
+	"\n", " ", "\r", " ", "\t", " ",
+)
+
+// MarkdownInput reúne os dados exportados
+type MarkdownInput struct {
+	Projects []*entities.Project
+	Tasks    []*entities.Task
+	// Archived é o histórico arquivado (opcional), listado em seção própria
+	Archived []*entities.Task
+	// Location é o fuso usado nas datas (o do usuário)
+	Location *time.Location
+}
+
+// markdownFile agrupa as tarefas de um arquivo (um por projeto)
+type markdownFile struct {
+	project  *entities.Project
+	tasks    []*entities.Task
+	archived []*entities.Task
+}
+
+// WriteMarkdownZip grava em w um ZIP com um arquivo Markdown por projeto
+// (e um para as tarefas sem projeto). Retorna a quantidade de arquivos gerados.
+func WriteMarkdownZip(w io.Writer, input *MarkdownInput, exportedAt time.Time) (int, error) {
+	loc := input.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	files := make(map[primitive.ObjectID]*markdownFile, len(input.Projects)+1)
+	order := make([]primitive.ObjectID, 0, len(input.Projects)+1)
+
+	noProject := &markdownFile{}
+	files[primitive.NilObjectID] = noProject
+	order = append(order, primitive.NilObjectID)
+
+	for _, project := range input.Projects {
+		files[project.ID] = &markdownFile{project: project}
+		order = append(order, project.ID)
+	}
+
+	// Tarefas de projetos excluídos ficam no arquivo sem projeto
+	fileFor := func(task *entities.Task) *markdownFile {
+		if task.ProjectID != nil {
+			if file, ok := files[*task.ProjectID]; ok {
+				return file
+			}
+		}
+		return noProject
+	}
+	for _, task := range input.Tasks {
+		file := fileFor(task)
+		file.tasks = append(file.tasks, task)
+	}
+	for _, task := range input.Archived {
+		file := fileFor(task)
+		file.archived = append(file.archived, task)
+	}
+
+	archive := zip.NewWriter(w)
+	used := make(map[string]int)
+	written := 0
+
+	for _, id := range order {
+		file := files[id]
+		// O arquivo sem projeto só é gerado se tiver tarefas
+		if file.project == nil && len(file.tasks) == 0 && len(file.archived) == 0 {
+			continue
+		}
+
+		name := noProjectName
+		if file.project != nil {
+			name = file.project.Name
+		}
+
+		header := &zip.FileHeader{
+			Name:     markdownFolder + "/" + uniqueFileName(used, name) + ".md",
+			Method:   zip.Deflate,
+			Modified: exportedAt,
+		}
+		entry, err := archive.CreateHeader(header)
+		if err != nil {
+			return 0, fmt.Errorf("erro ao criar arquivo da exportação: %w", err)
+		}
+
+		if _, err := io.WriteString(entry, renderMarkdown(name, file, exportedAt, loc)); err != nil {
+			return 0, fmt.Errorf("erro ao gravar arquivo da exportação: %w", err)
+		}
+		written++
+	}
+
+	if err := archive.Close(); err != nil {
+		return 0, fmt.Errorf("erro ao finalizar exportação: %w", err)
+	}
+
+	return written, nil
+}
+
+// renderMarkdown monta o arquivo de um projeto: front matter, tarefas em aberto,
+// concluídas e, se exportado, o histórico arquivado
+func renderMarkdown(name string, file *markdownFile, exportedAt time.Time, loc *time.Location) string {
+	var open, done []*entities.Task
+	for _, task := range file.tasks {
+		if task.Status == enums.StatusCompleted || task.Status == enums.StatusCancelled {
+			done = append(done, task)
+		} else {
+			open = append(open, task)
+		}
+	}
+	sortTasks(open)
+	sortTasks(done)
+	sortTasks(file.archived)
+
+	var b strings.Builder
+
+	b.WriteString("---\n")
+	writeYAML(&b, "title", name)
+	if file.project != nil {
+		writeYAML(&b, "project_id", file.project.ID.Hex())
+		if file.project.Color != "" {
+			writeYAML(&b, "color", file.project.Color)
+		}
+		writeYAML(&b, "created", file.project.CreatedAt.In(loc).Format(time.RFC3339))
+	}
+	writeYAML(&b, "exported", exportedAt.In(loc).Format(time.RFC3339))
+	fmt.Fprintf(&b, "tasks: %d\n", len(file.tasks))
+	fmt.Fprintf(&b, "open: %d\n", len(open))
+	b.WriteString("tags:\n  - todo-it\n")
+	b.WriteString("---\n\n")
+
+	b.WriteString("# " + singleLine(name) + "\n")
+
+	writeSection(&b, "Em aberto", open, file.project, loc)
+	writeSection(&b, "Concluídas", done, file.project, loc)
+	if len(file.archived) > 0 {
+		writeSection(&b, "Histórico arquivado", file.archived, file.project, loc)
+	}
+
+	return b.String()
+}
+
+// writeSection grava uma lista de tarefas com título de seção
+func writeSection(b *strings.Builder, title string, tasks []*entities.Task, project *entities.Project, loc *time.Location) {
+	b.WriteString("\n## " + title + "\n\n")
+	if len(tasks) == 0 {
+		b.WriteString("_Nenhuma tarefa._\n")
+		return
+	}
+
+	for _, task := range tasks {
+		writeTask(b, task, project, loc)
+	}
+}
+
+// writeTask grava a tarefa como item de checklist, com os metadados no formato
+// do plugin Tasks, a descrição indentada e o checklist como subitens
+func writeTask(b *strings.Builder, task *entities.Task, project *entities.Project, loc *time.Location) {
+	parts := []string{"- " + checkbox(task.Status), singleLine(task.Title)}
+
+	if marker, ok := priorityMarkers[task.Priority]; ok && task.Priority != enums.PriorityMedium {
+		parts = append(parts, marker)
+	}
+	if task.Recurrence != "" {
+		parts = append(parts, "🔁 "+task.Recurrence)
+	}
+	if task.ReminderAt != nil {
+		parts = append(parts, "⏰ "+task.ReminderAt.In(loc).Format("2006-01-02 15:04"))
+	}
+	if task.DueDate != nil {
+		parts = append(parts, "📅 "+task.DueDate.In(loc).Format("2006-01-02"))
+	}
+	parts = append(parts, "➕ "+task.CreatedAt.In(loc).Format("2006-01-02"))
+	if task.CompletedAt != nil && task.Status == enums.StatusCompleted {
+		parts = append(parts, "✅ "+task.CompletedAt.In(loc).Format("2006-01-02"))
+	}
+	if status := customStatusName(task, project); status != "" {
+		parts = append(parts, "[status:: "+status+"]")
+	}
+	for _, tag := range task.Tags {
+		parts = append(parts, markdownTag(tag))
+	}
+
+	b.WriteString(strings.Join(parts, " ") + "\n")
+
+	if description := strings.TrimSpace(task.Description); description != "" {
+		for _, line := range strings.Split(description, "\n") {
+			b.WriteString("    " + strings.TrimRight(line, "\r ") + "\n")
+		}
+	}
+
+	for _, item := range task.Checklist {
+		mark := "[ ]"
+		if item.Done {
+			mark = "[x]"
+		}
+		b.WriteString("    - " + mark + " " + singleLine(item.Text) + "\n")
+	}
+}
+
+// checkbox converte o status no marcador do item (- [ ], [/], [x] e [-] no Obsidian)
+func checkbox(status enums.TaskStatus) string {
+	switch status {
+	case enums.StatusCompleted:
+		return "[x]"
+	case enums.StatusCancelled:
+		return "[-]"
+	case enums.StatusInProgress:
+		return "[/]"
+	default:
+		return "[ ]"
+	}
+}
+
+// customStatusName retorna o nome do status personalizado do projeto (ex.: coluna do quadro)
+func customStatusName(task *entities.Task, project *entities.Project) string {
+	if task.StatusID == "" || project == nil {
+		return ""
+	}
+	for _, status := range project.Statuses {
+		if status.ID == task.StatusID {
+			return singleLine(status.Name)
+		}
+	}
+	return ""
+}
+
+// sortTasks ordena por vencimento (sem vencimento por último) e, depois, pela criação
+func sortTasks(tasks []*entities.Task) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		a, b := tasks[i], tasks[j]
+		switch {
+		case a.DueDate != nil && b.DueDate != nil && !a.DueDate.Equal(*b.DueDate):
+			return a.DueDate.Before(*b.DueDate)
+		case a.DueDate != nil && b.DueDate == nil:
+			return true
+		case a.DueDate == nil && b.DueDate != nil:
+			return false
+		default:
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+	})
+}
+
+// markdownTag converte a etiqueta em tag do Obsidian (sem espaços)
+func markdownTag(tag string) string {
+	return "#" + strings.Join(strings.Fields(tag), "-")
+}
+
+// singleLine remove quebras de linha de textos usados em uma única linha
+func singleLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// writeYAML grava um campo de texto do front matter, sempre entre aspas
+func writeYAML(b *strings.Builder, key, value string) {
+	b.WriteString(key + ": " + strconv.Quote(value) + "\n")
+}
+
+// uniqueFileName normaliza o nome do arquivo e evita nomes repetidos no ZIP
+func uniqueFileName(used map[string]int, name string) string {
+	name = strings.Trim(strings.TrimSpace(fileNameReplacer.Replace(name)), ".")
+	if name == "" {
+		name = "Projeto"
+	}
+
+	key := strings.ToLower(name)
+	used[key]++
+	if used[key] > 1 {
+		return fmt.Sprintf("%s (%d)", name, used[key])
+	}
+	return name
+}
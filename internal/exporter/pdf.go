@@ -0,0 +1,416 @@
+package exporter
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+)
+
+// Página A4 em pontos (1/72 pol.) e margens do PDF
+const (
+	pdfPageWidth  = 595.0
+	pdfPageHeight = 842.0
+	pdfMargin     = 50.0
+	// pdfFooter é a altura reservada à numeração das páginas
+	pdfFooter = 30.0
+)
+
+// Fontes do PDF: as padrão do leitor (Helvetica), sem embutir arquivos de fonte
+const (
+	pdfFontRegular = "F1"
+	pdfFontBold    = "F2"
+)
+
+// PDFInput reúne os dados de uma exportação em PDF (lista de tarefas ou projeto)
+type PDFInput struct {
+	Title string
+	// Project traz os nomes dos status personalizados (opcional)
+	Project *entities.Project
+	Tasks   []*entities.Task
+	// Location é o fuso usado nas datas (o do usuário)
+	Location *time.Location
+}
+
+// priorityLabels são os nomes das prioridades exibidos no PDF
+var priorityLabels = map[enums.TaskPriority]string{
+	enums.PriorityLow:    "baixa",
+	enums.PriorityMedium: "média",
+	enums.PriorityHigh:   "alta",
+	enums.PriorityUrgent: "urgente",
+}
+
+// WriteTaskListPDF grava em w um PDF imprimível com as tarefas: caixa de
+// seleção (marcada nas concluídas), título, vencimento, prioridade e etiquetas.
+// As em aberto vêm primeiro, por vencimento.
+func WriteTaskListPDF(w io.Writer, input *PDFInput, exportedAt time.Time) error {
+	loc := input.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	var open, done []*entities.Task
+	for _, task := range input.Tasks {
+		if task.Status == enums.StatusCompleted || task.Status == enums.StatusCancelled {
+			done = append(done, task)
+		} else {
+			open = append(open, task)
+		}
+	}
+	sortTasks(open)
+	sortTasks(done)
+
+	doc := newPDFDocument()
+
+	title := singleLine(input.Title)
+	if title == "" {
+		title = "Tarefas"
+	}
+	for _, line := range wrapPDFText(title, 18, pdfFontBold, pdfPageWidth-2*pdfMargin) {
+		doc.ensureSpace(24)
+		doc.text(pdfMargin, doc.y, 18, pdfFontBold, line)
+		doc.y -= 24
+	}
+
+	summary := fmt.Sprintf("Exportado em %s · %d tarefas, %d em aberto",
+		exportedAt.In(loc).Format("02/01/2006 15:04"), len(input.Tasks), len(open))
+	doc.gray(true)
+	doc.text(pdfMargin, doc.y, 9, pdfFontRegular, summary)
+	doc.gray(false)
+	doc.y -= 24
+
+	writePDFSection(doc, "Em aberto", open, input.Project, exportedAt, loc)
+	writePDFSection(doc, "Concluídas", done, input.Project, exportedAt, loc)
+
+	return doc.write(w)
+}
+
+// PDFFileName retorna o nome do arquivo exportado: o título normalizado e a data
+func PDFFileName(title string, exportedAt time.Time) string {
+	name := strings.Trim(strings.TrimSpace(fileNameReplacer.Replace(singleLine(title))), ".")
+	if name == "" {
+		name = "Tarefas"
+	}
+	return name + " " + exportedAt.Format("2006-01-02") + ".pdf"
+}
+
+// writePDFSection grava uma lista de tarefas com título de seção
+func writePDFSection(doc *pdfDocument, title string, tasks []*entities.Task, project *entities.Project, now time.Time, loc *time.Location) {
+	doc.ensureSpace(40)
+	doc.text(pdfMargin, doc.y, 13, pdfFontBold, title)
+	doc.y -= 20
+
+	if len(tasks) == 0 {
+		doc.gray(true)
+		doc.text(pdfMargin, doc.y, 10, pdfFontRegular, "Nenhuma tarefa.")
+		doc.gray(false)
+		doc.y -= 22
+		return
+	}
+
+	for _, task := range tasks {
+		writePDFTask(doc, task, project, now, loc)
+	}
+	doc.y -= 8
+}
+
+// writePDFTask grava uma tarefa: caixa de seleção, título quebrado em linhas e
+// os metadados em uma linha menor
+func writePDFTask(doc *pdfDocument, task *entities.Task, project *entities.Project, now time.Time, loc *time.Location) {
+	const (
+		titleSize = 11.0
+		metaSize  = 8.5
+		indent    = 18.0
+	)
+
+	width := pdfPageWidth - 2*pdfMargin - indent
+	lines := wrapPDFText(singleLine(task.Title), titleSize, pdfFontRegular, width)
+	meta := pdfTaskMeta(task, project, now, loc)
+
+	height := float64(len(lines))*14 + 6
+	if meta != "" {
+		height += 12
+	}
+	doc.ensureSpace(height)
+
+	doc.checkbox(pdfMargin, doc.y-1, 9, task.Status)
+	for _, line := range lines {
+		doc.text(pdfMargin+indent, doc.y, titleSize, pdfFontRegular, line)
+		doc.y -= 14
+	}
+
+	if meta != "" {
+		doc.gray(true)
+		// Metadados longos são cortados: a linha é um resumo
+		metaLines := wrapPDFText(meta, metaSize, pdfFontRegular, width)
+		doc.text(pdfMargin+indent, doc.y+2, metaSize, pdfFontRegular, metaLines[0])
+		doc.gray(false)
+		doc.y -= 12
+	}
+	doc.y -= 6
+}
+
+// pdfTaskMeta monta a linha de metadados da tarefa (vencimento, prioridade,
+// status personalizado e etiquetas)
+func pdfTaskMeta(task *entities.Task, project *entities.Project, now time.Time, loc *time.Location) string {
+	var parts []string
+
+	if task.DueDate != nil {
+		due := "Vence em " + task.DueDate.In(loc).Format("02/01/2006")
+		if task.IsOverdueAt(now) {
+			due += " (atrasada)"
+		}
+		parts = append(parts, due)
+	}
+	if task.Status == enums.StatusCompleted && task.CompletedAt != nil {
+		parts = append(parts, "Concluída em "+task.CompletedAt.In(loc).Format("02/01/2006"))
+	}
+	if task.Status == enums.StatusCancelled {
+		parts = append(parts, "Cancelada")
+	}
+	if label, ok := priorityLabels[task.Priority]; ok && task.Priority != enums.PriorityMedium {
+		parts = append(parts, "Prioridade "+label)
+	}
+	if status := customStatusName(task, project); status != "" {
+		parts = append(parts, status)
+	}
+	if len(task.Tags) > 0 {
+		tags := make([]string, 0, len(task.Tags))
+		for _, tag := range task.Tags {
+			tags = append(tags, markdownTag(tag))
+		}
+		parts = append(parts, strings.Join(tags, " "))
+	}
+
+	return strings.Join(parts, " · ")
+}
+
+// pdfDocument monta as páginas do PDF em memória. Só o necessário para listas:
+// texto em Helvetica, linhas e retângulos.
+type pdfDocument struct {
+	pages []*bytes.Buffer
+	page  *bytes.Buffer
+	// y é a linha de base atual, de cima para baixo
+	y float64
+}
+
+func newPDFDocument() *pdfDocument {
+	doc := &pdfDocument{}
+	doc.newPage()
+	return doc
+}
+
+// newPage inicia uma página e posiciona o cursor no topo
+func (d *pdfDocument) newPage() {
+	d.page = &bytes.Buffer{}
+	d.pages = append(d.pages, d.page)
+	d.y = pdfPageHeight - pdfMargin
+}
+
+// ensureSpace abre uma nova página se o bloco de altura height não couber
+func (d *pdfDocument) ensureSpace(height float64) {
+	if d.y-height < pdfMargin+pdfFooter {
+		d.newPage()
+	}
+}
+
+// text escreve uma linha de texto com a linha de base em (x, y)
+func (d *pdfDocument) text(x, y, size float64, font, text string) {
+	fmt.Fprintf(d.page, "BT /%s %s Tf %s %s Td (%s) Tj ET\n", font, pdfNumber(size), pdfNumber(x), pdfNumber(y), pdfEscape(text))
+}
+
+// gray alterna a cor do texto e das linhas entre cinza e preto
+func (d *pdfDocument) gray(on bool) {
+	if on {
+		d.page.WriteString("0.45 g 0.45 G\n")
+	} else {
+		d.page.WriteString("0 g 0 G\n")
+	}
+}
+
+// checkbox desenha a caixa de seleção com o canto inferior esquerdo em (x, y):
+// marcada nas concluídas e riscada nas canceladas
+func (d *pdfDocument) checkbox(x, y, size float64, status enums.TaskStatus) {
+	fmt.Fprintf(d.page, "0.8 w %s %s %s %s re S\n", pdfNumber(x), pdfNumber(y), pdfNumber(size), pdfNumber(size))
+
+	switch status {
+	case enums.StatusCompleted:
+		fmt.Fprintf(d.page, "1.2 w %s %s m %s %s l %s %s l S\n",
+			pdfNumber(x+1.8), pdfNumber(y+size/2), pdfNumber(x+size/2.4), pdfNumber(y+1.8), pdfNumber(x+size-1.5), pdfNumber(y+size-1.5))
+	case enums.StatusCancelled:
+		fmt.Fprintf(d.page, "0.8 w %s %s m %s %s l S\n", pdfNumber(x+1.5), pdfNumber(y+size/2), pdfNumber(x+size-1.5), pdfNumber(y+size/2))
+	}
+}
+
+// write grava o documento: catálogo, páginas (com a numeração no rodapé),
+// fontes e a tabela de referências cruzadas
+func (d *pdfDocument) write(w io.Writer) error {
+	var out bytes.Buffer
+	var offsets []int
+
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// Objetos fixos: 1 catálogo, 2 árvore de páginas, 3 e 4 fontes; depois,
+	// página e conteúdo de cada página
+	kids := make([]string, 0, len(d.pages))
+	for i := range d.pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 5+2*i))
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, page := range d.pages {
+		footer := fmt.Sprintf("Página %d de %d", i+1, len(d.pages))
+		footerX := pdfPageWidth - pdfMargin - pdfTextWidth(footer, 8, pdfFontRegular)
+		fmt.Fprintf(page, "0.45 g BT /%s 8 Tf %s %s Td (%s) Tj ET\n", pdfFontRegular, pdfNumber(footerX), pdfNumber(pdfMargin-10), pdfEscape(footer))
+
+		var content bytes.Buffer
+		zw := zlib.NewWriter(&content)
+		if _, err := zw.Write(page.Bytes()); err != nil {
+			return fmt.Errorf("erro ao comprimir página do PDF: %w", err)
+		}
+		if err := zw.Close(); err != nil {
+			return fmt.Errorf("erro ao comprimir página do PDF: %w", err)
+		}
+
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /%s 3 0 R /%s 4 0 R >> >> /Contents %d 0 R >>",
+			pdfNumber(pdfPageWidth), pdfNumber(pdfPageHeight), pdfFontRegular, pdfFontBold, len(offsets)+2))
+		object(fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", content.Len(), content.Bytes()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	if _, err := w.Write(out.Bytes()); err != nil {
+		return fmt.Errorf("erro ao gravar PDF: %w", err)
+	}
+	return nil
+}
+
+// pdfNumber formata coordenadas e tamanhos com no máximo duas casas
+func pdfNumber(n float64) string {
+	return strconv.FormatFloat(math.Round(n*100)/100, 'f', -1, 64)
+}
+
+// winAnsiExtras são os caracteres do WinAnsiEncoding fora do Latin-1
+var winAnsiExtras = map[rune]byte{
+	'€': 0x80, '‚': 0x82, '„': 0x84, '…': 0x85, '‘': 0x91, '’': 0x92,
+	'“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97, '™': 0x99,
+}
+
+// pdfEscape converte o texto para WinAnsiEncoding (a codificação das fontes
+// padrão) e escapa os caracteres especiais das strings do PDF. Caracteres sem
+// representação (ex.: emojis) viram "?".
+func pdfEscape(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		var c byte
+		switch {
+		case r == '·':
+			c = 0xB7
+		case r < 0x20:
+			c = ' '
+		case r < 0x80, r >= 0xA0 && r <= 0xFF:
+			c = byte(r)
+		default:
+			extra, ok := winAnsiExtras[r]
+			if !ok {
+				extra = '?'
+			}
+			c = extra
+		}
+
+		if c == '(' || c == ')' || c == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// helveticaWidths são as larguras (milésimos do tamanho da fonte) dos
+// caracteres ASCII imprimíveis na Helvetica, a partir do espaço
+var helveticaWidths = [...]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+// pdfTextWidth estima a largura do texto em pontos. Acentuados e demais
+// caracteres usam uma largura média; o negrito, uma margem de 10%.
+func pdfTextWidth(text string, size float64, font string) float64 {
+	total := 0
+	for _, r := range text {
+		if r >= ' ' && int(r-' ') < len(helveticaWidths) {
+			total += helveticaWidths[r-' ']
+		} else {
+			total += 556
+		}
+	}
+
+	width := float64(total) * size / 1000
+	if font == pdfFontBold {
+		width *= 1.1
+	}
+	return width
+}
+
+// wrapPDFText quebra o texto em linhas que cabem em width. Palavras maiores
+// que a linha são cortadas.
+func wrapPDFText(text string, size float64, font string, width float64) []string {
+	var lines []string
+	line := ""
+
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if pdfTextWidth(candidate, size, font) <= width {
+			line = candidate
+			continue
+		}
+
+		if line != "" {
+			lines = append(lines, line)
+		}
+		line = word
+
+		for pdfTextWidth(line, size, font) > width {
+			runes := []rune(line)
+			cut := len(runes) - 1
+			for cut > 1 && pdfTextWidth(string(runes[:cut]), size, font) > width {
+				cut--
+			}
+			lines = append(lines, string(runes[:cut]))
+			line = string(runes[cut:])
+		}
+	}
+
+	if line != "" || len(lines) == 0 {
+		lines = append(lines, line)
+	}
+	return lines
+}
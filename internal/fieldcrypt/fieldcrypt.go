@@ -0,0 +1,163 @@
+// Package fieldcrypt cifra campos de texto gravados no banco (AES-256-GCM),
+// para implantações com requisitos de conformidade que não podem guardar
+// descrições e nomes de arquivos em claro.
+package fieldcrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// prefix identifica os valores cifrados. Formato: enc:v1:<key id>:<base64(nonce+ciphertext)>
+const prefix = "enc:v1:"
+
+// KeySize é o tamanho da chave (AES-256)
+const KeySize = 32
+
+var (
+	// ErrKeyUnavailable indica valor cifrado com uma chave que não está configurada
+	ErrKeyUnavailable = errors.New("chave de criptografia do valor não configurada")
+	// ErrMalformed indica valor com o prefixo de cifrado mas conteúdo inválido
+	ErrMalformed = errors.New("valor cifrado inválido")
+)
+
+// Cipher cifra e decifra valores de campos. Strings vazias não são cifradas,
+// e Decrypt devolve sem alteração valores gravados antes da criptografia.
+type Cipher interface {
+	Encrypt(plaintext string) (string, error)
+	Decrypt(value string) (string, error)
+	Enabled() bool
+}
+
+// IsEncrypted indica se o valor foi gravado cifrado
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// ParseKey decodifica uma chave em base64 (32 bytes)
+func ParseKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("chave deve estar em base64: %w", err)
+	}
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("chave deve ter %d bytes (recebido %d)", KeySize, len(key))
+	}
+	return key, nil
+}
+
+// plain é o Cipher usado sem chave configurada
+type plain struct{}
+
+// Disabled retorna um Cipher que grava os valores em claro. Valores já
+// cifrados não podem ser lidos e resultam em ErrKeyUnavailable.
+func Disabled() Cipher {
+	return plain{}
+}
+
+func (plain) Encrypt(plaintext string) (string, error) {
+	return plaintext, nil
+}
+
+func (plain) Decrypt(value string) (string, error) {
+	if IsEncrypted(value) {
+		return "", ErrKeyUnavailable
+	}
+	return value, nil
+}
+
+func (plain) Enabled() bool {
+	return false
+}
+
+// aesGCM cifra com a chave atual e decifra com ela ou com as anteriores
+type aesGCM struct {
+	current string
+	aeads   map[string]cipher.AEAD
+}
+
+// NewAESGCM cria o Cipher com a chave atual (usada ao gravar) e as chaves
+// anteriores, aceitas apenas na leitura durante a rotação
+func NewAESGCM(key []byte, previous ...[]byte) (Cipher, error) {
+	c := &aesGCM{aeads: make(map[string]cipher.AEAD, len(previous)+1)}
+
+	for i, k := range append([][]byte{key}, previous...) {
+		block, err := aes.NewCipher(k)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao criar cifra: %w", err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao criar cifra: %w", err)
+		}
+
+		id := keyID(k)
+		if i == 0 {
+			c.current = id
+		}
+		c.aeads[id] = aead
+	}
+
+	return c, nil
+}
+
+// keyID identifica a chave sem revelá-la (primeiros 4 bytes do SHA-256)
+func keyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:4])
+}
+
+func (c *aesGCM) Encrypt(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+
+	aead := c.aeads[c.current]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("erro ao gerar nonce: %w", err)
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return prefix + c.current + ":" + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+func (c *aesGCM) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	id, encoded, ok := strings.Cut(strings.TrimPrefix(value, prefix), ":")
+	if !ok {
+		return "", ErrMalformed
+	}
+
+	aead, ok := c.aeads[id]
+	if !ok {
+		return "", ErrKeyUnavailable
+	}
+
+	sealed, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", ErrMalformed
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", ErrMalformed
+	}
+
+	return string(plaintext), nil
+}
+
+func (c *aesGCM) Enabled() bool {
+	return true
+}
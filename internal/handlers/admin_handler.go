@@ -0,0 +1,481 @@
+package handlers
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/config"
+	"github.com/devgugga/todo-it/internal/database"
+	adminreq "github.com/devgugga/todo-it/internal/dtos/requests/admin"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/plans"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AdminHandler agrupa os handlers administrativos
+type AdminHandler struct {
+	db          database.Client
+	audit       services.AuditService
+	metrics     services.PlatformMetricsService
+	retention   services.RetentionService
+	integrity   services.IntegrityService
+	suspensions services.SuspensionService
+	plans       services.PlanService
+	maintenance *middleware.MaintenanceState
+	reloader    *config.Reloader
+}
+
+// NewAdminHandler cria uma nova instância do handler administrativo
+func NewAdminHandler(db database.Client, audit services.AuditService, metrics services.PlatformMetricsService, retention services.RetentionService, integrity services.IntegrityService, suspensions services.SuspensionService, plans services.PlanService, maintenance *middleware.MaintenanceState, reloader *config.Reloader) *AdminHandler {
+	return &AdminHandler{db: db, audit: audit, metrics: metrics, retention: retention, integrity: integrity, suspensions: suspensions, plans: plans, maintenance: maintenance, reloader: reloader}
+}
+
+// SetupAdminRoutes registra as rotas administrativas
+func SetupAdminRoutes(router fiber.Router, db database.Client, retention services.RetentionService, plans services.PlanService, maintenance *middleware.MaintenanceState, reloader *config.Reloader) {
+	metrics := services.NewPlatformMetricsService(repositories.NewPlatformMetricsRepository(db))
+	integrity := services.NewIntegrityService(repositories.NewIntegrityRepository(db))
+	suspensions := services.NewSuspensionService(repositories.NewUserRepository(db))
+	h := NewAdminHandler(db, services.NewAuditService(repositories.NewAuditLogRepository(db)), metrics, retention, integrity, suspensions, plans, maintenance, reloader)
+
+	// Toda ação administrativa é registrada na auditoria
+	router.Use(h.recordAdminAction)
+
+	router.Get("/database/indexes", h.GetIndexReport)
+	router.Post("/database/indexes/audit", h.AuditIndexes)
+	router.Post("/database/indexes/rebuild", middleware.Timeout(indexRebuildTimeout), h.RebuildIndexes)
+	router.Get("/audit-logs", h.ListAuditLogs)
+	router.Get("/metrics", h.GetPlatformMetrics)
+	router.Get("/retention/report", h.GetRetentionReport)
+	router.Get("/retention/overrides", h.ListRetentionOverrides)
+	router.Put("/retention/overrides/:userId", h.SetRetentionOverride)
+	router.Delete("/retention/overrides/:userId", h.DeleteRetentionOverride)
+	router.Get("/integrity/report", middleware.Timeout(integrityCheckTimeout), h.GetIntegrityReport)
+	router.Post("/integrity/repair", middleware.Timeout(integrityCheckTimeout), h.RepairIntegrity)
+	router.Post("/users/:userId/suspend", h.SuspendUser)
+	router.Post("/users/:userId/unsuspend", h.UnsuspendUser)
+	router.Put("/users/:userId/plan", h.AssignPlan)
+	router.Delete("/users/:userId/plan", h.CancelPlan)
+	router.Get("/maintenance", h.GetMaintenance)
+	router.Put("/maintenance", h.UpdateMaintenance)
+	router.Get("/config/reload", h.GetReloadableConfig)
+	router.Post("/config/reload", h.ReloadConfig)
+}
+
+// recordAdminAction registra na auditoria cada requisição às rotas administrativas
+func (h *AdminHandler) recordAdminAction(c *fiber.Ctx) error {
+	err := c.Next()
+
+	entry := newAuditEntry(c, entities.AuditActionAdminRequest, err)
+	entry.ActorType = entities.AuditActorAdmin
+	if entry.Details == nil {
+		entry.Details = map[string]interface{}{}
+	}
+	entry.Details["method"] = c.Method()
+	entry.Details["path"] = c.Path()
+	if err == nil && c.Response().StatusCode() >= fiber.StatusBadRequest {
+		entry.Outcome = entities.AuditOutcomeFailure
+	}
+	h.audit.Record(c.UserContext(), entry)
+
+	return err
+}
+
+// GetPlatformMetrics retorna o painel de métricas globais, recalculado
+// periodicamente pelo job platform-metrics
+func (h *AdminHandler) GetPlatformMetrics(c *fiber.Ctx) error {
+	metrics, err := h.metrics.Get(c.UserContext())
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    metrics,
+	})
+}
+
+// GetRetentionReport simula a política de retenção: conta, por recurso, o que
+// seria removido agora, sem remover nada
+func (h *AdminHandler) GetRetentionReport(c *fiber.Ctx) error {
+	report, err := h.retention.Report(c.UserContext())
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    report,
+	})
+}
+
+// SuspendUser suspende a conta do usuário, encerrando as sessões abertas
+func (h *AdminHandler) SuspendUser(c *fiber.Ctx) error {
+	userID, err := parseIDParam(c, "userId")
+	if err != nil {
+		return err
+	}
+
+	var req adminreq.SuspendUserRequest
+	if len(c.Body()) > 0 {
+		if err := parseBody(c, &req); err != nil {
+			return err
+		}
+	}
+
+	user, err := h.suspensions.Suspend(c.UserContext(), userID, req.Reason)
+	h.recordSuspension(c, entities.AuditActionUserSuspend, userID, req.Reason, err)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    suspensionData(user),
+	})
+}
+
+// UnsuspendUser reativa a conta suspensa; o usuário precisa entrar novamente
+func (h *AdminHandler) UnsuspendUser(c *fiber.Ctx) error {
+	userID, err := parseIDParam(c, "userId")
+	if err != nil {
+		return err
+	}
+
+	user, err := h.suspensions.Unsuspend(c.UserContext(), userID)
+	h.recordSuspension(c, entities.AuditActionUserUnsuspend, userID, "", err)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    suspensionData(user),
+	})
+}
+
+// AssignPlan atribui um plano ao usuário sem cobrança (assinatura manual)
+func (h *AdminHandler) AssignPlan(c *fiber.Ctx) error {
+	userID, err := parseIDParam(c, "userId")
+	if err != nil {
+		return err
+	}
+
+	var req adminreq.AssignPlanRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	subscription, err := h.plans.Assign(c.UserContext(), userID, plans.ID(req.Plan), req.CurrentPeriodEnd)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    subscription,
+	})
+}
+
+// CancelPlan cancela a assinatura do usuário, que volta ao plano gratuito
+func (h *AdminHandler) CancelPlan(c *fiber.Ctx) error {
+	userID, err := parseIDParam(c, "userId")
+	if err != nil {
+		return err
+	}
+
+	subscription, err := h.plans.Cancel(c.UserContext(), userID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    subscription,
+	})
+}
+
+// recordSuspension registra na auditoria a suspensão ou reativação de uma conta
+func (h *AdminHandler) recordSuspension(c *fiber.Ctx, action string, userID primitive.ObjectID, reason string, err error) {
+	entry := newAuditEntry(c, action, err)
+	entry.ActorType = entities.AuditActorAdmin
+	if entry.Details == nil {
+		entry.Details = map[string]interface{}{}
+	}
+	entry.Details["user_id"] = userID.Hex()
+	if reason != "" {
+		entry.Details["reason"] = reason
+	}
+	h.audit.Record(c.UserContext(), entry)
+}
+
+// suspensionData monta a resposta com o estado de suspensão da conta
+func suspensionData(user *entities.User) fiber.Map {
+	return fiber.Map{
+		"user_id":             user.ID.Hex(),
+		"suspended":           user.IsSuspended(),
+		"suspended_at":        user.SuspendedAt,
+		"suspension_reason":   user.SuspensionReason,
+		"sessions_revoked_at": user.SessionsRevokedAt,
+	}
+}
+
+// GetIntegrityReport procura inconsistências referenciais nos dados sem corrigir nada
+func (h *AdminHandler) GetIntegrityReport(c *fiber.Ctx) error {
+	report, err := h.integrity.Report(c.UserContext())
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    report,
+	})
+}
+
+// RepairIntegrity procura inconsistências referenciais e corrige as encontradas
+func (h *AdminHandler) RepairIntegrity(c *fiber.Ctx) error {
+	report, err := h.integrity.Repair(c.UserContext())
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    report,
+	})
+}
+
+// ListRetentionOverrides lista os usuários com retenção própria
+func (h *AdminHandler) ListRetentionOverrides(c *fiber.Ctx) error {
+	overrides, err := h.retention.ListOverrides(c.UserContext())
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    overrides,
+	})
+}
+
+// SetRetentionOverride define (substituindo) a retenção própria do usuário
+func (h *AdminHandler) SetRetentionOverride(c *fiber.Ctx) error {
+	userID, err := parseIDParam(c, "userId")
+	if err != nil {
+		return err
+	}
+
+	var req adminreq.RetentionOverrideRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	override, err := h.retention.SetOverride(c.UserContext(), userID, req.Days)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    override,
+	})
+}
+
+// DeleteRetentionOverride remove a retenção própria do usuário, que volta à política padrão
+func (h *AdminHandler) DeleteRetentionOverride(c *fiber.Ctx) error {
+	userID, err := parseIDParam(c, "userId")
+	if err != nil {
+		return err
+	}
+
+	if err := h.retention.DeleteOverride(c.UserContext(), userID); err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// GetReloadableConfig lista quais configurações são recarregáveis e quais exigem reinício
+func (h *AdminHandler) GetReloadableConfig(c *fiber.Ctx) error {
+	hot, restart := h.reloader.Current().ReloadableKeys()
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"hot_reload":       hot,
+			"restart_required": restart,
+		},
+	})
+}
+
+// ReloadConfig recarrega a configuração e aplica as mudanças que não exigem reinício
+func (h *AdminHandler) ReloadConfig(c *fiber.Ctx) error {
+	result, err := h.reloader.Reload()
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    result,
+	})
+}
+
+// GetMaintenance retorna o estado atual do modo de manutenção
+func (h *AdminHandler) GetMaintenance(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    maintenanceData(h.maintenance.Status()),
+	})
+}
+
+// UpdateMaintenance liga ou desliga o modo de manutenção (off, read_only ou full).
+// Sem retry_after_seconds, mantém o valor atual do Retry-After.
+func (h *AdminHandler) UpdateMaintenance(c *fiber.Ctx) error {
+	var req adminreq.UpdateMaintenanceRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	mode, err := middleware.ParseMaintenanceMode(req.Mode)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Modo de manutenção inválido")
+	}
+
+	retryAfter := h.maintenance.Status().RetryAfter
+	if req.RetryAfterSeconds != nil {
+		retryAfter = time.Duration(*req.RetryAfterSeconds) * time.Second
+	}
+
+	h.maintenance.Set(mode, retryAfter, req.Message)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    maintenanceData(h.maintenance.Status()),
+	})
+}
+
+// maintenanceData monta a resposta com o estado do modo de manutenção
+func maintenanceData(status middleware.MaintenanceStatus) fiber.Map {
+	return fiber.Map{
+		"mode":                status.Mode,
+		"retry_after_seconds": int(status.RetryAfter.Seconds()),
+		"message":             status.Message,
+		"since":               status.Since,
+	}
+}
+
+// ListAuditLogs consulta o log de auditoria.
+// Filtros: action, actor_id, outcome, ip, from e to (RFC3339).
+func (h *AdminHandler) ListAuditLogs(c *fiber.Ctx) error {
+	pagination, err := parsePagination(c, 50)
+	if err != nil {
+		return err
+	}
+
+	filters := &repositories.AuditLogFilters{
+		Action:  c.Query("action"),
+		Outcome: c.Query("outcome"),
+		IP:      c.Query("ip"),
+	}
+
+	if actorID := c.Query("actor_id"); actorID != "" {
+		id, err := primitive.ObjectIDFromHex(actorID)
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "actor_id inválido")
+		}
+		filters.ActorID = &id
+	}
+
+	for _, param := range []struct {
+		name  string
+		value **time.Time
+	}{{"from", &filters.From}, {"to", &filters.To}} {
+		raw := c.Query(param.name)
+		if raw == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, param.name+" deve estar no formato RFC3339")
+		}
+		*param.value = &parsed
+	}
+
+	entries, total, err := h.audit.List(c.UserContext(), pagination.Page, pagination.Limit, filters)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Erro ao consultar auditoria")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"entries": entries,
+			"total":   total,
+			"page":    pagination.Page,
+			"limit":   pagination.Limit,
+		},
+	})
+}
+
+// GetIndexReport retorna o relatório de índices sem alterar o banco
+func (h *AdminHandler) GetIndexReport(c *fiber.Ctx) error {
+	return h.runIndexAudit(c, false)
+}
+
+// AuditIndexes audita os índices e cria os ausentes
+func (h *AdminHandler) AuditIndexes(c *fiber.Ctx) error {
+	return h.runIndexAudit(c, true)
+}
+
+// runIndexAudit executa a auditoria de índices e responde com o relatório
+func (h *AdminHandler) runIndexAudit(c *fiber.Ctx, fix bool) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 60*time.Second)
+	defer cancel()
+
+	audit, err := h.db.AuditIndexes(ctx, fix)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Erro ao auditar índices")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    audit,
+	})
+}
+
+// RebuildIndexes reconstrói online os índices declarados e informa o tempo de
+// cada um. O corpo é opcional: sem ele, cria os ausentes e substitui os divergentes.
+func (h *AdminHandler) RebuildIndexes(c *fiber.Ctx) error {
+	var req adminreq.RebuildIndexesRequest
+	if len(c.Body()) > 0 {
+		if err := parseBody(c, &req); err != nil {
+			return err
+		}
+	}
+
+	indexed := database.IndexedCollections()
+	for _, name := range req.Collections {
+		if !slices.Contains(indexed, name) {
+			return fiber.NewError(fiber.StatusBadRequest, "Collection desconhecida: "+name)
+		}
+	}
+
+	rebuild, err := h.db.RebuildIndexes(c.UserContext(), database.IndexRebuildOptions{
+		Recreate:    req.Mode == adminreq.IndexRebuildModeRecreate,
+		Collections: req.Collections,
+	})
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Erro ao reconstruir índices")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    rebuild,
+	})
+}
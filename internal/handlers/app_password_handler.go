@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	userreq "github.com/devgugga/todo-it/internal/dtos/requests/user"
+	userres "github.com/devgugga/todo-it/internal/dtos/responses/user"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// AppPasswordHandler agrupa os handlers de senhas de aplicativo do usuário autenticado
+type AppPasswordHandler struct {
+	passwords services.AppPasswordService
+	audit     services.AuditService
+}
+
+// NewAppPasswordHandler cria uma nova instância do handler de senhas de aplicativo
+func NewAppPasswordHandler(passwords services.AppPasswordService, audit services.AuditService) *AppPasswordHandler {
+	return &AppPasswordHandler{passwords: passwords, audit: audit}
+}
+
+// List lista as senhas de aplicativo (sem o valor da senha)
+func (h *AppPasswordHandler) List(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	passwords, err := h.passwords.List(c.UserContext(), userID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    userres.NewAppPasswordResponses(passwords),
+	})
+}
+
+// Create gera uma senha de aplicativo, exibida apenas nesta resposta
+func (h *AppPasswordHandler) Create(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var req userreq.CreateAppPasswordRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	password, plain, err := h.passwords.Create(c.UserContext(), userID, req.Name)
+	h.audit.Record(c.UserContext(), newAuditEntry(c, entities.AuditActionAppPasswordCreate, err))
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	response := userres.NewAppPasswordResponse(password)
+	response.Password = plain
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    response,
+	})
+}
+
+// Revoke revoga a senha de aplicativo; clientes que a usam deixam de sincronizar
+func (h *AppPasswordHandler) Revoke(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	err = h.passwords.Revoke(c.UserContext(), userID, id)
+	h.audit.Record(c.UserContext(), newAuditEntry(c, entities.AuditActionAppPasswordRevoke, err))
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+// newAuditEntry monta a entrada de auditoria com os dados da requisição.
+// O ator é o usuário autenticado, quando houver; o resultado vem de err.
+func newAuditEntry(c *fiber.Ctx, action string, err error) *entities.AuditLog {
+	entry := &entities.AuditLog{
+		Action:    action,
+		ActorType: entities.AuditActorAnonymous,
+		Outcome:   entities.AuditOutcomeSuccess,
+		IP:        c.IP(),
+		UserAgent: c.Get(fiber.HeaderUserAgent),
+	}
+
+	if requestID, ok := c.Locals(middleware.RequestIDKey).(string); ok {
+		entry.RequestID = requestID
+	}
+
+	if userID, ok := middleware.GetUserID(c); ok {
+		entry.ActorType = entities.AuditActorUser
+		entry.ActorID = &userID
+	}
+
+	if err != nil {
+		entry.Outcome = entities.AuditOutcomeFailure
+		entry.Details = map[string]interface{}{"error": err.Error()}
+	}
+
+	return entry
+}
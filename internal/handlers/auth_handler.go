@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"github.com/devgugga/todo-it/internal/auth"
+	"github.com/devgugga/todo-it/internal/database"
+	userreq "github.com/devgugga/todo-it/internal/dtos/requests/user"
+	userres "github.com/devgugga/todo-it/internal/dtos/responses/user"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AuthHandler agrupa os handlers de autenticação
+type AuthHandler struct {
+	users services.UserService
+	audit services.AuditService
+}
+
+// NewAuthHandler cria uma nova instância do handler de autenticação
+func NewAuthHandler(users services.UserService, audit services.AuditService) *AuthHandler {
+	return &AuthHandler{users: users, audit: audit}
+}
+
+// SetupAuthRoutes registra as rotas de autenticação
+func SetupAuthRoutes(router fiber.Router, db database.Client, tokens *auth.TokenManager, bus events.Bus) {
+	users := services.NewUserService(repositories.NewUserRepository(db), repositories.NewTodoRepository(db), repositories.NewAccountClosureRepository(db), repositories.NewAttachmentRepository(db), tokens, bus)
+	audit := services.NewAuditService(repositories.NewAuditLogRepository(db))
+	h := NewAuthHandler(users, audit)
+
+	router.Post("/register", h.Register)
+	router.Post("/login", h.Login)
+}
+
+// Register cadastra um novo usuário
+func (h *AuthHandler) Register(c *fiber.Ctx) error {
+	var req userreq.CreateUserRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	user, err := h.users.Register(c.UserContext(), &req)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data": userres.UserCreatedResponse{
+			User:    *userres.NewUserResponse(user),
+			Message: "Usuário criado com sucesso",
+		},
+	})
+}
+
+// Login autentica o usuário e retorna o token de acesso
+func (h *AuthHandler) Login(c *fiber.Ctx) error {
+	var req userreq.LoginRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	response, err := h.users.Login(c.UserContext(), &req)
+
+	entry := newAuditEntry(c, entities.AuditActionLogin, err)
+	if entry.Details == nil {
+		entry.Details = map[string]interface{}{}
+	}
+	entry.Details["email"] = req.Email
+	if err == nil {
+		if userID, parseErr := primitive.ObjectIDFromHex(response.User.ID); parseErr == nil {
+			entry.ActorType = entities.AuditActorUser
+			entry.ActorID = &userID
+		}
+	}
+	h.audit.Record(c.UserContext(), entry)
+
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    response,
+	})
+}
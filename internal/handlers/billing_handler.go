@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"errors"
+
+	billingreq "github.com/devgugga/todo-it/internal/dtos/requests/billing"
+	"github.com/devgugga/todo-it/internal/integrations/stripe"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/plans"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// BillingHandler agrupa o checkout dos planos pagos e o webhook do Stripe
+type BillingHandler struct {
+	plans    services.PlanService
+	provider plans.Provider
+}
+
+// NewBillingHandler cria uma nova instância do handler de cobrança
+func NewBillingHandler(plans services.PlanService, provider plans.Provider) *BillingHandler {
+	return &BillingHandler{plans: plans, provider: provider}
+}
+
+// SetupBillingRoutes registra o checkout (autenticado pelo usuário) e o webhook
+// (autenticado pela assinatura do Stripe)
+func SetupBillingRoutes(router fiber.Router, plans services.PlanService, provider plans.Provider, requireAuth, attachPlan fiber.Handler) {
+	h := NewBillingHandler(plans, provider)
+
+	router.Post("/checkout", requireAuth, attachPlan, h.Checkout)
+	router.Post("/stripe/webhook", h.StripeWebhook)
+}
+
+// Checkout cria a sessão de pagamento do plano e retorna a URL para onde o app
+// deve redirecionar o usuário. O plano muda quando o webhook confirma a assinatura.
+func (h *BillingHandler) Checkout(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var req billingreq.CheckoutRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	plan, ok := middleware.GetPlan(c)
+	if !ok {
+		var err error
+		if plan, err = h.plans.Effective(c.UserContext(), userID); err != nil {
+			return handleServiceError(err)
+		}
+	}
+	if plan.ID == plans.ID(req.Plan) {
+		return fiber.NewError(fiber.StatusConflict, "Plano já contratado")
+	}
+
+	url, err := h.provider.Checkout(c.UserContext(), userID, plans.ID(req.Plan))
+	if err != nil {
+		if errors.Is(err, stripe.ErrUnsupportedPlan) {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    fiber.Map{"checkout_url": url},
+	})
+}
+
+// StripeWebhook aplica as mudanças de assinatura notificadas pelo Stripe. Eventos
+// que nunca poderão ser aplicados (usuário ou plano desconhecido) são registrados
+// e respondidos com 200; as demais falhas respondem 500 para o Stripe reenviar.
+func (h *BillingHandler) StripeWebhook(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	change, err := h.provider.ParseWebhook(c.Body(), c.Get("Stripe-Signature"))
+	if err != nil {
+		if errors.Is(err, stripe.ErrInvalidSignature) {
+			return fiber.NewError(fiber.StatusBadRequest, "Assinatura inválida")
+		}
+		logging.FromContext(ctx).Warn("evento do Stripe ignorado", logging.Err(err))
+		return c.SendStatus(fiber.StatusOK)
+	}
+
+	if change == nil {
+		return c.SendStatus(fiber.StatusOK)
+	}
+
+	subscription, err := h.plans.ApplyProviderChange(ctx, h.provider.Name(), change)
+	switch {
+	case errors.Is(err, services.ErrUnknownPlan), errors.Is(err, repositories.ErrUserNotFound):
+		logging.FromContext(ctx).Warn("assinatura do Stripe ignorada",
+			"provider_ref", change.ProviderRef, "user_id", change.UserID.Hex(), logging.Err(err))
+		return c.SendStatus(fiber.StatusOK)
+	case err != nil:
+		return err
+	}
+
+	logging.FromContext(ctx).Info("assinatura atualizada pelo Stripe",
+		"user_id", subscription.UserID.Hex(), "plan", subscription.PlanID, "status", subscription.Status)
+
+	return c.SendStatus(fiber.StatusOK)
+}
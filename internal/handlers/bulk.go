@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// defaultMaxBulkIDs é o maior número de IDs por operação em lote até
+// SetMaxBulkIDs ser chamado
+const defaultMaxBulkIDs = 100
+
+// maxBulkIDs é o maior número de IDs aceito por operação em lote (BULK_MAX_IDS)
+var maxBulkIDs atomic.Int64
+
+func init() {
+	maxBulkIDs.Store(defaultMaxBulkIDs)
+}
+
+// SetMaxBulkIDs altera o maior número de IDs aceito por operação em lote
+func SetMaxBulkIDs(limit int) {
+	if limit > 0 {
+		maxBulkIDs.Store(int64(limit))
+	}
+}
+
+// checkBulkIDs rejeita operações em lote com IDs demais
+func checkBulkIDs(ids []string) error {
+	if limit := maxBulkIDs.Load(); int64(len(ids)) > limit {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("ids deve ter no máximo %d itens", limit))
+	}
+	return nil
+}
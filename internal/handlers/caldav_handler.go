@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/devgugga/todo-it/internal/caldav"
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Métodos WebDAV/CalDAV que precisam ser aceitos pelo Fiber (fiber.Config.RequestMethods)
+var CalDAVMethods = []string{"PROPFIND", "PROPPATCH", "REPORT", "MKCOL", "MKCALENDAR"}
+
+// caldavUserKey guarda o usuário autenticado pela senha de aplicativo
+const caldavUserKey = "caldav_user"
+
+// CalDAVHandler expõe as tarefas via CalDAV, autenticado por senha de aplicativo
+type CalDAVHandler struct {
+	server    *caldav.Server
+	passwords services.AppPasswordService
+}
+
+// NewCalDAVHandler cria uma nova instância do handler CalDAV
+func NewCalDAVHandler(server *caldav.Server, passwords services.AppPasswordService) *CalDAVHandler {
+	return &CalDAVHandler{server: server, passwords: passwords}
+}
+
+// SetupCalDAVRoutes registra o CalDAV. basePath é o caminho público de router,
+// usado nos hrefs das respostas.
+func SetupCalDAVRoutes(router fiber.Router, db database.Client, bus events.Bus, basePath string) {
+	todos := repositories.NewTodoRepository(db)
+	tasks := services.NewTaskService(todos, repositories.NewUserRepository(db), repositories.NewProjectRepository(db), repositories.NewTaskArchiveRepository(db), repositories.NewAttachmentRepository(db), repositories.NewLabelRepository(db), bus)
+	passwords := services.NewAppPasswordService(repositories.NewAppPasswordRepository(db), repositories.NewUserRepository(db))
+	h := NewCalDAVHandler(caldav.NewServer(basePath, tasks, todos), passwords)
+
+	router.Options("/*", h.Options)
+	router.Use(h.authenticate)
+	router.Add("PROPFIND", "/*", h.PropFind)
+	router.Add("REPORT", "/*", h.Report)
+	router.Get("/*", h.Get)
+	router.Put("/*", h.Put)
+	router.Delete("/*", h.Delete)
+	// Criar coleções e alterar propriedades (ex.: cor) não é suportado
+	for _, method := range []string{"PROPPATCH", "MKCOL", "MKCALENDAR"} {
+		router.Add(method, "/*", h.Forbidden)
+	}
+}
+
+// Options anuncia os recursos DAV suportados (sem autenticação, para descoberta)
+func (h *CalDAVHandler) Options(c *fiber.Ctx) error {
+	c.Set("DAV", "1, 3, calendar-access")
+	c.Set(fiber.HeaderAllow, "OPTIONS, GET, HEAD, PUT, DELETE, PROPFIND, REPORT")
+	return c.SendStatus(fiber.StatusOK)
+}
+
+// authenticate valida a autenticação básica: email da conta e senha de aplicativo
+func (h *CalDAVHandler) authenticate(c *fiber.Ctx) error {
+	email, password, ok := basicAuth(c.Get(fiber.HeaderAuthorization))
+	if ok {
+		user, err := h.passwords.Authenticate(c.UserContext(), email, password)
+		if err == nil {
+			c.Locals(caldavUserKey, user)
+			return c.Next()
+		}
+		if !errors.Is(err, services.ErrInvalidCredentials) {
+			return err
+		}
+	}
+
+	c.Set(fiber.HeaderWWWAuthenticate, `Basic realm="todo-it", charset="UTF-8"`)
+	return c.Status(fiber.StatusUnauthorized).SendString("Autenticação necessária: use o email da conta e uma senha de aplicativo")
+}
+
+// PropFind lista propriedades do recurso e, com Depth: 1, dos filhos
+func (h *CalDAVHandler) PropFind(c *fiber.Ctx) error {
+	depth := 1
+	if c.Get("Depth") == "0" {
+		depth = 0
+	}
+
+	ms, err := h.server.PropFind(c.UserContext(), caldavUser(c), c.Path(), depth, c.Body())
+	if err != nil {
+		return caldavError(c, err)
+	}
+
+	return sendMultistatus(c, ms)
+}
+
+// Report responde ao calendar-query e ao calendar-multiget
+func (h *CalDAVHandler) Report(c *fiber.Ctx) error {
+	ms, err := h.server.Report(c.UserContext(), caldavUser(c), c.Path(), c.Body())
+	if err != nil {
+		return caldavError(c, err)
+	}
+
+	return sendMultistatus(c, ms)
+}
+
+// Get retorna o VTODO da tarefa
+func (h *CalDAVHandler) Get(c *fiber.Ctx) error {
+	data, etag, err := h.server.Get(c.UserContext(), caldavUser(c), c.Path())
+	if err != nil {
+		return caldavError(c, err)
+	}
+
+	c.Set(fiber.HeaderETag, etag)
+	c.Set(fiber.HeaderContentType, "text/calendar; charset=utf-8")
+	return c.Send(data)
+}
+
+// Put cria ou substitui a tarefa a partir do VTODO enviado pelo cliente
+func (h *CalDAVHandler) Put(c *fiber.Ctx) error {
+	etag, created, err := h.server.Put(c.UserContext(), caldavUser(c), c.Path(), c.Body(),
+		c.Get(fiber.HeaderIfMatch), c.Get(fiber.HeaderIfNoneMatch))
+	if err != nil {
+		return caldavError(c, err)
+	}
+
+	c.Set(fiber.HeaderETag, etag)
+	if created {
+		return c.SendStatus(fiber.StatusCreated)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Delete remove a tarefa
+func (h *CalDAVHandler) Delete(c *fiber.Ctx) error {
+	if err := h.server.Delete(c.UserContext(), caldavUser(c), c.Path(), c.Get(fiber.HeaderIfMatch)); err != nil {
+		return caldavError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Forbidden recusa operações não suportadas
+func (h *CalDAVHandler) Forbidden(c *fiber.Ctx) error {
+	return c.SendStatus(fiber.StatusForbidden)
+}
+
+// caldavUser retorna o usuário autenticado pela senha de aplicativo
+func caldavUser(c *fiber.Ctx) *entities.User {
+	user, _ := c.Locals(caldavUserKey).(*entities.User)
+	return user
+}
+
+// sendMultistatus envia a resposta 207
+func sendMultistatus(c *fiber.Ctx, ms *caldav.Multistatus) error {
+	c.Set(fiber.HeaderContentType, "application/xml; charset=utf-8")
+	return c.Status(fiber.StatusMultiStatus).Send(ms.Bytes())
+}
+
+// caldavError converte os erros do CalDAV em status HTTP. Clientes CalDAV não
+// leem o JSON de erro da API, então as respostas levam apenas o status.
+func caldavError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, caldav.ErrNotFound):
+		return c.SendStatus(fiber.StatusNotFound)
+	case errors.Is(err, caldav.ErrPreconditionFailed):
+		return c.SendStatus(fiber.StatusPreconditionFailed)
+	case errors.Is(err, caldav.ErrMethodNotAllowed):
+		return c.SendStatus(fiber.StatusMethodNotAllowed)
+	case errors.Is(err, caldav.ErrUnsupportedComponent):
+		c.Set(fiber.HeaderContentType, "application/xml; charset=utf-8")
+		return c.Status(fiber.StatusForbidden).SendString(`<?xml version="1.0" encoding="UTF-8"?>` +
+			`<d:error xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav"><c:supported-calendar-component/></d:error>`)
+	case errors.Is(err, caldav.ErrUnsupportedReport):
+		return c.SendStatus(fiber.StatusForbidden)
+	case errors.Is(err, caldav.ErrInvalidCalendar), errors.Is(err, caldav.ErrInvalidRequest):
+		return c.SendStatus(fiber.StatusBadRequest)
+	default:
+		logging.FromContext(c.UserContext()).Error("erro no CalDAV", "method", c.Method(), "path", c.Path(), logging.Err(err))
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+}
+
+// basicAuth extrai usuário e senha do header Authorization: Basic
+func basicAuth(header string) (string, string, bool) {
+	encoded, ok := strings.CutPrefix(header, "Basic ")
+	if !ok {
+		return "", "", false
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return "", "", false
+	}
+
+	return strings.Cut(string(decoded), ":")
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	userreq "github.com/devgugga/todo-it/internal/dtos/requests/user"
+	userres "github.com/devgugga/todo-it/internal/dtos/responses/user"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// CustomStatusHandler agrupa os handlers dos status personalizados do usuário autenticado
+type CustomStatusHandler struct {
+	statuses services.CustomStatusService
+}
+
+// NewCustomStatusHandler cria uma nova instância do handler de status personalizados
+func NewCustomStatusHandler(statuses services.CustomStatusService) *CustomStatusHandler {
+	return &CustomStatusHandler{statuses: statuses}
+}
+
+// List lista os status personalizados do usuário
+func (h *CustomStatusHandler) List(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	statuses, err := h.statuses.List(c.UserContext(), userID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    userres.NewCustomStatusResponses(statuses),
+	})
+}
+
+// Create cria um status personalizado associado a um dos status padrão
+func (h *CustomStatusHandler) Create(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var req userreq.CreateCustomStatusRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	status, err := h.statuses.Create(c.UserContext(), userID, &req)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    userres.NewCustomStatusResponse(status),
+	})
+}
+
+// Update altera o nome e a cor de um status personalizado
+func (h *CustomStatusHandler) Update(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	var req userreq.UpdateCustomStatusRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	status, err := h.statuses.Update(c.UserContext(), userID, id, &req)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    userres.NewCustomStatusResponse(status),
+	})
+}
+
+// Delete remove um status personalizado; as tarefas mantêm o status padrão associado a ele
+func (h *CustomStatusHandler) Delete(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	if err := h.statuses.Delete(c.UserContext(), userID, id); err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/apperrors"
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/recurrence"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// unavailableRetryAfter é a espera sugerida (Retry-After) quando o banco está
+// indisponível; uma eleição de primário costuma terminar em poucos segundos
+const unavailableRetryAfter = 5 * time.Second
+
+// handleServiceError converte erros dos serviços sem categoria de apperrors em
+// erros HTTP. Os de apperrors (não encontrado, duplicado, conflito, sem
+// permissão) seguem para o handler de erros global.
+func handleServiceError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidCredentials):
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	case errors.Is(err, services.ErrInvalidPassword):
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	case errors.Is(err, services.ErrInvalidCursor), errors.Is(err, services.ErrInvalidSyncCursor):
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	case errors.Is(err, services.ErrSMSUnavailable):
+		return fiber.NewError(fiber.StatusServiceUnavailable, err.Error())
+	case errors.Is(err, services.ErrSMSQuotaExceeded), errors.Is(err, services.ErrPhoneCodeCooldown):
+		return fiber.NewError(fiber.StatusTooManyRequests, err.Error())
+	case errors.Is(err, services.ErrInvalidPhoneNumber), errors.Is(err, services.ErrInvalidPhoneCode):
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	case errors.Is(err, services.ErrTaskTagLimit), errors.Is(err, services.ErrInvalidLabelName):
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	case errors.Is(err, services.ErrTaskNotRecurring), errors.Is(err, services.ErrRecurrenceWithoutDueDate),
+		errors.Is(err, recurrence.ErrInvalidRule), errors.Is(err, services.ErrInvalidSnoozePreset):
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	case errors.Is(err, services.ErrPastDueDate):
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	case errors.Is(err, services.ErrUnknownPlan):
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	case errors.Is(err, services.ErrExportTooLarge):
+		return fiber.NewError(fiber.StatusRequestEntityTooLarge, err.Error())
+	default:
+		return err
+	}
+}
+
+// ErrorHandler é o handler de erros global da aplicação: converte fiber.Error e
+// as categorias de apperrors em respostas JSON com o status correspondente
+func ErrorHandler(c *fiber.Ctx, err error) error {
+	var panicErr *middleware.PanicError
+	switch {
+	case errors.As(err, &panicErr):
+		return internalError(c, panicErr)
+	case errors.Is(err, fiber.ErrRequestEntityTooLarge):
+		return bodyTooLarge(c)
+	case errors.Is(err, fiber.ErrGatewayTimeout):
+		return gatewayTimeout(c)
+	case errors.Is(err, services.ErrAccountSuspended):
+		return accountSuspended(c)
+	case errors.Is(err, apperrors.ErrUnavailable), database.IsUnavailable(err):
+		return serviceUnavailable(c, err)
+	}
+
+	code := fiber.StatusInternalServerError
+	message := "Erro interno do servidor"
+
+	var fiberErr *fiber.Error
+	switch {
+	case errors.As(err, &fiberErr):
+		code = fiberErr.Code
+		message = fiberErr.Message
+	case errors.Is(err, services.ErrSessionRevoked):
+		code = fiber.StatusUnauthorized
+		message = err.Error()
+	case errors.Is(err, apperrors.ErrNotFound):
+		code = fiber.StatusNotFound
+		message = err.Error()
+	case errors.Is(err, apperrors.ErrDuplicate), errors.Is(err, apperrors.ErrConflict):
+		code = fiber.StatusConflict
+		message = err.Error()
+	case errors.Is(err, apperrors.ErrForbidden):
+		code = fiber.StatusForbidden
+		message = err.Error()
+	}
+
+	if code >= fiber.StatusInternalServerError {
+		logging.FromContext(c.UserContext()).Error("erro na API", "error", err)
+	}
+
+	return c.Status(code).JSON(fiber.Map{
+		"success":   false,
+		"error":     true,
+		"message":   message,
+		"code":      code,
+		"timestamp": time.Now().Unix(),
+		"path":      c.Path(),
+	})
+}
+
+// bodyTooLarge responde 413 no formato problem+json, com o limite aplicado à
+// rota em max_bytes. Vale tanto para o limite do grupo de rotas quanto para o
+// do servidor, que rejeita o corpo antes do roteamento.
+func bodyTooLarge(c *fiber.Ctx) error {
+	limit := middleware.GetBodyLimit(c)
+
+	return problem(c, fiber.StatusRequestEntityTooLarge, "Corpo da requisição muito grande",
+		fmt.Sprintf("o corpo da requisição excede o limite de %d bytes desta rota", limit),
+		fiber.Map{"max_bytes": limit})
+}
+
+// gatewayTimeout responde 504 no formato problem+json quando a requisição
+// excede o tempo limite da rota (middleware.Timeout)
+func gatewayTimeout(c *fiber.Ctx) error {
+	timeout := middleware.GetTimeout(c)
+	logging.FromContext(c.UserContext()).Warn("requisição excedeu o tempo limite", "timeout", timeout.String())
+
+	return problem(c, fiber.StatusGatewayTimeout, "Tempo limite da requisição excedido",
+		fmt.Sprintf("a requisição excedeu o tempo limite de %s desta rota", timeout),
+		fiber.Map{"timeout_seconds": timeout.Seconds()})
+}
+
+// serviceUnavailable responde 503 no formato problem+json com Retry-After
+// quando o banco está temporariamente indisponível (eleição de primário, falha
+// de rede). A causa fica só no log.
+func serviceUnavailable(c *fiber.Ctx, err error) error {
+	logging.FromContext(c.UserContext()).Warn("banco de dados indisponível", "error", err)
+
+	seconds := int(unavailableRetryAfter.Seconds())
+	c.Set(fiber.HeaderRetryAfter, fmt.Sprintf("%d", seconds))
+	return problem(c, fiber.StatusServiceUnavailable, "Serviço temporariamente indisponível",
+		"o banco de dados está temporariamente indisponível; tente novamente em instantes",
+		fiber.Map{"retry_after_seconds": seconds})
+}
+
+// internalError responde 500 no formato problem+json a um panic já registrado
+// por middleware.Recover, com o ID da requisição para correlação com o log
+func internalError(c *fiber.Ctx, panicErr *middleware.PanicError) error {
+	return problem(c, fiber.StatusInternalServerError, "Erro interno do servidor",
+		"ocorreu um erro inesperado; informe o request_id ao suporte",
+		fiber.Map{"request_id": panicErr.RequestID})
+}
+
+// accountSuspended responde 403 no formato problem+json com error_code
+// account_suspended, para o cliente distinguir a suspensão de outras recusas
+func accountSuspended(c *fiber.Ctx) error {
+	return problem(c, fiber.StatusForbidden, "Conta suspensa",
+		"esta conta foi suspensa por um administrador; entre em contato com o suporte",
+		fiber.Map{"error_code": "account_suspended"})
+}
+
+// problem escreve a resposta de erro no formato problem+json (RFC 9457);
+// extensions são os campos adicionais do problema
+func problem(c *fiber.Ctx, status int, title, detail string, extensions fiber.Map) error {
+	body := fiber.Map{
+		"type":     "about:blank",
+		"title":    title,
+		"status":   status,
+		"detail":   detail,
+		"instance": c.Path(),
+	}
+	for key, value := range extensions {
+		body[key] = value
+	}
+
+	return c.Status(status).JSON(body, "application/problem+json")
+}
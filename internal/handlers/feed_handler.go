@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"github.com/devgugga/todo-it/internal/database"
+	feedres "github.com/devgugga/todo-it/internal/dtos/responses/feed"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// FeedBasePath é o caminho público das rotas de feed (montadas em /api/v1/feeds)
+const FeedBasePath = "/api/v1/feeds"
+
+// FeedHandler agrupa os handlers do feed Atom de atividades
+type FeedHandler struct {
+	feeds services.ActivityFeedService
+	audit services.AuditService
+}
+
+// NewFeedHandler cria uma nova instância do handler de feeds
+func NewFeedHandler(feeds services.ActivityFeedService, audit services.AuditService) *FeedHandler {
+	return &FeedHandler{feeds: feeds, audit: audit}
+}
+
+// SetupFeedRoutes registra as rotas públicas de feed (autenticadas pelo token na URL)
+func SetupFeedRoutes(router fiber.Router, db database.Client) {
+	feeds := services.NewActivityFeedService(repositories.NewUserRepository(db), repositories.NewTaskChangeRepository(db))
+	h := NewFeedHandler(feeds, services.NewAuditService(repositories.NewAuditLogRepository(db)))
+
+	router.Get("/:token/activity.atom", h.Activity)
+}
+
+// Activity retorna o feed Atom com as tarefas criadas e concluídas recentemente
+func (h *FeedHandler) Activity(c *fiber.Ctx) error {
+	user, changes, err := h.feeds.Activity(c.UserContext(), c.Params("token"))
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	body, err := feedres.NewActivityFeed(user, changes, c.BaseURL()+c.Path()).Bytes()
+	if err != nil {
+		return err
+	}
+
+	c.Set(fiber.HeaderContentType, "application/atom+xml; charset=utf-8")
+	c.Set(fiber.HeaderCacheControl, "private, max-age=300")
+	return c.Send(body)
+}
+
+// RotateToken gera um novo endereço para o feed; o anterior deixa de funcionar
+func (h *FeedHandler) RotateToken(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	token, err := h.feeds.RotateToken(c.UserContext(), userID)
+	h.audit.Record(c.UserContext(), newAuditEntry(c, entities.AuditActionFeedTokenRotate, err))
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"token": token,
+			"url":   c.BaseURL() + FeedBasePath + "/" + token + "/activity.atom",
+		},
+	})
+}
+
+// RevokeToken desativa o feed
+func (h *FeedHandler) RevokeToken(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	err := h.feeds.RevokeToken(c.UserContext(), userID)
+	h.audit.Record(c.UserContext(), newAuditEntry(c, entities.AuditActionFeedTokenRevoke, err))
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/devgugga/todo-it/internal/auth"
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// filesBasePath é o caminho público dos downloads por URL assinada (grupo /files da API)
+const filesBasePath = "/api/v1/files"
+
+// FileHandler agrupa a emissão e o download das URLs assinadas
+type FileHandler struct {
+	files services.FileService
+}
+
+// NewFileHandler cria uma nova instância do handler de arquivos
+func NewFileHandler(files services.FileService) *FileHandler {
+	return &FileHandler{files: files}
+}
+
+// newFileHandler monta o handler com os repositórios do banco
+func newFileHandler(db database.Client, tasks services.TaskService, signer *auth.URLSigner) *FileHandler {
+	files := services.NewFileService(repositories.NewExportJobRepository(db), repositories.NewAttachmentRepository(db), tasks, signer)
+	return NewFileHandler(files)
+}
+
+// SetupFileRoutes registra o download por URL assinada (sem autenticação: a
+// assinatura autoriza o acesso ao arquivo)
+func SetupFileRoutes(router fiber.Router, db database.Client, bus events.Bus, signer *auth.URLSigner) {
+	attachments := repositories.NewAttachmentRepository(db)
+	tasks := services.NewTaskService(repositories.NewTodoRepository(db), repositories.NewUserRepository(db), repositories.NewProjectRepository(db), repositories.NewTaskArchiveRepository(db), attachments, repositories.NewLabelRepository(db), bus)
+	h := newFileHandler(db, tasks, signer)
+
+	router.Get("/:id", middleware.RequireSignedURL(signer), h.Download)
+}
+
+// ExportLink emite a URL assinada de download de uma exportação concluída
+func (h *FileHandler) ExportLink(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	link, err := h.files.ExportLink(c.UserContext(), userID, id)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return sendFileLink(c, link)
+}
+
+// AttachmentLink emite a URL assinada de download de um anexo da tarefa
+func (h *FileHandler) AttachmentLink(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	attachmentID, err := parseIDParam(c, "attachmentId")
+	if err != nil {
+		return err
+	}
+
+	link, err := h.files.AttachmentLink(c.UserContext(), userID, id, attachmentID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return sendFileLink(c, link)
+}
+
+// sendFileLink responde com a URL assinada e o seu vencimento
+func sendFileLink(c *fiber.Ctx, link *services.FileLink) error {
+	url := c.BaseURL() + filesBasePath + "/" + link.ID +
+		"?exp=" + strconv.FormatInt(link.ExpiresAt.Unix(), 10) + "&sig=" + link.Signature
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    fiber.Map{"url": url, "expires_at": link.ExpiresAt},
+	})
+}
+
+// Download envia o arquivo da URL assinada (verificada por middleware.RequireSignedURL)
+func (h *FileHandler) Download(c *fiber.Ctx) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	file, err := h.files.Open(c.UserContext(), id)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	// O link é uma credencial: nada de cache compartilhado nem de Referer para terceiros
+	c.Set(fiber.HeaderCacheControl, "private, no-store")
+	c.Set(fiber.HeaderReferrerPolicy, "no-referrer")
+	c.Attachment(file.Name)
+	c.Set(fiber.HeaderContentType, file.ContentType)
+	return c.SendStream(file.Content, int(file.Size))
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	focusreq "github.com/devgugga/todo-it/internal/dtos/requests/focus"
+	focusres "github.com/devgugga/todo-it/internal/dtos/responses/focus"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// FocusHandler agrupa os handlers das sessões de foco
+type FocusHandler struct {
+	focus services.FocusService
+	users repositories.UserRepository
+}
+
+// NewFocusHandler cria uma nova instância do handler de sessões de foco
+func NewFocusHandler(focus services.FocusService, users repositories.UserRepository) *FocusHandler {
+	return &FocusHandler{focus: focus, users: users}
+}
+
+// SetupFocusRoutes registra as rotas das sessões de foco (requer autenticação)
+func SetupFocusRoutes(router fiber.Router, db database.Client, bus events.Bus) {
+	attachments := repositories.NewAttachmentRepository(db)
+	users := repositories.NewUserRepository(db)
+	tasks := services.NewTaskService(repositories.NewTodoRepository(db), users, repositories.NewProjectRepository(db), repositories.NewTaskArchiveRepository(db), attachments, repositories.NewLabelRepository(db), bus)
+	h := NewFocusHandler(services.NewFocusService(repositories.NewFocusSessionRepository(db), tasks), users)
+
+	router.Get("/active", h.Active)
+	router.Post("/start", h.Start)
+	router.Post("/stop", h.Stop)
+	router.Get("/stats", h.DailySummary)
+}
+
+// Active retorna a sessão em andamento, para o cliente retomar o cronômetro
+// após reconectar
+func (h *FocusHandler) Active(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	session, err := h.focus.Active(c.UserContext(), userID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    focusres.NewFocusSessionResponse(session, time.Now()),
+	})
+}
+
+// Start inicia uma sessão de foco na tarefa informada
+func (h *FocusHandler) Start(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var req focusreq.StartFocusRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	// Já validado como ObjectID pelo parseBody
+	taskID, _ := primitive.ObjectIDFromHex(req.TaskID)
+
+	session, err := h.focus.Start(c.UserContext(), userID, taskID, time.Duration(req.PlannedMinutes)*time.Minute)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    focusres.NewFocusSessionResponse(session, time.Now()),
+	})
+}
+
+// Stop encerra a sessão em andamento
+func (h *FocusHandler) Stop(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	session, err := h.focus.Stop(c.UserContext(), userID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    focusres.NewFocusSessionResponse(session, time.Now()),
+	})
+}
+
+// DailySummary retorna o tempo de foco por dia no período ?range=Nd (padrão
+// 90d), no fuso do usuário
+func (h *FocusHandler) DailySummary(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	days, err := parseRangeDays(c.Query("range"))
+	if err != nil {
+		return err
+	}
+
+	user, err := h.users.GetByID(c.UserContext(), userID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	summary, err := h.focus.DailySummary(c.UserContext(), userID, days, user.Preferences.Location())
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    summary,
+	})
+}
@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"errors"
+	"strconv"
+
+	githubreq "github.com/devgugga/todo-it/internal/dtos/requests/github"
+	githubres "github.com/devgugga/todo-it/internal/dtos/responses/github"
+	"github.com/devgugga/todo-it/internal/integrations/github"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GitHubHandler agrupa o webhook de issues e as rotas de conexão e vínculo de repositórios
+type GitHubHandler struct {
+	syncer *github.Syncer
+}
+
+// NewGitHubHandler cria uma nova instância do handler do GitHub
+func NewGitHubHandler(syncer *github.Syncer) *GitHubHandler {
+	return &GitHubHandler{syncer: syncer}
+}
+
+// SetupGitHubRoutes registra o webhook (autenticado pela assinatura HMAC do
+// GitHub) e as rotas de conexão (autenticadas pelo usuário)
+func SetupGitHubRoutes(router fiber.Router, syncer *github.Syncer, requireAuth fiber.Handler) {
+	h := NewGitHubHandler(syncer)
+
+	router.Post("/webhook", h.Webhook)
+	router.Post("/oauth/start", requireAuth, h.StartOAuth)
+	router.Post("/oauth/callback", requireAuth, h.CompleteOAuth)
+	router.Get("/", requireAuth, h.GetAccount)
+	router.Delete("/", requireAuth, h.Disconnect)
+	router.Post("/repos", requireAuth, h.LinkRepo)
+	router.Post("/repos/:owner/:name/sync", requireAuth, h.SyncRepo)
+	router.Delete("/repos/:owner/:name", requireAuth, h.UnlinkRepo)
+}
+
+// Webhook recebe os eventos de issues. Falhas ao processar um evento válido são
+// registradas mas respondidas com 200, evitando reenvios em loop.
+func (h *GitHubHandler) Webhook(c *fiber.Ctx) error {
+	if !h.syncer.VerifySignature(c.Body(), c.Get("X-Hub-Signature-256")) {
+		return fiber.NewError(fiber.StatusUnauthorized, "Assinatura inválida")
+	}
+
+	event := c.Get("X-GitHub-Event")
+	hookID, _ := strconv.ParseInt(c.Get("X-GitHub-Hook-ID"), 10, 64)
+
+	if err := h.syncer.HandleWebhook(c.UserContext(), event, hookID, c.Body()); err != nil {
+		logging.FromContext(c.UserContext()).Error("erro ao processar evento do GitHub",
+			"event", event, "delivery", c.Get("X-GitHub-Delivery"), logging.Err(err))
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+// StartOAuth retorna a URL de autorização para onde o app deve redirecionar o usuário
+func (h *GitHubHandler) StartOAuth(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	url, err := h.syncer.StartOAuth(c.UserContext(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    fiber.Map{"authorize_url": url},
+	})
+}
+
+// CompleteOAuth conclui a conexão com o code e o state recebidos no redirecionamento
+func (h *GitHubHandler) CompleteOAuth(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var req githubreq.OAuthCallbackRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	account, err := h.syncer.CompleteOAuth(c.UserContext(), userID, req.Code, req.State)
+	if err != nil {
+		if errors.Is(err, repositories.ErrGitHubAccountNotFound) {
+			return fiber.NewError(fiber.StatusBadRequest, "State inválido ou expirado. Inicie a conexão novamente.")
+		}
+		return githubError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    githubres.NewAccountResponse(account),
+	})
+}
+
+// GetAccount informa se a conta está conectada e os repositórios vinculados
+func (h *GitHubHandler) GetAccount(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	account, err := h.syncer.GetAccount(c.UserContext(), userID)
+	if err != nil && !errors.Is(err, repositories.ErrGitHubAccountNotFound) {
+		return err
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    githubres.NewAccountResponse(account),
+	})
+}
+
+// Disconnect remove a conexão e os webhooks. As tarefas sincronizadas são mantidas.
+func (h *GitHubHandler) Disconnect(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.syncer.Disconnect(c.UserContext(), userID); err != nil {
+		return githubError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "GitHub desconectado",
+	})
+}
+
+// LinkRepo vincula um repositório e importa as issues abertas atribuídas ao usuário
+func (h *GitHubHandler) LinkRepo(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var req githubreq.LinkRepoRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	synced, err := h.syncer.LinkRepo(c.UserContext(), userID, req.Repo, req.CloseOnComplete)
+	if err != nil {
+		return githubError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    fiber.Map{"synced": synced},
+	})
+}
+
+// SyncRepo reimporta as issues abertas atribuídas ao usuário no repositório
+func (h *GitHubHandler) SyncRepo(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	synced, err := h.syncer.SyncRepo(c.UserContext(), userID, c.Params("owner")+"/"+c.Params("name"))
+	if err != nil {
+		return githubError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    fiber.Map{"synced": synced},
+	})
+}
+
+// UnlinkRepo desvincula o repositório e remove o webhook
+func (h *GitHubHandler) UnlinkRepo(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.syncer.UnlinkRepo(c.UserContext(), userID, c.Params("owner")+"/"+c.Params("name")); err != nil {
+		return githubError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Repositório desvinculado",
+	})
+}
+
+// githubError converte os erros da integração em erros HTTP
+func githubError(err error) error {
+	switch {
+	case errors.Is(err, repositories.ErrGitHubAccountNotFound):
+		return fiber.NewError(fiber.StatusNotFound, "Conta não conectada ao GitHub")
+	case errors.Is(err, github.ErrRepoNotLinked):
+		return fiber.NewError(fiber.StatusNotFound, "Repositório não vinculado")
+	case errors.Is(err, github.ErrInvalidRepo):
+		return fiber.NewError(fiber.StatusBadRequest, "Informe o repositório no formato owner/nome")
+	case errors.Is(err, github.ErrNotFound):
+		return fiber.NewError(fiber.StatusNotFound, "Repositório não encontrado no GitHub")
+	case errors.Is(err, github.ErrRepoAdminRequired):
+		return fiber.NewError(fiber.StatusForbidden, "É necessário ser administrador do repositório para receber eventos de issues")
+	case errors.Is(err, github.ErrUnauthorized):
+		return fiber.NewError(fiber.StatusBadGateway, "O GitHub recusou o acesso. Conecte a conta novamente.")
+	default:
+		return err
+	}
+}
@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+
+	importreq "github.com/devgugga/todo-it/internal/dtos/requests/importer"
+	importres "github.com/devgugga/todo-it/internal/dtos/responses/importer"
+	"github.com/devgugga/todo-it/internal/importer"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ImportHandler agrupa os handlers de importação de outras ferramentas
+type ImportHandler struct {
+	runner  *importer.Runner
+	todoist *importer.TodoistImporter
+	trello  *importer.TrelloImporter
+	msTodo  *importer.MicrosoftTodoImporter
+}
+
+// NewImportHandler cria uma nova instância do handler de importação
+func NewImportHandler(runner *importer.Runner, todoist *importer.TodoistImporter, trello *importer.TrelloImporter, msTodo *importer.MicrosoftTodoImporter) *ImportHandler {
+	return &ImportHandler{runner: runner, todoist: todoist, trello: trello, msTodo: msTodo}
+}
+
+// SetupImportRoutes registra as rotas de importação (requer autenticação)
+func SetupImportRoutes(router fiber.Router, runner *importer.Runner, todoist *importer.TodoistImporter, trello *importer.TrelloImporter, msTodo *importer.MicrosoftTodoImporter) {
+	h := NewImportHandler(runner, todoist, trello, msTodo)
+
+	router.Post("/todoist", bulkRateLimit, h.Todoist)
+	router.Post("/trello", bulkRateLimit, h.Trello)
+	router.Post("/microsoft-todo", bulkRateLimit, h.MicrosoftTodo)
+	router.Get("/jobs/:id", h.GetJob)
+}
+
+// Todoist inicia a importação do Todoist a partir de um arquivo (campo "file"
+// multipart ou corpo bruto: JSON da API de sync, backup ZIP ou CSV) ou de
+// {"api_token": "..."}. Responde 202 com a importação a acompanhar em /import/jobs/:id.
+func (h *ImportHandler) Todoist(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	data, err := importPayload(c)
+	if err != nil {
+		return err
+	}
+
+	var run importer.Func
+
+	var tokenReq importreq.TodoistImportRequest
+	if c.Is("json") && json.Unmarshal(data, &tokenReq) == nil && tokenReq.APIToken != "" {
+		if err := validate.Struct(&tokenReq); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, formatValidationError(err))
+		}
+		run = h.todoist.FromAPIToken(userID, tokenReq.APIToken)
+	} else {
+		export, err := importer.ParseTodoistExport(data)
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "Arquivo de exportação do Todoist inválido")
+		}
+		run = h.todoist.FromExport(userID, export)
+	}
+
+	job, err := h.runner.Start(c.UserContext(), userID, importer.SourceTodoist, run)
+	if err != nil {
+		return importError(err)
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"success": true,
+		"data":    importres.NewImportJobResponse(job),
+	})
+}
+
+// Trello inicia a importação do JSON exportado de um quadro do Trello (campo "file"
+// multipart ou corpo bruto). Colunas viram status personalizados do projeto e o que
+// não puder ser convertido (anexos, comentários, membros...) aparece nos avisos da importação.
+func (h *ImportHandler) Trello(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	data, err := importPayload(c)
+	if err != nil {
+		return err
+	}
+
+	boards, err := importer.ParseTrelloExport(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Arquivo de exportação do Trello inválido")
+	}
+
+	job, err := h.runner.Start(c.UserContext(), userID, importer.SourceTrello, h.trello.FromExport(userID, boards))
+	if err != nil {
+		return importError(err)
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"success": true,
+		"data":    importres.NewImportJobResponse(job),
+	})
+}
+
+// MicrosoftTodo inicia a importação do Microsoft To Do pela Graph API a partir de
+// {"access_token": "..."} (token delegado com Tasks.Read, obtido pelo frontend).
+// Listas viram projetos; recorrências são convertidas em RRULE e lembretes são mantidos.
+func (h *ImportHandler) MicrosoftTodo(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var req importreq.MicrosoftTodoImportRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	job, err := h.runner.Start(c.UserContext(), userID, importer.SourceMicrosoftTodo, h.msTodo.FromAccessToken(userID, req.AccessToken))
+	if err != nil {
+		return importError(err)
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"success": true,
+		"data":    importres.NewImportJobResponse(job),
+	})
+}
+
+// GetJob retorna o andamento de uma importação
+func (h *ImportHandler) GetJob(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	job, err := h.runner.Get(c.UserContext(), userID, id)
+	if err != nil {
+		return importError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    importres.NewImportJobResponse(job),
+	})
+}
+
+// importPayload lê o arquivo enviado via multipart (campo "file") ou o corpo bruto
+func importPayload(c *fiber.Ctx) ([]byte, error) {
+	if strings.HasPrefix(c.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		header, err := c.FormFile("file")
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "Arquivo de exportação não encontrado na requisição")
+		}
+
+		file, err := header.Open()
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "Arquivo de exportação inválido")
+		}
+		defer file.Close()
+
+		return io.ReadAll(file)
+	}
+
+	if len(c.Body()) == 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Arquivo de exportação não encontrado na requisição")
+	}
+
+	return c.Body(), nil
+}
+
+// importError converte os erros de importação em erros HTTP
+func importError(err error) error {
+	switch {
+	case errors.Is(err, repositories.ErrImportJobNotFound):
+		return fiber.NewError(fiber.StatusNotFound, "Importação não encontrada")
+	default:
+		return err
+	}
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/devgugga/todo-it/internal/integrations/inboundmail"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+// inboundTokenHeader carrega o token do webhook de inbound parse
+// (alternativamente, ?token= para provedores que não enviam headers)
+const inboundTokenHeader = "X-Inbound-Token"
+
+// InboundEmailHandler agrupa o webhook de inbound parse e o endereço de entrada do usuário
+type InboundEmailHandler struct {
+	ingester     *inboundmail.Ingester
+	webhookToken string
+}
+
+// NewInboundEmailHandler cria uma nova instância do handler de email de entrada
+func NewInboundEmailHandler(ingester *inboundmail.Ingester, webhookToken string) *InboundEmailHandler {
+	return &InboundEmailHandler{ingester: ingester, webhookToken: webhookToken}
+}
+
+// SetupInboundEmailRoutes registra o endereço de entrada do usuário (autenticado)
+// e, se houver token configurado, o webhook de inbound parse
+func SetupInboundEmailRoutes(router fiber.Router, ingester *inboundmail.Ingester, webhookToken string, requireAuth fiber.Handler) {
+	h := NewInboundEmailHandler(ingester, webhookToken)
+
+	if webhookToken != "" {
+		router.Post("/inbound", h.Webhook)
+	}
+	router.Get("/address", requireAuth, h.GetAddress)
+	router.Post("/address/rotate", requireAuth, h.RotateAddress)
+}
+
+// Webhook recebe o email bruto (MIME) de um provedor de inbound parse:
+// corpo message/rfc822 ou formulário com o campo "email" (SendGrid) ou
+// "body-mime" (Mailgun). Emails sem destinatário conhecido ou duplicados são
+// aceitos sem efeito, para que o provedor não os reenvie.
+func (h *InboundEmailHandler) Webhook(c *fiber.Ctx) error {
+	token := c.Get(inboundTokenHeader)
+	if token == "" {
+		token = c.Query("token")
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(h.webhookToken)) != 1 {
+		return fiber.NewError(fiber.StatusUnauthorized, "Token inválido")
+	}
+
+	raw, envelope := inboundPayload(c)
+	if len(raw) == 0 {
+		return fiber.NewError(fiber.StatusBadRequest, "Email não encontrado na requisição")
+	}
+
+	email, err := inboundmail.Parse(bytes.NewReader(raw), h.ingester.MaxAttachmentBytes())
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Email inválido")
+	}
+
+	task, err := h.ingester.Ingest(c.UserContext(), email, envelope...)
+	if errors.Is(err, inboundmail.ErrUnknownRecipient) || errors.Is(err, inboundmail.ErrDuplicateEmail) {
+		logging.FromContext(c.UserContext()).Info("email ignorado", "message_id", email.MessageID, "reason", err.Error())
+		return c.JSON(fiber.Map{"success": true, "data": fiber.Map{"created": false}})
+	}
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    fiber.Map{"created": true, "task_id": task.ID.Hex()},
+	})
+}
+
+// inboundPayload extrai o email bruto e os destinatários do envelope
+func inboundPayload(c *fiber.Ctx) ([]byte, []string) {
+	if strings.HasPrefix(c.Get(fiber.HeaderContentType), "message/rfc822") {
+		return c.Body(), nil
+	}
+
+	var envelope []string
+	if recipient := c.FormValue("recipient"); recipient != "" {
+		envelope = append(envelope, recipient)
+	}
+	if raw := c.FormValue("envelope"); raw != "" {
+		var parsed struct {
+			To []string `json:"to"`
+		}
+		if json.Unmarshal([]byte(raw), &parsed) == nil {
+			envelope = append(envelope, parsed.To...)
+		}
+	}
+
+	for _, field := range []string{"email", "body-mime"} {
+		if value := c.FormValue(field); value != "" {
+			return []byte(value), envelope
+		}
+		if file, err := c.FormFile(field); err == nil {
+			content, err := file.Open()
+			if err != nil {
+				continue
+			}
+			raw, err := io.ReadAll(content)
+			content.Close()
+			if err == nil {
+				return raw, envelope
+			}
+		}
+	}
+
+	return nil, envelope
+}
+
+// GetAddress retorna o endereço de email que cria tarefas para o usuário
+func (h *InboundEmailHandler) GetAddress(c *fiber.Ctx) error {
+	return h.address(c, false)
+}
+
+// RotateAddress gera um novo endereço, invalidando o anterior
+func (h *InboundEmailHandler) RotateAddress(c *fiber.Ctx) error {
+	return h.address(c, true)
+}
+
+func (h *InboundEmailHandler) address(c *fiber.Ctx, rotate bool) error {
+	userID, _ := middleware.GetUserID(c)
+
+	address, err := h.ingester.AddressFor(c.UserContext(), userID, rotate)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    fiber.Map{"address": address},
+	})
+}
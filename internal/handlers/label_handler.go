@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"github.com/devgugga/todo-it/internal/database"
+	labelreq "github.com/devgugga/todo-it/internal/dtos/requests/label"
+	labelres "github.com/devgugga/todo-it/internal/dtos/responses/label"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// LabelHandler agrupa os handlers das etiquetas gerenciadas do usuário autenticado
+type LabelHandler struct {
+	labels services.LabelService
+}
+
+// NewLabelHandler cria uma nova instância do handler de etiquetas
+func NewLabelHandler(labels services.LabelService) *LabelHandler {
+	return &LabelHandler{labels: labels}
+}
+
+// SetupLabelRoutes registra as rotas das etiquetas gerenciadas (requer
+// autenticação). POST /migrate converte as etiquetas já usadas nas tarefas.
+func SetupLabelRoutes(router fiber.Router, db database.Client) {
+	h := NewLabelHandler(services.NewLabelService(repositories.NewLabelRepository(db), repositories.NewTodoRepository(db)))
+
+	router.Get("/", h.List)
+	router.Post("/", h.Create)
+	router.Post("/migrate", h.Migrate)
+	router.Put("/:id", h.Update)
+	router.Delete("/:id", h.Delete)
+}
+
+// List lista as etiquetas do usuário
+func (h *LabelHandler) List(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	labels, err := h.labels.List(c.UserContext(), userID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    labelres.NewLabelResponses(labels),
+	})
+}
+
+// Create cria uma etiqueta, já associada às tarefas com o mesmo nome
+func (h *LabelHandler) Create(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var req labelreq.CreateLabelRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	label, err := h.labels.Create(c.UserContext(), userID, &req)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    labelres.NewLabelResponse(label),
+	})
+}
+
+// Update altera nome, cor e ícone de uma etiqueta
+func (h *LabelHandler) Update(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	var req labelreq.UpdateLabelRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	label, err := h.labels.Update(c.UserContext(), userID, id, &req)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    labelres.NewLabelResponse(label),
+	})
+}
+
+// Delete remove uma etiqueta; as tarefas mantêm o texto da etiqueta
+func (h *LabelHandler) Delete(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	if err := h.labels.Delete(c.UserContext(), userID, id); err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Migrate cria etiquetas gerenciadas para as etiquetas usadas nas tarefas
+func (h *LabelHandler) Migrate(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	migration, err := h.labels.Migrate(c.UserContext(), userID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    labelres.NewMigrationResponse(migration.Created, migration.Skipped, migration.LinkedTasks),
+	})
+}
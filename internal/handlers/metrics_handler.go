@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Content types da exposição: OpenMetrics quando o scraper o aceita, senão o
+// formato texto do Prometheus (que ignora a linha "# EOF" como comentário)
+const (
+	openMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+	prometheusContentType  = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// MetricsHandler exporta os indicadores de negócio para o Prometheus
+type MetricsHandler struct {
+	metrics services.PlatformMetricsService
+}
+
+// NewMetricsHandler cria uma nova instância do handler de métricas
+func NewMetricsHandler(metrics services.PlatformMetricsService) *MetricsHandler {
+	return &MetricsHandler{metrics: metrics}
+}
+
+// Export escreve os indicadores calculados pelo job business-kpis. São os
+// mesmos em todas as instâncias, lidos do banco a cada scrape.
+func (h *MetricsHandler) Export(c *fiber.Ctx) error {
+	kpis, err := h.metrics.KPIs(c.UserContext())
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	var b strings.Builder
+	gauge := func(name, help string, value interface{}) {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s gauge\n%s %v\n", name, help, name, name, value)
+	}
+
+	gauge("todoit_daily_active_users", "Usuários ativos desde o início do dia (UTC).", kpis.DailyActiveUsers)
+	gauge("todoit_tasks_completed_today", "Tarefas concluídas desde o início do dia (UTC).", kpis.TasksCompletedToday)
+	gauge("todoit_open_tasks", "Tarefas não concluídas e não arquivadas.", kpis.OpenTasks)
+	gauge("todoit_overdue_tasks", "Tarefas abertas com vencimento no passado.", kpis.OverdueTasks)
+	gauge("todoit_overdue_ratio", "Proporção das tarefas abertas que estão atrasadas.", kpis.OverdueRatio)
+	gauge("todoit_kpis_computed_timestamp_seconds", "Quando os indicadores foram calculados.", kpis.ComputedAt.Unix())
+	b.WriteString("# EOF\n")
+
+	contentType := prometheusContentType
+	if strings.Contains(c.Get(fiber.HeaderAccept), "application/openmetrics-text") {
+		contentType = openMetricsContentType
+	}
+	c.Set(fiber.HeaderContentType, contentType)
+	c.Set(fiber.HeaderCacheControl, "no-store")
+
+	return c.SendString(b.String())
+}
@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"github.com/devgugga/todo-it/internal/database"
+	notificationres "github.com/devgugga/todo-it/internal/dtos/responses/notification"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NotificationHandler agrupa os handlers da caixa de entrada de notificações e
+// das entregas do webhook
+type NotificationHandler struct {
+	notifications repositories.NotificationRepository
+	deliveries    services.WebhookDeliveryService
+}
+
+// NewNotificationHandler cria uma nova instância do handler de notificações
+func NewNotificationHandler(notifications repositories.NotificationRepository, deliveries services.WebhookDeliveryService) *NotificationHandler {
+	return &NotificationHandler{notifications: notifications, deliveries: deliveries}
+}
+
+// SetupNotificationRoutes registra as rotas de notificações (requer autenticação)
+func SetupNotificationRoutes(router fiber.Router, db database.Client, deliveries services.WebhookDeliveryService) {
+	h := NewNotificationHandler(repositories.NewNotificationRepository(db), deliveries)
+
+	router.Get("/", h.List)
+	router.Post("/read-all", h.MarkAllRead)
+	router.Get("/webhook/deliveries", h.ListWebhookDeliveries)
+	router.Get("/webhook/deliveries/:id", h.GetWebhookDelivery)
+	router.Post("/webhook/deliveries/:id/redeliver", h.RedeliverWebhook)
+	router.Post("/:id/read", h.MarkRead)
+}
+
+// List lista a caixa de entrada (?unread=true filtra as não lidas)
+func (h *NotificationHandler) List(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	pagination, err := parsePagination(c, 20)
+	if err != nil {
+		return err
+	}
+
+	notifications, total, err := h.notifications.ListInbox(c.UserContext(), userID, pagination.Page, pagination.Limit, c.QueryBool("unread"))
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    notificationres.NewNotificationListResponse(notifications, total, pagination.Page, pagination.Limit),
+	})
+}
+
+// MarkRead marca uma notificação como lida
+func (h *NotificationHandler) MarkRead(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	if err := h.notifications.MarkRead(c.UserContext(), userID, id); err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// MarkAllRead marca todas as notificações como lidas
+func (h *NotificationHandler) MarkAllRead(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	updated, err := h.notifications.MarkAllRead(c.UserContext(), userID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    fiber.Map{"updated": updated},
+	})
+}
+
+// ListWebhookDeliveries lista as tentativas de entrega no webhook, sem o corpo enviado
+func (h *NotificationHandler) ListWebhookDeliveries(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	pagination, err := parsePagination(c, 20)
+	if err != nil {
+		return err
+	}
+
+	deliveries, total, err := h.deliveries.List(c.UserContext(), userID, pagination.Page, pagination.Limit)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    notificationres.NewWebhookDeliveryListResponse(deliveries, total, pagination.Page, pagination.Limit),
+	})
+}
+
+// GetWebhookDelivery retorna uma tentativa de entrega com o corpo enviado
+func (h *NotificationHandler) GetWebhookDelivery(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	delivery, err := h.deliveries.Get(c.UserContext(), userID, id)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    notificationres.NewWebhookDeliveryResponse(delivery),
+	})
+}
+
+// RedeliverWebhook reenvia uma entrega para a URL de webhook atual e retorna a
+// nova tentativa (success indica se o destino a aceitou)
+func (h *NotificationHandler) RedeliverWebhook(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	delivery, err := h.deliveries.Redeliver(c.UserContext(), userID, id)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    notificationres.NewWebhookDeliveryResponse(delivery),
+	})
+}
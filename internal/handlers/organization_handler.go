@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"github.com/devgugga/todo-it/internal/database"
+	orgreq "github.com/devgugga/todo-it/internal/dtos/requests/organization"
+	orgres "github.com/devgugga/todo-it/internal/dtos/responses/organization"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// OrganizationHandler agrupa os handlers das organizações: assentos, membros e uso
+type OrganizationHandler struct {
+	organizations services.OrganizationService
+}
+
+// NewOrganizationHandler cria uma nova instância do handler de organizações
+func NewOrganizationHandler(organizations services.OrganizationService) *OrganizationHandler {
+	return &OrganizationHandler{organizations: organizations}
+}
+
+// SetupOrganizationRoutes registra as rotas das organizações (requer autenticação)
+func SetupOrganizationRoutes(router fiber.Router, db database.Client) {
+	h := NewOrganizationHandler(services.NewOrganizationService(
+		repositories.NewOrganizationRepository(db),
+		repositories.NewOrgUsageRepository(db),
+		repositories.NewUserRepository(db),
+	))
+
+	router.Get("/", h.List)
+	router.Post("/", h.Create)
+	router.Get("/invites", h.ListInvites)
+	router.Get("/:id", h.GetByID)
+	router.Get("/:id/usage", h.Usage)
+	router.Post("/:id/invites", h.Invite)
+	router.Post("/:id/invites/accept", h.AcceptInvite)
+	router.Delete("/:id/invites", h.DeclineInvite)
+	router.Delete("/:id/members/:userId", h.RemoveMember)
+}
+
+// List lista as organizações de que o usuário é dono ou membro
+func (h *OrganizationHandler) List(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	organizations, err := h.organizations.List(c.UserContext(), userID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    orgres.NewOrganizationResponses(organizations),
+	})
+}
+
+// Create cria uma organização com o usuário como dono
+func (h *OrganizationHandler) Create(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var req orgreq.CreateOrganizationRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	organization, err := h.organizations.Create(c.UserContext(), userID, &req)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    orgres.NewOrganizationResponse(organization),
+	})
+}
+
+// GetByID busca uma organização com os assentos e os membros
+func (h *OrganizationHandler) GetByID(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	organization, err := h.organizations.Get(c.UserContext(), userID, id)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    orgres.NewOrganizationResponse(organization),
+	})
+}
+
+// Usage retorna os assentos ocupados, as tarefas criadas por membro e o espaço
+// ocupado pelos anexos (somente o dono), recalculados periodicamente
+func (h *OrganizationHandler) Usage(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	usage, err := h.organizations.Usage(c.UserContext(), userID, id)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    orgres.NewUsageResponse(usage),
+	})
+}
+
+// Invite convida um email para a organização (somente o dono). A resposta é a
+// mesma para emails com ou sem conta.
+func (h *OrganizationHandler) Invite(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	var req orgreq.InviteOrganizationMemberRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	if err := h.organizations.Invite(c.UserContext(), userID, id, &req); err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"success": true,
+		"message": "Convite enviado",
+	})
+}
+
+// ListInvites lista os convites pendentes para o email do usuário
+func (h *OrganizationHandler) ListInvites(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	organizations, email, err := h.organizations.Invites(c.UserContext(), userID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    orgres.NewInviteResponses(organizations, email),
+	})
+}
+
+// AcceptInvite aceita o convite e ocupa um assento da organização
+func (h *OrganizationHandler) AcceptInvite(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	organization, err := h.organizations.AcceptInvite(c.UserContext(), userID, id)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    orgres.NewOrganizationResponse(organization),
+	})
+}
+
+// DeclineInvite recusa o convite da organização
+func (h *OrganizationHandler) DeclineInvite(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	if err := h.organizations.DeclineInvite(c.UserContext(), userID, id); err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// RemoveMember libera o assento de um membro; o próprio membro pode sair
+func (h *OrganizationHandler) RemoveMember(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	memberID, err := parseIDParam(c, "userId")
+	if err != nil {
+		return err
+	}
+
+	if err := h.organizations.RemoveMember(c.UserContext(), userID, id, memberID); err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
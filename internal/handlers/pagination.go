@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	// defaultMaxPageLimit é o maior limit aceito até SetMaxPageLimit ser chamado
+	defaultMaxPageLimit = 100
+	// maxPage evita que (page-1)*limit estoure ao calcular o skip
+	maxPage = 1000000
+)
+
+// maxPageLimit é o maior limit aceito nas listagens (PAGINATION_MAX_LIMIT)
+var maxPageLimit atomic.Int64
+
+func init() {
+	maxPageLimit.Store(defaultMaxPageLimit)
+}
+
+// SetMaxPageLimit altera o maior limit aceito nas listagens paginadas
+func SetMaxPageLimit(limit int) {
+	if limit > 0 {
+		maxPageLimit.Store(int64(limit))
+	}
+}
+
+// Pagination é a página solicitada em uma listagem
+type Pagination struct {
+	Page  int64
+	Limit int64
+}
+
+// parsePagination lê page e limit da query string. page menor que 1 vira 1 e
+// limit fica entre 1 e o máximo configurado; valores não numéricos retornam 400.
+func parsePagination(c *fiber.Ctx, defaultLimit int64) (Pagination, error) {
+	maxLimit := maxPageLimit.Load()
+	pagination := Pagination{Page: 1, Limit: min(defaultLimit, maxLimit)}
+
+	if raw := c.Query("page"); raw != "" {
+		page, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return Pagination{}, fiber.NewError(fiber.StatusBadRequest, "page deve ser um número inteiro")
+		}
+		pagination.Page = min(max(page, 1), maxPage)
+	}
+
+	if raw := c.Query("limit"); raw != "" {
+		limit, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return Pagination{}, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("limit deve ser um número inteiro entre 1 e %d", maxLimit))
+		}
+		pagination.Limit = min(max(limit, 1), maxLimit)
+	}
+
+	return pagination, nil
+}
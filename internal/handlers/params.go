@@ -0,0 +1,16 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// parseIDParam lê o ObjectID do parâmetro de rota informado; IDs malformados
+// retornam 400
+func parseIDParam(c *fiber.Ctx, param string) (primitive.ObjectID, error) {
+	id, err := primitive.ObjectIDFromHex(c.Params(param))
+	if err != nil {
+		return primitive.NilObjectID, fiber.NewError(fiber.StatusBadRequest, "ID inválido")
+	}
+	return id, nil
+}
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"github.com/devgugga/todo-it/internal/database"
+	taskres "github.com/devgugga/todo-it/internal/dtos/responses/task"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// PDFExportHandler agrupa os handlers de exportação das tarefas em PDF
+type PDFExportHandler struct {
+	pdf   services.TaskPDFService
+	audit services.AuditService
+}
+
+// NewPDFExportHandler cria uma nova instância do handler de exportação em PDF
+func NewPDFExportHandler(pdf services.TaskPDFService, audit services.AuditService) *PDFExportHandler {
+	return &PDFExportHandler{pdf: pdf, audit: audit}
+}
+
+// newPDFExportHandler monta o handler com os repositórios do banco
+func newPDFExportHandler(db database.Client) *PDFExportHandler {
+	pdf := services.NewTaskPDFService(
+		repositories.NewTodoRepository(db),
+		repositories.NewProjectRepository(db),
+		repositories.NewUserRepository(db),
+		repositories.NewExportJobRepository(db),
+	)
+	return NewPDFExportHandler(pdf, services.NewAuditService(repositories.NewAuditLogRepository(db)))
+}
+
+// ExportProject exporta as tarefas do projeto em PDF (dono ou membro)
+func (h *PDFExportHandler) ExportProject(c *fiber.Ctx) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	return h.export(c, entities.ExportQuery{ProjectID: &id})
+}
+
+// ExportTasks exporta em PDF a lista de tarefas com os filtros da listagem
+// (status, priority, tags, search e project_id)
+func (h *PDFExportHandler) ExportTasks(c *fiber.Ctx) error {
+	filters, err := parseTaskFilters(c)
+	if err != nil {
+		return err
+	}
+
+	return h.export(c, entities.ExportQuery{
+		ProjectID: filters.ProjectID,
+		Status:    filters.Status,
+		Priority:  filters.Priority,
+		Tags:      filters.Tags,
+		Search:    filters.Search,
+	})
+}
+
+// export envia o PDF ou, para listas grandes, responde 202 com a exportação
+// a acompanhar em /todos/exports/:id
+func (h *PDFExportHandler) export(c *fiber.Ctx, query entities.ExportQuery) error {
+	userID, _ := middleware.GetUserID(c)
+
+	export, err := h.pdf.Export(c.UserContext(), userID, query)
+
+	entry := newAuditEntry(c, entities.AuditActionDataExport, err)
+	if err == nil {
+		entry.Details = map[string]interface{}{
+			"format": services.ExportFormatPDF,
+			"queued": export.Job != nil,
+		}
+		if query.ProjectID != nil {
+			entry.Details["project_id"] = query.ProjectID.Hex()
+		}
+	}
+	h.audit.Record(c.UserContext(), entry)
+
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	if export.Job != nil {
+		return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+			"success": true,
+			"data":    taskres.NewExportJobResponse(export.Job),
+		})
+	}
+
+	return sendPDF(c, export.FileName, export.File)
+}
+
+// GetJob retorna o andamento de uma exportação
+func (h *PDFExportHandler) GetJob(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	job, err := h.pdf.Job(c.UserContext(), userID, id)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    taskres.NewExportJobResponse(job),
+	})
+}
+
+// Download envia o arquivo de uma exportação concluída
+func (h *PDFExportHandler) Download(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	job, err := h.pdf.Download(c.UserContext(), userID, id)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return sendPDF(c, job.FileName, job.File)
+}
+
+// sendPDF envia o PDF como anexo
+func sendPDF(c *fiber.Ctx, fileName string, file []byte) error {
+	c.Attachment(fileName)
+	c.Set(fiber.HeaderContentType, "application/pdf")
+	return c.Send(file)
+}
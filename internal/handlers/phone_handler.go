@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	userreq "github.com/devgugga/todo-it/internal/dtos/requests/user"
+	userres "github.com/devgugga/todo-it/internal/dtos/responses/user"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// PhoneHandler agrupa os handlers do telefone usado nos lembretes por SMS
+type PhoneHandler struct {
+	phones services.PhoneService
+	audit  services.AuditService
+}
+
+// NewPhoneHandler cria uma nova instância do handler de telefone
+func NewPhoneHandler(phones services.PhoneService, audit services.AuditService) *PhoneHandler {
+	return &PhoneHandler{phones: phones, audit: audit}
+}
+
+// Get retorna o telefone cadastrado e o uso da cota de SMS no mês
+func (h *PhoneHandler) Get(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	phone, usage, err := h.phones.Get(c.UserContext(), userID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    userres.NewPhoneResponse(phone, usage),
+	})
+}
+
+// StartVerification cadastra o número e envia o código de verificação por SMS
+func (h *PhoneHandler) StartVerification(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var req userreq.StartPhoneVerificationRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	phone, err := h.phones.StartVerification(c.UserContext(), userID, req.Phone)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"success": true,
+		"data":    userres.NewPhoneResponse(phone, nil),
+	})
+}
+
+// ConfirmVerification confirma o número com o código recebido
+func (h *PhoneHandler) ConfirmVerification(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var req userreq.ConfirmPhoneVerificationRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	phone, err := h.phones.ConfirmVerification(c.UserContext(), userID, req.Code)
+	h.audit.Record(c.UserContext(), newAuditEntry(c, entities.AuditActionPhoneVerify, err))
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    userres.NewPhoneResponse(phone, nil),
+	})
+}
+
+// Remove apaga o telefone e desabilita os lembretes por SMS
+func (h *PhoneHandler) Remove(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	err := h.phones.Remove(c.UserContext(), userID)
+	h.audit.Record(c.UserContext(), newAuditEntry(c, entities.AuditActionPhoneRemove, err))
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	planres "github.com/devgugga/todo-it/internal/dtos/responses/plan"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/quota"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// PlanHandler agrupa os handlers dos planos de uso
+type PlanHandler struct {
+	plans  services.PlanService
+	quotas quota.Quota
+}
+
+// NewPlanHandler cria uma nova instância do handler de planos
+func NewPlanHandler(plans services.PlanService, quotas quota.Quota) *PlanHandler {
+	return &PlanHandler{plans: plans, quotas: quotas}
+}
+
+// SetupPlanRoutes registra as rotas dos planos (requer autenticação e middleware.AttachPlan)
+func SetupPlanRoutes(router fiber.Router, plans services.PlanService, quotas quota.Quota) {
+	h := NewPlanHandler(plans, quotas)
+
+	router.Get("/", h.List)
+	router.Get("/current", h.Current)
+}
+
+// List lista os planos disponíveis e os seus limites
+func (h *PlanHandler) List(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    planres.NewPlanListResponse(h.plans.Catalog()),
+	})
+}
+
+// Current retorna o plano efetivo do usuário, a assinatura e o uso de cada cota do plano
+func (h *PlanHandler) Current(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+	ctx := c.UserContext()
+
+	plan, ok := middleware.GetPlan(c)
+	if !ok {
+		var err error
+		if plan, err = h.plans.Effective(ctx, userID); err != nil {
+			return handleServiceError(err)
+		}
+	}
+
+	subscription, err := h.plans.Subscription(ctx, userID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	usage := make([]*quota.Usage, 0, len(plan.Limits))
+	for resource := range plan.Limits {
+		item, err := h.quotas.Usage(ctx, userID, resource)
+		if err != nil {
+			return handleServiceError(err)
+		}
+		usage = append(usage, item)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    planres.NewCurrentPlanResponse(plan, subscription, usage),
+	})
+}
@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"github.com/devgugga/todo-it/internal/database"
+	projectreq "github.com/devgugga/todo-it/internal/dtos/requests/project"
+	projectres "github.com/devgugga/todo-it/internal/dtos/responses/project"
+	taskres "github.com/devgugga/todo-it/internal/dtos/responses/task"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ProjectHandler agrupa os handlers de projetos
+type ProjectHandler struct {
+	projects services.ProjectService
+	rollups  services.ProjectRollupService
+}
+
+// NewProjectHandler cria uma nova instância do handler de projetos
+func NewProjectHandler(projects services.ProjectService, rollups services.ProjectRollupService) *ProjectHandler {
+	return &ProjectHandler{projects: projects, rollups: rollups}
+}
+
+// SetupProjectRoutes registra as rotas de projetos e do seu compartilhamento (requer autenticação)
+func SetupProjectRoutes(router fiber.Router, db database.Client, bus events.Bus) {
+	projectRepo := repositories.NewProjectRepository(db)
+	todos := repositories.NewTodoRepository(db)
+	users := repositories.NewUserRepository(db)
+	tasks := services.NewTaskService(todos, users, projectRepo, repositories.NewTaskArchiveRepository(db), repositories.NewAttachmentRepository(db), repositories.NewLabelRepository(db), bus)
+
+	rollups := services.NewProjectRollupService(projectRepo, todos)
+	rollups.Subscribe(bus)
+	h := NewProjectHandler(services.NewProjectService(projectRepo, todos, repositories.NewTaskChangeRepository(db)), rollups)
+	members := NewProjectMemberHandler(services.NewProjectMemberService(projectRepo, users, tasks))
+
+	router.Get("/", h.List)
+	router.Post("/", h.Create)
+	router.Get("/:id", h.GetByID)
+	router.Put("/:id", h.Update)
+	router.Delete("/:id", h.Delete)
+	router.Get("/:id/activity", h.Activity)
+	router.Get("/:id/rollup", h.Rollup)
+	router.Get("/:id/export.pdf", bulkRateLimit, exportDeadline, newPDFExportHandler(db).ExportProject)
+
+	router.Get("/:id/members", members.List)
+	router.Post("/:id/members", members.Add)
+	router.Put("/:id/members/:userId", members.UpdateRole)
+	router.Delete("/:id/members/:userId", members.Remove)
+	router.Get("/:id/tasks", members.Tasks)
+	router.Get("/:id/tasks/:taskId", members.GetTask)
+	router.Put("/:id/tasks/:taskId", members.UpdateTask)
+	router.Patch("/:id/tasks/:taskId", members.UpdateTask)
+	router.Patch("/:id/tasks/:taskId/status", members.UpdateTaskStatus)
+}
+
+// List lista os projetos do usuário
+func (h *ProjectHandler) List(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	projects, err := h.projects.List(c.UserContext(), userID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    projectres.NewProjectResponses(projects),
+	})
+}
+
+// Create cria um novo projeto
+func (h *ProjectHandler) Create(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var req projectreq.CreateProjectRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	project, err := h.projects.Create(c.UserContext(), userID, &req)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    projectres.NewProjectResponse(project),
+	})
+}
+
+// GetByID busca um projeto por ID
+func (h *ProjectHandler) GetByID(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	project, err := h.projects.GetByID(c.UserContext(), userID, id)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    projectres.NewProjectResponse(project),
+	})
+}
+
+// Update atualiza um projeto
+func (h *ProjectHandler) Update(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	var req projectreq.UpdateProjectRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	project, err := h.projects.Update(c.UserContext(), userID, id, &req)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    projectres.NewProjectResponse(project),
+	})
+}
+
+// Delete remove um projeto (as tarefas ficam sem projeto)
+func (h *ProjectHandler) Delete(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	if err := h.projects.Delete(c.UserContext(), userID, id); err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Activity lista o histórico das tarefas do projeto, do mais recente para o mais antigo
+func (h *ProjectHandler) Activity(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	pagination, err := parsePagination(c, 20)
+	if err != nil {
+		return err
+	}
+
+	changes, total, err := h.projects.Activity(c.UserContext(), userID, id, pagination.Page, pagination.Limit)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    taskres.NewTaskActivityResponse(changes, total, pagination.Page, pagination.Limit),
+	})
+}
+
+// Rollup retorna o resumo das tarefas do projeto: abertas, atrasadas,
+// estimativa restante e percentual concluído. O resumo gravado é servido por
+// alguns segundos; ?fresh=true força o recálculo.
+func (h *ProjectHandler) Rollup(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	rollup, err := h.rollups.Get(c.UserContext(), userID, id, c.QueryBool("fresh"))
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    projectres.NewRollupResponse(rollup),
+	})
+}
@@ -0,0 +1,232 @@
+package handlers
+
+import (
+	projectreq "github.com/devgugga/todo-it/internal/dtos/requests/project"
+	taskreq "github.com/devgugga/todo-it/internal/dtos/requests/task"
+	projectres "github.com/devgugga/todo-it/internal/dtos/responses/project"
+	taskres "github.com/devgugga/todo-it/internal/dtos/responses/task"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ProjectMemberHandler agrupa os handlers do compartilhamento de projetos:
+// membros e acesso às tarefas do projeto
+type ProjectMemberHandler struct {
+	members services.ProjectMemberService
+}
+
+// NewProjectMemberHandler cria uma nova instância do handler de membros
+func NewProjectMemberHandler(members services.ProjectMemberService) *ProjectMemberHandler {
+	return &ProjectMemberHandler{members: members}
+}
+
+// List lista quem tem acesso ao projeto: o dono e os membros com os seus papéis
+func (h *ProjectMemberHandler) List(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	project, err := h.members.Members(c.UserContext(), userID, id)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    projectres.NewMemberResponses(project),
+	})
+}
+
+// Add compartilha o projeto com o usuário do email informado (somente o dono)
+func (h *ProjectMemberHandler) Add(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	var req projectreq.AddProjectMemberRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	member, err := h.members.AddMember(c.UserContext(), userID, id, &req)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    projectres.NewMemberResponse(member),
+	})
+}
+
+// UpdateRole altera o papel de um membro (somente o dono)
+func (h *ProjectMemberHandler) UpdateRole(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	memberID, err := parseIDParam(c, "userId")
+	if err != nil {
+		return err
+	}
+
+	var req projectreq.UpdateProjectMemberRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	member, err := h.members.SetRole(c.UserContext(), userID, id, memberID, req.Role)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    projectres.NewMemberResponse(member),
+	})
+}
+
+// Remove remove um membro do projeto; o próprio membro pode sair
+func (h *ProjectMemberHandler) Remove(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	memberID, err := parseIDParam(c, "userId")
+	if err != nil {
+		return err
+	}
+
+	if err := h.members.RemoveMember(c.UserContext(), userID, id, memberID); err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Tasks lista as tarefas do projeto
+func (h *ProjectMemberHandler) Tasks(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	pagination, err := parsePagination(c, 20)
+	if err != nil {
+		return err
+	}
+
+	tasks, total, err := h.members.Tasks(c.UserContext(), userID, id, pagination.Page, pagination.Limit)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    taskres.NewTaskListResponse(tasks, total, pagination.Page, pagination.Limit),
+	})
+}
+
+// GetTask busca uma tarefa do projeto
+func (h *ProjectMemberHandler) GetTask(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	taskID, err := parseIDParam(c, "taskId")
+	if err != nil {
+		return err
+	}
+
+	task, err := h.members.GetTask(c.UserContext(), userID, id, taskID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    taskres.NewTaskResponse(task),
+	})
+}
+
+// UpdateTask altera uma tarefa do projeto (dono ou editor)
+func (h *ProjectMemberHandler) UpdateTask(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	taskID, err := parseIDParam(c, "taskId")
+	if err != nil {
+		return err
+	}
+
+	var req taskreq.UpdateTaskRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	task, err := h.members.UpdateTask(c.UserContext(), userID, id, taskID, &req)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    taskres.NewTaskResponse(task),
+	})
+}
+
+// UpdateTaskStatus altera o status de uma tarefa do projeto (dono ou editor)
+func (h *ProjectMemberHandler) UpdateTaskStatus(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	taskID, err := parseIDParam(c, "taskId")
+	if err != nil {
+		return err
+	}
+
+	var req taskreq.UpdateTaskStatusRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	// Status personalizados são de cada usuário; as tarefas do projeto são do dono
+	if req.CustomStatusID != "" {
+		return fiber.NewError(fiber.StatusBadRequest, "custom_status_id não é aceito em tarefas de projetos compartilhados")
+	}
+
+	if err := h.members.UpdateTaskStatus(c.UserContext(), userID, id, taskID, req.Status); err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Status atualizado com sucesso",
+	})
+}
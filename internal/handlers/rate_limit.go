@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"sync/atomic"
+
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+// rateLimiter é o limitador das rotas pesadas (nil até SetRateLimiter = sem limite)
+var rateLimiter atomic.Pointer[middleware.RateLimiter]
+
+// SetRateLimiter define o limitador cuja classe middleware.RateLimitBulk é
+// aplicada às operações em lote, exportações e importações
+func SetRateLimiter(limiter *middleware.RateLimiter) {
+	rateLimiter.Store(limiter)
+}
+
+// bulkRateLimit aplica às rotas pesadas o bucket de lote, além do bucket de
+// leitura ou escrita do grupo
+func bulkRateLimit(c *fiber.Ctx) error {
+	if limiter := rateLimiter.Load(); limiter != nil {
+		return limiter.Limit(middleware.RateLimitBulk)(c)
+	}
+	return c.Next()
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	// streamBufferSize limita os eventos pendentes por conexão; com o cliente
+	// lento os excedentes são descartados (ele pode se ressincronizar por GET /todos/changes)
+	streamBufferSize = 64
+	// streamHeartbeat é o intervalo dos comentários que mantêm a conexão viva
+	// em proxies e detectam clientes desconectados
+	streamHeartbeat = 15 * time.Second
+	// streamRetry é o tempo (ms) sugerido ao EventSource para reconectar
+	streamRetry = 3000
+)
+
+// StreamHandler envia ao cliente, via Server-Sent Events, os eventos das suas tarefas
+type StreamHandler struct {
+	bus events.Bus
+	// window é a duração máxima de cada conexão (0 = sem limite)
+	window time.Duration
+}
+
+// NewStreamHandler cria uma nova instância do handler de eventos
+func NewStreamHandler(bus events.Bus, window time.Duration) *StreamHandler {
+	return &StreamHandler{bus: bus, window: window}
+}
+
+// SetupStreamRoutes registra o stream de eventos (requer autenticação).
+// O fasthttp aplica o WriteTimeout do servidor à resposta inteira, então cada
+// conexão é encerrada antes dele e o cliente reconecta (retry do SSE).
+func SetupStreamRoutes(router fiber.Router, bus events.Bus, writeTimeout time.Duration) {
+	h := NewStreamHandler(bus, writeTimeout*9/10)
+
+	router.Get("/", h.Stream)
+}
+
+// Stream mantém a conexão aberta enviando os eventos task.* do usuário,
+// inclusive os publicados por outras instâncias (barramento Redis)
+func (h *StreamHandler) Stream(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	queue := make(chan events.Event, streamBufferSize)
+	unsubscribe := h.bus.Subscribe(events.AllEvents, func(_ context.Context, event events.Event) {
+		if event.UserID != userID || !strings.HasPrefix(event.Type, "task.") {
+			return
+		}
+
+		select {
+		case queue <- event:
+		default:
+			slog.Warn("evento descartado no stream: cliente lento", "user_id", userID.Hex(), "event_type", event.Type)
+		}
+	}, events.IncludeRemote())
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	// Desativa o buffer de proxies (nginx), que atrasaria os eventos
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer unsubscribe()
+
+		var deadline <-chan time.Time
+		if h.window > 0 {
+			timer := time.NewTimer(h.window)
+			defer timer.Stop()
+			deadline = timer.C
+		}
+
+		heartbeat := time.NewTicker(streamHeartbeat)
+		defer heartbeat.Stop()
+
+		fmt.Fprintf(w, "retry: %d\n\n", streamRetry)
+		if err := w.Flush(); err != nil {
+			return
+		}
+
+		for {
+			select {
+			case event := <-queue:
+				if err := writeStreamEvent(w, event); err != nil {
+					slog.Warn("erro ao serializar evento do stream", "event_id", event.ID, "error", err)
+					continue
+				}
+			case <-heartbeat.C:
+				fmt.Fprint(w, ": ping\n\n")
+			case <-deadline:
+				return
+			}
+
+			// Falha no flush indica que o cliente desconectou
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	})
+
+	return nil
+}
+
+// writeStreamEvent escreve o evento no formato SSE (id, event e data em JSON)
+func writeStreamEvent(w *bufio.Writer, event events.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	return nil
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/devgugga/todo-it/internal/database"
+	taskreq "github.com/devgugga/todo-it/internal/dtos/requests/task"
+	taskres "github.com/devgugga/todo-it/internal/dtos/responses/task"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// SyncHandler agrupa os handlers da sincronização de clientes offline
+type SyncHandler struct {
+	sync services.SyncService
+}
+
+// NewSyncHandler cria uma nova instância do handler de sincronização
+func NewSyncHandler(sync services.SyncService) *SyncHandler {
+	return &SyncHandler{sync: sync}
+}
+
+// SetupSyncRoutes registra as rotas de sincronização (requer autenticação)
+func SetupSyncRoutes(router fiber.Router, db database.Client, bus events.Bus) {
+	attachments := repositories.NewAttachmentRepository(db)
+	todos := repositories.NewTodoRepository(db)
+	tasks := services.NewTaskService(todos, repositories.NewUserRepository(db), repositories.NewProjectRepository(db), repositories.NewTaskArchiveRepository(db), attachments, repositories.NewLabelRepository(db), bus)
+	h := NewSyncHandler(services.NewSyncService(tasks, todos, repositories.NewTombstoneRepository(db)))
+
+	router.Get("/", h.Pull)
+	router.Post("/", h.Push)
+	router.Get("/deleted", h.Deleted)
+}
+
+// Pull retorna as tarefas alteradas e excluídas desde ?since= (cursor da
+// resposta anterior; vazio faz a sincronização completa)
+func (h *SyncHandler) Pull(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	limit := int64(c.QueryInt("limit", services.MaxSyncTasks))
+	if limit < 1 || limit > services.MaxSyncTasks {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("limit deve estar entre 1 e %d", services.MaxSyncTasks))
+	}
+
+	page, err := h.sync.Pull(c.UserContext(), userID, c.Query("since"), limit)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    taskres.NewSyncPullResponse(page.Tasks, page.DeletedTaskIDs, page.Reset, page.Cursor, page.HasMore, page.ServerTime),
+	})
+}
+
+// Push aplica as alterações feitas offline e retorna o resultado de cada uma
+func (h *SyncHandler) Push(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var req taskreq.SyncRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	results, err := h.sync.Push(c.UserContext(), userID, req.Changes)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	data := make([]taskres.SyncResultResponse, 0, len(results))
+	for _, result := range results {
+		item := taskres.SyncResultResponse{ID: result.ID.Hex(), Op: result.Op, Status: result.Status}
+		if result.Err != nil {
+			item.Error = result.Err.Error()
+		}
+		if result.Task != nil {
+			item.Task = taskres.NewTaskResponse(result.Task)
+		}
+		data = append(data, item)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    fiber.Map{"results": data},
+	})
+}
+
+// Deleted retorna as exclusões de ?type= (task ou project) desde ?since=,
+// para integrações que consultam por polling (ex.: Zapier)
+func (h *SyncHandler) Deleted(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	entityType := c.Query("type", entities.TombstoneTask)
+	if entityType != entities.TombstoneTask && entityType != entities.TombstoneProject {
+		return fiber.NewError(fiber.StatusBadRequest, "type deve ser task ou project")
+	}
+
+	page, err := h.sync.Deleted(c.UserContext(), userID, entityType, c.Query("since"))
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    taskres.NewTombstonesResponse(page.Tombstones, page.Reset, page.Cursor, page.ServerTime),
+	})
+}
@@ -0,0 +1,813 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/auth"
+	"github.com/devgugga/todo-it/internal/database"
+	taskreq "github.com/devgugga/todo-it/internal/dtos/requests/task"
+	taskres "github.com/devgugga/todo-it/internal/dtos/responses/task"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/exporter"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// TaskHandler agrupa os handlers de tarefas
+type TaskHandler struct {
+	tasks       services.TaskService
+	audit       services.AuditService
+	attachments repositories.AttachmentRepository
+	changes     services.TaskChangeService
+	users       repositories.UserRepository
+	stats       services.TaskStatsService
+}
+
+// NewTaskHandler cria uma nova instância do handler de tarefas
+func NewTaskHandler(tasks services.TaskService, audit services.AuditService, attachments repositories.AttachmentRepository, changes services.TaskChangeService, users repositories.UserRepository, stats services.TaskStatsService) *TaskHandler {
+	return &TaskHandler{tasks: tasks, audit: audit, attachments: attachments, changes: changes, users: users, stats: stats}
+}
+
+// SetupTodoRoutes registra as rotas de tarefas (requer autenticação)
+func SetupTodoRoutes(router fiber.Router, db database.Client, bus events.Bus, signer *auth.URLSigner) {
+	attachments := repositories.NewAttachmentRepository(db)
+	todos := repositories.NewTodoRepository(db)
+	tasks := services.NewTaskService(todos, repositories.NewUserRepository(db), repositories.NewProjectRepository(db), repositories.NewTaskArchiveRepository(db), attachments, repositories.NewLabelRepository(db), bus)
+	audit := services.NewAuditService(repositories.NewAuditLogRepository(db))
+	changes := services.NewTaskChangeService(repositories.NewTaskChangeRepository(db), todos)
+	stats := services.NewTaskStatsService(todos)
+	stats.Subscribe(bus)
+	h := NewTaskHandler(tasks, audit, attachments, changes, repositories.NewUserRepository(db), stats)
+	pdf := newPDFExportHandler(db)
+	files := newFileHandler(db, tasks, signer)
+
+	router.Get("/", h.List)
+	router.Post("/", h.Create)
+	router.Get("/stats", h.GetStats)
+	router.Get("/stats/completed", h.GetCompletedSeries)
+	router.Get("/overdue", h.GetOverdue)
+	router.Get("/nearby", h.GetNearby)
+	router.Get("/suggest", h.Suggest)
+	router.Get("/export", bulkRateLimit, exportDeadline, h.Export)
+	router.Get("/export.pdf", bulkRateLimit, exportDeadline, pdf.ExportTasks)
+	router.Get("/exports/:id", pdf.GetJob)
+	router.Get("/exports/:id/download", pdf.Download)
+	router.Post("/exports/:id/link", files.ExportLink)
+	router.Get("/changes", h.Changes)
+	router.Post("/bulk/status", bulkRateLimit, h.BulkUpdateStatus)
+	router.Post("/bulk/delete", bulkRateLimit, h.BulkDelete)
+	router.Patch("/reorder", h.Reorder)
+	router.Get("/:id", h.GetByID)
+	router.Get("/:id/occurrences", h.GetOccurrences)
+	router.Get("/:id/attachments/:attachmentId", h.DownloadAttachment)
+	router.Post("/:id/attachments/:attachmentId/link", files.AttachmentLink)
+	router.Put("/:id", h.Update)
+	router.Patch("/:id", h.Update)
+	router.Patch("/:id/status", h.UpdateStatus)
+	router.Post("/:id/complete", h.Complete)
+	router.Post("/:id/reopen", h.Reopen)
+	router.Post("/:id/snooze", h.Snooze)
+	router.Patch("/:id/checklist/:itemId", h.UpdateChecklistItem)
+	router.Delete("/:id", h.Delete)
+}
+
+// List lista as tarefas do usuário
+func (h *TaskHandler) List(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	pagination, err := parsePagination(c, 20)
+	if err != nil {
+		return err
+	}
+
+	filters, err := parseTaskFilters(c)
+	if err != nil {
+		return err
+	}
+
+	tasks, total, err := h.tasks.List(c.UserContext(), userID, pagination.Page, pagination.Limit, filters)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    taskres.NewTaskListResponse(tasks, total, pagination.Page, pagination.Limit),
+	})
+}
+
+// Create cria uma nova tarefa
+func (h *TaskHandler) Create(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var req taskreq.CreateTaskRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	task, err := h.tasks.Create(c.UserContext(), userID, &req)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    taskres.NewTaskResponse(task),
+	})
+}
+
+// GetByID busca uma tarefa por ID
+func (h *TaskHandler) GetByID(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	task, err := h.tasks.GetByID(c.UserContext(), userID, id)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    taskres.NewTaskResponse(task),
+	})
+}
+
+// DownloadAttachment envia o conteúdo de um anexo da tarefa
+func (h *TaskHandler) DownloadAttachment(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	attachmentID, err := parseIDParam(c, "attachmentId")
+	if err != nil {
+		return err
+	}
+
+	task, err := h.tasks.GetByID(c.UserContext(), userID, id)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	var attachment *entities.TaskAttachment
+	for i := range task.Attachments {
+		if task.Attachments[i].ID == attachmentID {
+			attachment = &task.Attachments[i]
+			break
+		}
+	}
+	if attachment == nil {
+		return fiber.NewError(fiber.StatusNotFound, "Anexo não encontrado")
+	}
+
+	content, err := h.attachments.Open(c.UserContext(), task.UserID, attachment.ID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrAttachmentNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "Anexo não encontrado")
+		}
+		return err
+	}
+
+	c.Attachment(attachment.Filename)
+	c.Set(fiber.HeaderContentType, attachment.ContentType)
+	return c.SendStream(content, int(attachment.Size))
+}
+
+// Update atualiza os campos enviados de uma tarefa (PUT ou PATCH)
+func (h *TaskHandler) Update(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	var req taskreq.UpdateTaskRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	task, err := h.tasks.Update(c.UserContext(), userID, id, &req)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    taskres.NewTaskResponse(task),
+	})
+}
+
+// UpdateChecklistItem marca ou desmarca um item da lista de verificação da tarefa
+func (h *TaskHandler) UpdateChecklistItem(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	itemID, err := parseIDParam(c, "itemId")
+	if err != nil {
+		return err
+	}
+
+	var req taskreq.UpdateChecklistItemRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	task, err := h.tasks.SetChecklistItemDone(c.UserContext(), userID, id, itemID, *req.Done)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    taskres.NewTaskResponse(task),
+	})
+}
+
+// UpdateStatus atualiza o status de uma tarefa
+func (h *TaskHandler) UpdateStatus(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	var req taskreq.UpdateTaskStatusRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	if req.CustomStatusID != "" {
+		statusID, _ := primitive.ObjectIDFromHex(req.CustomStatusID)
+		err = h.tasks.SetCustomStatus(c.UserContext(), userID, id, statusID)
+	} else {
+		err = h.tasks.UpdateStatus(c.UserContext(), userID, id, req.Status)
+	}
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Status atualizado com sucesso",
+	})
+}
+
+// Complete conclui uma tarefa; repetir a chamada não altera a tarefa já concluída
+func (h *TaskHandler) Complete(c *fiber.Ctx) error {
+	return h.setCompleted(c, h.tasks.Complete)
+}
+
+// Reopen volta uma tarefa concluída para pendente; repetir a chamada não altera
+// a tarefa já reaberta
+func (h *TaskHandler) Reopen(c *fiber.Ctx) error {
+	return h.setCompleted(c, h.tasks.Reopen)
+}
+
+// setCompleted aplica a conclusão ou reabertura e responde a tarefa no estado final
+func (h *TaskHandler) setCompleted(c *fiber.Ctx, apply func(context.Context, primitive.ObjectID, primitive.ObjectID) (*entities.Task, error)) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	task, err := apply(c.UserContext(), userID, id)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    taskres.NewTaskResponse(task),
+	})
+}
+
+// Snooze adia o vencimento da tarefa para amanhã, o próximo dia útil ou a
+// próxima semana
+func (h *TaskHandler) Snooze(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	var req taskreq.SnoozeTaskRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	task, err := h.tasks.Snooze(c.UserContext(), userID, id, req.Preset)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    taskres.NewTaskResponse(task),
+	})
+}
+
+// Delete remove uma tarefa
+func (h *TaskHandler) Delete(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	if err := h.tasks.Delete(c.UserContext(), userID, id); err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// BulkUpdateStatus atualiza o status de várias tarefas. IDs inexistentes ou
+// sem permissão são ignorados; a resposta informa quantas foram alteradas.
+func (h *TaskHandler) BulkUpdateStatus(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var req taskreq.BulkUpdateStatusRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+	if err := checkBulkIDs(req.IDs); err != nil {
+		return err
+	}
+
+	updated, err := h.tasks.BulkUpdateStatus(c.UserContext(), userID, req.ObjectIDs(), req.Status)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    fiber.Map{"updated": updated},
+	})
+}
+
+// Reorder grava a ordem arrastada pelo usuário nas tarefas de uma coluna e
+// retorna as novas posições
+func (h *TaskHandler) Reorder(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var req taskreq.ReorderTasksRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+	if err := checkBulkIDs(req.IDs); err != nil {
+		return err
+	}
+
+	positions, err := h.tasks.Reorder(c.UserContext(), userID, req.ObjectIDs())
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    fiber.Map{"positions": positions},
+	})
+}
+
+// BulkDelete remove várias tarefas. IDs inexistentes ou sem permissão são
+// ignorados; a resposta informa quantas foram removidas.
+func (h *TaskHandler) BulkDelete(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var req taskreq.BulkDeleteRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+	if err := checkBulkIDs(req.IDs); err != nil {
+		return err
+	}
+
+	deleted, err := h.tasks.BulkDelete(c.UserContext(), userID, req.ObjectIDs())
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    fiber.Map{"deleted": deleted},
+	})
+}
+
+// GetStats retorna as estatísticas de tarefas do usuário. Os valores ficam em
+// cache por alguns segundos; ?fresh=true força o recálculo.
+func (h *TaskHandler) GetStats(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	stats, err := h.stats.Get(c.UserContext(), userID, c.QueryBool("fresh"))
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    stats,
+	})
+}
+
+// GetCompletedSeries retorna as tarefas concluídas por dia ou semana
+// (?granularity=day|week) no período ?range=Nd (padrão 90d), no fuso do usuário
+func (h *TaskHandler) GetCompletedSeries(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	granularity := services.SeriesGranularity(c.Query("granularity", string(services.GranularityDay)))
+	if granularity != services.GranularityDay && granularity != services.GranularityWeek {
+		return fiber.NewError(fiber.StatusBadRequest, "granularity deve ser day ou week")
+	}
+
+	days, err := parseRangeDays(c.Query("range"))
+	if err != nil {
+		return err
+	}
+
+	user, err := h.users.GetByID(c.UserContext(), userID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	series, err := h.stats.CompletedSeries(c.UserContext(), userID, granularity, days, user.Preferences.Location())
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    series,
+	})
+}
+
+// GetOverdue retorna as tarefas atrasadas do usuário
+func (h *TaskHandler) GetOverdue(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	tasks, err := h.tasks.GetOverdue(c.UserContext(), userID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    taskres.NewTaskResponses(tasks),
+	})
+}
+
+const (
+	// defaultNearbyRadius é o raio padrão, em metros, das tarefas próximas
+	defaultNearbyRadius = 1000
+	// maxNearbyRadius é o maior raio aceito, em metros
+	maxNearbyRadius = 50000
+	// maxNearbyTasks limita as tarefas próximas retornadas
+	maxNearbyTasks = 100
+)
+
+const (
+	// maxSuggestQueryLength limita o texto digitado nas sugestões
+	maxSuggestQueryLength = 50
+	// maxSuggestions limita as sugestões de cada tipo (títulos e etiquetas)
+	maxSuggestions = 20
+)
+
+// Suggest retorna sugestões de títulos e etiquetas para o texto ?q= digitado
+// na busca (typeahead)
+func (h *TaskHandler) Suggest(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	query := c.Query("q")
+	if strings.TrimSpace(query) == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "q é obrigatório")
+	}
+	if len([]rune(query)) > maxSuggestQueryLength {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("q deve ter no máximo %d caracteres", maxSuggestQueryLength))
+	}
+
+	limit := c.QueryInt("limit", 10)
+	if limit < 1 || limit > maxSuggestions {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("limit deve estar entre 1 e %d", maxSuggestions))
+	}
+
+	suggestions, err := h.tasks.Suggest(c.UserContext(), userID, query, int64(limit))
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    suggestions,
+	})
+}
+
+// maxOccurrences limita as ocorrências calculadas na prévia da recorrência
+const maxOccurrences = 100
+
+// GetOccurrences retorna as próximas ?count= ocorrências (padrão 10) da tarefa
+// recorrente, sem criá-las
+func (h *TaskHandler) GetOccurrences(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	count := c.QueryInt("count", 10)
+	if count < 1 || count > maxOccurrences {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("count deve estar entre 1 e %d", maxOccurrences))
+	}
+
+	occurrences, err := h.tasks.Occurrences(c.UserContext(), userID, id, count)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    fiber.Map{"occurrences": occurrences},
+	})
+}
+
+// GetNearby retorna as tarefas com local a até ?radius= metros de ?lat=&lng=,
+// da mais próxima para a mais distante. Sem ?status= só as em aberto.
+func (h *TaskHandler) GetNearby(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	lat, err := strconv.ParseFloat(c.Query("lat"), 64)
+	if err != nil || !(lat >= -90 && lat <= 90) {
+		return fiber.NewError(fiber.StatusBadRequest, "lat deve ser uma latitude entre -90 e 90")
+	}
+
+	lng, err := strconv.ParseFloat(c.Query("lng"), 64)
+	if err != nil || !(lng >= -180 && lng <= 180) {
+		return fiber.NewError(fiber.StatusBadRequest, "lng deve ser uma longitude entre -180 e 180")
+	}
+
+	radius := c.QueryFloat("radius", defaultNearbyRadius)
+	if !(radius > 0 && radius <= maxNearbyRadius) {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("radius deve estar entre 1 e %d metros", maxNearbyRadius))
+	}
+
+	status := enums.TaskStatus(c.Query("status"))
+	if status != "" && !slices.Contains(enums.GetAllStatuses(), status) {
+		return fiber.NewError(fiber.StatusBadRequest, "status inválido")
+	}
+
+	limit := c.QueryInt("limit", 50)
+	if limit < 1 || limit > maxNearbyTasks {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("limit deve estar entre 1 e %d", maxNearbyTasks))
+	}
+
+	nearby, err := h.tasks.ListNearby(c.UserContext(), userID, repositories.NearbyQuery{
+		Lat:    lat,
+		Lng:    lng,
+		Radius: radius,
+		Status: status,
+		Limit:  int64(limit),
+	})
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	data := make([]*taskres.NearbyTaskResponse, 0, len(nearby))
+	for _, item := range nearby {
+		data = append(data, taskres.NewNearbyTaskResponse(&item.Task, item.Distance))
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    data,
+	})
+}
+
+// Changes retorna o feed de alterações de tarefas para integrações por polling
+// (?since=<cursor|RFC 3339|unix>&types=task.created,task.updated&limit=50)
+func (h *TaskHandler) Changes(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var types []string
+	for _, eventType := range strings.Split(c.Query("types"), ",") {
+		eventType = strings.TrimSpace(eventType)
+		if eventType == "" {
+			continue
+		}
+		if !services.IsTaskChangeType(eventType) {
+			return fiber.NewError(fiber.StatusBadRequest, "tipo de alteração inválido: "+eventType)
+		}
+		types = append(types, eventType)
+	}
+
+	limit := int64(c.QueryInt("limit", 50))
+	if limit < 1 || limit > services.MaxTaskChanges {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("limit deve estar entre 1 e %d", services.MaxTaskChanges))
+	}
+
+	page, err := h.changes.List(c.UserContext(), userID, c.Query("since"), types, limit)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    taskres.NewTaskChangesResponse(page.Changes, page.Cursor, page.HasMore),
+	})
+}
+
+// Formatos aceitos na exportação
+const (
+	exportFormatJSON     = "json"
+	exportFormatMarkdown = "markdown"
+)
+
+// Export exporta todas as tarefas do usuário (?include_archived=true inclui o
+// histórico). Com ?format=markdown, gera um ZIP com um arquivo Markdown por
+// projeto, compatível com o Obsidian.
+func (h *TaskHandler) Export(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	format := c.Query("format", exportFormatJSON)
+	if format != exportFormatJSON && format != exportFormatMarkdown {
+		return fiber.NewError(fiber.StatusBadRequest, "Formato de exportação inválido")
+	}
+
+	includeArchived := c.QueryBool("include_archived")
+	export, err := h.tasks.Export(c.UserContext(), userID, includeArchived)
+
+	entry := newAuditEntry(c, entities.AuditActionDataExport, err)
+	if err == nil {
+		entry.Details = map[string]interface{}{
+			"format":           format,
+			"include_archived": includeArchived,
+			"tasks":            len(export.Tasks),
+			"archived":         len(export.Archived),
+		}
+	}
+	h.audit.Record(c.UserContext(), entry)
+
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	if format == exportFormatMarkdown {
+		return h.sendMarkdownExport(c, userID, export)
+	}
+
+	data := fiber.Map{
+		"tasks": taskres.NewTaskResponses(export.Tasks),
+	}
+	if export.Archived != nil {
+		data["archived"] = taskres.NewTaskResponses(export.Archived)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    data,
+	})
+}
+
+// sendMarkdownExport envia a exportação como ZIP de arquivos Markdown, com as
+// datas no fuso do usuário
+func (h *TaskHandler) sendMarkdownExport(c *fiber.Ctx, userID primitive.ObjectID, export *services.TaskExport) error {
+	user, err := h.users.GetByID(c.UserContext(), userID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	var buf bytes.Buffer
+	input := &exporter.MarkdownInput{
+		Projects: export.Projects,
+		Tasks:    export.Tasks,
+		Archived: export.Archived,
+		Location: user.Preferences.Location(),
+	}
+	if _, err := exporter.WriteMarkdownZip(&buf, input, time.Now()); err != nil {
+		return handleServiceError(err)
+	}
+
+	c.Attachment("todo-it-" + time.Now().Format("2006-01-02") + ".zip")
+	c.Set(fiber.HeaderContentType, "application/zip")
+	return c.Send(buf.Bytes())
+}
+
+// parseTaskFilters monta os filtros de listagem a partir da query string
+func parseTaskFilters(c *fiber.Ctx) (*repositories.TaskFilters, error) {
+	filters := &repositories.TaskFilters{
+		Status:   enums.TaskStatus(c.Query("status")),
+		Priority: enums.TaskPriority(c.Query("priority")),
+		Search:   c.Query("search"),
+		SortBy:   c.Query("sort"),
+	}
+
+	if len([]rune(filters.Search)) > repositories.MaxTaskSearchLength {
+		return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("search deve ter no máximo %d caracteres", repositories.MaxTaskSearchLength))
+	}
+
+	if filters.SortBy != "" && !repositories.IsValidTaskSortField(filters.SortBy) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "sort deve ser created_at, updated_at, due_date, title, priority, position ou relevance")
+	}
+
+	if order := c.Query("order"); order != "" {
+		if order != "asc" && order != "desc" {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "order deve ser asc ou desc")
+		}
+		filters.SortOrder = order
+	}
+
+	// view=compact omite descrição e anexos, reduzindo o tamanho das listagens
+	switch c.Query("view") {
+	case "", "full":
+	case "compact":
+		filters.Compact = true
+	default:
+		return nil, fiber.NewError(fiber.StatusBadRequest, "view deve ser full ou compact")
+	}
+
+	if tags := c.Query("tags"); tags != "" {
+		for _, tag := range strings.Split(tags, ",") {
+			if tag = entities.NormalizeTag(tag); tag != "" {
+				filters.Tags = append(filters.Tags, tag)
+			}
+		}
+	}
+
+	if projectID := c.Query("project_id"); projectID != "" {
+		id, err := primitive.ObjectIDFromHex(projectID)
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "project_id inválido")
+		}
+		filters.ProjectID = &id
+	}
+
+	if archived := c.Query("archived"); archived != "" {
+		isArchived := archived == "true"
+		filters.IsArchived = &isArchived
+	}
+
+	if dueBefore := c.Query("due_before"); dueBefore != "" {
+		t, err := time.Parse(time.RFC3339, dueBefore)
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "due_before deve estar no formato RFC3339")
+		}
+		filters.DueBefore = &t
+	}
+
+	if dueAfter := c.Query("due_after"); dueAfter != "" {
+		t, err := time.Parse(time.RFC3339, dueAfter)
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "due_after deve estar no formato RFC3339")
+		}
+		filters.DueAfter = &t
+	}
+
+	return filters, nil
+}
+
+const (
+	// defaultSeriesRangeDays é o período padrão das séries temporais
+	defaultSeriesRangeDays = 90
+	// maxSeriesRangeDays é o maior período aceito nas séries temporais
+	maxSeriesRangeDays = 366
+)
+
+// parseRangeDays lê o período no formato "Nd" (ex.: 90d)
+func parseRangeDays(raw string) (int, error) {
+	if raw == "" {
+		return defaultSeriesRangeDays, nil
+	}
+
+	days, err := strconv.Atoi(strings.TrimSuffix(raw, "d"))
+	if err != nil || !strings.HasSuffix(raw, "d") || days < 1 || days > maxSeriesRangeDays {
+		return 0, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("range deve estar no formato Nd, entre 1d e %dd", maxSeriesRangeDays))
+	}
+
+	return days, nil
+}
@@ -0,0 +1,167 @@
+package handlers_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"github.com/devgugga/todo-it/internal/testsupport"
+)
+
+// taskListBody é o corpo de GET /todos
+type taskListBody struct {
+	Data struct {
+		Tasks []struct {
+			ID     string `json:"id"`
+			Title  string `json:"title"`
+			Status string `json:"status"`
+		} `json:"tasks"`
+		Total      int64 `json:"total"`
+		TotalPages int64 `json:"total_pages"`
+		HasNext    bool  `json:"has_next"`
+		HasPrev    bool  `json:"has_prev"`
+	} `json:"data"`
+}
+
+// newTaskAPI monta a API sobre um banco de teste novo e retorna o token de um
+// usuário. Os testes de integração usam Docker e são ignorados com -short.
+func newTaskAPI(t *testing.T) (*testsupport.App, database.Client, *entities.User, string) {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("teste de integração ignorado com -short")
+	}
+
+	db := testsupport.NewDatabase(t)
+	app := testsupport.NewApp(t, db)
+	user := testsupport.SeedUser(t, db)
+
+	return app, db, user, app.Token(t, user.ID)
+}
+
+func TestTaskListFiltersAndPagination(t *testing.T) {
+	app, db, user, token := newTaskAPI(t)
+
+	testsupport.SeedTasks(t, db, user.ID, 5)
+	testsupport.SeedTask(t, db, user.ID, entities.Task{Title: "Urgente", Priority: enums.PriorityUrgent, Tags: []string{"trabalho"}})
+	testsupport.SeedTask(t, db, user.ID, entities.Task{Title: "Feita", Status: enums.StatusCompleted, Tags: []string{"trabalho"}})
+
+	tests := []struct {
+		name  string
+		query string
+		total int64
+		count int
+	}{
+		{"todas", "", 7, 7},
+		{"status", "?status=completed", 1, 1},
+		{"prioridade", "?priority=urgent", 1, 1},
+		{"etiquetas", "?tags=trabalho", 2, 2},
+		{"primeira página", "?limit=3&sort=title&order=asc", 7, 3},
+		{"última página", "?page=3&limit=3&sort=title&order=asc", 7, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body taskListBody
+			app.Do(t, http.MethodGet, "/api/v1/todos"+tt.query, token, nil).Expect(t, http.StatusOK).Decode(t, &body)
+
+			if body.Data.Total != tt.total || len(body.Data.Tasks) != tt.count {
+				t.Errorf("total %d com %d tarefas, esperado %d com %d", body.Data.Total, len(body.Data.Tasks), tt.total, tt.count)
+			}
+		})
+	}
+
+	var page taskListBody
+	app.Do(t, http.MethodGet, "/api/v1/todos?page=2&limit=3", token, nil).Expect(t, http.StatusOK).Decode(t, &page)
+	if page.Data.TotalPages != 3 || !page.Data.HasNext || !page.Data.HasPrev {
+		t.Errorf("página 2 de 3: total_pages %d, has_next %v, has_prev %v", page.Data.TotalPages, page.Data.HasNext, page.Data.HasPrev)
+	}
+
+	app.Do(t, http.MethodGet, "/api/v1/todos?sort=unknown", token, nil).Expect(t, http.StatusBadRequest)
+}
+
+func TestTaskListIsolatedByUser(t *testing.T) {
+	app, db, user, token := newTaskAPI(t)
+
+	other := testsupport.SeedUser(t, db)
+	testsupport.SeedTasks(t, db, other.ID, 3)
+	testsupport.SeedTask(t, db, user.ID, entities.Task{Title: "Minha"})
+
+	var body taskListBody
+	app.Do(t, http.MethodGet, "/api/v1/todos", token, nil).Expect(t, http.StatusOK).Decode(t, &body)
+	if body.Data.Total != 1 {
+		t.Errorf("total %d, esperado só a tarefa do usuário", body.Data.Total)
+	}
+
+	app.Do(t, http.MethodGet, "/api/v1/todos", "", nil).Expect(t, http.StatusUnauthorized)
+}
+
+func TestTaskStatsEndpoint(t *testing.T) {
+	app, db, user, token := newTaskAPI(t)
+
+	testsupport.SeedTasks(t, db, user.ID, 2)
+	testsupport.SeedTask(t, db, user.ID, entities.Task{Title: "Concluída", Status: enums.StatusCompleted})
+
+	var body struct {
+		Data struct {
+			Total     int64 `json:"total"`
+			Pending   int64 `json:"pending"`
+			Completed int64 `json:"completed"`
+		} `json:"data"`
+	}
+	app.Do(t, http.MethodGet, "/api/v1/todos/stats?fresh=true", token, nil).Expect(t, http.StatusOK).Decode(t, &body)
+
+	if body.Data.Total != 3 || body.Data.Pending != 2 || body.Data.Completed != 1 {
+		t.Errorf("estatísticas %+v, esperado total 3, pending 2, completed 1", body.Data)
+	}
+}
+
+func TestTaskBulkOperations(t *testing.T) {
+	app, db, user, token := newTaskAPI(t)
+
+	tasks := testsupport.SeedTasks(t, db, user.ID, 3)
+	foreign := testsupport.SeedTask(t, db, testsupport.SeedUser(t, db).ID, entities.Task{Title: "De outro usuário"})
+
+	var updated struct {
+		Data struct {
+			Updated int64 `json:"updated"`
+		} `json:"data"`
+	}
+	app.Do(t, http.MethodPost, "/api/v1/todos/bulk/status", token, map[string]interface{}{
+		"ids":    []string{tasks[0].ID.Hex(), tasks[1].ID.Hex(), foreign.ID.Hex()},
+		"status": enums.StatusCompleted,
+	}).Expect(t, http.StatusOK).Decode(t, &updated)
+	if updated.Data.Updated != 2 {
+		t.Errorf("updated %d, esperado 2 (a tarefa de outro usuário é ignorada)", updated.Data.Updated)
+	}
+
+	var completed taskListBody
+	app.Do(t, http.MethodGet, "/api/v1/todos?status=completed", token, nil).Expect(t, http.StatusOK).Decode(t, &completed)
+	if completed.Data.Total != 2 {
+		t.Errorf("%d tarefas concluídas, esperado 2", completed.Data.Total)
+	}
+
+	var deleted struct {
+		Data struct {
+			Deleted int64 `json:"deleted"`
+		} `json:"data"`
+	}
+	app.Do(t, http.MethodPost, "/api/v1/todos/bulk/delete", token, map[string]interface{}{
+		"ids": []string{tasks[0].ID.Hex(), tasks[2].ID.Hex()},
+	}).Expect(t, http.StatusOK).Decode(t, &deleted)
+	if deleted.Data.Deleted != 2 {
+		t.Errorf("deleted %d, esperado 2", deleted.Data.Deleted)
+	}
+
+	var remaining taskListBody
+	app.Do(t, http.MethodGet, "/api/v1/todos", token, nil).Expect(t, http.StatusOK).Decode(t, &remaining)
+	if len(remaining.Data.Tasks) != 1 || remaining.Data.Tasks[0].ID != tasks[1].ID.Hex() {
+		t.Errorf("restaram %d tarefas, esperado apenas %s", remaining.Data.Total, tasks[1].ID.Hex())
+	}
+
+	app.Do(t, http.MethodPost, "/api/v1/todos/bulk/status", token, map[string]interface{}{
+		"ids":    []string{"id-invalido"},
+		"status": enums.StatusCompleted,
+	}).Expect(t, http.StatusBadRequest)
+}
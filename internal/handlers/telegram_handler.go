@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"errors"
+
+	telegramres "github.com/devgugga/todo-it/internal/dtos/responses/telegram"
+	"github.com/devgugga/todo-it/internal/integrations/telegram"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/gofiber/fiber/v2"
+)
+
+// telegramSecretHeader carrega o secret_token informado no setWebhook
+const telegramSecretHeader = "X-Telegram-Bot-Api-Secret-Token"
+
+// TelegramHandler agrupa o webhook do bot e as rotas de vinculação de conta
+type TelegramHandler struct {
+	bot           *telegram.Bot
+	webhookSecret string
+}
+
+// NewTelegramHandler cria uma nova instância do handler do Telegram
+func NewTelegramHandler(bot *telegram.Bot, webhookSecret string) *TelegramHandler {
+	return &TelegramHandler{bot: bot, webhookSecret: webhookSecret}
+}
+
+// SetupTelegramRoutes registra o webhook (autenticado pelo secret token do
+// Telegram) e as rotas de vinculação (autenticadas pelo usuário)
+func SetupTelegramRoutes(router fiber.Router, bot *telegram.Bot, webhookSecret string, requireAuth fiber.Handler) {
+	h := NewTelegramHandler(bot, webhookSecret)
+
+	router.Post("/webhook", h.Webhook)
+	router.Post("/link-code", requireAuth, h.CreateLinkCode)
+	router.Get("/link", requireAuth, h.GetLink)
+	router.Delete("/link", requireAuth, h.Unlink)
+}
+
+// Webhook recebe os updates do Telegram. Falhas ao processar um update são
+// registradas mas respondidas com 200, evitando reenvios em loop.
+func (h *TelegramHandler) Webhook(c *fiber.Ctx) error {
+	provided := c.Get(telegramSecretHeader)
+	if subtle.ConstantTimeCompare([]byte(provided), []byte(h.webhookSecret)) != 1 {
+		return fiber.NewError(fiber.StatusUnauthorized, "Secret token inválido")
+	}
+
+	var update telegram.Update
+	if err := c.BodyParser(&update); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Update inválido")
+	}
+
+	if err := h.bot.HandleUpdate(c.UserContext(), &update); err != nil {
+		logging.FromContext(c.UserContext()).Error("erro ao processar update do Telegram",
+			"update_id", update.UpdateID, logging.Err(err))
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+// CreateLinkCode gera um código de uso único para vincular um chat à conta
+func (h *TelegramHandler) CreateLinkCode(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	code, err := h.bot.CreateLinkCode(c.UserContext(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    code,
+	})
+}
+
+// GetLink informa se a conta está vinculada a um chat
+func (h *TelegramHandler) GetLink(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	link, err := h.bot.GetLink(c.UserContext(), userID)
+	if err != nil && !errors.Is(err, repositories.ErrTelegramLinkNotFound) {
+		return err
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    telegramres.NewLinkResponse(link),
+	})
+}
+
+// Unlink remove o vínculo da conta com o chat
+func (h *TelegramHandler) Unlink(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.bot.Unlink(c.UserContext(), userID); err != nil {
+		if errors.Is(err, repositories.ErrTelegramLinkNotFound) {
+			return fiber.NewError(fiber.StatusNotFound, "Conta não vinculada ao Telegram")
+		}
+		return err
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Telegram desvinculado",
+	})
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+// defaultExportTimeout é o tempo limite das exportações até SetExportTimeout ser chamado
+const defaultExportTimeout = 2 * time.Minute
+
+// indexRebuildTimeout é o tempo limite da reconstrução de índices, que pode
+// levar minutos em collections grandes
+const indexRebuildTimeout = 30 * time.Minute
+
+// integrityCheckTimeout é o tempo limite da verificação de integridade, que
+// percorre as collections de tarefas inteiras
+const integrityCheckTimeout = 30 * time.Minute
+
+// exportTimeout é o tempo limite das rotas de exportação (EXPORT_REQUEST_TIMEOUT)
+var exportTimeout atomic.Int64
+
+func init() {
+	exportTimeout.Store(int64(defaultExportTimeout))
+}
+
+// SetExportTimeout altera o tempo limite das rotas de exportação, que
+// substitui o REQUEST_TIMEOUT aplicado às demais rotas
+func SetExportTimeout(timeout time.Duration) {
+	if timeout > 0 {
+		exportTimeout.Store(int64(timeout))
+	}
+}
+
+// exportDeadline aplica às exportações o tempo limite próprio
+func exportDeadline(c *fiber.Ctx) error {
+	return middleware.Timeout(time.Duration(exportTimeout.Load()))(c)
+}
@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"github.com/devgugga/todo-it/internal/auth"
+	"github.com/devgugga/todo-it/internal/database"
+	userreq "github.com/devgugga/todo-it/internal/dtos/requests/user"
+	userres "github.com/devgugga/todo-it/internal/dtos/responses/user"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Modos de encerramento de conta (DELETE /users/me?mode=)
+const (
+	// ClosureDeactivate desativa a conta mantendo os dados
+	ClosureDeactivate = "deactivate"
+	// ClosureAnonymize remove os dados pessoais, mantendo as tarefas anonimizadas nas estatísticas
+	ClosureAnonymize = "anonymize"
+)
+
+// UserHandler agrupa os handlers do usuário autenticado
+type UserHandler struct {
+	users       services.UserService
+	audit       services.AuditService
+	closureMode string
+}
+
+// NewUserHandler cria uma nova instância do handler de usuários. closureMode é
+// o modo de encerramento de conta usado quando a requisição não informa um.
+func NewUserHandler(users services.UserService, audit services.AuditService, closureMode string) *UserHandler {
+	return &UserHandler{users: users, audit: audit, closureMode: closureMode}
+}
+
+// SetupUserRoutes registra as rotas de usuário (requer autenticação)
+func SetupUserRoutes(router fiber.Router, db database.Client, tokens *auth.TokenManager, bus events.Bus, phones services.PhoneService, closureMode string) {
+	users := services.NewUserService(repositories.NewUserRepository(db), repositories.NewTodoRepository(db), repositories.NewAccountClosureRepository(db), repositories.NewAttachmentRepository(db), tokens, bus)
+	audit := services.NewAuditService(repositories.NewAuditLogRepository(db))
+	h := NewUserHandler(users, audit, closureMode)
+
+	router.Get("/me", h.GetProfile)
+	router.Put("/me", h.Update)
+	router.Put("/me/password", h.ChangePassword)
+	router.Delete("/me", h.Delete)
+	router.Get("/me/preferences", h.GetPreferences)
+	router.Put("/me/preferences", h.UpdatePreferences)
+
+	passwords := NewAppPasswordHandler(services.NewAppPasswordService(repositories.NewAppPasswordRepository(db), repositories.NewUserRepository(db)), audit)
+	router.Get("/me/app-passwords", passwords.List)
+	router.Post("/me/app-passwords", passwords.Create)
+	router.Delete("/me/app-passwords/:id", passwords.Revoke)
+
+	phone := NewPhoneHandler(phones, audit)
+	router.Get("/me/phone", phone.Get)
+	router.Put("/me/phone", phone.StartVerification)
+	router.Post("/me/phone/verify", phone.ConfirmVerification)
+	router.Delete("/me/phone", phone.Remove)
+
+	statuses := NewCustomStatusHandler(services.NewCustomStatusService(repositories.NewUserRepository(db), repositories.NewTodoRepository(db)))
+	router.Get("/me/custom-statuses", statuses.List)
+	router.Post("/me/custom-statuses", statuses.Create)
+	router.Put("/me/custom-statuses/:id", statuses.Update)
+	router.Delete("/me/custom-statuses/:id", statuses.Delete)
+
+	feeds := NewFeedHandler(services.NewActivityFeedService(repositories.NewUserRepository(db), repositories.NewTaskChangeRepository(db)), audit)
+	router.Post("/me/feed-token", feeds.RotateToken)
+	router.Delete("/me/feed-token", feeds.RevokeToken)
+}
+
+// GetProfile retorna o perfil do usuário autenticado
+func (h *UserHandler) GetProfile(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	profile, err := h.users.GetProfile(c.UserContext(), userID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    profile,
+	})
+}
+
+// Update atualiza os dados do usuário autenticado
+func (h *UserHandler) Update(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var req userreq.UpdateUserRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	user, err := h.users.Update(c.UserContext(), userID, &req)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    userres.NewUserResponse(user),
+	})
+}
+
+// ChangePassword troca a senha do usuário autenticado
+func (h *UserHandler) ChangePassword(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var req userreq.ChangePasswordRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	err := h.users.ChangePassword(c.UserContext(), userID, &req)
+	h.audit.Record(c.UserContext(), newAuditEntry(c, entities.AuditActionPasswordChange, err))
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Senha alterada com sucesso",
+	})
+}
+
+// Delete encerra a conta do usuário autenticado: desativa (mode=deactivate)
+// ou remove os dados pessoais (mode=anonymize). Sem mode, usa o padrão configurado.
+func (h *UserHandler) Delete(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	mode := c.Query("mode", h.closureMode)
+
+	var err error
+	switch mode {
+	case ClosureDeactivate:
+		err = h.users.Delete(c.UserContext(), userID)
+	case ClosureAnonymize:
+		err = h.users.Anonymize(c.UserContext(), userID)
+	default:
+		return fiber.NewError(fiber.StatusBadRequest, "mode deve ser deactivate ou anonymize")
+	}
+
+	entry := newAuditEntry(c, entities.AuditActionAccountDelete, err)
+	if entry.Details == nil {
+		entry.Details = map[string]interface{}{}
+	}
+	entry.Details["mode"] = mode
+	h.audit.Record(c.UserContext(), entry)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// GetPreferences retorna as preferências do usuário autenticado
+func (h *UserHandler) GetPreferences(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	user, err := h.users.GetByID(c.UserContext(), userID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    userres.NewPreferencesResponse(&user.Preferences),
+	})
+}
+
+// UpdatePreferences atualiza as preferências do usuário autenticado
+func (h *UserHandler) UpdatePreferences(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	var req userreq.UpdatePreferencesRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	preferences, err := h.users.UpdatePreferences(c.UserContext(), userID, &req)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    userres.NewPreferencesResponse(preferences),
+	})
+}
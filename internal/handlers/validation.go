@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	taskreq "github.com/devgugga/todo-it/internal/dtos/requests/task"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+var validate = newValidator()
+
+// newValidator cria o validator com suporte aos campos Nullable das requisições
+func newValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterCustomTypeFunc(nullableValue, taskreq.Nullable[string]{}, taskreq.Nullable[taskreq.DueDate]{}, taskreq.Nullable[taskreq.LocationRequest]{}, taskreq.Nullable[int]{})
+	return v
+}
+
+// nullableValue expõe ao validator o valor de um campo Nullable
+func nullableValue(field reflect.Value) interface{} {
+	if nullable, ok := field.Interface().(interface{ ValidationValue() interface{} }); ok {
+		return nullable.ValidationValue()
+	}
+	return nil
+}
+
+// parseBody faz o parse do corpo JSON e valida as regras declaradas nas tags validate
+func parseBody(c *fiber.Ctx, dst interface{}) error {
+	if err := c.BodyParser(dst); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Corpo da requisição inválido")
+	}
+
+	if err := validate.Struct(dst); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, formatValidationError(err))
+	}
+
+	return nil
+}
+
+// formatValidationError transforma os erros do validator em uma mensagem legível
+func formatValidationError(err error) string {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return "Dados inválidos"
+	}
+
+	messages := make([]string, 0, len(validationErrors))
+	for _, fieldErr := range validationErrors {
+		rule := fieldErr.Tag()
+		if fieldErr.Param() != "" {
+			rule = fmt.Sprintf("%s=%s", rule, fieldErr.Param())
+		}
+		messages = append(messages, fmt.Sprintf("campo '%s' inválido (%s)", fieldErr.Field(), rule))
+	}
+
+	return "Dados inválidos: " + strings.Join(messages, "; ")
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"github.com/devgugga/todo-it/internal/database"
+	viewreq "github.com/devgugga/todo-it/internal/dtos/requests/view"
+	viewres "github.com/devgugga/todo-it/internal/dtos/responses/view"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/services"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ViewSettingsHandler agrupa os handlers das configurações de visualização
+type ViewSettingsHandler struct {
+	views services.ViewSettingsService
+}
+
+// NewViewSettingsHandler cria uma nova instância do handler de configurações de visualização
+func NewViewSettingsHandler(views services.ViewSettingsService) *ViewSettingsHandler {
+	return &ViewSettingsHandler{views: views}
+}
+
+// SetupViewSettingsRoutes registra as rotas das configurações de visualização
+// (requer autenticação): / é a padrão do usuário e /projects/:id a de cada projeto
+func SetupViewSettingsRoutes(router fiber.Router, db database.Client) {
+	h := NewViewSettingsHandler(services.NewViewSettingsService(repositories.NewViewSettingsRepository(db), repositories.NewProjectRepository(db)))
+
+	router.Get("/", h.Get)
+	router.Put("/", h.Save)
+	router.Get("/projects/:id", h.Get)
+	router.Put("/projects/:id", h.Save)
+}
+
+// Get retorna a configuração padrão ou a do projeto (herdada se não houver uma salva)
+func (h *ViewSettingsHandler) Get(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	projectID, err := parseViewProject(c)
+	if err != nil {
+		return err
+	}
+
+	settings, err := h.views.Get(c.UserContext(), userID, projectID)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    viewres.NewViewSettingsResponse(settings),
+	})
+}
+
+// Save substitui a configuração padrão ou a do projeto
+func (h *ViewSettingsHandler) Save(c *fiber.Ctx) error {
+	userID, _ := middleware.GetUserID(c)
+
+	projectID, err := parseViewProject(c)
+	if err != nil {
+		return err
+	}
+
+	var req viewreq.UpdateViewSettingsRequest
+	if err := parseBody(c, &req); err != nil {
+		return err
+	}
+
+	settings, err := h.views.Save(c.UserContext(), userID, projectID, &req)
+	if err != nil {
+		return handleServiceError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    viewres.NewViewSettingsResponse(settings),
+	})
+}
+
+// parseViewProject lê o projeto da rota (nil na configuração padrão)
+func parseViewProject(c *fiber.Ctx) (*primitive.ObjectID, error) {
+	if c.Params("id") == "" {
+		return nil, nil
+	}
+
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
@@ -0,0 +1,151 @@
+// Package health verifica as dependências da instância (banco, Redis, SMTP,
+// armazenamento de anexos e jobs) para o endpoint de prontidão /readyz.
+package health
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Situação de uma dependência na verificação
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// Dependências verificadas (nomes aceitos em READINESS_REQUIRED)
+const (
+	DependencyMongo   = "mongo"
+	DependencyRedis   = "redis"
+	DependencySMTP    = "smtp"
+	DependencyStorage = "storage"
+	DependencyJobs    = "jobs"
+)
+
+// defaultCheckTimeout limita cada verificação quando Check.Timeout não é definido
+const defaultCheckTimeout = 3 * time.Second
+
+// Check é a verificação de uma dependência
+type Check struct {
+	Name string
+	// Required faz a falha da dependência tornar a instância não pronta (503)
+	Required bool
+	// Timeout limita a verificação (padrão: 3s)
+	Timeout time.Duration
+	// MinInterval reaproveita o último resultado por esse intervalo, para
+	// dependências caras de verificar a cada chamada (ex.: SMTP)
+	MinInterval time.Duration
+	Probe       func(ctx context.Context) error
+}
+
+// DependencyStatus é o resultado da verificação de uma dependência
+type DependencyStatus struct {
+	Name          string     `json:"name"`
+	Status        string     `json:"status"`
+	Required      bool       `json:"required"`
+	LatencyMs     int64      `json:"latency_ms"`
+	CheckedAt     time.Time  `json:"checked_at"`
+	LastSuccessAt *time.Time `json:"last_success_at,omitempty"`
+	Error         string     `json:"error,omitempty"`
+}
+
+// Report resume a prontidão da instância
+type Report struct {
+	// Ready é falso quando alguma dependência obrigatória falhou
+	Ready        bool               `json:"ready"`
+	Dependencies []DependencyStatus `json:"dependencies"`
+	CheckedAt    time.Time          `json:"checked_at"`
+}
+
+// Monitor executa as verificações registradas e guarda, por dependência, o
+// último resultado e o último sucesso. O estado é local à instância.
+type Monitor struct {
+	mu     sync.Mutex
+	checks []Check
+	last   map[string]DependencyStatus
+}
+
+// NewMonitor cria um monitor sem verificações
+func NewMonitor() *Monitor {
+	return &Monitor{last: make(map[string]DependencyStatus)}
+}
+
+// Register adiciona a verificação de uma dependência
+func (m *Monitor) Register(check Check) {
+	if check.Timeout <= 0 {
+		check.Timeout = defaultCheckTimeout
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.checks = append(m.checks, check)
+}
+
+// Check verifica todas as dependências em paralelo
+func (m *Monitor) Check(ctx context.Context) *Report {
+	m.mu.Lock()
+	checks := append([]Check(nil), m.checks...)
+	m.mu.Unlock()
+
+	results := make([]DependencyStatus, len(checks))
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+			results[i] = m.run(ctx, check)
+		}(i, check)
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Name < results[j].Name })
+
+	report := &Report{Ready: true, Dependencies: results, CheckedAt: time.Now()}
+	for _, result := range results {
+		if result.Required && result.Status != StatusUp {
+			report.Ready = false
+		}
+	}
+
+	return report
+}
+
+// run verifica uma dependência (ou reaproveita o resultado dentro de MinInterval)
+func (m *Monitor) run(ctx context.Context, check Check) DependencyStatus {
+	m.mu.Lock()
+	previous, checked := m.last[check.Name]
+	m.mu.Unlock()
+
+	if checked && check.MinInterval > 0 && time.Since(previous.CheckedAt) < check.MinInterval {
+		return previous
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, check.Timeout)
+	defer cancel()
+
+	startedAt := time.Now()
+	err := check.Probe(ctx)
+
+	result := DependencyStatus{
+		Name:          check.Name,
+		Status:        StatusUp,
+		Required:      check.Required,
+		LatencyMs:     time.Since(startedAt).Milliseconds(),
+		CheckedAt:     startedAt,
+		LastSuccessAt: previous.LastSuccessAt,
+	}
+	if err != nil {
+		result.Status = StatusDown
+		result.Error = err.Error()
+	} else {
+		result.LastSuccessAt = &startedAt
+	}
+
+	m.mu.Lock()
+	m.last[check.Name] = result
+	m.mu.Unlock()
+
+	return result
+}
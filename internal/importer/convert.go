@@ -0,0 +1,136 @@
+package importer
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/clock"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Limites de CreateTaskRequest / CreateProjectRequest, aplicados aos dados importados
+const (
+	maxTitleLength       = 200
+	maxDescriptionLength = 1000
+	maxProjectNameLength = 100
+	maxChecklistItems    = 100
+	maxChecklistText     = 200
+)
+
+// store grava projetos e tarefas importados diretamente nos repositórios,
+// sem publicar um evento por tarefa como a criação pela API faria
+type store struct {
+	projects repositories.ProjectRepository
+	todos    repositories.TodoRepository
+	clock    clock.Clock
+}
+
+// newStore cria o store usando o relógio do sistema
+func newStore(projects repositories.ProjectRepository, todos repositories.TodoRepository) store {
+	return store{projects: projects, todos: todos, clock: clock.System()}
+}
+
+// createProject cria o projeto importado e contabiliza no progresso
+func (s *store) createProject(ctx context.Context, p *Progress, userID primitive.ObjectID, name, color string, statuses ...entities.ProjectStatus) (*entities.Project, error) {
+	project := &entities.Project{
+		Name:     truncate(strings.TrimSpace(name), maxProjectNameLength),
+		Color:    color,
+		Statuses: statuses,
+	}
+	if project.Name == "" {
+		project.Name = "Sem nome"
+	}
+	project.PrepareForCreate(userID)
+
+	if err := s.projects.Create(ctx, project); err != nil {
+		return nil, err
+	}
+
+	p.ProjectCreated()
+	return project, nil
+}
+
+// createTask normaliza e cria a tarefa importada, registrando avisos de truncamento
+func (s *store) createTask(ctx context.Context, p *Progress, userID primitive.ObjectID, task *entities.Task) error {
+	title := strings.TrimSpace(task.Title)
+	if title == "" {
+		title = "(sem título)"
+	}
+	if len([]rune(title)) > maxTitleLength {
+		p.Warn("título de %q truncado em %d caracteres", truncate(title, 40), maxTitleLength)
+		title = truncate(title, maxTitleLength)
+	}
+	task.Title = title
+
+	if len([]rune(task.Description)) > maxDescriptionLength {
+		p.Warn("descrição de %q truncada em %d caracteres", truncate(title, 40), maxDescriptionLength)
+		task.Description = truncate(task.Description, maxDescriptionLength)
+	}
+
+	task.Tags = normalizeTags(p, title, task.Tags)
+
+	if len(task.Checklist) > maxChecklistItems {
+		p.Warn("%q tem %d itens de checklist; apenas os %d primeiros foram importados", truncate(title, 40), len(task.Checklist), maxChecklistItems)
+		task.Checklist = task.Checklist[:maxChecklistItems]
+	}
+	for i := range task.Checklist {
+		task.Checklist[i].Text = truncate(task.Checklist[i].Text, maxChecklistText)
+	}
+
+	// PrepareForCreate reinicia arquivamento e conclusão; os valores da origem são mantidos
+	completedAt, archived := task.CompletedAt, task.IsArchived
+	now := s.clock.Now()
+	task.PrepareForCreateAt(userID, now)
+	task.IsArchived = archived
+	if task.Status == enums.StatusCompleted {
+		task.MarkAsCompletedAt(now)
+		if completedAt != nil {
+			task.CompletedAt = completedAt
+		}
+	}
+
+	if err := s.todos.Create(ctx, task); err != nil {
+		return err
+	}
+
+	p.TaskCreated()
+	return nil
+}
+
+// normalizeTags padroniza as etiquetas (entities.NormalizeTags), avisando
+// sobre as truncadas e as que passam do limite por tarefa
+func normalizeTags(p *Progress, title string, tags []string) []string {
+	distinct := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		if len([]rune(strings.TrimSpace(tag))) > entities.MaxTagLength {
+			p.Warn("etiqueta %q de %q truncada em %d caracteres", truncate(tag, 20), truncate(title, 40), entities.MaxTagLength)
+		}
+		if tag = entities.NormalizeTag(tag); tag != "" {
+			distinct[tag] = true
+		}
+	}
+
+	if len(distinct) > entities.MaxTaskTags {
+		p.Warn("%q tem %d etiquetas; apenas as %d primeiras foram importadas", truncate(title, 40), len(distinct), entities.MaxTaskTags)
+	}
+
+	return entities.NormalizeTags(tags)
+}
+
+// endOfDay retorna o último instante do dia no fuso informado
+func endOfDay(year int, month time.Month, day int, loc *time.Location) time.Time {
+	return time.Date(year, month, day, 23, 59, 59, 0, loc)
+}
+
+// truncate limita o texto a max runas, sem cortar caracteres multibyte
+func truncate(text string, max int) string {
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	return string(runes[:max])
+}
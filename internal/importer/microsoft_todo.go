@@ -0,0 +1,456 @@
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SourceMicrosoftTodo identifica as importações vindas do Microsoft To Do
+const SourceMicrosoftTodo = "microsoft_todo"
+
+// microsoftGraphURL é a raiz da Microsoft Graph API
+const microsoftGraphURL = "https://graph.microsoft.com/v1.0"
+
+// maxGraphResponseBytes limita cada página lida da Graph API
+const maxGraphResponseBytes = 10 << 20
+
+// graphDateTimeLayout é o formato de dateTime da Graph API (sem fuso, até 7 casas decimais)
+const graphDateTimeLayout = "2006-01-02T15:04:05.9999999"
+
+// htmlTagPattern remove as tags de descrições em HTML
+var htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// graphWeekdays converte os dias da semana da Graph API para o BYDAY do RRULE
+var graphWeekdays = map[string]string{
+	"sunday":    "SU",
+	"monday":    "MO",
+	"tuesday":   "TU",
+	"wednesday": "WE",
+	"thursday":  "TH",
+	"friday":    "FR",
+	"saturday":  "SA",
+}
+
+// graphWeekIndexes converte o índice da semana dos padrões relativos em posição do RRULE
+var graphWeekIndexes = map[string]int{
+	"first":  1,
+	"second": 2,
+	"third":  3,
+	"fourth": 4,
+	"last":   -1,
+}
+
+// MicrosoftTodoList é uma lista do To Do (todoTaskList) com as tarefas já carregadas
+type MicrosoftTodoList struct {
+	ID                string              `json:"id"`
+	DisplayName       string              `json:"displayName"`
+	WellknownListName string              `json:"wellknownListName"`
+	Tasks             []MicrosoftTodoTask `json:"-"`
+}
+
+// MicrosoftTodoTask segue o recurso todoTask da Graph API
+type MicrosoftTodoTask struct {
+	ID                string                   `json:"id"`
+	Title             string                   `json:"title"`
+	Body              *graphItemBody           `json:"body"`
+	Importance        string                   `json:"importance"`
+	Status            string                   `json:"status"`
+	Categories        []string                 `json:"categories"`
+	DueDateTime       *graphDateTime           `json:"dueDateTime"`
+	CompletedDateTime *graphDateTime           `json:"completedDateTime"`
+	IsReminderOn      bool                     `json:"isReminderOn"`
+	ReminderDateTime  *graphDateTime           `json:"reminderDateTime"`
+	Recurrence        *graphRecurrence         `json:"recurrence"`
+	ChecklistItems    []graphChecklistItem     `json:"checklistItems"`
+	LinkedResources   []map[string]interface{} `json:"linkedResources"`
+}
+
+type graphItemBody struct {
+	Content     string `json:"content"`
+	ContentType string `json:"contentType"`
+}
+
+type graphDateTime struct {
+	DateTime string `json:"dateTime"`
+	TimeZone string `json:"timeZone"`
+}
+
+type graphChecklistItem struct {
+	DisplayName string `json:"displayName"`
+	IsChecked   bool   `json:"isChecked"`
+}
+
+// graphRecurrence é o patternedRecurrence da Graph API
+type graphRecurrence struct {
+	Pattern struct {
+		Type           string   `json:"type"`
+		Interval       int      `json:"interval"`
+		Month          int      `json:"month"`
+		DayOfMonth     int      `json:"dayOfMonth"`
+		DaysOfWeek     []string `json:"daysOfWeek"`
+		FirstDayOfWeek string   `json:"firstDayOfWeek"`
+		Index          string   `json:"index"`
+	} `json:"pattern"`
+	Range struct {
+		Type                string `json:"type"`
+		EndDate             string `json:"endDate"`
+		NumberOfOccurrences int    `json:"numberOfOccurrences"`
+	} `json:"range"`
+}
+
+// MicrosoftTodoImporter converte listas e tarefas do Microsoft To Do
+type MicrosoftTodoImporter struct {
+	store
+	users    repositories.UserRepository
+	client   *http.Client
+	graphURL string
+}
+
+// NewMicrosoftTodoImporter cria o importador do Microsoft To Do
+func NewMicrosoftTodoImporter(users repositories.UserRepository, projects repositories.ProjectRepository, todos repositories.TodoRepository) *MicrosoftTodoImporter {
+	return &MicrosoftTodoImporter{
+		store:    newStore(projects, todos),
+		users:    users,
+		client:   &http.Client{Timeout: 60 * time.Second},
+		graphURL: microsoftGraphURL,
+	}
+}
+
+// FromAccessToken retorna a importação que lê listas e tarefas pela Graph API com
+// um access token delegado do usuário (escopo Tasks.Read)
+func (i *MicrosoftTodoImporter) FromAccessToken(userID primitive.ObjectID, token string) Func {
+	return func(ctx context.Context, p *Progress) error {
+		lists, err := i.fetch(ctx, token)
+		if err != nil {
+			return err
+		}
+		return i.run(ctx, p, userID, lists)
+	}
+}
+
+// fetch baixa as listas e, de cada uma, as tarefas com os itens de checklist
+func (i *MicrosoftTodoImporter) fetch(ctx context.Context, token string) ([]MicrosoftTodoList, error) {
+	lists, err := graphFetchAll[MicrosoftTodoList](ctx, i.client, token, i.graphURL+"/me/todo/lists")
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range lists {
+		endpoint := i.graphURL + "/me/todo/lists/" + url.PathEscape(lists[index].ID) + "/tasks?$top=100&$expand=checklistItems"
+		lists[index].Tasks, err = graphFetchAll[MicrosoftTodoTask](ctx, i.client, token, endpoint)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return lists, nil
+}
+
+// graphFetchAll lê todas as páginas de uma coleção da Graph API (seguindo @odata.nextLink)
+func graphFetchAll[T any](ctx context.Context, client *http.Client, token, endpoint string) ([]T, error) {
+	var items []T
+
+	for endpoint != "" {
+		page, err := graphFetchPage[T](ctx, client, token, endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page.Value...)
+		endpoint = page.NextLink
+	}
+
+	return items, nil
+}
+
+// graphPage é o envelope das coleções da Graph API
+type graphPage[T any] struct {
+	Value    []T    `json:"value"`
+	NextLink string `json:"@odata.nextLink"`
+}
+
+// graphFetchPage lê uma página de uma coleção da Graph API
+func graphFetchPage[T any](ctx context.Context, client *http.Client, token, endpoint string) (*graphPage[T], error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao montar requisição ao Microsoft To Do: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	// Datas e horários em UTC, independentemente do fuso da conta
+	req.Header.Set("Prefer", `outlook.timezone="UTC"`)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao acessar a API do Microsoft To Do: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		return nil, errors.New("token da Microsoft inválido ou expirado")
+	case resp.StatusCode == http.StatusForbidden:
+		return nil, errors.New("token da Microsoft sem permissão de leitura de tarefas (Tasks.Read)")
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("API do Microsoft To Do respondeu %d", resp.StatusCode)
+	}
+
+	var page graphPage[T]
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxGraphResponseBytes)).Decode(&page); err != nil {
+		return nil, fmt.Errorf("erro ao ler resposta do Microsoft To Do: %w", err)
+	}
+
+	return &page, nil
+}
+
+// run cria um projeto por lista e, em seguida, as tarefas. A lista padrão
+// ("Tarefas") fica sem projeto, como a caixa de entrada do Todoist.
+func (i *MicrosoftTodoImporter) run(ctx context.Context, p *Progress, userID primitive.ObjectID, lists []MicrosoftTodoList) error {
+	user, err := i.users.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	loc := user.Preferences.Location()
+
+	total := len(lists)
+	for _, list := range lists {
+		total += len(list.Tasks)
+	}
+	p.SetTotal(ctx, total)
+
+	var waiting, linked int
+	for _, list := range lists {
+		var projectID *primitive.ObjectID
+		if list.WellknownListName != "defaultList" {
+			project, err := i.createProject(ctx, p, userID, list.DisplayName, "")
+			if err != nil {
+				return err
+			}
+			projectID = &project.ID
+		}
+
+		if err := p.Step(ctx); err != nil {
+			return err
+		}
+
+		for _, source := range list.Tasks {
+			task := &entities.Task{
+				ProjectID:   projectID,
+				Title:       source.Title,
+				Description: graphBodyText(source.Body),
+				Status:      graphStatus(source.Status),
+				Priority:    graphPriority(source.Importance),
+				DueDate:     graphDueDate(source.DueDateTime, loc),
+				Recurrence:  graphRRule(p, source),
+				Tags:        source.Categories,
+			}
+
+			if source.IsReminderOn {
+				if reminder, ok := parseGraphDateTime(source.ReminderDateTime); ok {
+					task.ReminderAt = &reminder
+				}
+			}
+			if task.Status == enums.StatusCompleted {
+				if completedAt, ok := parseGraphDateTime(source.CompletedDateTime); ok {
+					task.CompletedAt = &completedAt
+				}
+			}
+
+			for _, item := range source.ChecklistItems {
+				task.Checklist = append(task.Checklist, entities.ChecklistItem{
+					ID:   primitive.NewObjectID(),
+					Text: item.DisplayName,
+					Done: item.IsChecked,
+				})
+			}
+
+			if source.Status == "waitingOnOthers" || source.Status == "deferred" {
+				waiting++
+			}
+			if len(source.LinkedResources) > 0 {
+				linked++
+			}
+
+			if err := i.createTask(ctx, p, userID, task); err != nil {
+				return err
+			}
+
+			if err := p.Step(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	if waiting > 0 {
+		p.Warn("%d tarefas aguardando outras pessoas ou adiadas importadas como pendentes", waiting)
+	}
+	if linked > 0 {
+		p.Warn("%d tarefas com vínculos (emails, arquivos) importadas sem os vínculos", linked)
+	}
+
+	return nil
+}
+
+// graphStatus converte o status da tarefa
+func graphStatus(status string) enums.TaskStatus {
+	switch status {
+	case "completed":
+		return enums.StatusCompleted
+	case "inProgress":
+		return enums.StatusInProgress
+	default:
+		return enums.StatusPending
+	}
+}
+
+// graphPriority converte a importância (o To Do só marca "importante" = high)
+func graphPriority(importance string) enums.TaskPriority {
+	switch importance {
+	case "high":
+		return enums.PriorityHigh
+	case "low":
+		return enums.PriorityLow
+	default:
+		return enums.PriorityMedium
+	}
+}
+
+// graphBodyText retorna a descrição como texto simples
+func graphBodyText(body *graphItemBody) string {
+	if body == nil {
+		return ""
+	}
+
+	content := body.Content
+	if strings.EqualFold(body.ContentType, "html") {
+		content = html.UnescapeString(htmlTagPattern.ReplaceAllString(content, " "))
+		content = strings.Join(strings.Fields(content), " ")
+	}
+
+	return strings.TrimSpace(content)
+}
+
+// parseGraphDateTime interpreta um dateTimeTimeZone (pedido em UTC)
+func parseGraphDateTime(value *graphDateTime) (time.Time, bool) {
+	if value == nil || value.DateTime == "" {
+		return time.Time{}, false
+	}
+
+	loc := time.UTC
+	if value.TimeZone != "" && value.TimeZone != "UTC" {
+		if zone, err := time.LoadLocation(value.TimeZone); err == nil {
+			loc = zone
+		}
+	}
+
+	parsed, err := time.ParseInLocation(graphDateTimeLayout, value.DateTime, loc)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return parsed, true
+}
+
+// graphDueDate converte o vencimento. O To Do só tem vencimento por dia, então a
+// tarefa vence no fim desse dia no fuso do usuário.
+func graphDueDate(value *graphDateTime, loc *time.Location) *time.Time {
+	due, ok := parseGraphDateTime(value)
+	if !ok {
+		return nil
+	}
+
+	end := endOfDay(due.Year(), due.Month(), due.Day(), loc)
+	return &end
+}
+
+// graphRRule converte o padrão de recorrência da Graph API em RRULE (RFC 5545).
+// Padrões desconhecidos geram aviso e a tarefa fica sem recorrência.
+func graphRRule(p *Progress, task MicrosoftTodoTask) string {
+	recurrence := task.Recurrence
+	if recurrence == nil || recurrence.Pattern.Type == "" {
+		return ""
+	}
+	pattern := recurrence.Pattern
+
+	days := make([]string, 0, len(pattern.DaysOfWeek))
+	for _, day := range pattern.DaysOfWeek {
+		if code, ok := graphWeekdays[strings.ToLower(day)]; ok {
+			days = append(days, code)
+		}
+	}
+
+	var parts []string
+	switch pattern.Type {
+	case "daily":
+		parts = append(parts, "FREQ=DAILY")
+	case "weekly":
+		parts = append(parts, "FREQ=WEEKLY")
+		if len(days) > 0 {
+			parts = append(parts, "BYDAY="+strings.Join(days, ","))
+		}
+		if start, ok := graphWeekdays[strings.ToLower(pattern.FirstDayOfWeek)]; ok && start != "MO" {
+			parts = append(parts, "WKST="+start)
+		}
+	case "absoluteMonthly":
+		parts = append(parts, "FREQ=MONTHLY", "BYMONTHDAY="+strconv.Itoa(pattern.DayOfMonth))
+	case "relativeMonthly":
+		parts = append(parts, "FREQ=MONTHLY")
+		parts = append(parts, relativeByDay(days, pattern.Index)...)
+	case "absoluteYearly":
+		parts = append(parts, "FREQ=YEARLY", "BYMONTH="+strconv.Itoa(pattern.Month), "BYMONTHDAY="+strconv.Itoa(pattern.DayOfMonth))
+	case "relativeYearly":
+		parts = append(parts, "FREQ=YEARLY", "BYMONTH="+strconv.Itoa(pattern.Month))
+		parts = append(parts, relativeByDay(days, pattern.Index)...)
+	default:
+		p.Warn("recorrência %q de %q não reconhecida; a tarefa foi importada sem repetição", pattern.Type, truncate(task.Title, 40))
+		return ""
+	}
+
+	if pattern.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(pattern.Interval))
+	}
+
+	switch recurrence.Range.Type {
+	case "endDate":
+		if end, err := time.Parse("2006-01-02", recurrence.Range.EndDate); err == nil {
+			parts = append(parts, "UNTIL="+end.Format("20060102"))
+		}
+	case "numbered":
+		if recurrence.Range.NumberOfOccurrences > 0 {
+			parts = append(parts, "COUNT="+strconv.Itoa(recurrence.Range.NumberOfOccurrences))
+		}
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// relativeByDay monta o BYDAY dos padrões relativos ("segunda terça-feira do mês").
+// Com vários dias, a posição vai em BYSETPOS.
+func relativeByDay(days []string, index string) []string {
+	if len(days) == 0 {
+		return nil
+	}
+
+	position, ok := graphWeekIndexes[strings.ToLower(index)]
+	if !ok {
+		position = 1
+	}
+
+	if len(days) == 1 {
+		return []string{"BYDAY=" + strconv.Itoa(position) + days[0]}
+	}
+	return []string{"BYDAY=" + strings.Join(days, ","), "BYSETPOS=" + strconv.Itoa(position)}
+}
@@ -0,0 +1,181 @@
+// Package importer traz projetos e tarefas de outras ferramentas (Todoist, ...).
+// Cada importação roda em segundo plano e tem o progresso gravado em import_jobs,
+// consultável pelo usuário enquanto o processamento acontece.
+package importer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/apperrors"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrImportInProgress indica que o usuário já tem uma importação em andamento
+var ErrImportInProgress = apperrors.Conflict("já existe uma importação em andamento")
+
+const (
+	// saveInterval limita a frequência de gravação do progresso
+	saveInterval = 2 * time.Second
+	// staleAfter é o tempo sem progresso após o qual uma importação deixa de bloquear novas
+	staleAfter = 10 * time.Minute
+)
+
+// Func executa uma importação, registrando o andamento em p
+type Func func(ctx context.Context, p *Progress) error
+
+// Runner executa importações em segundo plano
+type Runner struct {
+	jobs repositories.ImportJobRepository
+	ctx  context.Context
+	wg   sync.WaitGroup
+}
+
+// NewRunner cria o executor. As importações são canceladas quando ctx é cancelado.
+func NewRunner(ctx context.Context, jobs repositories.ImportJobRepository) *Runner {
+	return &Runner{jobs: jobs, ctx: ctx}
+}
+
+// Start registra a importação e a executa em segundo plano, retornando o estado inicial
+func (r *Runner) Start(ctx context.Context, userID primitive.ObjectID, source string, run Func) (*entities.ImportJob, error) {
+	active, err := r.jobs.HasActive(ctx, userID, staleAfter)
+	if err != nil {
+		return nil, err
+	}
+	if active {
+		return nil, ErrImportInProgress
+	}
+
+	job := &entities.ImportJob{}
+	job.PrepareForCreate(userID, source)
+
+	if err := r.jobs.Create(ctx, job); err != nil {
+		return nil, err
+	}
+
+	created := *job
+
+	r.wg.Add(1)
+	go r.execute(job, run)
+
+	return &created, nil
+}
+
+// Get retorna o estado atual de uma importação do usuário
+func (r *Runner) Get(ctx context.Context, userID, id primitive.ObjectID) (*entities.ImportJob, error) {
+	return r.jobs.GetByID(ctx, userID, id)
+}
+
+// Wait aguarda as importações em execução terminarem (usado no shutdown)
+func (r *Runner) Wait() {
+	r.wg.Wait()
+}
+
+// execute roda a importação e grava o resultado final
+func (r *Runner) execute(job *entities.ImportJob, run Func) {
+	defer r.wg.Done()
+
+	logger := slog.With("import_id", job.ID.Hex(), "source", job.Source, "user_id", job.UserID.Hex())
+	ctx := logging.WithContext(r.ctx, logger)
+
+	p := &Progress{job: job, jobs: r.jobs}
+
+	startedAt := time.Now()
+	job.Status = entities.ImportJobRunning
+	job.StartedAt = &startedAt
+	p.save(ctx)
+
+	err := safeRun(ctx, run, p)
+
+	finishedAt := time.Now()
+	job.FinishedAt = &finishedAt
+	job.Status = entities.ImportJobCompleted
+
+	switch {
+	case err != nil && ctx.Err() != nil:
+		job.Status = entities.ImportJobFailed
+		job.Error = "importação interrompida pelo encerramento do servidor"
+	case err != nil:
+		job.Status = entities.ImportJobFailed
+		job.Error = err.Error()
+	}
+
+	// O resultado é gravado mesmo com o contexto cancelado pelo shutdown
+	p.save(context.WithoutCancel(ctx))
+
+	logger.Info("importação finalizada",
+		"status", job.Status,
+		"projects", job.ProjectsCreated,
+		"tasks", job.TasksCreated,
+		"warnings", len(job.Warnings)+job.WarningsOmitted,
+		"duration", finishedAt.Sub(startedAt).String(),
+		logging.Err(err),
+	)
+}
+
+// safeRun executa a importação convertendo panics em erro
+func safeRun(ctx context.Context, run Func, p *Progress) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			logging.FromContext(ctx).Error("panic durante importação", "panic", recovered)
+			err = fmt.Errorf("erro inesperado durante a importação")
+		}
+	}()
+
+	return run(ctx, p)
+}
+
+// Progress registra o andamento de uma importação
+type Progress struct {
+	job      *entities.ImportJob
+	jobs     repositories.ImportJobRepository
+	lastSave time.Time
+}
+
+// SetTotal informa quantos itens serão processados
+func (p *Progress) SetTotal(ctx context.Context, total int) {
+	p.job.Total = total
+	p.save(ctx)
+}
+
+// Step marca um item como processado, gravando o progresso periodicamente.
+// Retorna erro se a importação foi cancelada.
+func (p *Progress) Step(ctx context.Context) error {
+	p.job.Processed++
+
+	if time.Since(p.lastSave) >= saveInterval {
+		p.save(ctx)
+	}
+
+	return ctx.Err()
+}
+
+// ProjectCreated contabiliza um projeto criado
+func (p *Progress) ProjectCreated() {
+	p.job.ProjectsCreated++
+}
+
+// TaskCreated contabiliza uma tarefa criada
+func (p *Progress) TaskCreated() {
+	p.job.TasksCreated++
+}
+
+// Warn registra um item que não pôde ser convertido integralmente
+func (p *Progress) Warn(format string, args ...interface{}) {
+	p.job.AddWarning(fmt.Sprintf(format, args...))
+}
+
+// save grava o estado atual; falhas só afetam a exibição do progresso
+func (p *Progress) save(ctx context.Context) {
+	p.lastSave = time.Now()
+
+	if err := p.jobs.Save(ctx, p.job); err != nil {
+		logging.FromContext(ctx).Warn("erro ao gravar progresso da importação", "error", err)
+	}
+}
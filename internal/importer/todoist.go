@@ -0,0 +1,425 @@
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SourceTodoist identifica as importações vindas do Todoist
+const SourceTodoist = "todoist"
+
+// todoistSyncURL é o endpoint de sincronização da API do Todoist
+const todoistSyncURL = "https://api.todoist.com/api/v1/sync"
+
+// maxTodoistResponseBytes limita a resposta lida da API do Todoist
+const maxTodoistResponseBytes = 50 << 20
+
+// ErrInvalidExport indica arquivo de exportação em formato não reconhecido
+var ErrInvalidExport = errors.New("arquivo de exportação inválido")
+
+// todoistColors converte os nomes de cor do Todoist em hexadecimal
+var todoistColors = map[string]string{
+	"berry_red":   "#b8255f",
+	"red":         "#db4035",
+	"orange":      "#ff9933",
+	"yellow":      "#fad000",
+	"olive_green": "#afb83b",
+	"lime_green":  "#7ecc49",
+	"green":       "#299438",
+	"mint_green":  "#6accbc",
+	"teal":        "#158fad",
+	"sky_blue":    "#14aaf5",
+	"light_blue":  "#96c3eb",
+	"blue":        "#4073ff",
+	"grape":       "#884dff",
+	"violet":      "#af38eb",
+	"lavender":    "#eb96eb",
+	"magenta":     "#e05194",
+	"salmon":      "#ff8d85",
+	"charcoal":    "#808080",
+	"grey":        "#b8b8b8",
+	"taupe":       "#ccac93",
+}
+
+// TodoistExport segue o formato da resposta de /sync da API do Todoist.
+// Backups em ZIP/CSV são convertidos para este formato por ParseTodoistExport.
+type TodoistExport struct {
+	Projects []TodoistProject `json:"projects"`
+	Items    []TodoistItem    `json:"items"`
+	Labels   []TodoistLabel   `json:"labels"`
+}
+
+type TodoistProject struct {
+	ID           todoistID   `json:"id"`
+	Name         string      `json:"name"`
+	Color        string      `json:"color"`
+	InboxProject bool        `json:"inbox_project"`
+	IsDeleted    todoistBool `json:"is_deleted"`
+	IsArchived   todoistBool `json:"is_archived"`
+}
+
+type TodoistItem struct {
+	ID          todoistID   `json:"id"`
+	ProjectID   todoistID   `json:"project_id"`
+	ParentID    todoistID   `json:"parent_id"`
+	Content     string      `json:"content"`
+	Description string      `json:"description"`
+	Priority    int         `json:"priority"`
+	Due         *TodoistDue `json:"due"`
+	Labels      []todoistID `json:"labels"`
+	Checked     todoistBool `json:"checked"`
+	IsDeleted   todoistBool `json:"is_deleted"`
+	CompletedAt string      `json:"completed_at"`
+}
+
+type TodoistDue struct {
+	Date        string `json:"date"`
+	Timezone    string `json:"timezone"`
+	String      string `json:"string"`
+	IsRecurring bool   `json:"is_recurring"`
+}
+
+type TodoistLabel struct {
+	ID   todoistID `json:"id"`
+	Name string    `json:"name"`
+}
+
+// todoistID aceita IDs como texto (API atual) ou número (versões antigas)
+type todoistID string
+
+func (id *todoistID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*id = ""
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*id = todoistID(text)
+		return nil
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return fmt.Errorf("id do Todoist inválido: %s", data)
+	}
+	*id = todoistID(number.String())
+	return nil
+}
+
+// todoistBool aceita true/false ou 0/1 (versões antigas da API)
+type todoistBool bool
+
+func (b *todoistBool) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case "true", "1":
+		*b = true
+	case "false", "0", "null":
+		*b = false
+	default:
+		return fmt.Errorf("booleano do Todoist inválido: %s", data)
+	}
+	return nil
+}
+
+// TodoistImporter converte projetos, tarefas e etiquetas do Todoist
+type TodoistImporter struct {
+	store
+	users   repositories.UserRepository
+	client  *http.Client
+	syncURL string
+}
+
+// NewTodoistImporter cria o importador do Todoist
+func NewTodoistImporter(users repositories.UserRepository, projects repositories.ProjectRepository, todos repositories.TodoRepository) *TodoistImporter {
+	return &TodoistImporter{
+		store:   newStore(projects, todos),
+		users:   users,
+		client:  &http.Client{Timeout: 60 * time.Second},
+		syncURL: todoistSyncURL,
+	}
+}
+
+// ParseTodoistExport interpreta o arquivo enviado: JSON no formato da API de sync,
+// backup ZIP (um CSV por projeto) ou um único CSV de projeto
+func ParseTodoistExport(data []byte) (*TodoistExport, error) {
+	trimmed := bytes.TrimSpace(data)
+
+	switch {
+	case len(trimmed) == 0:
+		return nil, ErrInvalidExport
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		var export TodoistExport
+		if err := json.Unmarshal(trimmed, &export); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidExport, err)
+		}
+		if export.Projects == nil && export.Items == nil {
+			return nil, fmt.Errorf("%w: projects e items ausentes", ErrInvalidExport)
+		}
+		return &export, nil
+	case bytes.HasPrefix(trimmed, []byte("PK")):
+		return parseTodoistBackup(data)
+	default:
+		export := &TodoistExport{}
+		if err := addTodoistCSV(export, "Todoist", bytes.NewReader(data)); err != nil {
+			return nil, err
+		}
+		return export, nil
+	}
+}
+
+// parseTodoistBackup lê o ZIP de backup do Todoist (um CSV por projeto)
+func parseTodoistBackup(data []byte) (*TodoistExport, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidExport, err)
+	}
+
+	export := &TodoistExport{}
+	for _, file := range archive.File {
+		if file.FileInfo().IsDir() || !strings.EqualFold(path.Ext(file.Name), ".csv") {
+			continue
+		}
+
+		content, err := file.Open()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidExport, err)
+		}
+
+		name := strings.TrimSuffix(path.Base(file.Name), path.Ext(file.Name))
+		err = addTodoistCSV(export, name, content)
+		content.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(export.Projects) == 0 {
+		return nil, fmt.Errorf("%w: nenhum CSV encontrado no ZIP", ErrInvalidExport)
+	}
+
+	return export, nil
+}
+
+// FromExport retorna a importação dos dados já interpretados
+func (i *TodoistImporter) FromExport(userID primitive.ObjectID, export *TodoistExport) Func {
+	return func(ctx context.Context, p *Progress) error {
+		return i.run(ctx, p, userID, export)
+	}
+}
+
+// FromAPIToken retorna a importação que busca os dados na API do Todoist com o token do usuário.
+// A API de sync devolve apenas tarefas em aberto.
+func (i *TodoistImporter) FromAPIToken(userID primitive.ObjectID, token string) Func {
+	return func(ctx context.Context, p *Progress) error {
+		export, err := i.fetch(ctx, token)
+		if err != nil {
+			return err
+		}
+		return i.run(ctx, p, userID, export)
+	}
+}
+
+// fetch baixa projetos, tarefas e etiquetas pela API de sync
+func (i *TodoistImporter) fetch(ctx context.Context, token string) (*TodoistExport, error) {
+	form := url.Values{
+		"sync_token":     {"*"},
+		"resource_types": {`["projects","items","labels"]`},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, i.syncURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao montar requisição ao Todoist: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := i.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao acessar a API do Todoist: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return nil, errors.New("token do Todoist inválido ou sem permissão")
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("API do Todoist respondeu %d", resp.StatusCode)
+	}
+
+	var export TodoistExport
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxTodoistResponseBytes)).Decode(&export); err != nil {
+		return nil, fmt.Errorf("erro ao ler resposta do Todoist: %w", err)
+	}
+
+	return &export, nil
+}
+
+// run cria os projetos e, em seguida, as tarefas
+func (i *TodoistImporter) run(ctx context.Context, p *Progress, userID primitive.ObjectID, export *TodoistExport) error {
+	user, err := i.users.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	loc := user.Preferences.Location()
+
+	var projects []TodoistProject
+	for _, project := range export.Projects {
+		if !project.IsDeleted {
+			projects = append(projects, project)
+		}
+	}
+
+	var items []TodoistItem
+	for _, item := range export.Items {
+		if !item.IsDeleted {
+			items = append(items, item)
+		}
+	}
+
+	p.SetTotal(ctx, len(projects)+len(items))
+
+	labels := make(map[todoistID]string, len(export.Labels))
+	for _, label := range export.Labels {
+		labels[label.ID] = label.Name
+	}
+
+	// Tarefas da caixa de entrada ficam sem projeto
+	projectIDs := make(map[todoistID]*primitive.ObjectID, len(projects))
+	archived := make(map[todoistID]bool)
+
+	for _, source := range projects {
+		if !source.InboxProject {
+			project, err := i.createProject(ctx, p, userID, source.Name, todoistColors[source.Color])
+			if err != nil {
+				return err
+			}
+			projectIDs[source.ID] = &project.ID
+			archived[source.ID] = bool(source.IsArchived)
+		}
+
+		if err := p.Step(ctx); err != nil {
+			return err
+		}
+	}
+
+	subtasks := 0
+	for _, item := range items {
+		if item.ParentID != "" {
+			subtasks++
+		}
+
+		task := &entities.Task{
+			ProjectID:   projectIDs[item.ProjectID],
+			Title:       item.Content,
+			Description: item.Description,
+			Priority:    todoistPriority(item.Priority),
+			DueDate:     todoistDueDate(p, item, loc),
+			Tags:        todoistTags(item.Labels, labels),
+			IsArchived:  archived[item.ProjectID],
+		}
+		if item.Checked {
+			task.Status = enums.StatusCompleted
+			if completedAt, err := time.Parse(time.RFC3339, item.CompletedAt); err == nil {
+				task.CompletedAt = &completedAt
+			}
+		}
+
+		if err := i.createTask(ctx, p, userID, task); err != nil {
+			return err
+		}
+
+		if err := p.Step(ctx); err != nil {
+			return err
+		}
+	}
+
+	if subtasks > 0 {
+		p.Warn("%d subtarefas importadas como tarefas independentes", subtasks)
+	}
+
+	return nil
+}
+
+// todoistPriority converte a prioridade da API (4 = p1, a mais alta)
+func todoistPriority(priority int) enums.TaskPriority {
+	switch priority {
+	case 4:
+		return enums.PriorityUrgent
+	case 3:
+		return enums.PriorityHigh
+	case 2:
+		return enums.PriorityMedium
+	default:
+		return enums.PriorityLow
+	}
+}
+
+// todoistDueDate converte o vencimento: datas sem horário vencem no fim do dia
+// (no fuso do usuário) e horários "flutuantes" usam o fuso da tarefa ou do usuário
+func todoistDueDate(p *Progress, item TodoistItem, loc *time.Location) *time.Time {
+	if item.Due == nil || item.Due.Date == "" {
+		return nil
+	}
+
+	if item.Due.Timezone != "" {
+		if taskLoc, err := time.LoadLocation(item.Due.Timezone); err == nil {
+			loc = taskLoc
+		}
+	}
+
+	due, ok := parseTodoistDate(item.Due.Date, loc)
+	if !ok {
+		p.Warn("vencimento %q de %q não reconhecido", item.Due.Date, truncate(item.Content, 40))
+		return nil
+	}
+
+	if item.Due.IsRecurring {
+		p.Warn("recorrência de %q (%s) não importada; apenas o próximo vencimento foi mantido",
+			truncate(item.Content, 40), item.Due.String)
+	}
+
+	return &due
+}
+
+// parseTodoistDate aceita data/hora com fuso (RFC3339), data/hora sem fuso e data sem horário
+func parseTodoistDate(value string, loc *time.Location) (time.Time, bool) {
+	if due, err := time.Parse(time.RFC3339, value); err == nil {
+		return due, true
+	}
+	if due, err := time.ParseInLocation("2006-01-02T15:04:05", value, loc); err == nil {
+		return due, true
+	}
+	if day, err := time.Parse("2006-01-02", value); err == nil {
+		return endOfDay(day.Year(), day.Month(), day.Day(), loc), true
+	}
+	return time.Time{}, false
+}
+
+// todoistTags resolve as etiquetas: nomes (API atual) ou IDs (versões antigas)
+func todoistTags(values []todoistID, labels map[todoistID]string) []string {
+	tags := make([]string, 0, len(values))
+	for _, value := range values {
+		if name, ok := labels[value]; ok {
+			tags = append(tags, name)
+			continue
+		}
+		tags = append(tags, string(value))
+	}
+	return tags
+}
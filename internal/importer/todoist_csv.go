@@ -0,0 +1,164 @@
+package importer
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// todoistFileSuffix remove o sufixo " [id]" que o Todoist acrescenta ao nome dos arquivos
+var todoistFileSuffix = regexp.MustCompile(`\s*\[\d+\]$`)
+
+// todoistInlineLabel encontra etiquetas escritas no texto da tarefa (@etiqueta)
+var todoistInlineLabel = regexp.MustCompile(`(^|\s)@([\p{L}\p{N}_\-]+)`)
+
+// todoistCSVDateLayouts lista os formatos de data reconhecidos nos CSVs.
+// Datas em linguagem natural ("toda segunda") não são convertidas.
+var todoistCSVDateLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"Jan 2 2006 15:04",
+	"Jan 2 2006",
+	"2 Jan 2006 15:04",
+	"2 Jan 2006",
+}
+
+// todoistRecurringPrefixes identifica datas recorrentes escritas em linguagem natural
+var todoistRecurringPrefixes = []string{"every ", "every!", "a cada ", "todo ", "toda ", "todos ", "todas "}
+
+// addTodoistCSV converte o CSV de um projeto (colunas TYPE, CONTENT, DESCRIPTION,
+// PRIORITY, INDENT, DATE, TIMEZONE...) para o formato da API
+func addTodoistCSV(export *TodoistExport, fileName string, r io.Reader) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidExport, fileName, err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for index, name := range header {
+		columns[strings.ToUpper(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = index
+	}
+	if _, ok := columns["CONTENT"]; !ok {
+		return fmt.Errorf("%w: %s: coluna CONTENT ausente", ErrInvalidExport, fileName)
+	}
+
+	name := todoistFileSuffix.ReplaceAllString(fileName, "")
+	project := TodoistProject{
+		ID:           todoistID(fmt.Sprintf("csv-%d", len(export.Projects))),
+		Name:         name,
+		InboxProject: strings.EqualFold(name, "Inbox") || strings.EqualFold(name, "Entrada"),
+	}
+	export.Projects = append(export.Projects, project)
+
+	field := func(record []string, column string) string {
+		if index, ok := columns[column]; ok && index < len(record) {
+			return strings.TrimSpace(record[index])
+		}
+		return ""
+	}
+
+	var last *TodoistItem
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrInvalidExport, fileName, err)
+		}
+
+		switch strings.ToLower(field(record, "TYPE")) {
+		case "task", "":
+			content := field(record, "CONTENT")
+			if content == "" {
+				continue
+			}
+
+			item := TodoistItem{
+				ID:          todoistID(fmt.Sprintf("%s-%d", project.ID, len(export.Items))),
+				ProjectID:   project.ID,
+				Description: field(record, "DESCRIPTION"),
+				Priority:    todoistCSVPriority(field(record, "PRIORITY")),
+			}
+			item.Content, item.Labels = todoistCSVLabels(content)
+
+			if indent, _ := strconv.Atoi(field(record, "INDENT")); indent > 1 && last != nil {
+				item.ParentID = last.ID
+			}
+
+			if date := field(record, "DATE"); date != "" {
+				item.Due = todoistCSVDue(date, field(record, "TIMEZONE"))
+			}
+
+			export.Items = append(export.Items, item)
+			last = &export.Items[len(export.Items)-1]
+		case "note":
+			// Comentários são anexados à descrição da tarefa anterior
+			if last != nil {
+				if note := field(record, "CONTENT"); note != "" {
+					last.Description = strings.TrimSpace(last.Description + "\n\n" + note)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// todoistCSVPriority converte a prioridade do CSV (1 = p1, a mais alta) para a escala da API
+func todoistCSVPriority(value string) int {
+	priority, err := strconv.Atoi(value)
+	if err != nil || priority < 1 || priority > 4 {
+		return 1
+	}
+	return 5 - priority
+}
+
+// todoistCSVLabels separa as etiquetas escritas no texto (@etiqueta) do título
+func todoistCSVLabels(content string) (string, []todoistID) {
+	var labels []todoistID
+	for _, match := range todoistInlineLabel.FindAllStringSubmatch(content, -1) {
+		labels = append(labels, todoistID(match[2]))
+	}
+
+	title := todoistInlineLabel.ReplaceAllString(content, "$1")
+	return strings.Join(strings.Fields(title), " "), labels
+}
+
+// todoistCSVDue converte datas explícitas; datas em linguagem natural são mantidas
+// em String para que o aviso de "não reconhecido" mostre o texto original
+func todoistCSVDue(value, timezone string) *TodoistDue {
+	due := &TodoistDue{Date: value, String: value, Timezone: timezone}
+
+	lower := strings.ToLower(value)
+	for _, prefix := range todoistRecurringPrefixes {
+		if strings.HasPrefix(lower, prefix) {
+			due.IsRecurring = true
+			break
+		}
+	}
+
+	for _, layout := range todoistCSVDateLayouts {
+		parsed, err := time.Parse(layout, value)
+		if err != nil {
+			continue
+		}
+		if strings.Contains(layout, "15:04") {
+			due.Date = parsed.Format("2006-01-02T15:04:05")
+		} else {
+			due.Date = parsed.Format("2006-01-02")
+		}
+		return due
+	}
+
+	return due
+}
@@ -0,0 +1,406 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SourceTrello identifica as importações vindas do Trello
+const SourceTrello = "trello"
+
+// trelloReportExamples limita quantos cartões são citados em cada item do relatório
+const trelloReportExamples = 5
+
+// trelloColors converte as cores de fundo e de etiqueta do Trello em hexadecimal
+var trelloColors = map[string]string{
+	"blue":   "#0079bf",
+	"orange": "#d29034",
+	"green":  "#519839",
+	"red":    "#b04632",
+	"purple": "#89609e",
+	"pink":   "#cd5a91",
+	"lime":   "#4bbf6b",
+	"sky":    "#00aecc",
+	"grey":   "#838c91",
+	"yellow": "#d9b51c",
+	"black":  "#344563",
+}
+
+// trelloColumnKeywords associa nomes comuns de colunas aos status padrão.
+// Colunas sem correspondência ficam como pendentes.
+var trelloColumnKeywords = []struct {
+	status   enums.TaskStatus
+	keywords []string
+}{
+	{enums.StatusCompleted, []string{"done", "complete", "finished", "conclu", "feito", "finaliz", "pronto", "entregue"}},
+	{enums.StatusCancelled, []string{"cancel", "descart", "won't", "wont"}},
+	{enums.StatusInProgress, []string{"doing", "progress", "andamento", "fazendo", "progresso", "wip", "review", "revis", "testing", "teste"}},
+}
+
+// TrelloBoard segue o formato do JSON exportado por quadro no Trello
+// (Menu → Imprimir, exportar e compartilhar → Exportar como JSON)
+type TrelloBoard struct {
+	ID           string            `json:"id"`
+	Name         string            `json:"name"`
+	Prefs        TrelloBoardPrefs  `json:"prefs"`
+	Labels       []TrelloLabel     `json:"labels"`
+	Lists        []TrelloList      `json:"lists"`
+	Cards        []TrelloCard      `json:"cards"`
+	Checklists   []TrelloChecklist `json:"checklists"`
+	Actions      []TrelloAction    `json:"actions"`
+	CustomFields []json.RawMessage `json:"customFields"`
+}
+
+type TrelloBoardPrefs struct {
+	Background string `json:"background"`
+}
+
+type TrelloLabel struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type TrelloList struct {
+	ID     string    `json:"id"`
+	Name   string    `json:"name"`
+	Closed bool      `json:"closed"`
+	Pos    trelloPos `json:"pos"`
+}
+
+type TrelloCard struct {
+	ID               string            `json:"id"`
+	IDList           string            `json:"idList"`
+	Name             string            `json:"name"`
+	Desc             string            `json:"desc"`
+	Closed           bool              `json:"closed"`
+	Due              string            `json:"due"`
+	Start            string            `json:"start"`
+	IDLabels         []string          `json:"idLabels"`
+	IDMembers        []string          `json:"idMembers"`
+	Attachments      []json.RawMessage `json:"attachments"`
+	CustomFieldItems []json.RawMessage `json:"customFieldItems"`
+	DateLastActivity string            `json:"dateLastActivity"`
+	Pos              trelloPos         `json:"pos"`
+}
+
+type TrelloChecklist struct {
+	ID         string            `json:"id"`
+	IDCard     string            `json:"idCard"`
+	Name       string            `json:"name"`
+	Pos        trelloPos         `json:"pos"`
+	CheckItems []TrelloCheckItem `json:"checkItems"`
+}
+
+type TrelloCheckItem struct {
+	Name  string    `json:"name"`
+	State string    `json:"state"`
+	Pos   trelloPos `json:"pos"`
+}
+
+type TrelloAction struct {
+	Type string `json:"type"`
+	Data struct {
+		Card struct {
+			ID string `json:"id"`
+		} `json:"card"`
+	} `json:"data"`
+}
+
+// trelloPos aceita posições numéricas ou textuais ("top"/"bottom")
+type trelloPos float64
+
+func (p *trelloPos) UnmarshalJSON(data []byte) error {
+	var number float64
+	if err := json.Unmarshal(data, &number); err == nil {
+		*p = trelloPos(number)
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return fmt.Errorf("posição do Trello inválida: %s", data)
+	}
+	if text == "bottom" {
+		*p = trelloPos(1 << 52)
+	} else {
+		*p = 0
+	}
+	return nil
+}
+
+// TrelloImporter converte quadros do Trello em projetos, status personalizados e tarefas
+type TrelloImporter struct {
+	store
+}
+
+// NewTrelloImporter cria o importador do Trello
+func NewTrelloImporter(projects repositories.ProjectRepository, todos repositories.TodoRepository) *TrelloImporter {
+	return &TrelloImporter{store: newStore(projects, todos)}
+}
+
+// ParseTrelloExport interpreta o JSON exportado de um quadro (ou uma lista de quadros)
+func ParseTrelloExport(data []byte) ([]TrelloBoard, error) {
+	trimmed := bytes.TrimSpace(data)
+
+	var boards []TrelloBoard
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("[")):
+		if err := json.Unmarshal(trimmed, &boards); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidExport, err)
+		}
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		var board TrelloBoard
+		if err := json.Unmarshal(trimmed, &board); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidExport, err)
+		}
+		boards = []TrelloBoard{board}
+	default:
+		return nil, ErrInvalidExport
+	}
+
+	for _, board := range boards {
+		if board.Name == "" || board.Lists == nil {
+			return nil, fmt.Errorf("%w: quadro sem nome ou listas", ErrInvalidExport)
+		}
+	}
+
+	return boards, nil
+}
+
+// FromExport retorna a importação dos quadros já interpretados
+func (i *TrelloImporter) FromExport(userID primitive.ObjectID, boards []TrelloBoard) Func {
+	return func(ctx context.Context, p *Progress) error {
+		total := len(boards)
+		for _, board := range boards {
+			total += len(board.Cards)
+		}
+		p.SetTotal(ctx, total)
+
+		report := newTrelloReport()
+		for _, board := range boards {
+			if err := i.importBoard(ctx, p, userID, board, report); err != nil {
+				return err
+			}
+		}
+
+		report.flush(p)
+		return nil
+	}
+}
+
+// importBoard cria o projeto do quadro (colunas como status personalizados) e as tarefas dos cartões
+func (i *TrelloImporter) importBoard(ctx context.Context, p *Progress, userID primitive.ObjectID, board TrelloBoard, report *trelloReport) error {
+	lists := make(map[string]TrelloList, len(board.Lists))
+	statuses := make([]entities.ProjectStatus, 0, len(board.Lists))
+
+	sort.SliceStable(board.Lists, func(a, b int) bool { return board.Lists[a].Pos < board.Lists[b].Pos })
+	for _, list := range board.Lists {
+		lists[list.ID] = list
+		statuses = append(statuses, entities.ProjectStatus{
+			ID:       list.ID,
+			Name:     truncate(strings.TrimSpace(list.Name), maxProjectNameLength),
+			Category: trelloColumnStatus(list.Name),
+		})
+	}
+
+	project, err := i.createProject(ctx, p, userID, board.Name, trelloColors[board.Prefs.Background], statuses...)
+	if err != nil {
+		return err
+	}
+	if err := p.Step(ctx); err != nil {
+		return err
+	}
+
+	categories := make(map[string]enums.TaskStatus, len(statuses))
+	for _, status := range statuses {
+		categories[status.ID] = status.Category
+	}
+
+	labels := make(map[string]string, len(board.Labels))
+	for _, label := range board.Labels {
+		name := strings.TrimSpace(label.Name)
+		if name == "" {
+			name = label.Color
+		}
+		labels[label.ID] = name
+	}
+
+	checklists := make(map[string][]TrelloChecklist)
+	for _, checklist := range board.Checklists {
+		checklists[checklist.IDCard] = append(checklists[checklist.IDCard], checklist)
+	}
+
+	comments := make(map[string]int)
+	for _, action := range board.Actions {
+		if action.Type == "commentCard" {
+			comments[action.Data.Card.ID]++
+		}
+	}
+
+	if len(board.CustomFields) > 0 {
+		report.add("campos personalizados do quadro", board.Name)
+	}
+
+	cards := board.Cards
+	sort.SliceStable(cards, func(a, b int) bool {
+		listA, listB := lists[cards[a].IDList].Pos, lists[cards[b].IDList].Pos
+		if listA != listB {
+			return listA < listB
+		}
+		return cards[a].Pos < cards[b].Pos
+	})
+
+	for _, card := range cards {
+		list, ok := lists[card.IDList]
+
+		task := &entities.Task{
+			ProjectID:   &project.ID,
+			Title:       card.Name,
+			Description: card.Desc,
+			Status:      enums.StatusPending,
+			Tags:        trelloTags(card.IDLabels, labels),
+			Checklist:   trelloChecklist(checklists[card.ID]),
+			IsArchived:  card.Closed || list.Closed,
+		}
+
+		if ok {
+			task.StatusID = list.ID
+			task.Status = categories[list.ID]
+		} else {
+			report.add("cartões de listas inexistentes (sem status personalizado)", card.Name)
+		}
+
+		if card.Due != "" {
+			if due, err := time.Parse(time.RFC3339, card.Due); err == nil {
+				task.DueDate = &due
+			} else {
+				report.add("vencimentos não reconhecidos", card.Name)
+			}
+		}
+
+		if task.Status == enums.StatusCompleted {
+			if lastActivity, err := time.Parse(time.RFC3339, card.DateLastActivity); err == nil {
+				task.CompletedAt = &lastActivity
+			}
+		}
+
+		if len(card.Attachments) > 0 {
+			report.add("anexos", card.Name)
+		}
+		if comments[card.ID] > 0 {
+			report.add("comentários", card.Name)
+		}
+		if len(card.IDMembers) > 0 {
+			report.add("membros atribuídos", card.Name)
+		}
+		if len(card.CustomFieldItems) > 0 {
+			report.add("valores de campos personalizados", card.Name)
+		}
+		if card.Start != "" {
+			report.add("datas de início", card.Name)
+		}
+
+		if err := i.createTask(ctx, p, userID, task); err != nil {
+			return err
+		}
+
+		if err := p.Step(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// trelloColumnStatus deduz o status padrão de uma coluna pelo nome
+func trelloColumnStatus(name string) enums.TaskStatus {
+	lower := strings.ToLower(name)
+	for _, candidate := range trelloColumnKeywords {
+		for _, keyword := range candidate.keywords {
+			if strings.Contains(lower, keyword) {
+				return candidate.status
+			}
+		}
+	}
+	return enums.StatusPending
+}
+
+// trelloTags resolve as etiquetas do cartão (etiquetas sem nome usam a cor)
+func trelloTags(ids []string, labels map[string]string) []string {
+	tags := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if name := labels[id]; name != "" {
+			tags = append(tags, name)
+		}
+	}
+	return tags
+}
+
+// trelloChecklist junta as checklists do cartão em uma só, prefixando os itens
+// com o nome da checklist quando há mais de uma
+func trelloChecklist(checklists []TrelloChecklist) []entities.ChecklistItem {
+	sort.SliceStable(checklists, func(a, b int) bool { return checklists[a].Pos < checklists[b].Pos })
+
+	var items []entities.ChecklistItem
+	for _, checklist := range checklists {
+		checkItems := checklist.CheckItems
+		sort.SliceStable(checkItems, func(a, b int) bool { return checkItems[a].Pos < checkItems[b].Pos })
+
+		for _, checkItem := range checkItems {
+			text := strings.TrimSpace(checkItem.Name)
+			if len(checklists) > 1 && checklist.Name != "" {
+				text = checklist.Name + ": " + text
+			}
+			items = append(items, entities.ChecklistItem{
+				ID:   primitive.NewObjectID(),
+				Text: text,
+				Done: checkItem.State == "complete",
+			})
+		}
+	}
+	return items
+}
+
+// trelloReport agrupa o que não pôde ser convertido, citando alguns cartões de exemplo
+type trelloReport struct {
+	order    []string
+	counts   map[string]int
+	examples map[string][]string
+}
+
+func newTrelloReport() *trelloReport {
+	return &trelloReport{counts: map[string]int{}, examples: map[string][]string{}}
+}
+
+// add registra um item não convertido para o cartão (ou quadro) informado
+func (r *trelloReport) add(kind, name string) {
+	if _, ok := r.counts[kind]; !ok {
+		r.order = append(r.order, kind)
+	}
+	r.counts[kind]++
+	if len(r.examples[kind]) < trelloReportExamples {
+		r.examples[kind] = append(r.examples[kind], fmt.Sprintf("%q", truncate(name, 40)))
+	}
+}
+
+// flush grava o relatório como avisos da importação
+func (r *trelloReport) flush(p *Progress) {
+	for _, kind := range r.order {
+		examples := strings.Join(r.examples[kind], ", ")
+		if extra := r.counts[kind] - len(r.examples[kind]); extra > 0 {
+			examples += fmt.Sprintf(" e mais %d", extra)
+		}
+		p.Warn("não importado: %s (%d): %s", kind, r.counts[kind], examples)
+	}
+}
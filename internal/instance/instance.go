@@ -0,0 +1,27 @@
+package instance
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	once sync.Once
+	id   string
+)
+
+// ID identifica unicamente esta instância da API (hostname, pid e sufixo aleatório).
+// Usado como dono de locks e origem de eventos quando há várias réplicas.
+func ID() string {
+	once.Do(func() {
+		hostname, err := os.Hostname()
+		if err != nil {
+			hostname = "unknown"
+		}
+		id = fmt.Sprintf("%s-%d-%s", hostname, os.Getpid(), primitive.NewObjectID().Hex()[18:])
+	})
+	return id
+}
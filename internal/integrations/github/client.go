@@ -0,0 +1,305 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultAPIURL é o endereço da API REST do GitHub
+	defaultAPIURL = "https://api.github.com"
+	// defaultOAuthURL é o endereço do fluxo OAuth do GitHub
+	defaultOAuthURL = "https://github.com/login/oauth"
+	// OAuthScopes são os escopos pedidos: leitura/escrita de issues e gestão de webhooks
+	OAuthScopes = "repo admin:repo_hook"
+	// maxIssuePages limita a paginação da sincronização inicial (100 issues por página)
+	maxIssuePages = 10
+)
+
+// ErrNotFound indica repositório, issue ou webhook inexistente (ou sem acesso)
+var ErrNotFound = errors.New("recurso não encontrado no GitHub")
+
+// ErrUnauthorized indica token revogado ou sem os escopos necessários
+var ErrUnauthorized = errors.New("acesso negado pelo GitHub")
+
+// User é o usuário autenticado pelo token
+type User struct {
+	Login string `json:"login"`
+}
+
+// Repository é um repositório do GitHub
+type Repository struct {
+	FullName    string `json:"full_name"`
+	Permissions struct {
+		Admin bool `json:"admin"`
+		Push  bool `json:"push"`
+	} `json:"permissions"`
+}
+
+// Issue é uma issue do GitHub (no formato da API e do payload do webhook)
+type Issue struct {
+	Number    int        `json:"number"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body"`
+	State     string     `json:"state"`
+	HTMLURL   string     `json:"html_url"`
+	Labels    []Label    `json:"labels"`
+	Assignees []User     `json:"assignees"`
+	Milestone *Milestone `json:"milestone"`
+	// PullRequest é preenchido quando a "issue" é na verdade um pull request
+	PullRequest *struct{} `json:"pull_request,omitempty"`
+}
+
+// Label é uma etiqueta de issue
+type Label struct {
+	Name string `json:"name"`
+}
+
+// Milestone é o marco da issue, cujo prazo vira o vencimento da tarefa
+type Milestone struct {
+	DueOn *time.Time `json:"due_on"`
+}
+
+// IsAssignedTo indica se a issue está atribuída ao login informado
+func (i *Issue) IsAssignedTo(login string) bool {
+	for _, assignee := range i.Assignees {
+		if strings.EqualFold(assignee.Login, login) {
+			return true
+		}
+	}
+	return false
+}
+
+// Client chama os endpoints do GitHub usados pela sincronização
+type Client interface {
+	AuthorizeURL(state string) string
+	ExchangeCode(ctx context.Context, code string) (token, scopes string, err error)
+	GetUser(ctx context.Context, token string) (*User, error)
+	GetRepo(ctx context.Context, token, fullName string) (*Repository, error)
+	ListAssignedIssues(ctx context.Context, token, fullName, login string) ([]Issue, error)
+	CreateHook(ctx context.Context, token, fullName, hookURL, secret string) (int64, error)
+	DeleteHook(ctx context.Context, token, fullName string, hookID int64) error
+	SetIssueState(ctx context.Context, token, fullName string, number int, state string) error
+}
+
+// httpClient implementa Client via HTTP
+type httpClient struct {
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	apiURL       string
+	oauthURL     string
+	client       *http.Client
+}
+
+// NewClient cria o cliente do GitHub para o OAuth App informado
+func NewClient(clientID, clientSecret, redirectURL string) Client {
+	return &httpClient{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		redirectURL:  redirectURL,
+		apiURL:       defaultAPIURL,
+		oauthURL:     defaultOAuthURL,
+		client:       &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+// AuthorizeURL monta a URL de autorização para onde o usuário é redirecionado
+func (c *httpClient) AuthorizeURL(state string) string {
+	query := url.Values{
+		"client_id":    {c.clientID},
+		"redirect_uri": {c.redirectURL},
+		"scope":        {OAuthScopes},
+		"state":        {state},
+		"allow_signup": {"false"},
+	}
+	return c.oauthURL + "/authorize?" + query.Encode()
+}
+
+// ExchangeCode troca o code do callback OAuth pelo token de acesso
+func (c *httpClient) ExchangeCode(ctx context.Context, code string) (string, string, error) {
+	form := url.Values{
+		"client_id":     {c.clientID},
+		"client_secret": {c.clientSecret},
+		"code":          {code},
+		"redirect_uri":  {c.redirectURL},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.oauthURL+"/access_token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", "", fmt.Errorf("erro ao montar troca do code: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", "", fmt.Errorf("erro ao trocar code no GitHub: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		AccessToken      string `json:"access_token"`
+		Scope            string `json:"scope"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", "", fmt.Errorf("resposta inválida do GitHub na troca do code (status %d)", resp.StatusCode)
+	}
+
+	// O GitHub responde 200 com "error" para code inválido ou expirado
+	if result.Error != "" || result.AccessToken == "" {
+		return "", "", fmt.Errorf("%w: %s", ErrUnauthorized, result.ErrorDescription)
+	}
+
+	return result.AccessToken, result.Scope, nil
+}
+
+// GetUser busca o usuário dono do token
+func (c *httpClient) GetUser(ctx context.Context, token string) (*User, error) {
+	var user User
+	if err := c.call(ctx, token, http.MethodGet, "/user", nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// GetRepo busca o repositório, incluindo as permissões do usuário nele
+func (c *httpClient) GetRepo(ctx context.Context, token, fullName string) (*Repository, error) {
+	var repo Repository
+	if err := c.call(ctx, token, http.MethodGet, "/repos/"+fullName, nil, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// ListAssignedIssues lista as issues abertas atribuídas ao login (sem pull requests)
+func (c *httpClient) ListAssignedIssues(ctx context.Context, token, fullName, login string) ([]Issue, error) {
+	var issues []Issue
+	for page := 1; page <= maxIssuePages; page++ {
+		query := url.Values{
+			"state":    {"open"},
+			"assignee": {login},
+			"per_page": {"100"},
+			"page":     {fmt.Sprint(page)},
+		}
+
+		var batch []Issue
+		if err := c.call(ctx, token, http.MethodGet, "/repos/"+fullName+"/issues?"+query.Encode(), nil, &batch); err != nil {
+			return nil, err
+		}
+
+		for _, issue := range batch {
+			if issue.PullRequest == nil {
+				issues = append(issues, issue)
+			}
+		}
+
+		if len(batch) < 100 {
+			break
+		}
+	}
+
+	return issues, nil
+}
+
+// CreateHook registra o webhook de eventos de issues no repositório
+func (c *httpClient) CreateHook(ctx context.Context, token, fullName, hookURL, secret string) (int64, error) {
+	payload := map[string]interface{}{
+		"name":   "web",
+		"active": true,
+		"events": []string{"issues"},
+		"config": map[string]string{
+			"url":          hookURL,
+			"content_type": "json",
+			"secret":       secret,
+			"insecure_ssl": "0",
+		},
+	}
+
+	var hook struct {
+		ID int64 `json:"id"`
+	}
+	if err := c.call(ctx, token, http.MethodPost, "/repos/"+fullName+"/hooks", payload, &hook); err != nil {
+		return 0, err
+	}
+
+	return hook.ID, nil
+}
+
+// DeleteHook remove o webhook do repositório
+func (c *httpClient) DeleteHook(ctx context.Context, token, fullName string, hookID int64) error {
+	return c.call(ctx, token, http.MethodDelete, fmt.Sprintf("/repos/%s/hooks/%d", fullName, hookID), nil, nil)
+}
+
+// SetIssueState fecha ("closed") ou reabre ("open") a issue
+func (c *httpClient) SetIssueState(ctx context.Context, token, fullName string, number int, state string) error {
+	payload := map[string]string{"state": state}
+	if state == "closed" {
+		payload["state_reason"] = "completed"
+	}
+	return c.call(ctx, token, http.MethodPatch, fmt.Sprintf("/repos/%s/issues/%d", fullName, number), payload, nil)
+}
+
+// apiError é o corpo de erro da API REST
+type apiError struct {
+	Message string `json:"message"`
+}
+
+func (c *httpClient) call(ctx context.Context, token, method, path string, payload, out interface{}) error {
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("erro ao serializar requisição ao GitHub: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.apiURL+path, body)
+	if err != nil {
+		return fmt.Errorf("erro ao montar requisição ao GitHub: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("erro ao chamar %s %s no GitHub: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return ErrUnauthorized
+	case resp.StatusCode >= 300:
+		var apiErr apiError
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+		return fmt.Errorf("GitHub recusou %s %s (status %d): %s", method, path, resp.StatusCode, apiErr.Message)
+	}
+
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("resposta inválida do GitHub em %s %s: %w", method, path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,447 @@
+// Package github sincroniza as issues do GitHub atribuídas ao usuário como
+// tarefas. A conta é conectada via OAuth; cada repositório vinculado recebe um
+// webhook de issues que mantém título, etiquetas e status (fechada/reaberta)
+// atualizados. Opcionalmente, concluir a tarefa fecha a issue.
+package github
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/clock"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// stateTTL é a validade do state do fluxo OAuth
+	stateTTL = 10 * time.Minute
+	// Limites aplicados ao converter a issue (os mesmos da criação de tarefas)
+	maxTitleLength       = 200
+	maxDescriptionLength = 1000
+)
+
+var (
+	// ErrInvalidRepo indica nome de repositório fora do formato owner/nome
+	ErrInvalidRepo = errors.New("repositório inválido")
+	// ErrRepoNotLinked indica repositório não vinculado à conta
+	ErrRepoNotLinked = errors.New("repositório não vinculado")
+	// ErrRepoAdminRequired indica que o usuário não pode criar webhooks no repositório
+	ErrRepoAdminRequired = errors.New("é necessário ser administrador do repositório")
+)
+
+// repoNamePattern valida o nome completo do repositório (owner/nome)
+var repoNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+$`)
+
+// Syncer conecta contas, vincula repositórios e processa os eventos de issues
+type Syncer struct {
+	client        Client
+	accounts      repositories.GitHubAccountRepository
+	todos         repositories.TodoRepository
+	webhookURL    string
+	webhookSecret string
+	clock         clock.Clock
+}
+
+// NewSyncer cria o sincronizador. Sem webhookURL os repositórios são vinculados
+// sem webhook e as issues só são atualizadas pela sincronização manual.
+func NewSyncer(client Client, accounts repositories.GitHubAccountRepository, todos repositories.TodoRepository, webhookURL, webhookSecret string) *Syncer {
+	return &Syncer{
+		client:        client,
+		accounts:      accounts,
+		todos:         todos,
+		webhookURL:    webhookURL,
+		webhookSecret: webhookSecret,
+		clock:         clock.System(),
+	}
+}
+
+// Subscribe inscreve o sincronizador na conclusão de tarefas, para fechar as issues
+func (s *Syncer) Subscribe(bus events.Bus) {
+	bus.Subscribe(events.TaskCompleted, s.handleTaskCompleted)
+}
+
+// StartOAuth gera o state de uso único e retorna a URL de autorização do GitHub
+func (s *Syncer) StartOAuth(ctx context.Context, userID primitive.ObjectID) (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("erro ao gerar state OAuth: %w", err)
+	}
+	state := hex.EncodeToString(raw)
+
+	if err := s.accounts.SaveState(ctx, userID, hashState(state), time.Now().Add(stateTTL)); err != nil {
+		return "", err
+	}
+
+	return s.client.AuthorizeURL(state), nil
+}
+
+// CompleteOAuth valida o state, troca o code pelo token e conclui a conexão.
+// Retorna repositories.ErrGitHubAccountNotFound para state inválido ou expirado.
+func (s *Syncer) CompleteOAuth(ctx context.Context, userID primitive.ObjectID, code, state string) (*entities.GitHubAccount, error) {
+	if err := s.accounts.ConsumeState(ctx, userID, hashState(state)); err != nil {
+		return nil, err
+	}
+
+	token, scopes, err := s.client.ExchangeCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.client.GetUser(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.accounts.SetToken(ctx, userID, user.Login, token, scopes); err != nil {
+		return nil, err
+	}
+
+	return s.accounts.GetByUserID(ctx, userID)
+}
+
+// GetAccount retorna a conta conectada do usuário
+func (s *Syncer) GetAccount(ctx context.Context, userID primitive.ObjectID) (*entities.GitHubAccount, error) {
+	account, err := s.accounts.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !account.IsConnected() {
+		return nil, repositories.ErrGitHubAccountNotFound
+	}
+	return account, nil
+}
+
+// Disconnect remove os webhooks (melhor esforço) e a conexão. As tarefas já
+// sincronizadas são mantidas.
+func (s *Syncer) Disconnect(ctx context.Context, userID primitive.ObjectID) error {
+	account, err := s.accounts.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, repo := range account.Repos {
+		s.deleteHook(ctx, account, repo)
+	}
+
+	return s.accounts.Delete(ctx, userID)
+}
+
+// LinkRepo vincula o repositório, registra o webhook (se configurado) e importa
+// as issues abertas atribuídas ao usuário. Retorna o número de issues sincronizadas.
+func (s *Syncer) LinkRepo(ctx context.Context, userID primitive.ObjectID, fullName string, closeOnComplete bool) (int, error) {
+	if !repoNamePattern.MatchString(fullName) {
+		return 0, ErrInvalidRepo
+	}
+
+	account, err := s.GetAccount(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	repo, err := s.client.GetRepo(ctx, account.AccessToken, fullName)
+	if err != nil {
+		return 0, err
+	}
+
+	linked := entities.GitHubRepo{
+		FullName:        strings.ToLower(repo.FullName),
+		CloseOnComplete: closeOnComplete,
+		LinkedAt:        time.Now(),
+	}
+
+	// Revincular mantém o webhook existente (só muda close_on_complete)
+	if previous, ok := account.Repo(repo.FullName); ok {
+		linked.HookID = previous.HookID
+		linked.LinkedAt = previous.LinkedAt
+	}
+
+	if linked.HookID == 0 && s.webhookURL != "" {
+		if !repo.Permissions.Admin {
+			return 0, ErrRepoAdminRequired
+		}
+
+		linked.HookID, err = s.client.CreateHook(ctx, account.AccessToken, repo.FullName, s.webhookURL, s.webhookSecret)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if err := s.accounts.SaveRepo(ctx, userID, linked); err != nil {
+		return 0, err
+	}
+
+	return s.syncRepo(ctx, account, linked.FullName)
+}
+
+// SyncRepo reimporta as issues abertas atribuídas ao usuário no repositório vinculado
+func (s *Syncer) SyncRepo(ctx context.Context, userID primitive.ObjectID, fullName string) (int, error) {
+	account, err := s.GetAccount(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, ok := account.Repo(fullName); !ok {
+		return 0, ErrRepoNotLinked
+	}
+
+	return s.syncRepo(ctx, account, strings.ToLower(fullName))
+}
+
+// UnlinkRepo remove o webhook (melhor esforço) e desvincula o repositório
+func (s *Syncer) UnlinkRepo(ctx context.Context, userID primitive.ObjectID, fullName string) error {
+	account, err := s.GetAccount(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	repo, ok := account.Repo(fullName)
+	if !ok {
+		return ErrRepoNotLinked
+	}
+
+	s.deleteHook(ctx, account, *repo)
+
+	return s.accounts.RemoveRepo(ctx, userID, repo.FullName)
+}
+
+// VerifySignature confere o header X-Hub-Signature-256 (HMAC SHA-256 do corpo)
+func (s *Syncer) VerifySignature(body []byte, signature string) bool {
+	provided, ok := strings.CutPrefix(signature, "sha256=")
+	if !ok || s.webhookSecret == "" {
+		return false
+	}
+
+	expected, err := hex.DecodeString(provided)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(s.webhookSecret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// issuesEvent é o payload do evento "issues"
+type issuesEvent struct {
+	Action     string `json:"action"`
+	Issue      Issue  `json:"issue"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// HandleWebhook processa um evento já autenticado. hookID (header
+// X-GitHub-Hook-ID) restringe o evento às contas que registraram aquele
+// webhook, já que cada conta vinculada ao repositório tem o seu.
+func (s *Syncer) HandleWebhook(ctx context.Context, eventType string, hookID int64, body []byte) error {
+	if eventType != "issues" {
+		return nil
+	}
+
+	var event issuesEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("payload inválido do evento de issues: %w", err)
+	}
+
+	if event.Issue.PullRequest != nil || event.Repository.FullName == "" {
+		return nil
+	}
+
+	accounts, err := s.accounts.ListByRepo(ctx, event.Repository.FullName)
+	if err != nil {
+		return err
+	}
+
+	for _, account := range accounts {
+		repo, _ := account.Repo(event.Repository.FullName)
+		if repo == nil || (hookID != 0 && repo.HookID != hookID) {
+			continue
+		}
+
+		if err := s.applyIssue(ctx, account, repo.FullName, &event.Issue, event.Action); err != nil {
+			logging.FromContext(ctx).Error("erro ao sincronizar issue do GitHub",
+				"repo", repo.FullName, "issue", event.Issue.Number, "user_id", account.UserID.Hex(), logging.Err(err))
+		}
+	}
+
+	return nil
+}
+
+// syncRepo importa as issues abertas atribuídas ao login da conta
+func (s *Syncer) syncRepo(ctx context.Context, account *entities.GitHubAccount, fullName string) (int, error) {
+	issues, err := s.client.ListAssignedIssues(ctx, account.AccessToken, fullName, account.Login)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range issues {
+		if err := s.applyIssue(ctx, account, fullName, &issues[i], ""); err != nil {
+			return i, err
+		}
+	}
+
+	return len(issues), nil
+}
+
+// applyIssue cria ou atualiza a tarefa da issue. Issues que deixaram de estar
+// atribuídas ao usuário mantêm a tarefa como está; issues apagadas arquivam a
+// tarefa. As gravações vão direto ao repositório, sem eventos de domínio, para
+// que fechar a issue no GitHub não dispare o fechamento de volta.
+func (s *Syncer) applyIssue(ctx context.Context, account *entities.GitHubAccount, fullName string, issue *Issue, action string) error {
+	externalID := fmt.Sprintf("%s#%d", fullName, issue.Number)
+
+	task, err := s.todos.GetByExternalID(ctx, account.UserID, entities.ExternalSourceGitHub, externalID)
+	if err != nil && !errors.Is(err, repositories.ErrTodoNotFound) {
+		return err
+	}
+
+	if task == nil {
+		// Só issues abertas e atribuídas ao usuário viram tarefas novas
+		if action == "deleted" || issue.State != "open" || !issue.IsAssignedTo(account.Login) {
+			return nil
+		}
+
+		task = &entities.Task{
+			External: &entities.ExternalRef{Source: entities.ExternalSourceGitHub, ID: externalID, URL: issue.HTMLURL},
+		}
+		fillTask(task, issue)
+		task.PrepareForCreate(account.UserID)
+
+		if err := s.todos.Create(ctx, task); err != nil && !errors.Is(err, repositories.ErrExternalTaskExists) {
+			return err
+		}
+		return nil
+	}
+
+	if action == "deleted" {
+		task.IsArchived = true
+		return s.todos.Update(ctx, account.UserID, task)
+	}
+
+	fillTask(task, issue)
+	switch {
+	case issue.State == "closed" && task.Status != enums.StatusCompleted:
+		task.MarkAsCompletedAt(s.clock.Now())
+		task.StatusID = ""
+		task.CustomStatus = nil
+	case issue.State == "open" && task.Status == enums.StatusCompleted:
+		task.MarkAsPendingAt(s.clock.Now())
+		task.StatusID = ""
+		task.CustomStatus = nil
+	}
+
+	return s.todos.Update(ctx, account.UserID, task)
+}
+
+// handleTaskCompleted fecha a issue quando a tarefa sincronizada é concluída
+// e o repositório foi vinculado com close_on_complete
+func (s *Syncer) handleTaskCompleted(ctx context.Context, event events.Event) {
+	taskID, _ := event.Data["task_id"].(string)
+	id, err := primitive.ObjectIDFromHex(taskID)
+	if err != nil {
+		return
+	}
+
+	task, err := s.todos.GetByID(ctx, event.UserID, id)
+	if err != nil || task.External == nil || task.External.Source != entities.ExternalSourceGitHub {
+		return
+	}
+
+	fullName, number, ok := parseExternalID(task.External.ID)
+	if !ok {
+		return
+	}
+
+	account, err := s.GetAccount(ctx, event.UserID)
+	if err != nil {
+		return
+	}
+
+	repo, ok := account.Repo(fullName)
+	if !ok || !repo.CloseOnComplete {
+		return
+	}
+
+	if err := s.client.SetIssueState(ctx, account.AccessToken, fullName, number, "closed"); err != nil {
+		logging.FromContext(ctx).Warn("erro ao fechar issue do GitHub",
+			"repo", fullName, "issue", number, "task_id", taskID, logging.Err(err))
+	}
+}
+
+// deleteHook remove o webhook do repositório, registrando falhas sem interromper
+func (s *Syncer) deleteHook(ctx context.Context, account *entities.GitHubAccount, repo entities.GitHubRepo) {
+	if repo.HookID == 0 || !account.IsConnected() {
+		return
+	}
+
+	err := s.client.DeleteHook(ctx, account.AccessToken, repo.FullName, repo.HookID)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		logging.FromContext(ctx).Warn("erro ao remover webhook do GitHub", "repo", repo.FullName, logging.Err(err))
+	}
+}
+
+// fillTask copia título, descrição, etiquetas e prazo do marco da issue
+func fillTask(task *entities.Task, issue *Issue) {
+	task.Title = truncate(strings.TrimSpace(issue.Title), maxTitleLength)
+	if task.Title == "" {
+		task.Title = fmt.Sprintf("Issue #%d", issue.Number)
+	}
+	task.Description = truncate(strings.TrimSpace(issue.Body), maxDescriptionLength)
+
+	tags := make([]string, 0, len(issue.Labels))
+	for _, label := range issue.Labels {
+		tags = append(tags, label.Name)
+	}
+	task.Tags = entities.NormalizeTags(tags)
+
+	task.DueDate = nil
+	if issue.Milestone != nil && issue.Milestone.DueOn != nil {
+		due := *issue.Milestone.DueOn
+		task.DueDate = &due
+	}
+}
+
+// parseExternalID separa "owner/nome#número"
+func parseExternalID(externalID string) (string, int, bool) {
+	index := strings.LastIndex(externalID, "#")
+	if index <= 0 {
+		return "", 0, false
+	}
+
+	number, err := strconv.Atoi(externalID[index+1:])
+	if err != nil {
+		return "", 0, false
+	}
+
+	return externalID[:index], number, true
+}
+
+// hashState calcula o hash armazenado do state (o state em si não é persistido)
+func hashState(state string) string {
+	sum := sha256.Sum256([]byte(state))
+	return hex.EncodeToString(sum[:])
+}
+
+// truncate corta o texto em max caracteres
+func truncate(text string, max int) string {
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	return string(runes[:max])
+}
@@ -0,0 +1,214 @@
+package inboundmail
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/emersion/go-imap"
+	"github.com/emersion/go-imap/client"
+)
+
+const (
+	// imapBatchSize limita as mensagens processadas por consulta
+	imapBatchSize = 20
+	// imapTimeout limita cada comando IMAP
+	imapTimeout = 30 * time.Second
+)
+
+// IMAPConfig define o acesso à caixa consultada
+type IMAPConfig struct {
+	// Addr no formato host:porta
+	Addr     string
+	Username string
+	Password string
+	Mailbox  string
+	// TLS usa TLS implícito (porta 993); sem ele, STARTTLS é exigido
+	TLS bool
+}
+
+// IMAPPoller consulta a caixa IMAP e converte as mensagens não lidas em tarefas.
+// Mensagens processadas (ou descartáveis) são marcadas como lidas; falhas
+// transitórias deixam a mensagem para a próxima consulta.
+type IMAPPoller struct {
+	cfg      IMAPConfig
+	ingester *Ingester
+}
+
+// NewIMAPPoller cria o poller IMAP
+func NewIMAPPoller(cfg IMAPConfig, ingester *Ingester) *IMAPPoller {
+	if cfg.Mailbox == "" {
+		cfg.Mailbox = "INBOX"
+	}
+	return &IMAPPoller{cfg: cfg, ingester: ingester}
+}
+
+// fetchedMessage é uma mensagem baixada da caixa
+type fetchedMessage struct {
+	uid uint32
+	raw []byte
+}
+
+// Poll executa uma consulta (usado como job do scheduler)
+func (p *IMAPPoller) Poll(ctx context.Context) error {
+	c, err := p.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Logout()
+
+	// Encerra a conexão se o job for cancelado durante um comando
+	stop := context.AfterFunc(ctx, func() { _ = c.Terminate() })
+	defer stop()
+
+	if _, err := c.Select(p.cfg.Mailbox, false); err != nil {
+		return fmt.Errorf("erro ao selecionar caixa %s: %w", p.cfg.Mailbox, err)
+	}
+
+	criteria := imap.NewSearchCriteria()
+	criteria.WithoutFlags = []string{imap.SeenFlag}
+
+	uids, err := c.UidSearch(criteria)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar mensagens não lidas: %w", err)
+	}
+	if len(uids) == 0 {
+		return nil
+	}
+	if len(uids) > imapBatchSize {
+		uids = uids[:imapBatchSize]
+	}
+
+	messages, err := p.fetch(c, uids)
+	if err != nil {
+		return err
+	}
+
+	logger := logging.FromContext(ctx)
+	processed := new(imap.SeqSet)
+	for _, message := range messages {
+		if ctx.Err() != nil {
+			break
+		}
+
+		if err := p.ingest(ctx, message.raw); err != nil {
+			logger.Error("erro ao converter email em tarefa, nova tentativa na próxima consulta",
+				"uid", message.uid, "error", err)
+			continue
+		}
+		processed.AddNum(message.uid)
+	}
+
+	if processed.Empty() {
+		return nil
+	}
+
+	flags := []interface{}{imap.SeenFlag}
+	if err := c.UidStore(processed, imap.FormatFlagsOp(imap.AddFlags, true), flags, nil); err != nil {
+		return fmt.Errorf("erro ao marcar emails como lidos: %w", err)
+	}
+
+	return nil
+}
+
+// ingest converte a mensagem; retorna erro apenas para falhas transitórias
+func (p *IMAPPoller) ingest(ctx context.Context, raw []byte) error {
+	logger := logging.FromContext(ctx)
+
+	email, err := Parse(bytes.NewReader(raw), p.ingester.MaxAttachmentBytes())
+	if err != nil {
+		logger.Warn("email ilegível descartado", "error", err)
+		return nil
+	}
+
+	task, err := p.ingester.Ingest(ctx, email)
+	switch {
+	case errors.Is(err, ErrUnknownRecipient), errors.Is(err, ErrDuplicateEmail):
+		logger.Info("email ignorado", "message_id", email.MessageID, "reason", err.Error())
+		return nil
+	case err != nil:
+		return err
+	}
+
+	logger.Info("tarefa criada por email", "task_id", task.ID.Hex(), "user_id", task.UserID.Hex())
+	return nil
+}
+
+// connect abre a conexão (TLS implícito ou STARTTLS) e autentica
+func (p *IMAPPoller) connect(ctx context.Context) (*client.Client, error) {
+	host, _, err := net.SplitHostPort(p.cfg.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("IMAP_ADDR inválido: %w", err)
+	}
+	tlsConfig := &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}
+
+	dialer := &net.Dialer{Timeout: imapTimeout}
+	if deadline, ok := ctx.Deadline(); ok {
+		dialer.Deadline = deadline
+	}
+
+	var c *client.Client
+	if p.cfg.TLS {
+		c, err = client.DialWithDialerTLS(dialer, p.cfg.Addr, tlsConfig)
+	} else {
+		c, err = client.DialWithDialer(dialer, p.cfg.Addr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erro ao conectar ao servidor IMAP: %w", err)
+	}
+	c.Timeout = imapTimeout
+
+	if !p.cfg.TLS {
+		if err := c.StartTLS(tlsConfig); err != nil {
+			_ = c.Terminate()
+			return nil, fmt.Errorf("erro ao iniciar STARTTLS: %w", err)
+		}
+	}
+
+	if err := c.Login(p.cfg.Username, p.cfg.Password); err != nil {
+		_ = c.Logout()
+		return nil, fmt.Errorf("erro ao autenticar no servidor IMAP: %w", err)
+	}
+
+	return c, nil
+}
+
+// fetch baixa o conteúdo completo das mensagens sem marcá-las como lidas
+func (p *IMAPPoller) fetch(c *client.Client, uids []uint32) ([]fetchedMessage, error) {
+	seqset := new(imap.SeqSet)
+	seqset.AddNum(uids...)
+
+	section := &imap.BodySectionName{Peek: true}
+	items := []imap.FetchItem{imap.FetchUid, section.FetchItem()}
+
+	ch := make(chan *imap.Message, imapBatchSize)
+	done := make(chan error, 1)
+	go func() {
+		done <- c.UidFetch(seqset, items, ch)
+	}()
+
+	var messages []fetchedMessage
+	for msg := range ch {
+		body := msg.GetBody(section)
+		if body == nil {
+			continue
+		}
+		raw, err := io.ReadAll(body)
+		if err != nil {
+			continue
+		}
+		messages = append(messages, fetchedMessage{uid: msg.Uid, raw: raw})
+	}
+
+	if err := <-done; err != nil {
+		return nil, fmt.Errorf("erro ao baixar emails: %w", err)
+	}
+
+	return messages, nil
+}
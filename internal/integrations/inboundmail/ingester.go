@@ -0,0 +1,258 @@
+// Package inboundmail converte emails em tarefas. Cada usuário recebe um
+// endereço com alias (ex.: tarefas+k3j9x2@in.exemplo.com, a partir de
+// INBOUND_EMAIL_ADDRESS); os emails chegam por uma caixa IMAP consultada
+// periodicamente ou por webhooks de inbound parse (Mailgun, SendGrid, etc.).
+package inboundmail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	taskreq "github.com/devgugga/todo-it/internal/dtos/requests/task"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// aliasLength é o tamanho do alias gerado para cada usuário
+	aliasLength = 10
+	// aliasAlphabet usa apenas minúsculas e dígitos (alguns provedores ignoram maiúsculas)
+	aliasAlphabet = "abcdefghijkmnpqrstuvwxyz23456789"
+	// maxTitleLength e maxDescriptionLength seguem os limites de CreateTaskRequest
+	maxTitleLength       = 200
+	maxDescriptionLength = 1000
+	// maxAttachments limita os anexos convertidos por email
+	maxAttachments = 10
+	// emailTag identifica as tarefas criadas por email
+	emailTag = "email"
+)
+
+var (
+	// ErrUnknownRecipient indica email sem destinatário com alias de usuário válido
+	ErrUnknownRecipient = errors.New("nenhum destinatário corresponde a um usuário")
+	// ErrDuplicateEmail indica email já convertido em tarefa
+	ErrDuplicateEmail = errors.New("email já processado")
+)
+
+// Config define o endereço base e os limites da ingestão
+type Config struct {
+	// Address é o endereço base; os aliases usam sub-endereçamento (local+alias@domínio)
+	Address            string
+	MaxAttachmentBytes int64
+}
+
+// Ingester cria tarefas a partir dos emails recebidos
+type Ingester struct {
+	local              string
+	domain             string
+	maxAttachmentBytes int64
+
+	users       repositories.UserRepository
+	todos       repositories.TodoRepository
+	attachments repositories.AttachmentRepository
+	inbound     repositories.InboundEmailRepository
+	tasks       services.TaskService
+}
+
+// NewIngester cria o Ingester. O endereço base deve ser válido e sem "+".
+func NewIngester(cfg Config, users repositories.UserRepository, todos repositories.TodoRepository, attachments repositories.AttachmentRepository, inbound repositories.InboundEmailRepository, tasks services.TaskService) (*Ingester, error) {
+	address, err := mail.ParseAddress(cfg.Address)
+	if err != nil {
+		return nil, fmt.Errorf("endereço de entrada inválido: %w", err)
+	}
+
+	local, domain, _ := strings.Cut(address.Address, "@")
+	if strings.Contains(local, "+") {
+		return nil, fmt.Errorf("endereço de entrada não pode conter \"+\"")
+	}
+
+	return &Ingester{
+		local:              strings.ToLower(local),
+		domain:             strings.ToLower(domain),
+		maxAttachmentBytes: cfg.MaxAttachmentBytes,
+		users:              users,
+		todos:              todos,
+		attachments:        attachments,
+		inbound:            inbound,
+		tasks:              tasks,
+	}, nil
+}
+
+// MaxAttachmentBytes retorna o tamanho máximo aceito por anexo (usado no Parse)
+func (i *Ingester) MaxAttachmentBytes() int64 {
+	return i.maxAttachmentBytes
+}
+
+// AddressFor retorna o endereço de entrada do usuário, gerando o alias na
+// primeira chamada. Com rotate, gera um novo alias e invalida o anterior.
+func (i *Ingester) AddressFor(ctx context.Context, userID primitive.ObjectID, rotate bool) (string, error) {
+	user, err := i.users.GetByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	alias := user.InboundAlias
+	if alias == "" || rotate {
+		if alias, err = randomAlias(); err != nil {
+			return "", err
+		}
+		if err := i.users.SetInboundAlias(ctx, userID, alias); err != nil {
+			return "", err
+		}
+	}
+
+	return i.address(alias), nil
+}
+
+func (i *Ingester) address(alias string) string {
+	return fmt.Sprintf("%s+%s@%s", i.local, alias, i.domain)
+}
+
+// aliasFrom extrai o alias de um destinatário no formato local+alias@domínio
+func (i *Ingester) aliasFrom(recipient string) (string, bool) {
+	local, domain, ok := strings.Cut(strings.ToLower(strings.TrimSpace(recipient)), "@")
+	if !ok || domain != i.domain {
+		return "", false
+	}
+
+	base, alias, ok := strings.Cut(local, "+")
+	if !ok || base != i.local || alias == "" {
+		return "", false
+	}
+	return alias, true
+}
+
+// Ingest converte o email em tarefa para o usuário dono do alias. Destinatários
+// do envelope (informados pelo provedor de inbound parse) têm prioridade sobre
+// os cabeçalhos. Retorna ErrUnknownRecipient ou ErrDuplicateEmail quando não
+// há o que fazer; demais erros são transitórios.
+func (i *Ingester) Ingest(ctx context.Context, email *Email, envelope ...string) (*entities.Task, error) {
+	user, err := i.recipientUser(ctx, append(envelope, email.Recipients...))
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := i.inbound.Claim(ctx, email.MessageID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, ErrDuplicateEmail
+	}
+
+	task, err := i.createTask(ctx, user.ID, email)
+	if err != nil {
+		if releaseErr := i.inbound.Release(ctx, email.MessageID); releaseErr != nil {
+			logging.FromContext(ctx).Warn("erro ao liberar email para nova tentativa", "error", releaseErr)
+		}
+		return nil, err
+	}
+
+	if err := i.inbound.SetTask(ctx, email.MessageID, task.ID); err != nil {
+		logging.FromContext(ctx).Warn("erro ao associar tarefa ao email", "task_id", task.ID.Hex(), "error", err)
+	}
+
+	return task, nil
+}
+
+// recipientUser encontra o primeiro destinatário com alias de um usuário ativo
+func (i *Ingester) recipientUser(ctx context.Context, recipients []string) (*entities.User, error) {
+	for _, recipient := range recipients {
+		alias, ok := i.aliasFrom(recipient)
+		if !ok {
+			continue
+		}
+
+		user, err := i.users.GetByInboundAlias(ctx, alias)
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return user, nil
+	}
+
+	return nil, ErrUnknownRecipient
+}
+
+// createTask cria a tarefa e anexa os arquivos do email
+func (i *Ingester) createTask(ctx context.Context, userID primitive.ObjectID, email *Email) (*entities.Task, error) {
+	title := truncate(email.Subject, maxTitleLength)
+	if title == "" {
+		title = "(sem assunto)"
+	}
+
+	req := &taskreq.CreateTaskRequest{
+		Title:       title,
+		Description: truncate(email.Text, maxDescriptionLength),
+		Tags:        []string{emailTag},
+	}
+
+	task, err := i.tasks.Create(ctx, userID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(email.Attachments) == 0 {
+		return task, nil
+	}
+
+	// Anexos são melhor-esforço: a tarefa já existe e não deve ser duplicada
+	attachments := make([]entities.TaskAttachment, 0, len(email.Attachments))
+	for n, file := range email.Attachments {
+		if n >= maxAttachments {
+			logging.FromContext(ctx).Warn("anexos excedentes ignorados", "task_id", task.ID.Hex(), "count", len(email.Attachments)-n)
+			break
+		}
+
+		attachment, err := i.attachments.Upload(ctx, userID, file.Filename, file.ContentType, bytes.NewReader(file.Content))
+		if err != nil {
+			logging.FromContext(ctx).Error("erro ao gravar anexo do email", "task_id", task.ID.Hex(), "error", err)
+			continue
+		}
+		attachments = append(attachments, *attachment)
+	}
+
+	if len(attachments) > 0 {
+		if err := i.todos.AddAttachments(ctx, userID, task.ID, attachments); err != nil {
+			logging.FromContext(ctx).Error("erro ao associar anexos à tarefa", "task_id", task.ID.Hex(), "error", err)
+		} else {
+			task.Attachments = append(task.Attachments, attachments...)
+		}
+	}
+
+	return task, nil
+}
+
+// truncate limita o texto a max runas, sem cortar caracteres multibyte
+func truncate(text string, max int) string {
+	text = strings.TrimSpace(text)
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	return strings.TrimSpace(string(runes[:max]))
+}
+
+// randomAlias gera um alias aleatório para o endereço de entrada
+func randomAlias() (string, error) {
+	raw := make([]byte, aliasLength)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("erro ao gerar alias de email: %w", err)
+	}
+
+	alias := make([]byte, aliasLength)
+	for n, b := range raw {
+		alias[n] = aliasAlphabet[int(b)%len(aliasAlphabet)]
+	}
+	return string(alias), nil
+}
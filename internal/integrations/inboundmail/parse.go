@@ -0,0 +1,270 @@
+package inboundmail
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxMultipartDepth limita o aninhamento de partes multipart
+const maxMultipartDepth = 5
+
+// Email é a mensagem recebida já decodificada
+type Email struct {
+	MessageID string
+	From      string
+	// Recipients reúne To, Cc, Delivered-To e X-Original-To
+	Recipients  []string
+	Subject     string
+	Text        string
+	Attachments []Attachment
+}
+
+// Attachment é um arquivo anexado ao email
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+// Parse lê uma mensagem no formato RFC 5322 (MIME). Anexos maiores que
+// maxAttachmentBytes são descartados.
+func Parse(r io.Reader, maxAttachmentBytes int64) (*Email, error) {
+	msg, err := mail.ReadMessage(bufio.NewReader(r))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler email: %w", err)
+	}
+
+	email := &Email{
+		MessageID: strings.Trim(strings.TrimSpace(msg.Header.Get("Message-Id")), "<>"),
+		Subject:   decodeHeader(msg.Header.Get("Subject")),
+	}
+
+	if from, err := mail.ParseAddress(msg.Header.Get("From")); err == nil {
+		email.From = from.Address
+	}
+
+	for _, key := range []string{"To", "Cc", "Delivered-To", "X-Original-To"} {
+		for _, value := range msg.Header[key] {
+			addresses, err := mail.ParseAddressList(value)
+			if err != nil {
+				continue
+			}
+			for _, address := range addresses {
+				email.Recipients = append(email.Recipients, address.Address)
+			}
+		}
+	}
+
+	p := &partParser{email: email, maxAttachmentBytes: maxAttachmentBytes}
+	if err := p.walk(textproto.MIMEHeader(msg.Header), msg.Body, 0); err != nil {
+		return nil, err
+	}
+
+	if email.Text == "" && p.html != "" {
+		email.Text = htmlToText(p.html)
+	}
+	email.Text = strings.TrimSpace(email.Text)
+
+	if email.MessageID == "" {
+		email.MessageID = syntheticMessageID(msg.Header, email)
+	}
+
+	return email, nil
+}
+
+// partParser percorre as partes MIME acumulando texto e anexos
+type partParser struct {
+	email              *Email
+	html               string
+	maxAttachmentBytes int64
+}
+
+func (p *partParser) walk(header textproto.MIMEHeader, body io.Reader, depth int) error {
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", map[string]string{}
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		if depth >= maxMultipartDepth || params["boundary"] == "" {
+			return nil
+		}
+
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := reader.NextRawPart()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("erro ao ler parte do email: %w", err)
+			}
+			if err := p.walk(part.Header, part, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	decoded := decodeTransfer(header.Get("Content-Transfer-Encoding"), body)
+
+	disposition, dispositionParams, _ := mime.ParseMediaType(header.Get("Content-Disposition"))
+	filename := decodeHeader(dispositionParams["filename"])
+	if filename == "" {
+		filename = decodeHeader(params["name"])
+	}
+
+	if disposition == "attachment" || filename != "" {
+		return p.attachment(filename, mediaType, decoded)
+	}
+
+	switch mediaType {
+	case "text/plain", "text/html":
+		content, err := io.ReadAll(decoded)
+		if err != nil {
+			return fmt.Errorf("erro ao ler corpo do email: %w", err)
+		}
+		text := toUTF8(content, params["charset"])
+		if mediaType == "text/plain" && p.email.Text == "" {
+			p.email.Text = text
+		} else if mediaType == "text/html" && p.html == "" {
+			p.html = text
+		}
+	}
+
+	return nil
+}
+
+func (p *partParser) attachment(filename, contentType string, content io.Reader) error {
+	data, err := io.ReadAll(io.LimitReader(content, p.maxAttachmentBytes+1))
+	if err != nil {
+		return fmt.Errorf("erro ao ler anexo: %w", err)
+	}
+	if int64(len(data)) > p.maxAttachmentBytes {
+		return nil
+	}
+
+	if filename == "" {
+		filename = "anexo"
+	}
+
+	p.email.Attachments = append(p.email.Attachments, Attachment{
+		Filename:    filename,
+		ContentType: contentType,
+		Content:     data,
+	})
+	return nil
+}
+
+// decodeTransfer aplica o Content-Transfer-Encoding da parte
+func decodeTransfer(encoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, &newlineStripper{r: body})
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	default:
+		return body
+	}
+}
+
+// newlineStripper remove quebras de linha do conteúdo base64
+type newlineStripper struct {
+	r io.Reader
+}
+
+func (s *newlineStripper) Read(p []byte) (int, error) {
+	n, err := s.r.Read(p)
+	kept := 0
+	for _, b := range p[:n] {
+		if b != '\r' && b != '\n' {
+			p[kept] = b
+			kept++
+		}
+	}
+	return kept, err
+}
+
+// wordDecoder decodifica cabeçalhos RFC 2047 (=?charset?...?=)
+var wordDecoder = &mime.WordDecoder{
+	CharsetReader: func(charset string, input io.Reader) (io.Reader, error) {
+		content, err := io.ReadAll(input)
+		if err != nil {
+			return nil, err
+		}
+		return strings.NewReader(toUTF8(content, charset)), nil
+	},
+}
+
+func decodeHeader(value string) string {
+	decoded, err := wordDecoder.DecodeHeader(value)
+	if err != nil {
+		return strings.TrimSpace(value)
+	}
+	return strings.TrimSpace(decoded)
+}
+
+// toUTF8 converte o conteúdo para UTF-8. Além de UTF-8/ASCII, trata
+// ISO-8859-1 e Windows-1252 (comuns em clientes em português) como Latin-1.
+func toUTF8(content []byte, charset string) string {
+	switch strings.ToLower(charset) {
+	case "iso-8859-1", "latin1", "windows-1252", "cp1252":
+		runes := make([]rune, len(content))
+		for i, b := range content {
+			runes[i] = rune(b)
+		}
+		return string(runes)
+	}
+
+	if utf8.Valid(content) {
+		return string(content)
+	}
+	return strings.ToValidUTF8(string(content), "�")
+}
+
+var (
+	htmlBreaks = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</div>|</li>`)
+	htmlTags   = regexp.MustCompile(`(?s)<[^>]*>`)
+	htmlSkip   = regexp.MustCompile(`(?is)<(style|script)[^>]*>.*?</(style|script)>`)
+	blankLines = regexp.MustCompile(`\n{3,}`)
+)
+
+// htmlToText extrai um texto legível de um corpo HTML (sem parte text/plain)
+func htmlToText(body string) string {
+	text := htmlSkip.ReplaceAllString(body, "")
+	text = htmlBreaks.ReplaceAllString(text, "\n")
+	text = htmlTags.ReplaceAllString(text, "")
+
+	text = strings.ReplaceAll(html.UnescapeString(text), "\u00a0", " ")
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	return blankLines.ReplaceAllString(strings.Join(lines, "\n"), "\n\n")
+}
+
+// syntheticMessageID gera um identificador estável para emails sem Message-ID
+func syntheticMessageID(header mail.Header, email *Email) string {
+	var buf bytes.Buffer
+	for _, value := range []string{header.Get("From"), header.Get("Date"), email.Subject, email.Text} {
+		buf.WriteString(value)
+		buf.WriteByte(0)
+	}
+	sum := sha256.Sum256(buf.Bytes())
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,276 @@
+// Package stripe implementa plans.Provider sobre a API REST do Stripe: cria
+// sessões de checkout do plano pro e converte os webhooks de assinatura em
+// plans.SubscriptionChange.
+package stripe
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/plans"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// defaultAPIURL é o endereço da API REST do Stripe
+const defaultAPIURL = "https://api.stripe.com"
+
+// ProviderName identifica o Stripe em entities.Subscription.Provider
+const ProviderName = "stripe"
+
+// signatureTolerance é a idade máxima aceita para uma notificação assinada,
+// limitando o reenvio de notificações capturadas
+const signatureTolerance = 5 * time.Minute
+
+var (
+	// ErrInvalidSignature indica header Stripe-Signature ausente, inválido ou expirado
+	ErrInvalidSignature = errors.New("assinatura do Stripe inválida")
+	// ErrUnsupportedPlan indica plano sem preço configurado no Stripe
+	ErrUnsupportedPlan = errors.New("plano não disponível para contratação")
+)
+
+// Config define a conta e o preço do plano pro no Stripe
+type Config struct {
+	SecretKey     string
+	WebhookSecret string
+	// ProPriceID é o preço recorrente que concede o plano pro
+	ProPriceID string
+	SuccessURL string
+	CancelURL  string
+	// APIURL vazio usa api.stripe.com
+	APIURL string
+}
+
+// provider implementa plans.Provider via HTTP
+type provider struct {
+	config Config
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewProvider cria o provedor de pagamentos do Stripe
+func NewProvider(config Config) plans.Provider {
+	if config.APIURL == "" {
+		config.APIURL = defaultAPIURL
+	}
+	config.APIURL = strings.TrimRight(config.APIURL, "/")
+
+	return &provider{
+		config: config,
+		client: &http.Client{Timeout: 10 * time.Second},
+		now:    time.Now,
+	}
+}
+
+// Name retorna o identificador do provedor
+func (p *provider) Name() string {
+	return ProviderName
+}
+
+// apiError é o corpo de erro da API do Stripe
+type apiError struct {
+	Error struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Checkout cria uma sessão de checkout da assinatura e retorna a URL de pagamento.
+// O ID do usuário segue nos metadados da assinatura, de onde os webhooks o leem.
+func (p *provider) Checkout(ctx context.Context, userID primitive.ObjectID, plan plans.ID) (string, error) {
+	if plan != plans.Pro {
+		return "", ErrUnsupportedPlan
+	}
+
+	form := url.Values{}
+	form.Set("mode", "subscription")
+	form.Set("line_items[0][price]", p.config.ProPriceID)
+	form.Set("line_items[0][quantity]", "1")
+	form.Set("success_url", p.config.SuccessURL)
+	form.Set("cancel_url", p.config.CancelURL)
+	form.Set("client_reference_id", userID.Hex())
+	form.Set("metadata[user_id]", userID.Hex())
+	form.Set("subscription_data[metadata][user_id]", userID.Hex())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.APIURL+"/v1/checkout/sessions", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("erro ao montar checkout do Stripe: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+p.config.SecretKey)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("erro ao criar checkout no Stripe: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		var result apiError
+		_ = json.NewDecoder(resp.Body).Decode(&result)
+		return "", fmt.Errorf("Stripe recusou o checkout (status %d): %s", resp.StatusCode, result.Error.Message)
+	}
+
+	var session struct {
+		URL string `json:"url"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&session); err != nil || session.URL == "" {
+		return "", fmt.Errorf("resposta inválida do Stripe ao criar checkout: %v", err)
+	}
+
+	return session.URL, nil
+}
+
+// event é o envelope das notificações do Stripe
+type event struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Created int64  `json:"created"`
+	Data    struct {
+		Object json.RawMessage `json:"object"`
+	} `json:"data"`
+}
+
+// subscription é o objeto dos eventos customer.subscription.*
+type subscription struct {
+	ID               string            `json:"id"`
+	Status           string            `json:"status"`
+	CurrentPeriodEnd int64             `json:"current_period_end"`
+	Metadata         map[string]string `json:"metadata"`
+	Items            struct {
+		Data []struct {
+			CurrentPeriodEnd int64 `json:"current_period_end"`
+			Price            struct {
+				ID string `json:"id"`
+			} `json:"price"`
+		} `json:"data"`
+	} `json:"items"`
+}
+
+// ParseWebhook valida o header Stripe-Signature e converte os eventos de
+// assinatura (customer.subscription.created, updated e deleted). Outros
+// eventos retornam nil, sem erro.
+func (p *provider) ParseWebhook(payload []byte, signature string) (*plans.SubscriptionChange, error) {
+	if !p.verifySignature(payload, signature) {
+		return nil, ErrInvalidSignature
+	}
+
+	var evt event
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		return nil, fmt.Errorf("evento do Stripe inválido: %w", err)
+	}
+
+	switch evt.Type {
+	case "customer.subscription.created", "customer.subscription.updated", "customer.subscription.deleted":
+	default:
+		return nil, nil
+	}
+
+	var sub subscription
+	if err := json.Unmarshal(evt.Data.Object, &sub); err != nil {
+		return nil, fmt.Errorf("assinatura inválida no evento %s do Stripe: %w", evt.ID, err)
+	}
+
+	change := &plans.SubscriptionChange{
+		ProviderRef: sub.ID,
+		Plan:        plans.Free,
+		Status:      subscriptionStatus(sub.Status),
+		OccurredAt:  time.Unix(evt.Created, 0).UTC(),
+	}
+	if evt.Type == "customer.subscription.deleted" {
+		change.Status = entities.SubscriptionCanceled
+	}
+
+	// Um ID inválido ou ausente fica zero: o serviço recorre ao provider_ref
+	change.UserID, _ = primitive.ObjectIDFromHex(sub.Metadata["user_id"])
+
+	periodEnd := sub.CurrentPeriodEnd
+	for _, item := range sub.Items.Data {
+		if item.Price.ID == p.config.ProPriceID {
+			change.Plan = plans.Pro
+		}
+		if periodEnd == 0 {
+			periodEnd = item.CurrentPeriodEnd
+		}
+	}
+	if periodEnd > 0 {
+		end := time.Unix(periodEnd, 0).UTC()
+		change.CurrentPeriodEnd = &end
+	}
+
+	return change, nil
+}
+
+// subscriptionStatus converte o status do Stripe para entities.Subscription*.
+// Status sem acesso ao plano (unpaid, incomplete, paused...) viram canceled.
+func subscriptionStatus(status string) string {
+	switch status {
+	case "active":
+		return entities.SubscriptionActive
+	case "trialing":
+		return entities.SubscriptionTrialing
+	case "past_due":
+		return entities.SubscriptionPastDue
+	default:
+		return entities.SubscriptionCanceled
+	}
+}
+
+// verifySignature confere o header Stripe-Signature ("t=...,v1=..."): HMAC
+// SHA-256 de "t.corpo" com o segredo do endpoint, emitido há no máximo
+// signatureTolerance
+func (p *provider) verifySignature(payload []byte, header string) bool {
+	if p.config.WebhookSecret == "" {
+		return false
+	}
+
+	var timestamp string
+	var signatures [][]byte
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			if decoded, err := hex.DecodeString(value); err == nil {
+				signatures = append(signatures, decoded)
+			}
+		}
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return false
+	}
+
+	age := p.now().Sub(time.Unix(seconds, 0))
+	if age > signatureTolerance || age < -signatureTolerance {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(p.config.WebhookSecret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	expected := mac.Sum(nil)
+
+	for _, signature := range signatures {
+		if hmac.Equal(expected, signature) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,323 @@
+// Package telegram implementa o bot do Telegram (modo webhook) que permite a
+// um usuário vinculado criar tarefas, listar as tarefas do dia e concluí-las
+// pelo chat. O vínculo é feito com um código de uso único gerado pela API.
+package telegram
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	taskreq "github.com/devgugga/todo-it/internal/dtos/requests/task"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// linkCodeTTL é a validade do código de vinculação
+	linkCodeTTL = 10 * time.Minute
+	// linkCodeLength é o tamanho do código de vinculação
+	linkCodeLength = 8
+	// linkCodeAlphabet evita caracteres ambíguos (0/O, 1/I)
+	linkCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	// maxTodayTasks limita a listagem de tarefas do dia
+	maxTodayTasks = 50
+)
+
+// LinkCode é um código de vinculação recém-gerado
+type LinkCode struct {
+	Code      string    `json:"code"`
+	ExpiresAt time.Time `json:"expires_at"`
+	// DeepLink abre o bot já enviando o código (vazio sem TELEGRAM_BOT_USERNAME)
+	DeepLink string `json:"deep_link,omitempty"`
+}
+
+// Bot processa os updates recebidos pelo webhook
+type Bot struct {
+	client   Client
+	username string
+	links    repositories.TelegramLinkRepository
+	users    repositories.UserRepository
+	tasks    services.TaskService
+}
+
+// NewBot cria o bot. username é o @ do bot, usado no deep link de vinculação.
+func NewBot(client Client, username string, links repositories.TelegramLinkRepository, users repositories.UserRepository, tasks services.TaskService) *Bot {
+	return &Bot{
+		client:   client,
+		username: strings.TrimPrefix(username, "@"),
+		links:    links,
+		users:    users,
+		tasks:    tasks,
+	}
+}
+
+// CreateLinkCode gera um código de uso único para vincular o usuário a um chat
+func (b *Bot) CreateLinkCode(ctx context.Context, userID primitive.ObjectID) (*LinkCode, error) {
+	code, err := randomCode()
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(linkCodeTTL)
+	if err := b.links.SaveCode(ctx, userID, hashCode(code), expiresAt); err != nil {
+		return nil, err
+	}
+
+	linkCode := &LinkCode{Code: code, ExpiresAt: expiresAt}
+	if b.username != "" {
+		linkCode.DeepLink = fmt.Sprintf("https://t.me/%s?start=%s", b.username, code)
+	}
+
+	return linkCode, nil
+}
+
+// GetLink retorna o vínculo do usuário
+func (b *Bot) GetLink(ctx context.Context, userID primitive.ObjectID) (*entities.TelegramLink, error) {
+	return b.links.GetByUserID(ctx, userID)
+}
+
+// Unlink remove o vínculo do usuário
+func (b *Bot) Unlink(ctx context.Context, userID primitive.ObjectID) error {
+	return b.links.DeleteByUserID(ctx, userID)
+}
+
+// HandleUpdate processa um update e responde no chat. Apenas chats privados
+// são atendidos; mensagens que não são comandos recebem a ajuda.
+func (b *Bot) HandleUpdate(ctx context.Context, update *Update) error {
+	message := update.Message
+	if message == nil || message.Chat.Type != "private" {
+		return nil
+	}
+
+	reply := b.reply(ctx, message)
+	if reply == "" {
+		return nil
+	}
+
+	return b.client.SendMessage(ctx, message.Chat.ID, reply)
+}
+
+// reply executa o comando e retorna a resposta ao usuário
+func (b *Bot) reply(ctx context.Context, message *Message) string {
+	command, args, ok := parseCommand(message.Text)
+	if !ok {
+		return helpText
+	}
+
+	switch command {
+	case "start", "link":
+		if args == "" {
+			return helpText
+		}
+		return b.link(ctx, message, args)
+	case "help":
+		return helpText
+	}
+
+	link, err := b.links.GetByChatID(ctx, message.Chat.ID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTelegramLinkNotFound) {
+			return "Este chat não está vinculado. Gere um código no app e envie /link <código>."
+		}
+		return b.failure(ctx, "erro ao buscar vínculo", err)
+	}
+
+	switch command {
+	case "add":
+		return b.addTask(ctx, link.UserID, args)
+	case "today":
+		return b.listToday(ctx, link.UserID)
+	case "done":
+		return b.completeTask(ctx, link.UserID, args)
+	case "unlink":
+		if err := b.links.DeleteByChatID(ctx, message.Chat.ID); err != nil {
+			return b.failure(ctx, "erro ao desvincular chat", err)
+		}
+		return "Chat desvinculado."
+	default:
+		return helpText
+	}
+}
+
+const helpText = `Comandos disponíveis:
+/link <código> — vincula este chat à sua conta (gere o código no app)
+/add <título> — cria uma tarefa para hoje
+/today — lista as tarefas de hoje
+/done <número> — conclui a tarefa pelo número mostrado em /today
+/unlink — desvincula este chat`
+
+// link confirma o vínculo do chat com o código informado
+func (b *Bot) link(ctx context.Context, message *Message, code string) string {
+	var username string
+	if message.From != nil {
+		username = message.From.Username
+	}
+
+	_, err := b.links.ConsumeCode(ctx, hashCode(normalizeCode(code)), message.Chat.ID, username)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTelegramLinkNotFound) {
+			return "Código inválido ou expirado. Gere um novo código no app."
+		}
+		return b.failure(ctx, "erro ao vincular chat", err)
+	}
+
+	return "Chat vinculado! Envie /help para ver os comandos."
+}
+
+// addTask cria uma tarefa com vencimento no fim do dia do usuário
+func (b *Bot) addTask(ctx context.Context, userID primitive.ObjectID, title string) string {
+	if title == "" {
+		return "Informe o título: /add <título>"
+	}
+	if len([]rune(title)) > 200 {
+		return "O título deve ter no máximo 200 caracteres."
+	}
+
+	_, end, err := b.today(ctx, userID)
+	if err != nil {
+		return b.failure(ctx, "erro ao buscar usuário", err)
+	}
+
+	task, err := b.tasks.Create(ctx, userID, &taskreq.CreateTaskRequest{Title: title, DueDate: &taskreq.DueDate{Time: end}})
+	if err != nil {
+		return b.failure(ctx, "erro ao criar tarefa", err)
+	}
+
+	return fmt.Sprintf("Tarefa criada: %s", task.Title)
+}
+
+// listToday lista as tarefas com vencimento hoje (no fuso do usuário)
+func (b *Bot) listToday(ctx context.Context, userID primitive.ObjectID) string {
+	tasks, err := b.todayTasks(ctx, userID)
+	if err != nil {
+		return b.failure(ctx, "erro ao listar tarefas", err)
+	}
+
+	if len(tasks) == 0 {
+		return "Nenhuma tarefa para hoje."
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Tarefas de hoje:")
+	for i, task := range tasks {
+		mark := "⬜"
+		switch task.Status {
+		case enums.StatusCompleted:
+			mark = "✅"
+		case enums.StatusCancelled:
+			mark = "✖️"
+		}
+		fmt.Fprintf(&sb, "\n%d. %s %s", i+1, mark, task.Title)
+	}
+
+	return sb.String()
+}
+
+// completeTask conclui a tarefa pelo número da listagem de /today
+func (b *Bot) completeTask(ctx context.Context, userID primitive.ObjectID, args string) string {
+	index, err := strconv.Atoi(args)
+	if err != nil || index < 1 {
+		return "Informe o número da tarefa: /done <número> (veja /today)"
+	}
+
+	tasks, err := b.todayTasks(ctx, userID)
+	if err != nil {
+		return b.failure(ctx, "erro ao listar tarefas", err)
+	}
+
+	if index > len(tasks) {
+		return "Tarefa não encontrada. Veja os números em /today."
+	}
+
+	task := tasks[index-1]
+	if task.Status == enums.StatusCompleted {
+		return fmt.Sprintf("A tarefa já estava concluída: %s", task.Title)
+	}
+
+	if err := b.tasks.UpdateStatus(ctx, userID, task.ID, enums.StatusCompleted); err != nil {
+		if errors.Is(err, services.ErrTaskNotFound) {
+			return "Tarefa não encontrada. Veja os números em /today."
+		}
+		return b.failure(ctx, "erro ao concluir tarefa", err)
+	}
+
+	return fmt.Sprintf("Concluída: %s", task.Title)
+}
+
+// todayTasks busca as tarefas não arquivadas com vencimento hoje, em ordem
+// estável (vencimento e criação) para que os números de /today valham em /done
+func (b *Bot) todayTasks(ctx context.Context, userID primitive.ObjectID) ([]*entities.Task, error) {
+	start, end, err := b.today(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	archived := false
+	filters := &repositories.TaskFilters{
+		IsArchived: &archived,
+		DueAfter:   &start,
+		DueBefore:  &end,
+		SortBy:     "due_date",
+		SortOrder:  "asc",
+	}
+
+	tasks, _, err := b.tasks.List(ctx, userID, 1, maxTodayTasks, filters)
+	return tasks, err
+}
+
+// today retorna o início e o fim do dia atual no fuso do usuário
+func (b *Bot) today(ctx context.Context, userID primitive.ObjectID) (time.Time, time.Time, error) {
+	user, err := b.users.GetByID(ctx, userID)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	now := time.Now().In(user.Preferences.Location())
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	end := start.AddDate(0, 0, 1).Add(-time.Second)
+
+	return start, end, nil
+}
+
+// failure registra o erro e retorna uma resposta genérica ao usuário
+func (b *Bot) failure(ctx context.Context, msg string, err error) string {
+	logging.FromContext(ctx).Error("telegram: "+msg, logging.Err(err))
+	return "Não foi possível concluir a operação. Tente novamente mais tarde."
+}
+
+// randomCode gera um código de vinculação aleatório
+func randomCode() (string, error) {
+	raw := make([]byte, linkCodeLength)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("erro ao gerar código de vinculação: %w", err)
+	}
+
+	code := make([]byte, linkCodeLength)
+	for i, b := range raw {
+		code[i] = linkCodeAlphabet[int(b)%len(linkCodeAlphabet)]
+	}
+
+	return string(code), nil
+}
+
+// normalizeCode aceita o código digitado em minúsculas ou com espaços
+func normalizeCode(code string) string {
+	return strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(code), " ", ""))
+}
+
+// hashCode calcula o hash armazenado do código (o código em si não é persistido)
+func hashCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,93 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// defaultAPIURL é o endereço da Bot API do Telegram
+const defaultAPIURL = "https://api.telegram.org"
+
+// Client chama os métodos da Bot API usados pela integração
+type Client interface {
+	SendMessage(ctx context.Context, chatID int64, text string) error
+	SetWebhook(ctx context.Context, url, secretToken string) error
+}
+
+// httpClient implementa Client via HTTP
+type httpClient struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewClient cria o cliente da Bot API. apiURL vazio usa api.telegram.org.
+func NewClient(token, apiURL string) Client {
+	if apiURL == "" {
+		apiURL = defaultAPIURL
+	}
+
+	return &httpClient{
+		baseURL: strings.TrimRight(apiURL, "/") + "/bot" + token,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// SendMessage envia uma mensagem de texto simples ao chat
+func (c *httpClient) SendMessage(ctx context.Context, chatID int64, text string) error {
+	return c.call(ctx, "sendMessage", map[string]interface{}{
+		"chat_id": chatID,
+		"text":    text,
+	})
+}
+
+// SetWebhook registra a URL que recebe os updates, com o token enviado no
+// header X-Telegram-Bot-Api-Secret-Token
+func (c *httpClient) SetWebhook(ctx context.Context, url, secretToken string) error {
+	return c.call(ctx, "setWebhook", map[string]interface{}{
+		"url":             url,
+		"secret_token":    secretToken,
+		"allowed_updates": []string{"message"},
+	})
+}
+
+// apiResponse é o envelope de resposta da Bot API
+type apiResponse struct {
+	OK          bool   `json:"ok"`
+	Description string `json:"description"`
+}
+
+func (c *httpClient) call(ctx context.Context, method string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("erro ao serializar %s: %w", method, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/"+method, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("erro ao montar %s: %w", method, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		// A URL contém o token do bot; não repassa o erro original
+		return fmt.Errorf("erro ao chamar %s na API do Telegram", method)
+	}
+	defer resp.Body.Close()
+
+	var result apiResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("resposta inválida da API do Telegram em %s (status %d)", method, resp.StatusCode)
+	}
+
+	if !result.OK {
+		return fmt.Errorf("API do Telegram recusou %s: %s", method, result.Description)
+	}
+
+	return nil
+}
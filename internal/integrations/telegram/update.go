@@ -0,0 +1,43 @@
+package telegram
+
+import "strings"
+
+// Update é o subconjunto do objeto Update da Bot API usado pelo bot
+type Update struct {
+	UpdateID int64    `json:"update_id"`
+	Message  *Message `json:"message"`
+}
+
+// Message é uma mensagem recebida em um chat
+type Message struct {
+	MessageID int64  `json:"message_id"`
+	From      *User  `json:"from"`
+	Chat      Chat   `json:"chat"`
+	Text      string `json:"text"`
+}
+
+// Chat identifica a conversa com o bot
+type Chat struct {
+	ID   int64  `json:"id"`
+	Type string `json:"type"`
+}
+
+// User é o autor da mensagem no Telegram
+type User struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+}
+
+// parseCommand separa "/comando@bot argumentos" em comando (minúsculo, sem
+// barra e sem menção ao bot) e argumentos
+func parseCommand(text string) (command, args string, ok bool) {
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, "/") {
+		return "", "", false
+	}
+
+	command, args, _ = strings.Cut(text[1:], " ")
+	command, _, _ = strings.Cut(command, "@")
+
+	return strings.ToLower(command), strings.TrimSpace(args), command != ""
+}
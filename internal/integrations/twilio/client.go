@@ -0,0 +1,105 @@
+package twilio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// defaultAPIURL é o endereço da API REST da Twilio
+const defaultAPIURL = "https://api.twilio.com"
+
+// ErrInvalidNumber indica número de destino recusado pela Twilio (inexistente,
+// sem suporte a SMS ou bloqueado)
+var ErrInvalidNumber = errors.New("número de telefone recusado pela Twilio")
+
+// invalidNumberCodes são os códigos de erro da Twilio para destinos inválidos
+var invalidNumberCodes = map[int]bool{
+	21211: true, // número inválido
+	21408: true, // região sem permissão
+	21610: true, // destinatário respondeu STOP
+	21612: true, // rota indisponível para o número
+	21614: true, // número não é móvel
+}
+
+// Client envia SMS pela API de mensagens da Twilio
+type Client interface {
+	SendSMS(ctx context.Context, to, body string) error
+}
+
+// httpClient implementa Client via HTTP
+type httpClient struct {
+	baseURL    string
+	accountSID string
+	authToken  string
+	from       string
+	client     *http.Client
+}
+
+// NewClient cria o cliente da Twilio. from é um número E.164 ou o SID de um
+// Messaging Service (MG...). apiURL vazio usa api.twilio.com.
+func NewClient(accountSID, authToken, from, apiURL string) Client {
+	if apiURL == "" {
+		apiURL = defaultAPIURL
+	}
+
+	return &httpClient{
+		baseURL:    strings.TrimRight(apiURL, "/"),
+		accountSID: accountSID,
+		authToken:  authToken,
+		from:       from,
+		client:     &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// apiError é o corpo de erro da API da Twilio
+type apiError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// SendSMS envia um SMS para o número E.164 informado
+func (c *httpClient) SendSMS(ctx context.Context, to, body string) error {
+	form := url.Values{}
+	form.Set("To", to)
+	form.Set("Body", body)
+	if strings.HasPrefix(c.from, "MG") {
+		form.Set("MessagingServiceSid", c.from)
+	} else {
+		form.Set("From", c.from)
+	}
+
+	endpoint := c.baseURL + "/2010-04-01/Accounts/" + url.PathEscape(c.accountSID) + "/Messages.json"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("erro ao montar SMS: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(c.accountSID, c.authToken)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("erro ao enviar SMS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 300 {
+		return nil
+	}
+
+	var result apiError
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("API da Twilio respondeu %d", resp.StatusCode)
+	}
+
+	if invalidNumberCodes[result.Code] {
+		return fmt.Errorf("%w: %s", ErrInvalidNumber, result.Message)
+	}
+
+	return fmt.Errorf("API da Twilio recusou o SMS (%d): %s", result.Code, result.Message)
+}
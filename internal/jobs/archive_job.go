@@ -0,0 +1,50 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/repositories"
+)
+
+// archiveBatchSize limita quantas tarefas são movidas por operação
+const archiveBatchSize = 500
+
+// ArchiveJob move tarefas concluídas e arquivadas há muito tempo para o histórico
+type ArchiveJob struct {
+	archive     repositories.TaskArchiveRepository
+	afterMonths int
+}
+
+// NewArchiveJob cria o job de arquivamento
+func NewArchiveJob(archive repositories.TaskArchiveRepository, afterMonths int) *ArchiveJob {
+	return &ArchiveJob{
+		archive:     archive,
+		afterMonths: afterMonths,
+	}
+}
+
+// Run move em lotes todas as tarefas elegíveis
+func (j *ArchiveJob) Run(ctx context.Context) error {
+	cutoff := time.Now().AddDate(0, -j.afterMonths, 0)
+
+	var total int64
+	for {
+		moved, err := j.archive.MoveCompletedBefore(ctx, cutoff, archiveBatchSize)
+		if err != nil {
+			return err
+		}
+
+		total += moved
+		if moved < archiveBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		slog.Info("tarefas movidas para o histórico", "count", total)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// dueSoonBatchSize limita quantas tarefas são lidas por consulta
+const dueSoonBatchSize = 500
+
+// DueSoonJob avisa (evento task.due_soon) as tarefas abertas que vencem dentro
+// da janela configurada, uma vez por vencimento
+type DueSoonJob struct {
+	todos  repositories.TodoRepository
+	bus    events.Bus
+	window time.Duration
+}
+
+// NewDueSoonJob cria o job de avisos de vencimento
+func NewDueSoonJob(todos repositories.TodoRepository, bus events.Bus, window time.Duration) *DueSoonJob {
+	return &DueSoonJob{
+		todos:  todos,
+		bus:    bus,
+		window: window,
+	}
+}
+
+// Run publica os avisos em lotes, agrupados por usuário: as tarefas de um
+// usuário são avisadas e marcadas juntas, e o Notifier entrega os avisos
+// pendentes de cada canal em uma única mensagem
+func (j *DueSoonJob) Run(ctx context.Context) error {
+	now := time.Now()
+
+	var total int
+	for {
+		tasks, err := j.todos.ListDueSoon(ctx, now, now.Add(j.window), dueSoonBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, batch := range groupByUser(tasks) {
+			if err := j.notify(ctx, batch); err != nil {
+				return err
+			}
+		}
+
+		total += len(tasks)
+		if len(tasks) < dueSoonBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		slog.Info("avisos de vencimento publicados", "count", total)
+	}
+
+	return nil
+}
+
+// notify publica o aviso de cada tarefa do usuário e marca as avisadas. Uma
+// falha interrompe a execução; a próxima retoma das tarefas não marcadas.
+func (j *DueSoonJob) notify(ctx context.Context, tasks []*entities.Task) error {
+	ids := make([]primitive.ObjectID, 0, len(tasks))
+	var publishErr error
+	for _, task := range tasks {
+		event := events.New(events.TaskDueSoon, task.UserID, map[string]interface{}{
+			"task_id":  task.ID.Hex(),
+			"title":    task.Title,
+			"status":   task.Status,
+			"priority": task.Priority,
+			"due_date": task.DueDate,
+		})
+		if publishErr = j.bus.Publish(ctx, event); publishErr != nil {
+			break
+		}
+		ids = append(ids, task.ID)
+	}
+
+	if len(ids) > 0 {
+		if err := j.todos.MarkDueSoonNotified(ctx, ids); err != nil {
+			return err
+		}
+	}
+
+	if publishErr != nil {
+		return fmt.Errorf("erro ao publicar aviso de vencimento: %w", publishErr)
+	}
+
+	return nil
+}
+
+// groupByUser divide as tarefas, ordenadas por usuário, em um lote por usuário
+func groupByUser(tasks []*entities.Task) [][]*entities.Task {
+	var batches [][]*entities.Task
+	for start := 0; start < len(tasks); {
+		end := start + 1
+		for end < len(tasks) && tasks[end].UserID == tasks[start].UserID {
+			end++
+		}
+		batches = append(batches, tasks[start:end])
+		start = end
+	}
+	return batches
+}
@@ -0,0 +1,120 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// overdueSummaryPageSize limita quantos usuários são lidos por consulta
+	overdueSummaryPageSize = 200
+	// overdueSummaryMaxTitles limita os títulos citados no resumo
+	overdueSummaryMaxTitles = 5
+)
+
+// OverdueSummaryJob envia, uma vez por dia, o resumo das tarefas atrasadas aos
+// usuários que o habilitaram, a partir da hora configurada no fuso de cada um
+type OverdueSummaryJob struct {
+	users repositories.UserRepository
+	todos repositories.TodoRepository
+	bus   events.Bus
+	hour  int
+}
+
+// NewOverdueSummaryJob cria o job do resumo diário de atrasadas
+func NewOverdueSummaryJob(users repositories.UserRepository, todos repositories.TodoRepository, bus events.Bus, hour int) *OverdueSummaryJob {
+	return &OverdueSummaryJob{
+		users: users,
+		todos: todos,
+		bus:   bus,
+		hour:  hour,
+	}
+}
+
+// Run percorre os usuários inscritos e publica o resumo dos que já chegaram à
+// hora do envio e ainda não o receberam hoje
+func (j *OverdueSummaryJob) Run(ctx context.Context) error {
+	now := time.Now()
+
+	var sent int
+	afterID := primitive.NilObjectID
+	for {
+		users, err := j.users.ListOverdueSummarySubscribers(ctx, afterID, overdueSummaryPageSize)
+		if err != nil {
+			return err
+		}
+
+		for _, user := range users {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			published, err := j.summarize(ctx, user, now)
+			if err != nil {
+				return err
+			}
+			if published {
+				sent++
+			}
+		}
+
+		if len(users) < overdueSummaryPageSize {
+			break
+		}
+		afterID = users[len(users)-1].ID
+	}
+
+	if sent > 0 {
+		slog.Info("resumos de tarefas atrasadas publicados", "count", sent)
+	}
+
+	return nil
+}
+
+// summarize publica o resumo do usuário, se for a hora. O dia é registrado
+// mesmo sem tarefas atrasadas: tarefas que atrasarem depois só entram no
+// resumo do dia seguinte, em vez de gerar um aviso a cada execução.
+func (j *OverdueSummaryJob) summarize(ctx context.Context, user *entities.User, now time.Time) (bool, error) {
+	local := now.In(user.Preferences.Location())
+	day := local.Format(time.DateOnly)
+	if local.Hour() < j.hour || user.OverdueSummarySentOn == day {
+		return false, nil
+	}
+
+	tasks, err := j.todos.GetOverdueTodos(ctx, user.ID)
+	if err != nil {
+		return false, err
+	}
+
+	claimed, err := j.users.ClaimOverdueSummary(ctx, user.ID, day)
+	if err != nil || !claimed || len(tasks) == 0 {
+		return false, err
+	}
+
+	taskIDs := make([]string, 0, len(tasks))
+	titles := make([]string, 0, min(len(tasks), overdueSummaryMaxTitles))
+	for _, task := range tasks {
+		taskIDs = append(taskIDs, task.ID.Hex())
+		if len(titles) < overdueSummaryMaxTitles {
+			titles = append(titles, task.Title)
+		}
+	}
+
+	event := events.New(events.TaskOverdueSummary, user.ID, map[string]interface{}{
+		"count":    len(tasks),
+		"titles":   titles,
+		"task_ids": taskIDs,
+	})
+	if err := j.bus.Publish(ctx, event); err != nil {
+		return false, fmt.Errorf("erro ao publicar resumo de atrasadas: %w", err)
+	}
+
+	return true, nil
+}
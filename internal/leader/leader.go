@@ -0,0 +1,191 @@
+// Package leader elege, entre as réplicas da API, a instância responsável por
+// tarefas que devem rodar em um único lugar (ex.: jobs agendados). A liderança
+// é um lease no MongoDB renovado periodicamente: se a líder cair, o lease
+// expira e outra instância assume automaticamente.
+package leader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/instance"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// lease representa o documento de uma eleição na collection leader_leases
+type lease struct {
+	Name       string    `bson:"_id"`
+	Holder     string    `bson:"holder"`
+	AcquiredAt time.Time `bson:"acquired_at"`
+	ExpiresAt  time.Time `bson:"expires_at"`
+}
+
+// Elector disputa e renova a liderança de uma eleição em nome desta instância
+type Elector struct {
+	collection *mongo.Collection
+	name       string
+	id         string
+	ttl        time.Duration
+
+	mu sync.RWMutex
+	// validUntil é até quando esta instância se considera líder (zero = não é)
+	validUntil time.Time
+
+	done chan struct{}
+}
+
+// NewElector cria o participante da eleição name. ttl é a validade do lease:
+// a líder o renova a cada ttl/3, e outra instância assume após ttl sem renovação.
+func NewElector(db database.Client, name string, ttl time.Duration) *Elector {
+	return &Elector{
+		collection: db.Collections().LeaderLeases,
+		name:       name,
+		id:         instance.ID(),
+		ttl:        ttl,
+		done:       make(chan struct{}),
+	}
+}
+
+// Start disputa a liderança imediatamente (para que IsLeader já reflita o
+// resultado) e segue disputando/renovando em segundo plano até ctx ser
+// cancelado, quando renuncia para que outra instância assuma sem esperar o lease
+func (e *Elector) Start(ctx context.Context) {
+	e.campaign(ctx)
+
+	go func() {
+		defer close(e.done)
+
+		ticker := time.NewTicker(e.ttl / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				e.resign()
+				return
+			case <-ticker.C:
+				e.campaign(ctx)
+			}
+		}
+	}()
+}
+
+// Done é fechado após a renúncia que segue o cancelamento do contexto de Start
+func (e *Elector) Done() <-chan struct{} {
+	return e.done
+}
+
+// IsLeader indica se esta instância é a líder. A liderança local expira antes
+// do lease no banco (margem para diferença de relógio entre as instâncias),
+// então uma renovação que falhe faz a instância deixar de agir como líder
+// antes que outra possa assumir.
+func (e *Elector) IsLeader() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return time.Now().Before(e.validUntil)
+}
+
+// Leader retorna a instância que detém o lease válido ("" se não houver)
+func (e *Elector) Leader(ctx context.Context) (string, error) {
+	var current lease
+	err := e.collection.FindOne(ctx, bson.M{"_id": e.name, "expires_at": bson.M{"$gt": time.Now()}}).Decode(&current)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("erro ao buscar líder de %s: %w", e.name, err)
+	}
+
+	return current.Holder, nil
+}
+
+// campaign tenta obter ou renovar o lease, registrando as mudanças de liderança
+func (e *Elector) campaign(ctx context.Context) {
+	wasLeader := e.IsLeader()
+
+	start := time.Now()
+	acquired, err := e.acquire(ctx, start, wasLeader)
+	if err != nil {
+		if ctx.Err() == nil {
+			slog.Warn("erro ao renovar liderança", "election", e.name, "error", err)
+		}
+		// Sem confirmação do banco a liderança local expira sozinha em validUntil
+		return
+	}
+
+	e.mu.Lock()
+	if acquired {
+		e.validUntil = start.Add(e.ttl * 4 / 5)
+	} else {
+		e.validUntil = time.Time{}
+	}
+	e.mu.Unlock()
+
+	switch {
+	case acquired && !wasLeader:
+		slog.Info("liderança obtida", "election", e.name, "instance", e.id)
+	case !acquired && wasLeader:
+		slog.Warn("liderança perdida", "election", e.name, "instance", e.id)
+	}
+}
+
+// acquire grava o lease para esta instância se ela já o detém ou se o lease
+// atual expirou. A validade parte de now, medido antes da operação.
+func (e *Elector) acquire(ctx context.Context, now time.Time, renewing bool) (bool, error) {
+	filter := bson.M{
+		"_id": e.name,
+		"$or": []bson.M{
+			{"holder": e.id},
+			{"expires_at": bson.M{"$lte": now}},
+		},
+	}
+
+	set := bson.M{"holder": e.id, "expires_at": now.Add(e.ttl)}
+	if !renewing {
+		set["acquired_at"] = now
+	}
+
+	err := e.collection.FindOneAndUpdate(ctx, filter, bson.M{"$set": set}, options.FindOneAndUpdate().SetUpsert(true)).Err()
+	switch {
+	case err == nil, errors.Is(err, mongo.ErrNoDocuments):
+		// ErrNoDocuments: documento inserido pelo upsert (primeira eleição)
+		return true, nil
+	case mongo.IsDuplicateKeyError(err):
+		// O lease existe e pertence a outra instância
+		return false, nil
+	default:
+		return false, fmt.Errorf("erro ao obter lease de %s: %w", e.name, err)
+	}
+}
+
+// resign libera o lease, se for desta instância
+func (e *Elector) resign() {
+	e.mu.Lock()
+	wasLeader := time.Now().Before(e.validUntil)
+	e.validUntil = time.Time{}
+	e.mu.Unlock()
+
+	if !wasLeader {
+		return
+	}
+
+	// Contexto próprio: o de Start já foi cancelado
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := e.collection.UpdateOne(ctx, bson.M{"_id": e.name, "holder": e.id}, bson.M{"$set": bson.M{"expires_at": time.Now()}})
+	if err != nil {
+		slog.Warn("erro ao renunciar à liderança", "election", e.name, "error", err)
+		return
+	}
+
+	slog.Info("liderança liberada", "election", e.name, "instance", e.id)
+}
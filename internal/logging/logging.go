@@ -0,0 +1,77 @@
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// contextKey é a chave do logger no contexto da requisição
+type contextKey struct{}
+
+// level é o nível do logger padrão, alterável em tempo de execução via SetLevel
+var level = new(slog.LevelVar)
+
+// Setup cria o logger da aplicação e o define como padrão do slog.
+// format aceita "json" (padrão) ou "text"; level aceita debug, info, warn e error.
+func Setup(lvl, format string) *slog.Logger {
+	SetLevel(lvl)
+	logger := newLogger(os.Stdout, level, format)
+	slog.SetDefault(logger)
+	return logger
+}
+
+// SetLevel altera o nível do logger criado por Setup sem recriá-lo
+func SetLevel(lvl string) {
+	level.Set(ParseLevel(lvl))
+}
+
+// New cria um logger escrevendo em w
+func New(w io.Writer, lvl, format string) *slog.Logger {
+	return newLogger(w, ParseLevel(lvl), format)
+}
+
+func newLogger(w io.Writer, lvl slog.Leveler, format string) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: lvl}
+
+	var handler slog.Handler
+	if strings.EqualFold(format, "text") {
+		handler = slog.NewTextHandler(w, opts)
+	} else {
+		handler = slog.NewJSONHandler(w, opts)
+	}
+
+	return slog.New(handler)
+}
+
+// ParseLevel converte o nível configurado, usando info quando inválido
+func ParseLevel(lvl string) slog.Level {
+	var parsed slog.Level
+	if err := parsed.UnmarshalText([]byte(lvl)); err != nil {
+		return slog.LevelInfo
+	}
+	return parsed
+}
+
+// WithContext associa o logger ao contexto
+func WithContext(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// FromContext retorna o logger do contexto (com request_id/user_id quando
+// vindo de uma requisição) ou o logger padrão
+func FromContext(ctx context.Context) *slog.Logger {
+	if ctx != nil {
+		if logger, ok := ctx.Value(contextKey{}).(*slog.Logger); ok {
+			return logger
+		}
+	}
+	return slog.Default()
+}
+
+// Err padroniza o atributo de erro nos logs
+func Err(err error) slog.Attr {
+	return slog.Any("error", err)
+}
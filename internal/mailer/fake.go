@@ -0,0 +1,64 @@
+package mailer
+
+import (
+	"context"
+	"sync"
+)
+
+// Fake guarda em memória os emails enviados, para conferência em testes.
+// Templates são renderizados como no envio real.
+type Fake struct {
+	mu   sync.Mutex
+	sent []*Message
+	// Err, quando definido, é retornado por Send (ex.: simular falha de SMTP)
+	Err error
+}
+
+// NewFake cria um mailer em memória
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+func (f *Fake) Send(ctx context.Context, msg *Message) error {
+	if err := msg.validate(); err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.Err != nil {
+		return f.Err
+	}
+	copied := *msg
+	f.sent = append(f.sent, &copied)
+	return nil
+}
+
+func (f *Fake) SendTemplate(ctx context.Context, to string, tpl Template, data interface{}) error {
+	return sendTemplate(ctx, f, to, tpl, data)
+}
+
+// Sent retorna os emails enviados até o momento, em ordem
+func (f *Fake) Sent() []*Message {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]*Message(nil), f.sent...)
+}
+
+// SentTo retorna os emails enviados ao destinatário
+func (f *Fake) SentTo(to string) []*Message {
+	var messages []*Message
+	for _, msg := range f.Sent() {
+		if msg.To == to {
+			messages = append(messages, msg)
+		}
+	}
+	return messages
+}
+
+// Reset descarta os emails guardados
+func (f *Fake) Reset() {
+	f.mu.Lock()
+	f.sent = nil
+	f.mu.Unlock()
+}
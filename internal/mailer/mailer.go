@@ -0,0 +1,100 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// TLSMode define como a conexão SMTP é protegida
+type TLSMode string
+
+const (
+	// TLSModeStartTLS conecta em texto e negocia TLS via STARTTLS (porta 587)
+	TLSModeStartTLS TLSMode = "starttls"
+	// TLSModeImplicit conecta diretamente via TLS (porta 465)
+	TLSModeImplicit TLSMode = "tls"
+	// TLSModeNone não usa TLS (apenas para servidores locais de desenvolvimento)
+	TLSModeNone TLSMode = "none"
+)
+
+// Config define as configurações do envio de emails
+type Config struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	FromName string
+	TLS      TLSMode
+	// DryRun apenas registra os emails no log, sem enviá-los
+	DryRun bool
+}
+
+// Message representa um email com versões em texto e HTML
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Mailer define a interface de envio de emails
+type Mailer interface {
+	Send(ctx context.Context, msg *Message) error
+	SendTemplate(ctx context.Context, to string, tpl Template, data interface{}) error
+}
+
+// Checker é implementado pelos mailers que enviam por um servidor externo,
+// permitindo verificar a conexão sem enviar emails
+type Checker interface {
+	Check(ctx context.Context) error
+}
+
+// New cria o mailer configurado. Sem SMTP_HOST ou com DryRun, os emails
+// são apenas registrados no log.
+func New(cfg Config) Mailer {
+	if cfg.DryRun || cfg.Host == "" {
+		slog.Info("envio de emails em modo dry-run (apenas log)")
+		return &logMailer{}
+	}
+
+	if cfg.TLS == "" {
+		cfg.TLS = TLSModeStartTLS
+	}
+	return &smtpMailer{config: cfg}
+}
+
+// sendTemplate renderiza o template e envia a mensagem pelo mailer informado
+func sendTemplate(ctx context.Context, m Mailer, to string, tpl Template, data interface{}) error {
+	msg, err := Render(tpl, data)
+	if err != nil {
+		return err
+	}
+
+	msg.To = to
+	return m.Send(ctx, msg)
+}
+
+// logMailer registra os emails no log em vez de enviá-los (desenvolvimento)
+type logMailer struct{}
+
+func (m *logMailer) Send(ctx context.Context, msg *Message) error {
+	slog.Info("email (dry-run)", "to", msg.To, "subject", msg.Subject, "body", msg.Text)
+	return nil
+}
+
+func (m *logMailer) SendTemplate(ctx context.Context, to string, tpl Template, data interface{}) error {
+	return sendTemplate(ctx, m, to, tpl, data)
+}
+
+// validate verifica se a mensagem pode ser enviada
+func (msg *Message) validate() error {
+	if msg.To == "" {
+		return fmt.Errorf("destinatário não informado")
+	}
+	if msg.Text == "" && msg.HTML == "" {
+		return fmt.Errorf("email sem conteúdo")
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// smtpMailer envia emails via SMTP
+type smtpMailer struct {
+	config Config
+}
+
+func (m *smtpMailer) SendTemplate(ctx context.Context, to string, tpl Template, data interface{}) error {
+	return sendTemplate(ctx, m, to, tpl, data)
+}
+
+func (m *smtpMailer) Send(ctx context.Context, msg *Message) error {
+	if err := msg.validate(); err != nil {
+		return err
+	}
+
+	body, err := m.buildMessage(msg)
+	if err != nil {
+		return fmt.Errorf("erro ao montar email: %w", err)
+	}
+
+	client, err := m.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("erro ao conectar ao servidor SMTP: %w", err)
+	}
+	defer client.Close()
+
+	if m.config.Username != "" {
+		auth := smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("erro ao autenticar no servidor SMTP: %w", err)
+		}
+	}
+
+	if err := client.Mail(m.config.From); err != nil {
+		return fmt.Errorf("erro ao definir remetente: %w", err)
+	}
+	if err := client.Rcpt(msg.To); err != nil {
+		return fmt.Errorf("erro ao definir destinatário: %w", err)
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("erro ao iniciar envio: %w", err)
+	}
+	if _, err := writer.Write(body); err != nil {
+		writer.Close()
+		return fmt.Errorf("erro ao enviar email: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("erro ao enviar email: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// Check conecta e autentica no servidor SMTP, sem enviar emails
+func (m *smtpMailer) Check(ctx context.Context) error {
+	client, err := m.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("erro ao conectar ao servidor SMTP: %w", err)
+	}
+	defer client.Close()
+
+	if m.config.Username != "" {
+		auth := smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("erro ao autenticar no servidor SMTP: %w", err)
+		}
+	}
+
+	return client.Quit()
+}
+
+// dial abre a conexão com o servidor respeitando o deadline do contexto
+func (m *smtpMailer) dial(ctx context.Context) (*smtp.Client, error) {
+	addr := net.JoinHostPort(m.config.Host, strconv.Itoa(m.config.Port))
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	tlsConfig := &tls.Config{ServerName: m.config.Host}
+
+	if m.config.TLS == TLSModeImplicit {
+		tlsConn := tls.Client(conn, tlsConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+
+	client, err := smtp.NewClient(conn, m.config.Host)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if m.config.TLS == TLSModeStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			client.Close()
+			return nil, fmt.Errorf("servidor não suporta STARTTLS")
+		}
+		if err := client.StartTLS(tlsConfig); err != nil {
+			client.Close()
+			return nil, err
+		}
+	}
+
+	return client, nil
+}
+
+// buildMessage monta o email MIME (multipart/alternative quando há HTML)
+func (m *smtpMailer) buildMessage(msg *Message) ([]byte, error) {
+	var buf bytes.Buffer
+
+	from := mail.Address{Name: m.config.FromName, Address: m.config.From}
+	headers := []struct{ key, value string }{
+		{"From", from.String()},
+		{"To", msg.To},
+		{"Subject", mime.QEncoding.Encode("utf-8", msg.Subject)},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"Message-ID", m.messageID()},
+		{"MIME-Version", "1.0"},
+	}
+	for _, header := range headers {
+		fmt.Fprintf(&buf, "%s: %s\r\n", header.key, header.value)
+	}
+
+	if msg.HTML == "" {
+		buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+		buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+		if err := writeQuotedPrintable(&buf, msg.Text); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	writer := multipart.NewWriter(&buf)
+	fmt.Fprintf(&buf, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", writer.Boundary())
+
+	parts := []struct{ contentType, content string }{
+		{"text/plain; charset=utf-8", msg.Text},
+		{"text/html; charset=utf-8", msg.HTML},
+	}
+	for _, part := range parts {
+		if part.content == "" {
+			continue
+		}
+
+		partWriter, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := writeQuotedPrintable(partWriter, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// messageID gera um Message-ID único no domínio do remetente
+func (m *smtpMailer) messageID() string {
+	buf := make([]byte, 12)
+	rand.Read(buf)
+
+	domain := m.config.Host
+	if address, err := mail.ParseAddress(m.config.From); err == nil {
+		if at := strings.LastIndexByte(address.Address, '@'); at >= 0 {
+			domain = address.Address[at+1:]
+		}
+	}
+
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(buf), domain)
+}
+
+func writeQuotedPrintable(w io.Writer, content string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(content)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
@@ -0,0 +1,109 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+// Template identifica um template de email
+type Template string
+
+const (
+	TemplateVerification    Template = "verification"
+	TemplatePasswordReset   Template = "password_reset"
+	TemplateDigest          Template = "digest"
+	TemplateShareInvitation Template = "share_invitation"
+)
+
+// VerificationData são os dados do email de verificação de conta
+type VerificationData struct {
+	Name      string
+	URL       string
+	ExpiresIn string
+}
+
+// PasswordResetData são os dados do email de redefinição de senha
+type PasswordResetData struct {
+	Name      string
+	URL       string
+	ExpiresIn string
+}
+
+// DigestItem é um item do resumo de notificações
+type DigestItem struct {
+	Title string
+	Body  string
+}
+
+// DigestData são os dados do email de resumo de notificações
+type DigestData struct {
+	Name  string
+	Items []DigestItem
+}
+
+// ShareInvitationData são os dados do convite de compartilhamento
+type ShareInvitationData struct {
+	InviterName  string
+	ResourceName string
+	URL          string
+}
+
+// compiledTemplate agrupa as versões texto (com o bloco "subject") e HTML de um template
+type compiledTemplate struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+var templates = mustParseTemplates(
+	TemplateVerification,
+	TemplatePasswordReset,
+	TemplateDigest,
+	TemplateShareInvitation,
+)
+
+// mustParseTemplates compila os templates embutidos; falha na inicialização se algum for inválido
+func mustParseTemplates(names ...Template) map[Template]*compiledTemplate {
+	compiled := make(map[Template]*compiledTemplate, len(names))
+
+	for _, name := range names {
+		text := texttemplate.Must(texttemplate.ParseFS(templateFS, "templates/"+string(name)+".txt.tmpl"))
+		html := htmltemplate.Must(htmltemplate.ParseFS(templateFS, "templates/layout.html.tmpl", "templates/"+string(name)+".html.tmpl"))
+
+		compiled[name] = &compiledTemplate{text: text, html: html}
+	}
+
+	return compiled
+}
+
+// Render gera assunto, texto e HTML de um template
+func Render(tpl Template, data interface{}) (*Message, error) {
+	compiled, ok := templates[tpl]
+	if !ok {
+		return nil, fmt.Errorf("template de email desconhecido: %s", tpl)
+	}
+
+	var subject, text, html bytes.Buffer
+
+	if err := compiled.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, fmt.Errorf("erro ao renderizar assunto de %s: %w", tpl, err)
+	}
+	if err := compiled.text.ExecuteTemplate(&text, "body", data); err != nil {
+		return nil, fmt.Errorf("erro ao renderizar texto de %s: %w", tpl, err)
+	}
+	if err := compiled.html.ExecuteTemplate(&html, "layout", data); err != nil {
+		return nil, fmt.Errorf("erro ao renderizar HTML de %s: %w", tpl, err)
+	}
+
+	return &Message{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+		HTML:    html.String(),
+	}, nil
+}
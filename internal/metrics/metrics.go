@@ -0,0 +1,93 @@
+// Package metrics acumula em memória contadores da instância (erros HTTP,
+// entregas de notificação) e os envia periodicamente ao banco, onde são
+// somados com os das demais instâncias para o painel administrativo.
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Nomes dos contadores
+const (
+	// HTTPErrors conta respostas com status >= 400, por status
+	HTTPErrors = "http_errors"
+	// Panics conta panics capturados nas requisições, por rota
+	Panics = "panics"
+	// Deliveries conta entregas de notificação, por "<canal>:delivered" ou "<canal>:failed"
+	Deliveries = "deliveries"
+)
+
+// Resultados das entregas de notificação (chave "<canal>:<resultado>" em Deliveries)
+const (
+	OutcomeDelivered = "delivered"
+	OutcomeFailed    = "failed"
+)
+
+// Sink recebe os contadores acumulados de um dia (UTC, formato 2006-01-02)
+type Sink interface {
+	IncrementCounters(ctx context.Context, day, name string, counts map[string]int64) error
+}
+
+var (
+	mu       sync.Mutex
+	counters = make(map[string]map[string]int64)
+)
+
+// Add soma n ao contador name/key
+func Add(name, key string, n int64) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	counts, ok := counters[name]
+	if !ok {
+		counts = make(map[string]int64)
+		counters[name] = counts
+	}
+	counts[key] += n
+}
+
+// drain retorna os contadores acumulados e zera o acumulado
+func drain() map[string]map[string]int64 {
+	mu.Lock()
+	defer mu.Unlock()
+
+	drained := counters
+	counters = make(map[string]map[string]int64)
+	return drained
+}
+
+// Flush envia os contadores acumulados ao sink. Os que falharem voltam ao
+// acumulado para a próxima tentativa.
+func Flush(ctx context.Context, sink Sink) {
+	day := time.Now().UTC().Format(time.DateOnly)
+
+	for name, counts := range drain() {
+		if err := sink.IncrementCounters(ctx, day, name, counts); err != nil {
+			slog.Warn("erro ao enviar métricas", "name", name, "error", err)
+			for key, n := range counts {
+				Add(name, key, n)
+			}
+		}
+	}
+}
+
+// StartFlusher envia os contadores a cada interval até ctx ser cancelado. O
+// último envio, no encerramento, cabe a quem chamou (Flush antes de fechar o banco).
+func StartFlusher(ctx context.Context, sink Sink, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				Flush(ctx, sink)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
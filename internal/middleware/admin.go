@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"crypto/subtle"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// AdminTokenHeader é o header que carrega o token administrativo
+const AdminTokenHeader = "X-Admin-Token"
+
+// RequireAdminToken protege rotas administrativas com um token estático.
+// Se o token não estiver configurado, as rotas ficam indisponíveis.
+func RequireAdminToken(token string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if token == "" {
+			return fiber.NewError(fiber.StatusNotFound, "Rotas administrativas desabilitadas")
+		}
+
+		provided := c.Get(AdminTokenHeader)
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			return fiber.NewError(fiber.StatusUnauthorized, "Token administrativo inválido")
+		}
+
+		return c.Next()
+	}
+}
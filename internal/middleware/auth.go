@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/auth"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UserIDKey é a chave em c.Locals com o ID do usuário autenticado
+const UserIDKey = "user_id"
+
+// AccessChecker verifica, a cada requisição autenticada, se o usuário ainda
+// pode usar a API com o token emitido em issuedAt (ex.: conta suspensa)
+type AccessChecker interface {
+	CheckAccess(ctx context.Context, userID primitive.ObjectID, issuedAt time.Time) error
+}
+
+// RequireAuth valida o token Bearer e injeta o ID do usuário na requisição.
+// Com access, o erro de CheckAccess interrompe a requisição.
+func RequireAuth(tokens *auth.TokenManager, access AccessChecker) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		header := c.Get(fiber.HeaderAuthorization)
+		token, found := strings.CutPrefix(header, "Bearer ")
+		if !found || token == "" {
+			return fiber.NewError(fiber.StatusUnauthorized, "Token de acesso ausente")
+		}
+
+		session, err := tokens.ParseSession(token)
+		if err != nil {
+			return fiber.NewError(fiber.StatusUnauthorized, "Token de acesso inválido ou expirado")
+		}
+		userID := session.UserID
+
+		ctx := c.UserContext()
+		ctx = logging.WithContext(ctx, logging.FromContext(ctx).With("user_id", userID.Hex()))
+		c.SetUserContext(ctx)
+
+		if access != nil {
+			if err := access.CheckAccess(ctx, userID, session.IssuedAt); err != nil {
+				return err
+			}
+		}
+
+		c.Locals(UserIDKey, userID)
+
+		return c.Next()
+	}
+}
+
+// GetUserID retorna o ID do usuário autenticado
+func GetUserID(c *fiber.Ctx) (primitive.ObjectID, bool) {
+	userID, ok := c.Locals(UserIDKey).(primitive.ObjectID)
+	return userID, ok
+}
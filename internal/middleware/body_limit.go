@@ -0,0 +1,29 @@
+package middleware
+
+import "github.com/gofiber/fiber/v2"
+
+// BodyLimitKey é a chave em c.Locals com o limite de corpo do grupo de rotas
+const BodyLimitKey = "body_limit"
+
+// BodyLimit rejeita com 413 corpos maiores que limit bytes. O servidor aceita
+// até o maior limite configurado (config.MaxBodyLimit); cada grupo de rotas
+// aplica o seu, menor para JSON e maior para as rotas que recebem arquivos.
+func BodyLimit(limit int) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		c.Locals(BodyLimitKey, limit)
+
+		if len(c.Request().Body()) > limit || c.Request().Header.ContentLength() > limit {
+			return fiber.ErrRequestEntityTooLarge
+		}
+		return c.Next()
+	}
+}
+
+// GetBodyLimit retorna o limite de corpo aplicado à requisição: o do grupo de
+// rotas ou, sem um, o do servidor
+func GetBodyLimit(c *fiber.Ctx) int {
+	if limit, ok := c.Locals(BodyLimitKey).(int); ok {
+		return limit
+	}
+	return c.App().Config().BodyLimit
+}
@@ -0,0 +1,133 @@
+package middleware
+
+import (
+	"encoding/json"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/gofiber/fiber/v2"
+)
+
+// redactedValue substitui os valores de campos sensíveis
+const redactedValue = "[REDACTED]"
+
+// sensitiveKeyFragments identifica campos sensíveis pelo nome (case-insensitive)
+var sensitiveKeyFragments = []string{"password", "token", "secret", "authorization", "api_key", "apikey", "signature"}
+
+// sensitiveKeys são nomes curtos demais para comparar como fragmento (ex.: "sig"
+// das URLs de download assinadas, que também casaria com "assignee")
+var sensitiveKeys = map[string]bool{"sig": true}
+
+// BodyLoggerConfig define a amostragem e o tamanho máximo dos corpos registrados
+type BodyLoggerConfig struct {
+	// SampleRate é a fração das requisições registradas (0 a 1)
+	SampleRate float64
+	// MaxBytes limita o tamanho de cada corpo no log
+	MaxBytes int
+}
+
+// BodyLogger registra em nível debug o corpo de requisições e respostas
+// amostradas, com campos sensíveis (senhas, tokens, segredos) mascarados.
+// Destinado a diagnosticar integrações de clientes; não deve ficar ativo por padrão.
+func BodyLogger(cfg BodyLoggerConfig) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if cfg.SampleRate <= 0 || rand.Float64() >= cfg.SampleRate {
+			return c.Next()
+		}
+
+		requestBody := redactBody(c.Get(fiber.HeaderContentType), c.Body(), cfg.MaxBytes)
+
+		err := c.Next()
+
+		// Respostas em stream (ex.: eventos SSE) não são lidas: Body() consumiria o stream
+		var responseBody string
+		if !c.Response().IsBodyStream() {
+			responseBody = redactBody(string(c.Response().Header.ContentType()), c.Response().Body(), cfg.MaxBytes)
+		}
+
+		logging.FromContext(c.UserContext()).Debug("request body",
+			"method", c.Method(),
+			"path", c.Path(),
+			"query", redactQuery(c),
+			"request_body", requestBody,
+			"response_body", responseBody,
+		)
+
+		return err
+	}
+}
+
+// redactBody retorna o corpo com campos sensíveis mascarados.
+// Corpos que não são JSON são omitidos, indicando apenas o tamanho.
+func redactBody(contentType string, body []byte, maxBytes int) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	if !strings.Contains(contentType, "json") {
+		return "[corpo não-JSON omitido]"
+	}
+
+	var payload interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "[JSON inválido omitido]"
+	}
+
+	redacted, err := json.Marshal(redactValue(payload))
+	if err != nil {
+		return "[corpo omitido]"
+	}
+
+	if maxBytes > 0 && len(redacted) > maxBytes {
+		return string(redacted[:maxBytes]) + "...(truncado)"
+	}
+
+	return string(redacted)
+}
+
+// redactValue percorre o JSON mascarando os valores de chaves sensíveis
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			if isSensitiveKey(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactValue(nested)
+		}
+		return v
+	case []interface{}:
+		for i, nested := range v {
+			v[i] = redactValue(nested)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// redactQuery retorna a query string com parâmetros sensíveis mascarados
+func redactQuery(c *fiber.Ctx) map[string]string {
+	query := c.Queries()
+	for key := range query {
+		if isSensitiveKey(key) {
+			query[key] = redactedValue
+		}
+	}
+	return query
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	if sensitiveKeys[key] {
+		return true
+	}
+	for _, fragment := range sensitiveKeyFragments {
+		if strings.Contains(key, fragment) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// MaintenanceMode define o quanto da API fica disponível durante manutenção
+type MaintenanceMode string
+
+const (
+	// MaintenanceOff mantém a API funcionando normalmente
+	MaintenanceOff MaintenanceMode = "off"
+	// MaintenanceReadOnly aceita apenas leituras (GET, HEAD e OPTIONS)
+	MaintenanceReadOnly MaintenanceMode = "read_only"
+	// MaintenanceFull responde 503 para todas as requisições
+	MaintenanceFull MaintenanceMode = "full"
+)
+
+// ParseMaintenanceMode converte o valor configurado em MaintenanceMode
+func ParseMaintenanceMode(value string) (MaintenanceMode, error) {
+	switch mode := MaintenanceMode(value); mode {
+	case MaintenanceOff, MaintenanceReadOnly, MaintenanceFull:
+		return mode, nil
+	case "":
+		return MaintenanceOff, nil
+	default:
+		return "", fmt.Errorf("modo de manutenção inválido %q (use off, read_only ou full)", value)
+	}
+}
+
+// MaintenanceStatus é o estado atual do modo de manutenção
+type MaintenanceStatus struct {
+	Mode       MaintenanceMode `json:"mode"`
+	RetryAfter time.Duration   `json:"-"`
+	Message    string          `json:"message,omitempty"`
+	Since      *time.Time      `json:"since,omitempty"`
+}
+
+// MaintenanceState guarda o modo de manutenção, alterável em tempo de execução
+// pelas rotas administrativas. O estado é local à instância.
+type MaintenanceState struct {
+	mu     sync.RWMutex
+	status MaintenanceStatus
+}
+
+// NewMaintenanceState cria o estado inicial do modo de manutenção
+func NewMaintenanceState(mode MaintenanceMode, retryAfter time.Duration) *MaintenanceState {
+	s := &MaintenanceState{}
+	s.Set(mode, retryAfter, "")
+	return s
+}
+
+// Status retorna uma cópia do estado atual
+func (s *MaintenanceState) Status() MaintenanceStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.status
+}
+
+// Set altera o modo de manutenção
+func (s *MaintenanceState) Set(mode MaintenanceMode, retryAfter time.Duration, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status := MaintenanceStatus{Mode: mode, RetryAfter: retryAfter, Message: message}
+	if mode != MaintenanceOff {
+		since := s.status.Since
+		if since == nil || s.status.Mode == MaintenanceOff {
+			now := time.Now()
+			since = &now
+		}
+		status.Since = since
+	}
+	s.status = status
+}
+
+// Maintenance bloqueia requisições conforme o modo de manutenção, respondendo
+// 503 com Retry-After. Deve ser aplicado somente às rotas não administrativas.
+func Maintenance(state *MaintenanceState) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		status := state.Status()
+
+		switch status.Mode {
+		case MaintenanceReadOnly:
+			switch c.Method() {
+			case fiber.MethodGet, fiber.MethodHead, fiber.MethodOptions:
+				return c.Next()
+			}
+		case MaintenanceFull:
+		default:
+			return c.Next()
+		}
+
+		if seconds := int(status.RetryAfter.Seconds()); seconds > 0 {
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(seconds))
+		}
+
+		message := status.Message
+		if message == "" {
+			message = "API em manutenção, tente novamente mais tarde"
+			if status.Mode == MaintenanceReadOnly {
+				message = "API em manutenção: somente leitura"
+			}
+		}
+		return fiber.NewError(fiber.StatusServiceUnavailable, message)
+	}
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RequireMetricsToken protege o scrape de /metrics com um bearer token estático
+// (bearer_token do Prometheus). Se o token não estiver configurado, o endpoint
+// fica indisponível.
+func RequireMetricsToken(token string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if token == "" {
+			return fiber.NewError(fiber.StatusNotFound, "Métricas desabilitadas")
+		}
+
+		provided, _ := strings.CutPrefix(c.Get(fiber.HeaderAuthorization), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			return fiber.NewError(fiber.StatusUnauthorized, "Token de métricas inválido")
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/devgugga/todo-it/internal/plans"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PlanKey é a chave em c.Locals com o plano efetivo do usuário autenticado
+const PlanKey = "plan"
+
+// PlanResolver resolve o plano efetivo do usuário
+type PlanResolver interface {
+	Effective(ctx context.Context, userID primitive.ObjectID) (*plans.Plan, error)
+}
+
+// AttachPlan anexa o plano efetivo do usuário autenticado à requisição
+// (c.Locals e contexto), para que cotas e limites não o busquem de novo.
+// Deve vir depois de RequireAuth.
+func AttachPlan(resolver PlanResolver) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, ok := GetUserID(c)
+		if !ok {
+			return c.Next()
+		}
+
+		plan, err := resolver.Effective(c.UserContext(), userID)
+		if err != nil {
+			return err
+		}
+
+		c.Locals(PlanKey, plan)
+		c.SetUserContext(plans.WithContext(c.UserContext(), userID, plan))
+
+		return c.Next()
+	}
+}
+
+// GetPlan retorna o plano efetivo anexado por AttachPlan
+func GetPlan(c *fiber.Ctx) (*plans.Plan, bool) {
+	plan, ok := c.Locals(PlanKey).(*plans.Plan)
+	return plan, ok
+}
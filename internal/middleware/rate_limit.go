@@ -0,0 +1,199 @@
+package middleware
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Classes de rota do limite de requisições, cada uma com o seu bucket
+const (
+	// RateLimitAuth são as rotas de autenticação (login, cadastro, recuperação de senha)
+	RateLimitAuth = "auth"
+	// RateLimitRead são as leituras (GET, HEAD e OPTIONS) das rotas autenticadas
+	RateLimitRead = "read"
+	// RateLimitWrite são as demais requisições das rotas autenticadas
+	RateLimitWrite = "write"
+	// RateLimitBulk são as operações em lote, exportações e importações
+	RateLimitBulk = "bulk"
+)
+
+// Headers com o estado do bucket da requisição, para os clientes se regularem
+const (
+	HeaderRateLimitClass     = "X-RateLimit-Class"
+	HeaderRateLimitLimit     = "X-RateLimit-Limit"
+	HeaderRateLimitRemaining = "X-RateLimit-Remaining"
+	HeaderRateLimitReset     = "X-RateLimit-Reset"
+)
+
+// RateLimitHeaders são os headers expostos aos navegadores via CORS
+var RateLimitHeaders = []string{
+	HeaderRateLimitClass,
+	HeaderRateLimitLimit,
+	HeaderRateLimitRemaining,
+	HeaderRateLimitReset,
+	fiber.HeaderRetryAfter,
+}
+
+// rateLimitSweepInterval é o intervalo entre as remoções de buckets cheios
+const rateLimitSweepInterval = time.Minute
+
+// RateLimitClass configura o bucket de uma classe de rota: PerMinute
+// requisições por minuto sustentadas, com rajadas de até Burst requisições
+type RateLimitClass struct {
+	PerMinute int
+	Burst     int
+}
+
+// rate retorna quantas requisições a classe repõe por segundo
+func (c RateLimitClass) rate() float64 {
+	return float64(c.PerMinute) / 60
+}
+
+// rateBucket é o bucket de um cliente numa classe
+type rateBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// RateLimiter limita as requisições por cliente (usuário autenticado ou IP)
+// com token buckets por classe de rota. O estado é local à instância: com
+// várias instâncias atrás do balanceador, o limite efetivo é a soma delas.
+type RateLimiter struct {
+	mu        sync.Mutex
+	enabled   bool
+	classes   map[string]RateLimitClass
+	buckets   map[string]*rateBucket
+	lastSweep time.Time
+}
+
+// NewRateLimiter cria o limitador com as classes configuradas. Classes sem
+// configuração não são limitadas; com enabled falso, nenhuma é.
+func NewRateLimiter(enabled bool, classes map[string]RateLimitClass) *RateLimiter {
+	return &RateLimiter{
+		enabled:   enabled,
+		classes:   classes,
+		buckets:   make(map[string]*rateBucket),
+		lastSweep: time.Now(),
+	}
+}
+
+// Configure altera as classes em tempo de execução (recarga de configuração).
+// Os buckets existentes mantêm os tokens, limitados à nova rajada.
+func (l *RateLimiter) Configure(enabled bool, classes map[string]RateLimitClass) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.enabled = enabled
+	l.classes = classes
+}
+
+// Limit aplica o bucket da classe às rotas
+func (l *RateLimiter) Limit(class string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		return l.handle(c, class)
+	}
+}
+
+// ByMethod aplica o bucket de leitura aos métodos seguros e o de escrita aos demais
+func (l *RateLimiter) ByMethod() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		switch c.Method() {
+		case fiber.MethodGet, fiber.MethodHead, fiber.MethodOptions:
+			return l.handle(c, RateLimitRead)
+		default:
+			return l.handle(c, RateLimitWrite)
+		}
+	}
+}
+
+// handle consome um token do bucket da classe, respondendo 429 com
+// Retry-After quando ele está vazio
+func (l *RateLimiter) handle(c *fiber.Ctx, class string) error {
+	config, allowed, remaining, wait, ok := l.take(class, rateLimitKey(c))
+	if !ok {
+		return c.Next()
+	}
+
+	c.Set(HeaderRateLimitClass, class)
+	c.Set(HeaderRateLimitLimit, strconv.Itoa(config.Burst))
+	c.Set(HeaderRateLimitRemaining, strconv.Itoa(remaining))
+	c.Set(HeaderRateLimitReset, strconv.Itoa(secondsUntil(float64(config.Burst-remaining), config.rate())))
+
+	if !allowed {
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+		return fiber.NewError(fiber.StatusTooManyRequests, "Muitas requisições, tente novamente em instantes")
+	}
+	return c.Next()
+}
+
+// take repõe os tokens do bucket pelo tempo decorrido e consome um, se houver.
+// Retorna ok falso quando a classe não é limitada.
+func (l *RateLimiter) take(class, key string) (config RateLimitClass, allowed bool, remaining int, wait time.Duration, ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	config, ok = l.classes[class]
+	if !l.enabled || !ok || config.PerMinute <= 0 || config.Burst <= 0 {
+		return config, true, 0, 0, false
+	}
+
+	now := time.Now()
+	l.sweep(now)
+
+	id := class + ":" + key
+	bucket, exists := l.buckets[id]
+	if !exists {
+		bucket = &rateBucket{tokens: float64(config.Burst), updated: now}
+		l.buckets[id] = bucket
+	}
+
+	bucket.tokens = math.Min(float64(config.Burst), bucket.tokens+now.Sub(bucket.updated).Seconds()*config.rate())
+	bucket.updated = now
+
+	if bucket.tokens < 1 {
+		wait = time.Duration((1 - bucket.tokens) / config.rate() * float64(time.Second))
+		return config, false, 0, wait, true
+	}
+
+	bucket.tokens--
+	return config, true, int(bucket.tokens), 0, true
+}
+
+// sweep remove os buckets que já estariam cheios (equivalentes a um bucket
+// novo), para a memória não crescer com clientes que não voltaram
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	for id, bucket := range l.buckets {
+		class, _, _ := strings.Cut(id, ":")
+		config, ok := l.classes[class]
+		if !ok || bucket.tokens+now.Sub(bucket.updated).Seconds()*config.rate() >= float64(config.Burst) {
+			delete(l.buckets, id)
+		}
+	}
+}
+
+// rateLimitKey identifica o cliente: o usuário autenticado ou, antes da
+// autenticação, o IP
+func rateLimitKey(c *fiber.Ctx) string {
+	if userID, ok := GetUserID(c); ok {
+		return "user:" + userID.Hex()
+	}
+	return "ip:" + c.IP()
+}
+
+// secondsUntil retorna em quantos segundos missing tokens são repostos
+func secondsUntil(missing, rate float64) int {
+	if missing <= 0 {
+		return 0
+	}
+	return int(math.Ceil(missing / rate))
+}
@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/instance"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/metrics"
+	"github.com/gofiber/fiber/v2"
+)
+
+// PanicError é o erro retornado por Recover ao capturar um panic. O
+// ErrorHandler responde 500 com o ID da requisição para correlação.
+type PanicError struct {
+	Value     interface{}
+	RequestID string
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// PanicReport descreve um panic capturado em uma requisição
+type PanicReport struct {
+	RequestID  string    `json:"request_id"`
+	UserID     string    `json:"user_id,omitempty"`
+	Method     string    `json:"method"`
+	Route      string    `json:"route"`
+	Path       string    `json:"path"`
+	Panic      string    `json:"panic"`
+	Stack      string    `json:"stack"`
+	Instance   string    `json:"instance"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// PanicReporter envia os panics capturados a um serviço de erros
+type PanicReporter interface {
+	ReportPanic(ctx context.Context, report PanicReport)
+}
+
+// Recover captura panics dos handlers: registra o stack trace no log
+// estruturado (com os IDs da requisição e do usuário), conta o panic nas
+// métricas da instância e o envia ao reporter (nil = apenas log). Deve ser
+// aplicado depois do RequestLogger, que responde com o erro retornado.
+func Recover(reporter PanicReporter) fiber.Handler {
+	return func(c *fiber.Ctx) (err error) {
+		defer func() {
+			value := recover()
+			if value == nil {
+				return
+			}
+
+			report := newPanicReport(c, value, debug.Stack())
+			logging.FromContext(c.UserContext()).Error("panic na requisição",
+				"panic", report.Panic,
+				"route", report.Route,
+				"user_id", report.UserID,
+				"stack", report.Stack,
+			)
+			metrics.Add(metrics.Panics, report.Route, 1)
+
+			if reporter != nil {
+				reporter.ReportPanic(context.WithoutCancel(c.UserContext()), report)
+			}
+
+			err = &PanicError{Value: value, RequestID: report.RequestID}
+		}()
+
+		return c.Next()
+	}
+}
+
+// newPanicReport monta o relatório do panic com os dados da requisição
+func newPanicReport(c *fiber.Ctx, value interface{}, stack []byte) PanicReport {
+	report := PanicReport{
+		Method:     c.Method(),
+		Route:      c.Route().Path,
+		Path:       c.Path(),
+		Panic:      fmt.Sprint(value),
+		Stack:      string(stack),
+		Instance:   instance.ID(),
+		OccurredAt: time.Now(),
+	}
+	report.RequestID, _ = c.Locals(RequestIDKey).(string)
+	if userID, ok := GetUserID(c); ok {
+		report.UserID = userID.Hex()
+	}
+	return report
+}
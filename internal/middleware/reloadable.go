@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"sync/atomic"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Reloadable permite trocar um middleware já registrado no app (ex.: CORS
+// após recarregar a configuração) sem reiniciar o servidor
+type Reloadable struct {
+	current atomic.Pointer[fiber.Handler]
+}
+
+// NewReloadable cria um middleware substituível com o handler inicial
+func NewReloadable(handler fiber.Handler) *Reloadable {
+	r := &Reloadable{}
+	r.Swap(handler)
+	return r
+}
+
+// Swap substitui o handler usado pelas próximas requisições
+func (r *Reloadable) Swap(handler fiber.Handler) {
+	r.current.Store(&handler)
+}
+
+// Handler retorna o middleware a ser registrado no app
+func (r *Reloadable) Handler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		return (*r.current.Load())(c)
+	}
+}
+
+// Passthrough é um middleware que apenas segue para o próximo handler
+func Passthrough(c *fiber.Ctx) error {
+	return c.Next()
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/metrics"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+)
+
+// RequestIDKey é a chave em c.Locals com o ID da requisição
+const RequestIDKey = "request_id"
+
+// RequestIDHeader é o header usado para receber/propagar o ID da requisição
+const RequestIDHeader = "X-Request-ID"
+
+// RequestLogger atribui um ID à requisição, injeta um logger com esse ID no
+// contexto e registra uma entrada estruturada de acesso ao final
+func RequestLogger() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+
+		requestID := c.Get(RequestIDHeader)
+		if requestID == "" {
+			requestID = utils.UUIDv4()
+		}
+		c.Set(RequestIDHeader, requestID)
+		c.Locals(RequestIDKey, requestID)
+
+		logger := logging.FromContext(c.UserContext()).With("request_id", requestID)
+		c.SetUserContext(logging.WithContext(c.UserContext(), logger))
+
+		// O erro é tratado aqui para que o status final esteja disponível no log
+		if err := c.Next(); err != nil {
+			if handlerErr := c.App().ErrorHandler(c, err); handlerErr != nil {
+				_ = c.SendStatus(fiber.StatusInternalServerError)
+			}
+		}
+
+		status := c.Response().StatusCode()
+		attrs := []any{
+			"method", c.Method(),
+			"route", c.Route().Path,
+			"path", c.Path(),
+			"status", status,
+			"latency_ms", time.Since(start).Milliseconds(),
+			"ip", c.IP(),
+		}
+		if userID, ok := GetUserID(c); ok {
+			attrs = append(attrs, "user_id", userID.Hex())
+		}
+
+		if status >= fiber.StatusBadRequest {
+			metrics.Add(metrics.HTTPErrors, strconv.Itoa(status), 1)
+		}
+
+		level := slog.LevelInfo
+		switch {
+		case status >= fiber.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= fiber.StatusBadRequest:
+			level = slog.LevelWarn
+		}
+
+		logger.Log(c.UserContext(), level, "request", attrs...)
+		return nil
+	}
+}
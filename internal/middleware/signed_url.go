@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	"github.com/devgugga/todo-it/internal/auth"
+	"github.com/gofiber/fiber/v2"
+)
+
+// RequireSignedURL autoriza a requisição pela assinatura da URL (?exp=&sig=)
+// sobre o parâmetro :id, no lugar do token de acesso
+func RequireSignedURL(signer *auth.URLSigner) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if err := signer.Verify(c.Params("id"), c.Query("exp"), c.Query("sig")); err != nil {
+			return fiber.NewError(fiber.StatusForbidden, "Link inválido ou expirado")
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// TimeoutKey é a chave em c.Locals com o tempo limite aplicado à requisição
+const TimeoutKey = "request_timeout"
+
+// timeoutContextKey guarda o contexto do Timeout mais interno, o único que
+// decide se a requisição excedeu o tempo limite
+const timeoutContextKey = "request_timeout_context"
+
+// Timeout aplica um deadline ao contexto da requisição (c.UserContext()),
+// que é repassado pelos handlers até os repositórios: ao expirar, as operações
+// em andamento no MongoDB são canceladas e a resposta é 504. Um Timeout mais
+// interno (grupo ou rota, ex.: exportações) substitui o deadline do externo em
+// vez de ficar limitado por ele.
+func Timeout(timeout time.Duration) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		parent := c.UserContext()
+		if _, nested := c.Locals(timeoutContextKey).(context.Context); nested {
+			parent = context.WithoutCancel(parent)
+		}
+
+		ctx, cancel := context.WithTimeout(parent, timeout)
+		defer cancel()
+
+		c.SetUserContext(ctx)
+		c.Locals(TimeoutKey, timeout)
+		c.Locals(timeoutContextKey, ctx)
+
+		err := c.Next()
+
+		innermost := c.Locals(timeoutContextKey) == ctx
+		if err != nil && innermost && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fiber.ErrGatewayTimeout
+		}
+		return err
+	}
+}
+
+// GetTimeout retorna o tempo limite aplicado à requisição (zero sem Timeout)
+func GetTimeout(c *fiber.Ctx) time.Duration {
+	timeout, _ := c.Locals(TimeoutKey).(time.Duration)
+	return timeout
+}
@@ -0,0 +1,17 @@
+package notifications
+
+import (
+	"context"
+
+	"github.com/devgugga/todo-it/internal/entities"
+)
+
+// Channel define um canal de entrega de notificações
+type Channel interface {
+	// Name retorna o identificador do canal (ver entities.Channel*)
+	Name() string
+	// Immediate indica canais entregues no momento do evento, sem lote nem horário de silêncio
+	Immediate() bool
+	// Send entrega um lote de notificações ao usuário
+	Send(ctx context.Context, user *entities.User, batch []*entities.Notification) error
+}
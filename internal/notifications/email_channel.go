@@ -0,0 +1,46 @@
+package notifications
+
+import (
+	"context"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/mailer"
+)
+
+// EmailChannel envia as notificações por email, agrupando o lote em um resumo
+type EmailChannel struct {
+	mailer mailer.Mailer
+}
+
+// NewEmailChannel cria o canal de email
+func NewEmailChannel(m mailer.Mailer) *EmailChannel {
+	return &EmailChannel{mailer: m}
+}
+
+func (c *EmailChannel) Name() string {
+	return entities.ChannelEmail
+}
+
+func (c *EmailChannel) Immediate() bool {
+	return false
+}
+
+func (c *EmailChannel) Send(ctx context.Context, user *entities.User, batch []*entities.Notification) error {
+	if len(batch) == 1 {
+		return c.mailer.Send(ctx, &mailer.Message{
+			To:      user.Email,
+			Subject: batch[0].Title,
+			Text:    batch[0].Body,
+		})
+	}
+
+	items := make([]mailer.DigestItem, 0, len(batch))
+	for _, notification := range batch {
+		items = append(items, mailer.DigestItem{Title: notification.Title, Body: notification.Body})
+	}
+
+	return c.mailer.SendTemplate(ctx, user.Email, mailer.TemplateDigest, mailer.DigestData{
+		Name:  user.Name,
+		Items: items,
+	})
+}
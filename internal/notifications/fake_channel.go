@@ -0,0 +1,71 @@
+package notifications
+
+import (
+	"context"
+	"sync"
+
+	"github.com/devgugga/todo-it/internal/entities"
+)
+
+// Delivery é um lote entregue por FakeChannel
+type Delivery struct {
+	User  *entities.User
+	Batch []*entities.Notification
+}
+
+// FakeChannel guarda em memória os lotes entregues, para conferência em testes
+type FakeChannel struct {
+	name      string
+	immediate bool
+
+	mu         sync.Mutex
+	deliveries []Delivery
+	// Err, quando definido, é retornado por Send (ex.: simular falha de entrega)
+	Err error
+}
+
+// NewFakeChannel cria um canal em memória com o nome informado (ver entities.Channel*)
+func NewFakeChannel(name string, immediate bool) *FakeChannel {
+	return &FakeChannel{name: name, immediate: immediate}
+}
+
+func (c *FakeChannel) Name() string {
+	return c.name
+}
+
+func (c *FakeChannel) Immediate() bool {
+	return c.immediate
+}
+
+func (c *FakeChannel) Send(ctx context.Context, user *entities.User, batch []*entities.Notification) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.Err != nil {
+		return c.Err
+	}
+	c.deliveries = append(c.deliveries, Delivery{User: user, Batch: append([]*entities.Notification(nil), batch...)})
+	return nil
+}
+
+// Deliveries retorna os lotes entregues até o momento, em ordem
+func (c *FakeChannel) Deliveries() []Delivery {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Delivery(nil), c.deliveries...)
+}
+
+// Notifications retorna todas as notificações entregues, de todos os lotes
+func (c *FakeChannel) Notifications() []*entities.Notification {
+	var notifications []*entities.Notification
+	for _, delivery := range c.Deliveries() {
+		notifications = append(notifications, delivery.Batch...)
+	}
+	return notifications
+}
+
+// Reset descarta as entregas guardadas
+func (c *FakeChannel) Reset() {
+	c.mu.Lock()
+	c.deliveries = nil
+	c.mu.Unlock()
+}
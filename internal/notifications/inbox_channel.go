@@ -0,0 +1,36 @@
+package notifications
+
+import (
+	"context"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// InboxChannel exibe as notificações na caixa de entrada do app
+type InboxChannel struct {
+	notifications repositories.NotificationRepository
+}
+
+// NewInboxChannel cria o canal de caixa de entrada
+func NewInboxChannel(notifications repositories.NotificationRepository) *InboxChannel {
+	return &InboxChannel{notifications: notifications}
+}
+
+func (c *InboxChannel) Name() string {
+	return entities.ChannelInbox
+}
+
+func (c *InboxChannel) Immediate() bool {
+	return true
+}
+
+func (c *InboxChannel) Send(ctx context.Context, user *entities.User, batch []*entities.Notification) error {
+	ids := make([]primitive.ObjectID, 0, len(batch))
+	for _, notification := range batch {
+		ids = append(ids, notification.ID)
+	}
+
+	return c.notifications.ShowInInbox(ctx, ids)
+}
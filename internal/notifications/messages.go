@@ -0,0 +1,65 @@
+package notifications
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/devgugga/todo-it/internal/events"
+)
+
+// buildMessage monta título e corpo da notificação de um evento.
+// Retorna ok=false para eventos que não geram notificação.
+func buildMessage(event events.Event) (title, body string, ok bool) {
+	taskTitle, _ := event.Data["title"].(string)
+
+	switch event.Type {
+	case events.UserRegistered:
+		return "Bem-vindo ao Todo It!", "Sua conta foi criada. Comece adicionando sua primeira tarefa.", true
+	case events.TaskDueSoon:
+		return "Tarefa vencendo em breve", fmt.Sprintf("A tarefa \"%s\" vence em breve.", taskTitle), true
+	case events.TaskOverdue:
+		return "Tarefa atrasada", fmt.Sprintf("A tarefa \"%s\" está atrasada.", taskTitle), true
+	case events.TaskOverdueSummary:
+		return "Resumo de tarefas atrasadas", overdueSummaryBody(event.Data), true
+	default:
+		return "", "", false
+	}
+}
+
+// overdueSummaryBody lista as tarefas do resumo diário de atrasadas. Os tipos
+// numéricos e de lista variam quando o evento passa por JSON (barramento Redis).
+func overdueSummaryBody(data map[string]interface{}) string {
+	var count int
+	switch v := data["count"].(type) {
+	case int:
+		count = v
+	case float64:
+		count = int(v)
+	}
+
+	var titles []string
+	switch v := data["titles"].(type) {
+	case []string:
+		titles = v
+	case []interface{}:
+		for _, title := range v {
+			if s, ok := title.(string); ok {
+				titles = append(titles, s)
+			}
+		}
+	}
+
+	if count == 1 && len(titles) == 1 {
+		return fmt.Sprintf("A tarefa \"%s\" está atrasada.", titles[0])
+	}
+
+	body := fmt.Sprintf("Você tem %d tarefas atrasadas", count)
+	if len(titles) == 0 {
+		return body + "."
+	}
+	body += ": \"" + strings.Join(titles, "\", \"") + "\""
+	if count > len(titles) {
+		body += fmt.Sprintf(" e mais %d", count-len(titles))
+	}
+	return body + "."
+}
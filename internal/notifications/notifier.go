@@ -0,0 +1,186 @@
+package notifications
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/metrics"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// dispatchUsersPerRun limita quantos usuários são processados por execução do dispatch
+const dispatchUsersPerRun = 200
+
+// Notifier transforma eventos de domínio em notificações e as roteia para os
+// canais habilitados nas preferências do usuário. Canais imediatos (inbox) são
+// entregues na hora; os demais são entregues em lote pelo Dispatch, respeitando
+// o horário de silêncio.
+type Notifier struct {
+	notifications repositories.NotificationRepository
+	users         repositories.UserRepository
+	channels      map[string]Channel
+}
+
+// NewNotifier cria o serviço de notificações com os canais disponíveis
+func NewNotifier(notifications repositories.NotificationRepository, users repositories.UserRepository, channels ...Channel) *Notifier {
+	registered := make(map[string]Channel, len(channels))
+	for _, channel := range channels {
+		registered[channel.Name()] = channel
+	}
+
+	return &Notifier{
+		notifications: notifications,
+		users:         users,
+		channels:      registered,
+	}
+}
+
+// Subscribe passa a receber os eventos publicados no barramento
+func (n *Notifier) Subscribe(bus events.Bus) {
+	bus.Subscribe(events.AllEvents, n.handleEvent)
+}
+
+// handleEvent cria a notificação do evento e entrega nos canais imediatos
+func (n *Notifier) handleEvent(ctx context.Context, event events.Event) {
+	title, body, ok := buildMessage(event)
+	if !ok || event.UserID.IsZero() {
+		return
+	}
+
+	user, err := n.users.GetByID(ctx, event.UserID)
+	if err != nil || !user.IsActive {
+		return
+	}
+
+	prefs := user.Preferences.Notifications
+	if prefs.IsMuted(event.Type) {
+		return
+	}
+
+	var immediate []Channel
+	pending := []string{}
+	for _, name := range prefs.EnabledChannels() {
+		channel, ok := n.channels[name]
+		if !ok {
+			continue
+		}
+		if channel.Immediate() {
+			immediate = append(immediate, channel)
+		} else {
+			pending = append(pending, name)
+		}
+	}
+
+	notification := &entities.Notification{
+		UserID:          user.ID,
+		EventID:         event.ID,
+		Type:            event.Type,
+		Title:           title,
+		Body:            body,
+		Data:            event.Data,
+		PendingChannels: pending,
+	}
+
+	if err := n.notifications.Create(ctx, notification); err != nil {
+		slog.Error("erro ao registrar notificação", "event", event.Type, "user_id", event.UserID.Hex(), "error", err)
+		return
+	}
+
+	for _, channel := range immediate {
+		if err := channel.Send(ctx, user, []*entities.Notification{notification}); err != nil {
+			slog.Error("erro ao entregar notificação", "channel", channel.Name(), "user_id", user.ID.Hex(), "error", err)
+		}
+	}
+}
+
+// Dispatch entrega em lote as notificações pendentes nos canais externos.
+// Usuários em horário de silêncio ficam para a próxima execução.
+func (n *Notifier) Dispatch(ctx context.Context) error {
+	userIDs, err := n.notifications.UsersWithPending(ctx, dispatchUsersPerRun)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, userID := range userIDs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		n.dispatchUser(ctx, userID, now)
+	}
+
+	return nil
+}
+
+// dispatchUser agrupa por canal as notificações pendentes de um usuário e as envia
+func (n *Notifier) dispatchUser(ctx context.Context, userID primitive.ObjectID, now time.Time) {
+	user, err := n.users.GetByID(ctx, userID)
+	if err != nil {
+		slog.Warn("notificações pendentes para usuário inexistente", "user_id", userID.Hex(), "error", err)
+		return
+	}
+
+	if user.Preferences.InQuietHours(now) {
+		return
+	}
+
+	pending, err := n.notifications.GetPendingByUser(ctx, userID)
+	if err != nil {
+		slog.Error("erro ao buscar notificações pendentes", "user_id", userID.Hex(), "error", err)
+		return
+	}
+
+	enabled := make(map[string]bool)
+	for _, name := range user.Preferences.Notifications.EnabledChannels() {
+		enabled[name] = true
+	}
+
+	batches := make(map[string][]*entities.Notification)
+	for _, notification := range pending {
+		for _, name := range notification.PendingChannels {
+			batches[name] = append(batches[name], notification)
+		}
+	}
+
+	for name, batch := range batches {
+		ids := make([]primitive.ObjectID, 0, len(batch))
+		for _, notification := range batch {
+			ids = append(ids, notification.ID)
+		}
+
+		channel, ok := n.channels[name]
+		if !ok || !user.IsActive || !enabled[name] {
+			// Canal removido ou desabilitado após a criação: descarta a entrega
+			if err := n.notifications.MarkDelivered(ctx, ids, name); err != nil {
+				slog.Error("erro ao descartar entrega", "channel", name, "user_id", userID.Hex(), "error", err)
+			}
+			continue
+		}
+
+		err := channel.Send(ctx, user, batch)
+		metrics.Add(metrics.Deliveries, name+":"+deliveryOutcome(err), int64(len(batch)))
+		if err != nil {
+			slog.Warn("falha ao entregar notificações", "channel", name, "user_id", userID.Hex(), "count", len(batch), "error", err)
+			if err := n.notifications.MarkFailed(ctx, ids, name, err); err != nil {
+				slog.Error("erro ao registrar falha de entrega", "channel", name, "user_id", userID.Hex(), "error", err)
+			}
+			continue
+		}
+
+		if err := n.notifications.MarkDelivered(ctx, ids, name); err != nil {
+			slog.Error("erro ao registrar entrega", "channel", name, "user_id", userID.Hex(), "error", err)
+		}
+	}
+}
+
+// deliveryOutcome retorna o resultado da entrega para o contador metrics.Deliveries
+func deliveryOutcome(err error) string {
+	if err != nil {
+		return metrics.OutcomeFailed
+	}
+	return metrics.OutcomeDelivered
+}
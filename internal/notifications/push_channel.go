@@ -0,0 +1,71 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+)
+
+// PushChannel envia push notifications via gateway HTTP compatível com ntfy
+// (POST {baseURL}/{tópico} com o título no header Title)
+type PushChannel struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewPushChannel cria o canal de push
+func NewPushChannel(baseURL string) *PushChannel {
+	return &PushChannel{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (c *PushChannel) Name() string {
+	return entities.ChannelPush
+}
+
+func (c *PushChannel) Immediate() bool {
+	return false
+}
+
+func (c *PushChannel) Send(ctx context.Context, user *entities.User, batch []*entities.Notification) error {
+	topic := user.Preferences.Notifications.PushTopic
+	if topic == "" {
+		// Sem tópico configurado não há destino; o canal é considerado entregue
+		return nil
+	}
+
+	title, body := batch[0].Title, batch[0].Body
+	if len(batch) > 1 {
+		title = fmt.Sprintf("%d novas notificações", len(batch))
+		titles := make([]string, 0, len(batch))
+		for _, notification := range batch {
+			titles = append(titles, notification.Title)
+		}
+		body = strings.Join(titles, "\n")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/"+url.PathEscape(topic), strings.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("erro ao montar push: %w", err)
+	}
+	req.Header.Set("Title", title)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("erro ao enviar push: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("gateway de push respondeu %d", resp.StatusCode)
+	}
+
+	return nil
+}
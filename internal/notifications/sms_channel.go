@@ -0,0 +1,92 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/integrations/twilio"
+	"github.com/devgugga/todo-it/internal/quota"
+)
+
+// smsMaxLength mantém a mensagem em até dois segmentos GSM
+const smsMaxLength = 300
+
+// SMSChannel envia por SMS (Twilio) os lembretes de tarefas vencendo em breve.
+// Exige telefone verificado e consome a cota mensal de SMS do usuário; as
+// demais notificações são ignoradas por este canal.
+type SMSChannel struct {
+	client twilio.Client
+	quota  quota.Quota
+}
+
+// NewSMSChannel cria o canal de SMS
+func NewSMSChannel(client twilio.Client, quota quota.Quota) *SMSChannel {
+	return &SMSChannel{client: client, quota: quota}
+}
+
+func (c *SMSChannel) Name() string {
+	return entities.ChannelSMS
+}
+
+func (c *SMSChannel) Immediate() bool {
+	return false
+}
+
+func (c *SMSChannel) Send(ctx context.Context, user *entities.User, batch []*entities.Notification) error {
+	if !user.Phone.IsVerified() {
+		return nil
+	}
+
+	var titles []string
+	for _, notification := range batch {
+		if notification.Type != events.TaskDueSoon {
+			continue
+		}
+		title, _ := notification.Data["title"].(string)
+		titles = append(titles, title)
+	}
+	if len(titles) == 0 {
+		return nil
+	}
+
+	if err := c.quota.Consume(ctx, user.ID, quota.ResourceSMS); err != nil {
+		if errors.Is(err, quota.ErrExceeded) {
+			// Sem cota, o lembrete é descartado em vez de ficar pendente até o próximo mês
+			slog.Warn("cota de SMS excedida, lembrete descartado", "user_id", user.ID.Hex(), "count", len(titles))
+			return nil
+		}
+		return err
+	}
+
+	if err := c.client.SendSMS(ctx, user.Phone.Number, smsBody(titles)); err != nil {
+		if releaseErr := c.quota.Release(ctx, user.ID, quota.ResourceSMS); releaseErr != nil {
+			slog.Error("erro ao devolver cota de SMS", "user_id", user.ID.Hex(), "error", releaseErr)
+		}
+		if errors.Is(err, twilio.ErrInvalidNumber) {
+			// Repetir não adianta: o usuário precisa cadastrar outro número
+			slog.Warn("número recusado pela Twilio, lembrete descartado", "user_id", user.ID.Hex(), "error", err)
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// smsBody monta o texto do lembrete, agrupando as tarefas do lote
+func smsBody(titles []string) string {
+	body := fmt.Sprintf("Todo It: a tarefa \"%s\" vence em breve.", titles[0])
+	if len(titles) > 1 {
+		body = fmt.Sprintf("Todo It: %d tarefas vencem em breve: %s", len(titles), strings.Join(titles, ", "))
+	}
+
+	if runes := []rune(body); len(runes) > smsMaxLength {
+		body = string(runes[:smsMaxLength-3]) + "..."
+	}
+	return body
+}
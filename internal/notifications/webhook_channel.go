@@ -0,0 +1,147 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Headers enviados em cada entrega. O destino deve descartar eventos cujo
+// X-Webhook-Event-ID já processou: reentregas repetem o ID e o corpo.
+const (
+	WebhookEventHeader      = "X-Webhook-Event-ID"
+	WebhookDeliveryHeader   = "X-Webhook-Delivery-ID"
+	WebhookRedeliveryHeader = "X-Webhook-Redelivery"
+)
+
+// WebhookChannel envia as notificações em JSON para a URL configurada pelo
+// usuário e registra cada tentativa de entrega
+type WebhookChannel struct {
+	client     *http.Client
+	deliveries repositories.WebhookDeliveryRepository
+}
+
+// NewWebhookChannel cria o canal de webhook
+func NewWebhookChannel(deliveries repositories.WebhookDeliveryRepository) *WebhookChannel {
+	return &WebhookChannel{
+		client:     &http.Client{Timeout: 10 * time.Second},
+		deliveries: deliveries,
+	}
+}
+
+func (c *WebhookChannel) Name() string {
+	return entities.ChannelWebhook
+}
+
+func (c *WebhookChannel) Immediate() bool {
+	return false
+}
+
+// webhookNotification é o formato de cada notificação enviada no webhook
+type webhookNotification struct {
+	ID        string                 `json:"id"`
+	Type      string                 `json:"type"`
+	Title     string                 `json:"title"`
+	Body      string                 `json:"body"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+func (c *WebhookChannel) Send(ctx context.Context, user *entities.User, batch []*entities.Notification) error {
+	target := user.Preferences.Notifications.WebhookURL
+	if target == "" {
+		return nil
+	}
+
+	items := make([]webhookNotification, 0, len(batch))
+	for _, notification := range batch {
+		items = append(items, webhookNotification{
+			ID:        notification.ID.Hex(),
+			Type:      notification.Type,
+			Title:     notification.Title,
+			Body:      notification.Body,
+			Data:      notification.Data,
+			CreatedAt: notification.CreatedAt,
+		})
+	}
+
+	eventID := primitive.NewObjectID().Hex()
+	payload, err := json.Marshal(map[string]interface{}{
+		"event_id":      eventID,
+		"user_id":       user.ID.Hex(),
+		"notifications": items,
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao serializar webhook: %w", err)
+	}
+
+	delivery := c.Deliver(ctx, user.ID, target, eventID, payload, nil)
+	if !delivery.Success {
+		return fmt.Errorf("erro ao enviar webhook: %s", delivery.Error)
+	}
+
+	return nil
+}
+
+// Deliver envia o corpo ao destino e registra a tentativa. redeliveryOf indica
+// a entrega original de uma reentrega manual, que repete eventID e payload.
+func (c *WebhookChannel) Deliver(ctx context.Context, userID primitive.ObjectID, target, eventID string, payload []byte, redeliveryOf *primitive.ObjectID) *entities.WebhookDelivery {
+	delivery := &entities.WebhookDelivery{
+		UserID:       userID,
+		EventID:      eventID,
+		RedeliveryOf: redeliveryOf,
+		URL:          target,
+		Payload:      string(payload),
+	}
+	delivery.PrepareForCreate()
+
+	started := time.Now()
+	c.post(ctx, delivery, payload)
+	delivery.LatencyMs = time.Since(started).Milliseconds()
+
+	if err := c.deliveries.Create(ctx, delivery); err != nil {
+		slog.Warn("erro ao registrar entrega de webhook", "user_id", userID.Hex(), "event_id", eventID, "error", err)
+	}
+
+	return delivery
+}
+
+// post faz a requisição e preenche o resultado da entrega
+func (c *WebhookChannel) post(ctx context.Context, delivery *entities.WebhookDelivery, payload []byte) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(payload))
+	if err != nil {
+		delivery.Error = fmt.Sprintf("erro ao montar webhook: %v", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, delivery.EventID)
+	req.Header.Set(WebhookDeliveryHeader, delivery.ID.Hex())
+	if delivery.RedeliveryOf != nil {
+		req.Header.Set(WebhookRedeliveryHeader, "true")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		delivery.Error = err.Error()
+		return
+	}
+	defer resp.Body.Close()
+
+	snippet, _ := io.ReadAll(io.LimitReader(resp.Body, entities.WebhookResponseSnippetBytes))
+	delivery.StatusCode = resp.StatusCode
+	delivery.ResponseSnippet = strings.ToValidUTF8(string(snippet), "")
+	delivery.Success = resp.StatusCode < 300
+	if !delivery.Success {
+		delivery.Error = fmt.Sprintf("webhook respondeu %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,136 @@
+// Package plans define os planos de uso (free e pro) e os limites de cada um.
+// O plano efetivo do usuário vem da sua assinatura; a cobrança fica a cargo
+// de um Provider, que ainda não tem implementação.
+package plans
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/quota"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ID identifica um plano
+type ID string
+
+// Planos disponíveis
+const (
+	Free ID = "free"
+	Pro  ID = "pro"
+)
+
+// Plan é um plano com os seus limites mensais de uso
+type Plan struct {
+	ID     ID           `json:"id"`
+	Name   string       `json:"name"`
+	Limits quota.Limits `json:"limits"`
+}
+
+// Catalog reúne os planos disponíveis; o gratuito é o padrão
+type Catalog struct {
+	plans []*Plan
+}
+
+// NewCatalog cria o catálogo com os limites de cada plano
+func NewCatalog(free, pro quota.Limits) *Catalog {
+	return &Catalog{plans: []*Plan{
+		{ID: Free, Name: "Free", Limits: free},
+		{ID: Pro, Name: "Pro", Limits: pro},
+	}}
+}
+
+// Get retorna o plano pelo ID
+func (c *Catalog) Get(id ID) (*Plan, bool) {
+	for _, plan := range c.plans {
+		if plan.ID == id {
+			return plan, true
+		}
+	}
+	return nil, false
+}
+
+// Default retorna o plano de quem não tem assinatura válida
+func (c *Catalog) Default() *Plan {
+	plan, _ := c.Get(Free)
+	return plan
+}
+
+// List retorna os planos na ordem do catálogo
+func (c *Catalog) List() []*Plan {
+	return c.plans
+}
+
+// Prorate calcula os limites do período de cotas em que o plano mudou de
+// previous para current, com elapsed (0 a 1) sendo a fração do período já
+// decorrida na mudança: cada limite é proporcional ao tempo em cada plano, sem
+// ficar abaixo do limite de current. Recursos sem limite em algum dos planos
+// seguem current.
+func Prorate(previous, current quota.Limits, elapsed float64) quota.Limits {
+	elapsed = math.Max(0, math.Min(1, elapsed))
+
+	limits := make(quota.Limits, len(current))
+	for resource, limit := range current {
+		limits[resource] = limit
+
+		before, ok := previous[resource]
+		if !ok {
+			continue
+		}
+
+		prorated := int64(math.Round(float64(before)*elapsed + float64(limit)*(1-elapsed)))
+		if prorated > limit {
+			limits[resource] = prorated
+		}
+	}
+
+	return limits
+}
+
+// SubscriptionChange é uma mudança de assinatura informada pelo provedor de pagamentos
+type SubscriptionChange struct {
+	UserID      primitive.ObjectID
+	ProviderRef string
+	Plan        ID
+	// Status segue entities.Subscription* (active, trialing, past_due ou canceled)
+	Status           string
+	CurrentPeriodEnd *time.Time
+	// OccurredAt é quando o provedor registrou a mudança; mudanças mais antigas
+	// que a última aplicada são ignoradas (notificações fora de ordem)
+	OccurredAt time.Time
+}
+
+// Provider integra um provedor de pagamentos. Sem implementação, os planos
+// pagos são atribuídos pelo administrador.
+type Provider interface {
+	// Name identifica o provedor nas assinaturas (entities.Subscription.Provider)
+	Name() string
+	// Checkout inicia a contratação do plano e retorna a URL de pagamento
+	Checkout(ctx context.Context, userID primitive.ObjectID, plan ID) (string, error)
+	// ParseWebhook valida a assinatura da notificação e a converte em uma mudança de assinatura
+	ParseWebhook(payload []byte, signature string) (*SubscriptionChange, error)
+}
+
+// contextKey é a chave do plano efetivo no contexto da requisição
+type contextKey struct{}
+
+// contextPlan é o plano efetivo de um usuário anexado ao contexto
+type contextPlan struct {
+	userID primitive.ObjectID
+	plan   *Plan
+}
+
+// WithContext anexa ao contexto o plano efetivo do usuário
+func WithContext(ctx context.Context, userID primitive.ObjectID, plan *Plan) context.Context {
+	return context.WithValue(ctx, contextKey{}, contextPlan{userID: userID, plan: plan})
+}
+
+// FromContext retorna o plano do usuário anexado ao contexto, se houver
+func FromContext(ctx context.Context, userID primitive.ObjectID) (*Plan, bool) {
+	value, ok := ctx.Value(contextKey{}).(contextPlan)
+	if !ok || value.userID != userID {
+		return nil, false
+	}
+	return value.plan, true
+}
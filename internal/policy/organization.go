@@ -0,0 +1,17 @@
+package policy
+
+import (
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CanViewOrganization verifica se o usuário pode visualizar a organização (dono ou membro)
+func CanViewOrganization(userID primitive.ObjectID, organization *entities.Organization) bool {
+	return organization != nil && !userID.IsZero() && organization.HasMember(userID)
+}
+
+// CanManageOrganization verifica se o usuário pode adicionar e remover membros
+// e consultar o uso da organização
+func CanManageOrganization(userID primitive.ObjectID, organization *entities.Organization) bool {
+	return organization != nil && !userID.IsZero() && organization.OwnerID == userID
+}
@@ -0,0 +1,5 @@
+// Package policy concentra as regras de autorização da aplicação.
+// Os serviços devem consultar estas funções em vez de comparar IDs
+// diretamente, para que uma mudança de regra (ex.: compartilhamento)
+// seja feita em um único lugar.
+package policy
@@ -0,0 +1,54 @@
+package policy
+
+import (
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CanViewProject verifica se o usuário pode visualizar o projeto (dono ou qualquer membro)
+func CanViewProject(userID primitive.ObjectID, project *entities.Project) bool {
+	return projectRole(userID, project) != ""
+}
+
+// CanModifyProject verifica se o usuário pode alterar, remover ou adicionar tarefas ao projeto
+func CanModifyProject(userID primitive.ObjectID, project *entities.Project) bool {
+	return projectRole(userID, project) == enums.RoleOwner
+}
+
+// CanManageProjectMembers verifica se o usuário pode adicionar membros e alterar
+// ou remover os seus papéis
+func CanManageProjectMembers(userID primitive.ObjectID, project *entities.Project) bool {
+	return projectRole(userID, project) == enums.RoleOwner
+}
+
+// CanViewProjectTask verifica se o usuário pode visualizar a tarefa pelo projeto
+// compartilhado em que ela está
+func CanViewProjectTask(userID primitive.ObjectID, project *entities.Project, task *entities.Task) bool {
+	return inProject(project, task) && CanViewProject(userID, project)
+}
+
+// CanModifyProjectTask verifica se o usuário pode alterar a tarefa pelo projeto
+// compartilhado em que ela está (dono ou editor)
+func CanModifyProjectTask(userID primitive.ObjectID, project *entities.Project, task *entities.Task) bool {
+	if !inProject(project, task) {
+		return false
+	}
+
+	role := projectRole(userID, project)
+	return role == enums.RoleOwner || role == enums.RoleEditor
+}
+
+// projectRole retorna o papel do usuário no projeto ("" sem acesso)
+func projectRole(userID primitive.ObjectID, project *entities.Project) enums.ProjectRole {
+	if project == nil || userID.IsZero() {
+		return ""
+	}
+	return project.Role(userID)
+}
+
+// inProject verifica se a tarefa pertence ao projeto e ao dono dele
+func inProject(project *entities.Project, task *entities.Task) bool {
+	return project != nil && task != nil && task.ProjectID != nil &&
+		*task.ProjectID == project.ID && task.UserID == project.UserID
+}
@@ -0,0 +1,21 @@
+package policy
+
+import (
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CanViewTask verifica se o usuário pode visualizar a tarefa
+func CanViewTask(userID primitive.ObjectID, task *entities.Task) bool {
+	return isOwner(userID, task)
+}
+
+// CanModifyTask verifica se o usuário pode alterar ou remover a tarefa
+func CanModifyTask(userID primitive.ObjectID, task *entities.Task) bool {
+	return isOwner(userID, task)
+}
+
+// isOwner verifica se a tarefa pertence ao usuário
+func isOwner(userID primitive.ObjectID, task *entities.Task) bool {
+	return task != nil && !userID.IsZero() && task.UserID == userID
+}
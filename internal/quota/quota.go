@@ -0,0 +1,139 @@
+// Package quota controla limites de uso mensais por usuário (ex.: SMS
+// enviados). Os contadores ficam no banco, então o limite vale para todas as
+// instâncias da API. Os limites de cada usuário vêm de um LimitSource (o plano
+// do usuário, por exemplo).
+package quota
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Resource identifica um recurso com cota
+type Resource string
+
+// Recursos com cota
+const (
+	// ResourceSMS conta os SMS enviados ao usuário (lembretes e códigos de verificação)
+	ResourceSMS Resource = "sms"
+)
+
+// counterRetention é por quanto tempo o contador é mantido após o fim do período
+const counterRetention = 90 * 24 * time.Hour
+
+// ErrExceeded indica que o usuário atingiu a cota do recurso no período
+var ErrExceeded = errors.New("cota mensal excedida")
+
+// Limits define o limite mensal por recurso. Recursos ausentes não têm limite.
+type Limits map[Resource]int64
+
+// Limits faz de Limits um LimitSource com os mesmos limites para todos os usuários
+func (l Limits) Limits(context.Context, primitive.ObjectID) (Limits, error) {
+	return l, nil
+}
+
+// LimitSource informa os limites mensais que valem para o usuário
+type LimitSource interface {
+	Limits(ctx context.Context, userID primitive.ObjectID) (Limits, error)
+}
+
+// Usage é o uso de um recurso no período atual
+type Usage struct {
+	Resource Resource
+	Used     int64
+	// Limit é zero para recursos sem limite
+	Limit    int64
+	ResetsAt time.Time
+}
+
+// Quota interface define o controle de cotas
+type Quota interface {
+	// Consume registra um uso, retornando ErrExceeded se a cota já foi atingida
+	Consume(ctx context.Context, userID primitive.ObjectID, resource Resource) error
+	// Release devolve um uso registrado por Consume (ex.: quando o envio falha)
+	Release(ctx context.Context, userID primitive.ObjectID, resource Resource) error
+	Usage(ctx context.Context, userID primitive.ObjectID, resource Resource) (*Usage, error)
+}
+
+// quota implementa Quota
+type quota struct {
+	usage  repositories.UsageRepository
+	limits LimitSource
+}
+
+// New cria o controle de cotas com os limites mensais de limits
+func New(usage repositories.UsageRepository, limits LimitSource) Quota {
+	return &quota{usage: usage, limits: limits}
+}
+
+// limit retorna o limite do recurso para o usuário (ok false: sem limite)
+func (q *quota) limit(ctx context.Context, userID primitive.ObjectID, resource Resource) (int64, bool, error) {
+	limits, err := q.limits.Limits(ctx, userID)
+	if err != nil {
+		return 0, false, err
+	}
+
+	limit, ok := limits[resource]
+	return limit, ok, nil
+}
+
+func (q *quota) Consume(ctx context.Context, userID primitive.ObjectID, resource Resource) error {
+	limit, ok, err := q.limit(ctx, userID, resource)
+	if err != nil || !ok {
+		return err
+	}
+
+	period, resetsAt := monthPeriod(time.Now())
+	allowed, err := q.usage.Increment(ctx, userID, string(resource), period, limit, resetsAt.Add(counterRetention))
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return ErrExceeded
+	}
+
+	return nil
+}
+
+func (q *quota) Release(ctx context.Context, userID primitive.ObjectID, resource Resource) error {
+	if _, ok, err := q.limit(ctx, userID, resource); err != nil || !ok {
+		return err
+	}
+
+	period, _ := monthPeriod(time.Now())
+	return q.usage.Decrement(ctx, userID, string(resource), period)
+}
+
+func (q *quota) Usage(ctx context.Context, userID primitive.ObjectID, resource Resource) (*Usage, error) {
+	limit, _, err := q.limit(ctx, userID, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	period, resetsAt := monthPeriod(time.Now())
+
+	used, err := q.usage.Get(ctx, userID, string(resource), period)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Usage{Resource: resource, Used: used, Limit: limit, ResetsAt: resetsAt}, nil
+}
+
+// monthPeriod retorna o identificador do mês (UTC) e o início do mês seguinte
+func monthPeriod(now time.Time) (string, time.Time) {
+	start, end := Period(now)
+	return start.Format("2006-01"), end
+}
+
+// Period retorna o início do período das cotas (o mês UTC) que contém now e o
+// início do período seguinte, quando os contadores zeram
+func Period(now time.Time) (start, end time.Time) {
+	now = now.UTC()
+	start = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0)
+}
@@ -0,0 +1,410 @@
+// Package recurrence interpreta as regras de repetição das tarefas (RRULE da
+// RFC 5545) e calcula as próximas ocorrências sem gravar nada no banco.
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequências suportadas (FREQ)
+const (
+	Daily   = "DAILY"
+	Weekly  = "WEEKLY"
+	Monthly = "MONTHLY"
+	Yearly  = "YEARLY"
+)
+
+// maxPeriods limita os períodos (dias, semanas, meses ou anos) percorridos a
+// partir do início: regras que nunca casam (ex.: 30 de fevereiro) terminam
+const maxPeriods = 50000
+
+// ErrInvalidRule indica RRULE malformada ou com partes não suportadas
+var ErrInvalidRule = errors.New("regra de recorrência inválida")
+
+// weekdays converte os dias do BYDAY e do WKST
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// WeekdayRule é um dia do BYDAY; Ordinal é a posição no mês ou no ano
+// (ex.: 2 = segunda, -1 = última) e zero vale para todos
+type WeekdayRule struct {
+	Weekday time.Weekday
+	Ordinal int
+}
+
+// Rule é uma RRULE interpretada
+type Rule struct {
+	Freq       string
+	Interval   int
+	Count      int
+	Until      *time.Time
+	ByDay      []WeekdayRule
+	ByMonthDay []int
+	ByMonth    []time.Month
+	WeekStart  time.Weekday
+}
+
+// Parse interpreta a RRULE, com ou sem o prefixo "RRULE:". Partes que mudam a
+// hora da ocorrência ou dependem de BYSETPOS não são suportadas.
+func Parse(value string) (*Rule, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	if value == "" {
+		return nil, fmt.Errorf("%w: regra vazia", ErrInvalidRule)
+	}
+
+	rule := &Rule{Interval: 1, WeekStart: time.Monday}
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok || val == "" {
+			return nil, fmt.Errorf("%w: parte %q malformada", ErrInvalidRule, part)
+		}
+
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			rule.Freq = strings.ToUpper(val)
+		case "INTERVAL":
+			rule.Interval, err = positiveInt(val)
+		case "COUNT":
+			rule.Count, err = positiveInt(val)
+		case "UNTIL":
+			rule.Until, err = parseUntil(val)
+		case "BYDAY":
+			rule.ByDay, err = parseByDay(val)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseByMonthDay(val)
+		case "BYMONTH":
+			rule.ByMonth, err = parseByMonth(val)
+		case "WKST":
+			day, found := weekdays[strings.ToUpper(val)]
+			if !found {
+				err = fmt.Errorf("dia %q desconhecido", val)
+			}
+			rule.WeekStart = day
+		default:
+			err = fmt.Errorf("parte %s não suportada", key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidRule, key, err)
+		}
+	}
+
+	switch rule.Freq {
+	case Daily, Weekly, Monthly, Yearly:
+	case "":
+		return nil, fmt.Errorf("%w: FREQ é obrigatório", ErrInvalidRule)
+	default:
+		return nil, fmt.Errorf("%w: FREQ %s não suportada", ErrInvalidRule, rule.Freq)
+	}
+
+	if rule.Count > 0 && rule.Until != nil {
+		return nil, fmt.Errorf("%w: COUNT e UNTIL não podem ser usados juntos", ErrInvalidRule)
+	}
+
+	return rule, nil
+}
+
+// Next retorna até limit ocorrências posteriores a after, a partir da primeira
+// em start (o vencimento da tarefa). As datas mantêm a hora de start no fuso
+// loc, inclusive nas mudanças de horário de verão.
+func (r *Rule) Next(start, after time.Time, limit int, loc *time.Location) []time.Time {
+	return r.NextAdjusted(start, after, limit, loc, nil)
+}
+
+// NextAdjusted é como Next, mas passa cada ocorrência por adjust (ex.: para o
+// próximo dia útil). Ocorrências que o ajuste leva para a mesma data, ou para
+// antes da anterior, são unificadas.
+func (r *Rule) NextAdjusted(start, after time.Time, limit int, loc *time.Location, adjust func(time.Time) time.Time) []time.Time {
+	start = start.In(loc)
+	occurrences := make([]time.Time, 0, limit)
+	seen := 0
+
+	for period := 0; period < maxPeriods && len(occurrences) < limit; period++ {
+		for _, candidate := range r.candidates(start, period) {
+			if candidate.Before(start) {
+				continue
+			}
+			if r.Until != nil && candidate.After(*r.Until) {
+				return occurrences
+			}
+
+			seen++
+			if r.Count > 0 && seen > r.Count {
+				return occurrences
+			}
+
+			if candidate.After(after) {
+				if adjust != nil {
+					candidate = adjust(candidate)
+				}
+				if n := len(occurrences); n > 0 && !candidate.After(occurrences[n-1]) {
+					continue
+				}
+
+				occurrences = append(occurrences, candidate)
+				if len(occurrences) == limit {
+					return occurrences
+				}
+			}
+		}
+	}
+
+	return occurrences
+}
+
+// candidates retorna, em ordem, as datas do período (o n-ésimo dia, semana,
+// mês ou ano a partir de start, considerando INTERVAL) que casam com a regra
+func (r *Rule) candidates(start time.Time, period int) []time.Time {
+	step := period * r.Interval
+	var days []time.Time
+
+	switch r.Freq {
+	case Daily:
+		day := r.at(start, start.Year(), start.Month(), start.Day()+step)
+		if r.matchesMonth(day) && r.matchesMonthDay(day) && r.matchesWeekday(day) {
+			days = append(days, day)
+		}
+	case Weekly:
+		offset := (int(start.Weekday()) - int(r.WeekStart) + 7) % 7
+		weekStart := start.Day() - offset + step*7
+		for i := 0; i < 7; i++ {
+			day := r.at(start, start.Year(), start.Month(), weekStart+i)
+			if r.matchesMonth(day) && r.weeklyDay(start, day) {
+				days = append(days, day)
+			}
+		}
+	case Monthly:
+		first := r.at(start, start.Year(), start.Month()+time.Month(step), 1)
+		if r.matchesMonth(first) {
+			days = r.monthDays(start, first.Year(), first.Month())
+		}
+	case Yearly:
+		year := start.Year() + step
+		if len(r.ByDay) > 0 && len(r.ByMonth) == 0 && len(r.ByMonthDay) == 0 {
+			days = r.yearWeekdays(start, year)
+			break
+		}
+		months := r.ByMonth
+		switch {
+		case len(months) > 0:
+		case len(r.ByMonthDay) > 0:
+			// BYMONTHDAY sem BYMONTH vale para todos os meses
+			for month := time.January; month <= time.December; month++ {
+				months = append(months, month)
+			}
+		default:
+			months = []time.Month{start.Month()}
+		}
+		for _, month := range months {
+			days = append(days, r.monthDays(start, year, month)...)
+		}
+	}
+
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+	return days
+}
+
+// monthDays retorna os dias do mês que casam com BYMONTHDAY e BYDAY (os dois
+// juntos se restringem); sem eles, o mesmo dia de start, se o mês o tiver
+func (r *Rule) monthDays(start time.Time, year int, month time.Month) []time.Time {
+	length := daysIn(year, month)
+	var days []time.Time
+
+	for day := 1; day <= length; day++ {
+		date := r.at(start, year, month, day)
+		switch {
+		case len(r.ByMonthDay) == 0 && len(r.ByDay) == 0:
+			if day == start.Day() {
+				days = append(days, date)
+			}
+		case len(r.ByMonthDay) > 0 && !r.matchesMonthDay(date):
+		case len(r.ByDay) > 0 && !r.matchesOrdinalWeekday(day, length, date.Weekday()):
+		default:
+			days = append(days, date)
+		}
+	}
+
+	return days
+}
+
+// yearWeekdays retorna os dias do ano que casam com BYDAY, com as posições
+// contadas no ano inteiro (ex.: 20MO = vigésima segunda-feira do ano)
+func (r *Rule) yearWeekdays(start time.Time, year int) []time.Time {
+	length := 365
+	if daysIn(year, time.February) == 29 {
+		length = 366
+	}
+
+	var days []time.Time
+	for day := 1; day <= length; day++ {
+		date := r.at(start, year, time.January, day)
+		if r.matchesOrdinalWeekday(day, length, date.Weekday()) {
+			days = append(days, date)
+		}
+	}
+	return days
+}
+
+// weeklyDay indica se o dia da semana entra na regra semanal: os de BYDAY ou,
+// sem ele, o dia da semana de start
+func (r *Rule) weeklyDay(start, day time.Time) bool {
+	if len(r.ByDay) == 0 {
+		return day.Weekday() == start.Weekday()
+	}
+	return r.matchesWeekday(day)
+}
+
+// matchesOrdinalWeekday indica se o dia (posição day de um período com length
+// dias) casa com algum BYDAY, respeitando as posições (2TU, -1FR)
+func (r *Rule) matchesOrdinalWeekday(day, length int, weekday time.Weekday) bool {
+	for _, rule := range r.ByDay {
+		if rule.Weekday != weekday {
+			continue
+		}
+		switch {
+		case rule.Ordinal == 0:
+			return true
+		case rule.Ordinal > 0 && (day-1)/7+1 == rule.Ordinal:
+			return true
+		case rule.Ordinal < 0 && (length-day)/7+1 == -rule.Ordinal:
+			return true
+		}
+	}
+	return false
+}
+
+// matchesWeekday indica se o dia casa com BYDAY (sem considerar posições)
+func (r *Rule) matchesWeekday(day time.Time) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, rule := range r.ByDay {
+		if rule.Weekday == day.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesMonthDay indica se o dia casa com BYMONTHDAY (negativos contam do fim do mês)
+func (r *Rule) matchesMonthDay(day time.Time) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+	length := daysIn(day.Year(), day.Month())
+	for _, monthDay := range r.ByMonthDay {
+		if monthDay == day.Day() || (monthDay < 0 && length+monthDay+1 == day.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesMonth indica se o dia casa com BYMONTH
+func (r *Rule) matchesMonth(day time.Time) bool {
+	if len(r.ByMonth) == 0 {
+		return true
+	}
+	for _, month := range r.ByMonth {
+		if month == day.Month() {
+			return true
+		}
+	}
+	return false
+}
+
+// at monta a data com a hora de start no fuso de start (dias e meses fora do
+// intervalo são normalizados, como em time.Date)
+func (r *Rule) at(start time.Time, year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, start.Hour(), start.Minute(), start.Second(), 0, start.Location())
+}
+
+// daysIn retorna quantos dias o mês tem
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func positiveInt(value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("%q deve ser um inteiro positivo", value)
+	}
+	return n, nil
+}
+
+// parseUntil aceita data (AAAAMMDD, até o fim do dia em UTC) ou data e hora,
+// em UTC (sufixo Z) ou sem fuso (tratada como UTC)
+func parseUntil(value string) (*time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405"} {
+		if until, err := time.Parse(layout, value); err == nil {
+			return &until, nil
+		}
+	}
+	if date, err := time.Parse("20060102", value); err == nil {
+		until := date.Add(24*time.Hour - time.Second)
+		return &until, nil
+	}
+	return nil, fmt.Errorf("data %q inválida", value)
+}
+
+func parseByDay(value string) ([]WeekdayRule, error) {
+	var days []WeekdayRule
+	for _, item := range strings.Split(strings.ToUpper(value), ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("dia %q inválido", item)
+		}
+		code, prefix := item[len(item)-2:], item[:len(item)-2]
+
+		weekday, ok := weekdays[code]
+		if !ok {
+			return nil, fmt.Errorf("dia %q desconhecido", item)
+		}
+
+		rule := WeekdayRule{Weekday: weekday}
+		if prefix != "" {
+			ordinal, err := strconv.Atoi(prefix)
+			if err != nil || ordinal == 0 || ordinal < -53 || ordinal > 53 {
+				return nil, fmt.Errorf("posição %q inválida", prefix)
+			}
+			rule.Ordinal = ordinal
+		}
+		days = append(days, rule)
+	}
+	return days, nil
+}
+
+func parseByMonthDay(value string) ([]int, error) {
+	var days []int
+	for _, item := range strings.Split(value, ",") {
+		day, err := strconv.Atoi(item)
+		if err != nil || day == 0 || day < -31 || day > 31 {
+			return nil, fmt.Errorf("dia do mês %q inválido", item)
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+func parseByMonth(value string) ([]time.Month, error) {
+	var months []time.Month
+	for _, item := range strings.Split(value, ",") {
+		month, err := strconv.Atoi(item)
+		if err != nil || month < 1 || month > 12 {
+			return nil, fmt.Errorf("mês %q inválido", item)
+		}
+		months = append(months, time.Month(month))
+	}
+	return months, nil
+}
@@ -0,0 +1,246 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/devgugga/todo-it/internal/clock"
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AnonymizedTitle substitui o título das tarefas e o nome dos projetos de contas anonimizadas
+const AnonymizedTitle = "[anonimizado]"
+
+// AccountClosureRepository interface define o encerramento de conta com
+// anonimização dos dados pessoais
+type AccountClosureRepository interface {
+	Anonymize(ctx context.Context, userID primitive.ObjectID, placeholderEmail string) ([]primitive.ObjectID, error)
+}
+
+// accountClosureRepository implementa AccountClosureRepository
+type accountClosureRepository struct {
+	operationTimeouts
+	users         *mongo.Collection
+	tasks         []*mongo.Collection
+	projects      *mongo.Collection
+	organizations *mongo.Collection
+	orgUsage      *mongo.Collection
+	// owned são as collections removidas por completo (dados pessoais sem valor estatístico)
+	owned []*mongo.Collection
+	clock clock.Clock
+}
+
+// NewAccountClosureRepository cria uma nova instância do repositório
+func NewAccountClosureRepository(db database.Client) AccountClosureRepository {
+	return NewAccountClosureRepositoryWithClock(db, clock.System())
+}
+
+// NewAccountClosureRepositoryWithClock cria o repositório com o relógio
+// informado, usado nas datas de anonimização
+func NewAccountClosureRepositoryWithClock(db database.Client, clk clock.Clock) AccountClosureRepository {
+	collections := db.Collections()
+
+	return &accountClosureRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		users:             collections.Users,
+		tasks:             []*mongo.Collection{collections.Tasks, collections.TasksArchive},
+		projects:          collections.Projects,
+		organizations:     collections.Organizations,
+		orgUsage:          collections.OrgUsage,
+		owned: []*mongo.Collection{
+			collections.Notifications,
+			collections.TaskChanges,
+			collections.AppPasswords,
+			collections.TelegramLinks,
+			collections.GitHubAccounts,
+			collections.InboundEmails,
+			collections.ImportJobs,
+			collections.Tombstones,
+			collections.FocusSessions,
+			collections.ViewSettings,
+			collections.ExportJobs,
+			collections.Labels,
+		},
+		clock: clk,
+	}
+}
+
+// Anonymize desativa a conta e remove os dados pessoais do usuário: perfil
+// (email trocado por placeholderEmail), textos das tarefas e projetos e os
+// vínculos com integrações. As organizações de que a conta é dona são removidas
+// com o uso calculado. Status, prioridade e datas das tarefas são
+// mantidos para as estatísticas agregadas. Retorna os anexos (GridFS) das
+// tarefas, que devem ser removidos por quem chama.
+func (r *accountClosureRepository) Anonymize(ctx context.Context, userID primitive.ObjectID, placeholderEmail string) ([]primitive.ObjectID, error) {
+	ctx, cancel := r.aggregateContext(ctx)
+	defer cancel()
+
+	now := r.clock.Now()
+
+	// O perfil primeiro: a conta deixa de ser acessível mesmo que o restante falhe
+	// (a operação pode ser repetida)
+	var user entities.User
+	opts := options.FindOneAndUpdate().SetProjection(bson.M{"email": 1})
+	err := r.users.FindOneAndUpdate(ctx, bson.M{"_id": userID}, bson.M{
+		"$set": bson.M{
+			"name":          "",
+			"email":         placeholderEmail,
+			"password":      "",
+			"is_active":     false,
+			"preferences":   bson.M{},
+			"anonymized_at": now,
+			"updated_at":    now,
+		},
+		"$unset": bson.M{
+			"avatar":          "",
+			"inbound_alias":   "",
+			"phone":           "",
+			"feed_token_hash": "",
+		},
+	}, opts).Decode(&user)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrUserNotFound
+		}
+		return nil, wrapError("erro ao anonimizar usuário: %w", err)
+	}
+
+	filter := bson.M{"user_id": userID}
+
+	var attachmentIDs []primitive.ObjectID
+	for _, tasks := range r.tasks {
+		ids, err := r.taskAttachmentIDs(ctx, tasks, userID)
+		if err != nil {
+			return nil, err
+		}
+		attachmentIDs = append(attachmentIDs, ids...)
+
+		_, err = tasks.UpdateMany(ctx, filter, bson.M{
+			"$set": bson.M{"title": AnonymizedTitle, "title_tokens": entities.TitleTokens(AnonymizedTitle)},
+			"$unset": bson.M{
+				"description":   "",
+				"tags":          "",
+				"label_ids":     "",
+				"checklist":     "",
+				"attachments":   "",
+				"recurrence":    "",
+				"reminder_at":   "",
+				"external":      "",
+				"caldav":        "",
+				"location":      "",
+				"custom_status": "",
+			},
+		})
+		if err != nil {
+			return nil, wrapError("erro ao anonimizar tarefas: %w", err)
+		}
+	}
+
+	_, err = r.projects.UpdateMany(ctx, filter, bson.M{"$set": bson.M{"name": AnonymizedTitle, "updated_at": now}})
+	if err != nil {
+		return nil, wrapError("erro ao anonimizar projetos: %w", err)
+	}
+
+	// Projetos de outros usuários compartilhados com a conta encerrada
+	_, err = r.projects.UpdateMany(ctx, bson.M{"members.user_id": userID}, bson.M{"$pull": bson.M{"members": bson.M{"user_id": userID}}})
+	if err != nil {
+		return nil, wrapError("erro ao remover usuário dos projetos compartilhados: %w", err)
+	}
+
+	// A conta encerrada libera o assento nas organizações de que era membro
+	_, err = r.organizations.UpdateMany(ctx, bson.M{"members.user_id": userID}, bson.M{
+		"$pull": bson.M{"members": bson.M{"user_id": userID}},
+		"$set":  bson.M{"updated_at": now},
+	})
+	if err != nil {
+		return nil, wrapError("erro ao remover usuário das organizações: %w", err)
+	}
+
+	// Os convites pendentes guardam o email original
+	email := entities.NormalizeEmail(user.Email)
+	_, err = r.organizations.UpdateMany(ctx, bson.M{"invites.email": email}, bson.M{
+		"$pull": bson.M{"invites": bson.M{"email": email}},
+	})
+	if err != nil {
+		return nil, wrapError("erro ao remover convites das organizações: %w", err)
+	}
+
+	if err := r.deleteOwnedOrganizations(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	for _, collection := range r.owned {
+		if _, err := collection.DeleteMany(ctx, filter); err != nil {
+			return nil, wrapError("erro ao remover dados de %s: %w", collection.Name(), err)
+		}
+	}
+
+	return attachmentIDs, nil
+}
+
+// deleteOwnedOrganizations remove as organizações de que o usuário é dono e o
+// uso calculado de cada uma; os membros perdem o assento
+func (r *accountClosureRepository) deleteOwnedOrganizations(ctx context.Context, userID primitive.ObjectID) error {
+	cursor, err := r.organizations.Find(ctx, bson.M{"owner_id": userID}, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return wrapError("erro ao buscar organizações do usuário: %w", err)
+	}
+
+	var docs []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &docs); err != nil {
+		return wrapError("erro ao decodificar organizações do usuário: %w", err)
+	}
+	if len(docs) == 0 {
+		return nil
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(docs))
+	for _, doc := range docs {
+		ids = append(ids, doc.ID)
+	}
+
+	// O uso primeiro: se a remoção das organizações falhar, o job recalcula
+	if _, err := r.orgUsage.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}}); err != nil {
+		return wrapError("erro ao remover uso das organizações: %w", err)
+	}
+	if _, err := r.organizations.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}}); err != nil {
+		return wrapError("erro ao remover organizações do usuário: %w", err)
+	}
+
+	return nil
+}
+
+// taskAttachmentIDs lista os anexos das tarefas do usuário
+func (r *accountClosureRepository) taskAttachmentIDs(ctx context.Context, tasks *mongo.Collection, userID primitive.ObjectID) ([]primitive.ObjectID, error) {
+	opts := options.Find().SetProjection(bson.M{"attachments._id": 1})
+
+	cursor, err := tasks.Find(ctx, bson.M{"user_id": userID, "attachments.0": bson.M{"$exists": true}}, opts)
+	if err != nil {
+		return nil, wrapError("erro ao buscar anexos das tarefas: %w", err)
+	}
+
+	var docs []struct {
+		Attachments []struct {
+			ID primitive.ObjectID `bson:"_id"`
+		} `bson:"attachments"`
+	}
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, wrapError("erro ao decodificar anexos das tarefas: %w", err)
+	}
+
+	var ids []primitive.ObjectID
+	for _, doc := range docs {
+		for _, attachment := range doc.Attachments {
+			ids = append(ids, attachment.ID)
+		}
+	}
+
+	return ids, nil
+}
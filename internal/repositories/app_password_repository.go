@@ -0,0 +1,129 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AppPasswordRepository interface define os métodos do repositório de senhas de aplicativo
+type AppPasswordRepository interface {
+	Create(ctx context.Context, password *entities.AppPassword) error
+	ListByUser(ctx context.Context, userID primitive.ObjectID) ([]*entities.AppPassword, error)
+	CountByUser(ctx context.Context, userID primitive.ObjectID) (int64, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*entities.AppPassword, error)
+	TouchLastUsed(ctx context.Context, id primitive.ObjectID) error
+	Delete(ctx context.Context, userID, id primitive.ObjectID) error
+}
+
+// appPasswordRepository implementa AppPasswordRepository
+type appPasswordRepository struct {
+	operationTimeouts
+	collection *mongo.Collection
+}
+
+// NewAppPasswordRepository cria uma nova instância do repositório
+func NewAppPasswordRepository(db database.Client) AppPasswordRepository {
+	return &appPasswordRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		collection:        db.Collections().AppPasswords,
+	}
+}
+
+// Create grava uma nova senha de aplicativo
+func (r *appPasswordRepository) Create(ctx context.Context, password *entities.AppPassword) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	if _, err := r.collection.InsertOne(ctx, password); err != nil {
+		return wrapError("erro ao criar senha de aplicativo: %w", err)
+	}
+
+	return nil
+}
+
+// ListByUser lista as senhas de aplicativo do usuário, das mais recentes às mais antigas
+func (r *appPasswordRepository) ListByUser(ctx context.Context, userID primitive.ObjectID) ([]*entities.AppPassword, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, wrapError("erro ao listar senhas de aplicativo: %w", err)
+	}
+
+	passwords := []*entities.AppPassword{}
+	if err := cursor.All(ctx, &passwords); err != nil {
+		return nil, wrapError("erro ao decodificar senhas de aplicativo: %w", err)
+	}
+
+	return passwords, nil
+}
+
+// CountByUser conta as senhas de aplicativo do usuário
+func (r *appPasswordRepository) CountByUser(ctx context.Context, userID primitive.ObjectID) (int64, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	count, err := r.collection.CountDocuments(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return 0, wrapError("erro ao contar senhas de aplicativo: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetByTokenHash busca a senha de aplicativo pelo hash do token
+func (r *appPasswordRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*entities.AppPassword, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	var password entities.AppPassword
+	if err := r.collection.FindOne(ctx, bson.M{"token_hash": tokenHash}).Decode(&password); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrAppPasswordNotFound
+		}
+		return nil, wrapError("erro ao buscar senha de aplicativo: %w", err)
+	}
+
+	return &password, nil
+}
+
+// TouchLastUsed registra o último uso da senha de aplicativo
+func (r *appPasswordRepository) TouchLastUsed(ctx context.Context, id primitive.ObjectID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"last_used_at": time.Now()}})
+	if err != nil {
+		return wrapError("erro ao registrar uso da senha de aplicativo: %w", err)
+	}
+
+	return nil
+}
+
+// Delete revoga a senha de aplicativo do usuário
+func (r *appPasswordRepository) Delete(ctx context.Context, userID, id primitive.ObjectID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	result, err := r.collection.DeleteOne(ctx, ownedFilter(userID, id))
+	if err != nil {
+		return wrapError("erro ao revogar senha de aplicativo: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return ErrAppPasswordNotFound
+	}
+
+	return nil
+}
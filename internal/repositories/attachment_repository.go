@@ -0,0 +1,232 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AttachmentRepository interface define o armazenamento do conteúdo dos anexos (GridFS)
+type AttachmentRepository interface {
+	Upload(ctx context.Context, userID primitive.ObjectID, filename, contentType string, content io.Reader) (*entities.TaskAttachment, error)
+	Open(ctx context.Context, userID, id primitive.ObjectID) (io.ReadCloser, error)
+	OpenFile(ctx context.Context, id primitive.ObjectID) (*AttachmentFile, error)
+	Delete(ctx context.Context, id primitive.ObjectID) error
+	Ping(ctx context.Context) error
+}
+
+// attachmentMetadata é gravado em attachments.files para checar o dono do arquivo
+type attachmentMetadata struct {
+	UserID      primitive.ObjectID `bson:"user_id"`
+	ContentType string             `bson:"content_type"`
+}
+
+// AttachmentFile é o conteúdo de um anexo aberto para leitura, com os metadados
+type AttachmentFile struct {
+	Filename    string
+	ContentType string
+	Size        int64
+	Content     io.ReadCloser
+}
+
+// attachmentRepository implementa AttachmentRepository
+type attachmentRepository struct {
+	operationTimeouts
+	fieldEncryption
+	database *mongo.Database
+	name     string
+}
+
+// NewAttachmentRepository cria uma nova instância do repositório
+func NewAttachmentRepository(db database.Client) AttachmentRepository {
+	names := database.GetCollectionNames()
+
+	return &attachmentRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		fieldEncryption:   newFieldEncryption(db),
+		database:          db.GetCollection(names.Attachments + ".files").Database(),
+		name:              names.Attachments,
+	}
+}
+
+// bucket abre o bucket GridFS (o Bucket guarda estado de leitura/escrita,
+// então cada operação usa o seu)
+func (r *attachmentRepository) bucket() (*gridfs.Bucket, error) {
+	bucket, err := gridfs.NewBucket(r.database, options.GridFSBucket().SetName(r.name))
+	if err != nil {
+		return nil, wrapError("erro ao abrir bucket de anexos: %w", err)
+	}
+	return bucket, nil
+}
+
+// Upload grava o conteúdo do anexo e retorna seus metadados
+func (r *attachmentRepository) Upload(ctx context.Context, userID primitive.ObjectID, filename, contentType string, content io.Reader) (*entities.TaskAttachment, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	bucket, err := r.bucket()
+	if err != nil {
+		return nil, err
+	}
+
+	// Nome e tipo ficam cifrados também em attachments.files
+	storedFilename, err := r.encrypt(filename)
+	if err != nil {
+		return nil, err
+	}
+	storedContentType, err := r.encrypt(contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	id := primitive.NewObjectID()
+	opts := options.GridFSUpload().SetMetadata(attachmentMetadata{UserID: userID, ContentType: storedContentType})
+
+	stream, err := bucket.OpenUploadStreamWithID(id, storedFilename, opts)
+	if err != nil {
+		return nil, wrapError("erro ao iniciar upload do anexo: %w", err)
+	}
+	defer stream.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := stream.SetWriteDeadline(deadline); err != nil {
+			return nil, wrapError("erro ao iniciar upload do anexo: %w", err)
+		}
+	}
+
+	size, err := io.Copy(stream, content)
+	if err != nil {
+		_ = stream.Abort()
+		return nil, wrapError("erro ao gravar anexo: %w", err)
+	}
+
+	if err := stream.Close(); err != nil {
+		return nil, wrapError("erro ao finalizar anexo: %w", err)
+	}
+
+	return &entities.TaskAttachment{
+		ID:          id,
+		Filename:    filename,
+		ContentType: contentType,
+		Size:        size,
+		CreatedAt:   time.Now(),
+	}, nil
+}
+
+// Open abre o conteúdo do anexo do usuário para leitura
+func (r *attachmentRepository) Open(ctx context.Context, userID, id primitive.ObjectID) (io.ReadCloser, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	bucket, err := r.bucket()
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := bucket.FindContext(ctx, bson.M{"_id": id, "metadata.user_id": userID})
+	if err != nil {
+		return nil, wrapError("erro ao buscar anexo: %w", err)
+	}
+	found := cursor.Next(ctx)
+	_ = cursor.Close(ctx)
+	if !found {
+		return nil, ErrAttachmentNotFound
+	}
+
+	stream, err := bucket.OpenDownloadStream(id)
+	if err != nil {
+		if errors.Is(err, gridfs.ErrFileNotFound) {
+			return nil, ErrAttachmentNotFound
+		}
+		return nil, wrapError("erro ao abrir anexo: %w", err)
+	}
+
+	return stream, nil
+}
+
+// OpenFile abre o anexo de qualquer usuário, com nome e tipo decifrados. Só
+// para downloads já autorizados (URLs assinadas).
+func (r *attachmentRepository) OpenFile(ctx context.Context, id primitive.ObjectID) (*AttachmentFile, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	bucket, err := r.bucket()
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := bucket.FindContext(ctx, bson.M{"_id": id})
+	if err != nil {
+		return nil, wrapError("erro ao buscar anexo: %w", err)
+	}
+
+	var files []struct {
+		Filename string             `bson:"filename"`
+		Length   int64              `bson:"length"`
+		Metadata attachmentMetadata `bson:"metadata"`
+	}
+	if err := cursor.All(ctx, &files); err != nil {
+		return nil, wrapError("erro ao decodificar anexo: %w", err)
+	}
+	if len(files) == 0 {
+		return nil, ErrAttachmentNotFound
+	}
+
+	file := &AttachmentFile{Size: files[0].Length}
+	if file.Filename, err = r.decrypt(files[0].Filename); err != nil {
+		return nil, err
+	}
+	if file.ContentType, err = r.decrypt(files[0].Metadata.ContentType); err != nil {
+		return nil, err
+	}
+
+	file.Content, err = bucket.OpenDownloadStream(id)
+	if err != nil {
+		if errors.Is(err, gridfs.ErrFileNotFound) {
+			return nil, ErrAttachmentNotFound
+		}
+		return nil, wrapError("erro ao abrir anexo: %w", err)
+	}
+
+	return file, nil
+}
+
+// Delete remove o conteúdo do anexo
+func (r *attachmentRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	bucket, err := r.bucket()
+	if err != nil {
+		return err
+	}
+
+	if err := bucket.DeleteContext(ctx, id); err != nil && !errors.Is(err, gridfs.ErrFileNotFound) {
+		return wrapError("erro ao remover anexo: %w", err)
+	}
+
+	return nil
+}
+
+// Ping verifica o acesso ao bucket de anexos, lendo um arquivo qualquer
+func (r *attachmentRepository) Ping(ctx context.Context) error {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	opts := options.FindOne().SetProjection(bson.M{"_id": 1})
+	err := r.database.Collection(r.name+".files").FindOne(ctx, bson.M{}, opts).Err()
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return wrapError("erro ao acessar bucket de anexos: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AuditLogFilters representa filtros para consulta do log de auditoria
+type AuditLogFilters struct {
+	Action  string
+	ActorID *primitive.ObjectID
+	Outcome string
+	IP      string
+	From    *time.Time
+	To      *time.Time
+}
+
+// AuditLogRepository interface define os métodos do repositório de auditoria
+type AuditLogRepository interface {
+	Create(ctx context.Context, entry *entities.AuditLog) error
+	List(ctx context.Context, page, limit int64, filters *AuditLogFilters) ([]*entities.AuditLog, int64, error)
+}
+
+// auditLogRepository implementa AuditLogRepository
+type auditLogRepository struct {
+	operationTimeouts
+	collection *mongo.Collection
+}
+
+// NewAuditLogRepository cria uma nova instância do repositório
+func NewAuditLogRepository(db database.Client) AuditLogRepository {
+	return &auditLogRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		collection:        db.Collections().AuditLogs,
+	}
+}
+
+// Create grava uma entrada no log de auditoria
+func (r *auditLogRepository) Create(ctx context.Context, entry *entities.AuditLog) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	entry.PrepareForCreate()
+
+	if _, err := r.collection.InsertOne(ctx, entry); err != nil {
+		return wrapError("erro ao registrar auditoria: %w", err)
+	}
+
+	return nil
+}
+
+// List consulta o log de auditoria com filtros e paginação (mais recentes primeiro)
+func (r *auditLogRepository) List(ctx context.Context, page, limit int64, filters *AuditLogFilters) ([]*entities.AuditLog, int64, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	filter := bson.M{}
+	if filters != nil {
+		r.applyFilters(filter, filters)
+	}
+
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, wrapError("erro ao contar auditoria: %w", err)
+	}
+
+	opts := options.Find().
+		SetSkip((page - 1) * limit).
+		SetLimit(limit).
+		SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, wrapError("erro ao listar auditoria: %w", err)
+	}
+
+	entries := []*entities.AuditLog{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, 0, wrapError("erro ao decodificar auditoria: %w", err)
+	}
+
+	return entries, total, nil
+}
+
+// applyFilters aplica filtros na query
+func (r *auditLogRepository) applyFilters(filter bson.M, filters *AuditLogFilters) {
+	if filters.Action != "" {
+		filter["action"] = filters.Action
+	}
+
+	if filters.ActorID != nil {
+		filter["actor_id"] = *filters.ActorID
+	}
+
+	if filters.Outcome != "" {
+		filter["outcome"] = filters.Outcome
+	}
+
+	if filters.IP != "" {
+		filter["ip"] = filters.IP
+	}
+
+	if filters.From != nil || filters.To != nil {
+		createdAt := bson.M{}
+		if filters.From != nil {
+			createdAt["$gte"] = *filters.From
+		}
+		if filters.To != nil {
+			createdAt["$lte"] = *filters.To
+		}
+		filter["created_at"] = createdAt
+	}
+}
@@ -0,0 +1,126 @@
+package repositories
+
+import (
+	"fmt"
+
+	"github.com/devgugga/todo-it/internal/apperrors"
+	"github.com/devgugga/todo-it/internal/database"
+)
+
+// ErrTodoNotFound indica tarefa inexistente ou que não pertence ao usuário informado
+var ErrTodoNotFound = apperrors.NotFound("todo não encontrado")
+
+// ErrTaskIDTaken indica ID informado pelo cliente já usado por outra tarefa
+var ErrTaskIDTaken = apperrors.Duplicate("ID de tarefa já utilizado")
+
+// ErrExternalTaskExists indica item de origem (ex.: issue do GitHub) já sincronizado como tarefa
+var ErrExternalTaskExists = apperrors.Duplicate("tarefa já sincronizada com o item de origem")
+
+// ErrEmailAlreadyExists indica email já usado por outro usuário
+var ErrEmailAlreadyExists = apperrors.Duplicate("usuário com este email já existe")
+
+// ErrUserNotFound indica usuário inexistente ou inativo
+var ErrUserNotFound = apperrors.NotFound("usuário não encontrado")
+
+// ErrTelegramLinkNotFound indica chat não vinculado ou código de vinculação inválido/expirado
+var ErrTelegramLinkNotFound = apperrors.NotFound("vínculo com o Telegram não encontrado")
+
+// ErrAttachmentNotFound indica anexo inexistente ou de outro usuário
+var ErrAttachmentNotFound = apperrors.NotFound("anexo não encontrado")
+
+// ErrProjectNotFound indica projeto inexistente ou que não pertence ao usuário informado
+var ErrProjectNotFound = apperrors.NotFound("projeto não encontrado")
+
+// ErrProjectMemberNotFound indica usuário que não é membro do projeto
+var ErrProjectMemberNotFound = apperrors.NotFound("membro do projeto não encontrado")
+
+// ErrProjectMemberExists indica projeto já compartilhado com o usuário
+var ErrProjectMemberExists = apperrors.Duplicate("usuário já é membro do projeto")
+
+// ErrImportJobNotFound indica importação inexistente ou de outro usuário
+var ErrImportJobNotFound = apperrors.NotFound("importação não encontrada")
+
+// ErrExportJobNotFound indica exportação inexistente, expirada ou de outro usuário
+var ErrExportJobNotFound = apperrors.NotFound("exportação não encontrada")
+
+// ErrGitHubAccountNotFound indica conta do GitHub não conectada ou state OAuth inválido/expirado
+var ErrGitHubAccountNotFound = apperrors.NotFound("conta do GitHub não encontrada")
+
+// ErrAppPasswordNotFound indica senha de aplicativo inexistente, revogada ou de outro usuário
+var ErrAppPasswordNotFound = apperrors.NotFound("senha de aplicativo não encontrada")
+
+// ErrNotificationNotFound indica notificação inexistente, fora da caixa de entrada ou de outro usuário
+var ErrNotificationNotFound = apperrors.NotFound("notificação não encontrada")
+
+// ErrWebhookDeliveryNotFound indica entrega de webhook inexistente, expirada ou de outro usuário
+var ErrWebhookDeliveryNotFound = apperrors.NotFound("entrega de webhook não encontrada")
+
+// ErrPlatformMetricsNotFound indica que o painel de métricas ainda não foi calculado
+var ErrPlatformMetricsNotFound = apperrors.NotFound("métricas da plataforma ainda não calculadas")
+
+// ErrBusinessKPIsNotFound indica que os indicadores de negócio ainda não foram calculados
+var ErrBusinessKPIsNotFound = apperrors.NotFound("indicadores de negócio ainda não calculados")
+
+// ErrFocusSessionNotFound indica usuário sem sessão de foco em andamento
+var ErrFocusSessionNotFound = apperrors.NotFound("nenhuma sessão de foco em andamento")
+
+// ErrFocusSessionActive indica nova sessão de foco iniciada com outra em andamento
+var ErrFocusSessionActive = apperrors.Conflict("já existe uma sessão de foco em andamento")
+
+// ErrViewSettingsNotFound indica usuário sem configuração de visualização salva
+var ErrViewSettingsNotFound = apperrors.NotFound("configuração de visualização não encontrada")
+
+// ErrRetentionOverrideNotFound indica usuário sem retenção própria (segue a política padrão)
+var ErrRetentionOverrideNotFound = apperrors.NotFound("retenção do usuário não encontrada")
+
+// ErrCustomStatusNotFound indica status personalizado inexistente ou de outro usuário
+var ErrCustomStatusNotFound = apperrors.NotFound("status personalizado não encontrado")
+
+// ErrCustomStatusExists indica status personalizado com o mesmo nome de outro do usuário
+var ErrCustomStatusExists = apperrors.Duplicate("já existe um status personalizado com este nome")
+
+// ErrLabelNotFound indica etiqueta inexistente ou de outro usuário
+var ErrLabelNotFound = apperrors.NotFound("etiqueta não encontrada")
+
+// ErrLabelExists indica etiqueta com o mesmo nome de outra do usuário
+var ErrLabelExists = apperrors.Duplicate("já existe uma etiqueta com este nome")
+
+// ErrChecklistItemNotFound indica item inexistente na lista de verificação da tarefa
+var ErrChecklistItemNotFound = apperrors.NotFound("item da lista de verificação não encontrado")
+
+// ErrSubscriptionNotFound indica usuário sem assinatura (segue o plano gratuito)
+var ErrSubscriptionNotFound = apperrors.NotFound("assinatura não encontrada")
+
+// ErrSubscriptionRefInUse indica referência do provedor já usada na assinatura de outro usuário
+var ErrSubscriptionRefInUse = apperrors.Duplicate("assinatura do provedor já vinculada a outro usuário")
+
+// ErrOrganizationNotFound indica organização inexistente ou de que o usuário não faz parte
+var ErrOrganizationNotFound = apperrors.NotFound("organização não encontrada")
+
+// ErrOrganizationMemberExists indica usuário que já ocupa um assento da organização
+var ErrOrganizationMemberExists = apperrors.Duplicate("usuário já é membro da organização")
+
+// ErrOrganizationMemberNotFound indica usuário que não é membro da organização
+var ErrOrganizationMemberNotFound = apperrors.NotFound("membro da organização não encontrado")
+
+// ErrOrganizationSeatsFull indica organização com todos os assentos ocupados
+var ErrOrganizationSeatsFull = apperrors.Conflict("todos os assentos da organização estão ocupados")
+
+// ErrOrganizationInviteNotFound indica convite inexistente, expirado ou para outro email
+var ErrOrganizationInviteNotFound = apperrors.NotFound("convite da organização não encontrado")
+
+// ErrOrganizationInvitesFull indica organização com o limite de convites pendentes atingido
+var ErrOrganizationInvitesFull = apperrors.Conflict("limite de convites pendentes da organização atingido")
+
+// ErrOrgUsageNotFound indica que o uso da organização ainda não foi calculado
+var ErrOrgUsageNotFound = apperrors.NotFound("uso da organização ainda não calculado")
+
+// wrapError formata o erro de uma operação no banco como fmt.Errorf; falhas de
+// disponibilidade do MongoDB (eleição de primário, rede) viram ErrUnavailable
+func wrapError(format string, args ...interface{}) error {
+	err := fmt.Errorf(format, args...)
+	if database.IsUnavailable(err) {
+		return apperrors.Unavailable(err.Error())
+	}
+	return err
+}
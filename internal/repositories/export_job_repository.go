@@ -0,0 +1,158 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// exportJobWithoutFile omite o arquivo gerado nas consultas de andamento
+var exportJobWithoutFile = bson.M{"file": 0}
+
+// ExportJobRepository interface define os métodos do repositório de exportações
+type ExportJobRepository interface {
+	Create(ctx context.Context, job *entities.ExportJob) error
+	GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.ExportJob, error)
+	GetFile(ctx context.Context, userID, id primitive.ObjectID) (*entities.ExportJob, error)
+	GetFileByID(ctx context.Context, id primitive.ObjectID) (*entities.ExportJob, error)
+	HasActive(ctx context.Context, userID primitive.ObjectID, staleAfter time.Duration) (bool, error)
+	ClaimNext(ctx context.Context, staleAfter time.Duration) (*entities.ExportJob, error)
+	Save(ctx context.Context, job *entities.ExportJob) error
+}
+
+// exportJobRepository implementa ExportJobRepository
+type exportJobRepository struct {
+	operationTimeouts
+	collection *mongo.Collection
+}
+
+// NewExportJobRepository cria uma nova instância do repositório
+func NewExportJobRepository(db database.Client) ExportJobRepository {
+	return &exportJobRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		collection:        db.Collections().ExportJobs,
+	}
+}
+
+// Create registra uma nova exportação pendente
+func (r *exportJobRepository) Create(ctx context.Context, job *entities.ExportJob) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	if _, err := r.collection.InsertOne(ctx, job); err != nil {
+		return wrapError("erro ao criar exportação: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID busca o andamento de uma exportação do usuário, sem o arquivo gerado
+func (r *exportJobRepository) GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.ExportJob, error) {
+	return r.findOne(ctx, ownedFilter(userID, id), options.FindOne().SetProjection(exportJobWithoutFile))
+}
+
+// GetFile busca uma exportação do usuário com o arquivo gerado
+func (r *exportJobRepository) GetFile(ctx context.Context, userID, id primitive.ObjectID) (*entities.ExportJob, error) {
+	return r.findOne(ctx, ownedFilter(userID, id))
+}
+
+// GetFileByID busca uma exportação com o arquivo gerado, de qualquer usuário.
+// Só para downloads já autorizados (URLs assinadas).
+func (r *exportJobRepository) GetFileByID(ctx context.Context, id primitive.ObjectID) (*entities.ExportJob, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+// findOne busca uma exportação pelo filtro
+func (r *exportJobRepository) findOne(ctx context.Context, filter bson.M, opts ...*options.FindOneOptions) (*entities.ExportJob, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	var job entities.ExportJob
+	if err := r.collection.FindOne(ctx, filter, opts...).Decode(&job); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrExportJobNotFound
+		}
+		return nil, wrapError("erro ao buscar exportação: %w", err)
+	}
+
+	return &job, nil
+}
+
+// HasActive verifica se o usuário tem exportação pendente ou em andamento.
+// Exportações paradas há mais de staleAfter são ignoradas.
+func (r *exportJobRepository) HasActive(ctx context.Context, userID primitive.ObjectID, staleAfter time.Duration) (bool, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	filter := bson.M{
+		"user_id":    userID,
+		"status":     bson.M{"$in": []entities.ExportJobStatus{entities.ExportJobPending, entities.ExportJobRunning}},
+		"updated_at": bson.M{"$gt": time.Now().Add(-staleAfter)},
+	}
+
+	count, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return false, wrapError("erro ao verificar exportações em andamento: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// ClaimNext marca como em andamento a exportação pendente mais antiga e a
+// retorna (sem o arquivo). Exportações em andamento paradas há mais de
+// staleAfter (ex.: processo encerrado à força) são retomadas. Retorna
+// ErrExportJobNotFound se a fila estiver vazia.
+func (r *exportJobRepository) ClaimNext(ctx context.Context, staleAfter time.Duration) (*entities.ExportJob, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	now := time.Now()
+	filter := bson.M{
+		"$or": []bson.M{
+			{"status": entities.ExportJobPending},
+			{"status": entities.ExportJobRunning, "updated_at": bson.M{"$lte": now.Add(-staleAfter)}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"status": entities.ExportJobRunning, "updated_at": now}}
+
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetProjection(exportJobWithoutFile).
+		SetReturnDocument(options.After)
+
+	var job entities.ExportJob
+	if err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&job); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrExportJobNotFound
+		}
+		return nil, wrapError("erro ao obter exportação pendente: %w", err)
+	}
+
+	return &job, nil
+}
+
+// Save grava o estado atual da exportação (resultado e arquivo gerado)
+func (r *exportJobRepository) Save(ctx context.Context, job *entities.ExportJob) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	job.UpdatedAt = time.Now()
+
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": job.ID}, job)
+	if err != nil {
+		return wrapError("erro ao salvar exportação: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrExportJobNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"fmt"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/fieldcrypt"
+)
+
+// fieldEncryption cifra ao gravar e decifra ao ler os campos sensíveis das
+// tarefas: descrição e nome/tipo dos anexos. Com a criptografia ativa a busca
+// por texto deixa de encontrar termos da descrição (só o título é buscável).
+type fieldEncryption struct {
+	cipher fieldcrypt.Cipher
+}
+
+func newFieldEncryption(db database.Client) fieldEncryption {
+	return fieldEncryption{cipher: db.FieldCipher()}
+}
+
+// encrypt cifra um valor isolado (ex.: campos de PatchFields)
+func (f fieldEncryption) encrypt(value string) (string, error) {
+	encrypted, err := f.cipher.Encrypt(value)
+	if err != nil {
+		return "", fmt.Errorf("erro ao cifrar campo: %w", err)
+	}
+	return encrypted, nil
+}
+
+// decrypt decifra um valor isolado
+func (f fieldEncryption) decrypt(value string) (string, error) {
+	decrypted, err := f.cipher.Decrypt(value)
+	if err != nil {
+		return "", fmt.Errorf("erro ao decifrar campo: %w", err)
+	}
+	return decrypted, nil
+}
+
+// sealTask retorna uma cópia da tarefa com os campos sensíveis cifrados,
+// sem alterar a tarefa recebida (que segue em claro para quem chamou)
+func (f fieldEncryption) sealTask(task *entities.Task) (*entities.Task, error) {
+	if !f.cipher.Enabled() {
+		return task, nil
+	}
+
+	sealed := *task
+	var err error
+	if sealed.Description, err = f.encrypt(task.Description); err != nil {
+		return nil, err
+	}
+	if sealed.Attachments, err = f.sealAttachments(task.Attachments); err != nil {
+		return nil, err
+	}
+
+	return &sealed, nil
+}
+
+// sealAttachments retorna uma cópia dos anexos com nome e tipo cifrados
+func (f fieldEncryption) sealAttachments(attachments []entities.TaskAttachment) ([]entities.TaskAttachment, error) {
+	if !f.cipher.Enabled() || len(attachments) == 0 {
+		return attachments, nil
+	}
+
+	sealed := make([]entities.TaskAttachment, len(attachments))
+	for i, attachment := range attachments {
+		var err error
+		if attachment.Filename, err = f.encrypt(attachment.Filename); err != nil {
+			return nil, err
+		}
+		if attachment.ContentType, err = f.encrypt(attachment.ContentType); err != nil {
+			return nil, err
+		}
+		sealed[i] = attachment
+	}
+
+	return sealed, nil
+}
+
+// openTask decifra no lugar os campos sensíveis da tarefa lida do banco.
+// Valores gravados antes da criptografia ser ativada são mantidos.
+func (f fieldEncryption) openTask(task *entities.Task) error {
+	var err error
+	if task.Description, err = f.decrypt(task.Description); err != nil {
+		return err
+	}
+
+	for i := range task.Attachments {
+		attachment := &task.Attachments[i]
+		if attachment.Filename, err = f.decrypt(attachment.Filename); err != nil {
+			return err
+		}
+		if attachment.ContentType, err = f.decrypt(attachment.ContentType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// openTasks decifra uma lista de tarefas lidas do banco
+func (f fieldEncryption) openTasks(tasks []*entities.Task) error {
+	for _, task := range tasks {
+		if err := f.openTask(task); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// FocusDay é o tempo de foco de um dia, no fuso informado na consulta
+type FocusDay struct {
+	Date     time.Time `bson:"_id"`
+	Seconds  int64     `bson:"seconds"`
+	Sessions int64     `bson:"sessions"`
+}
+
+// FocusSessionRepository interface define os métodos do repositório de sessões de foco
+type FocusSessionRepository interface {
+	Create(ctx context.Context, session *entities.FocusSession) error
+	GetActive(ctx context.Context, userID primitive.ObjectID) (*entities.FocusSession, error)
+	Finish(ctx context.Context, session *entities.FocusSession) error
+	DailyTotals(ctx context.Context, userID primitive.ObjectID, since time.Time, timezone string) ([]FocusDay, error)
+}
+
+// focusSessionRepository implementa FocusSessionRepository
+type focusSessionRepository struct {
+	operationTimeouts
+	collection *mongo.Collection
+}
+
+// NewFocusSessionRepository cria uma nova instância do repositório
+func NewFocusSessionRepository(db database.Client) FocusSessionRepository {
+	return &focusSessionRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		collection:        db.Collections().FocusSessions,
+	}
+}
+
+// Create grava uma nova sessão em andamento. Retorna ErrFocusSessionActive se
+// o usuário já tiver uma (índice único parcial em active).
+func (r *focusSessionRepository) Create(ctx context.Context, session *entities.FocusSession) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	if _, err := r.collection.InsertOne(ctx, session); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrFocusSessionActive
+		}
+		return wrapError("erro ao criar sessão de foco: %w", err)
+	}
+
+	return nil
+}
+
+// GetActive busca a sessão em andamento do usuário
+func (r *focusSessionRepository) GetActive(ctx context.Context, userID primitive.ObjectID) (*entities.FocusSession, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	var session entities.FocusSession
+	err := r.collection.FindOne(ctx, bson.M{"user_id": userID, "active": true}).Decode(&session)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrFocusSessionNotFound
+	}
+	if err != nil {
+		return nil, wrapError("erro ao buscar sessão de foco: %w", err)
+	}
+
+	return &session, nil
+}
+
+// Finish grava o encerramento da sessão (já preparado com Finish). Retorna
+// ErrFocusSessionNotFound se ela já tiver sido encerrada por outra requisição.
+func (r *focusSessionRepository) Finish(ctx context.Context, session *entities.FocusSession) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"_id": session.ID, "user_id": session.UserID, "active": true}
+	update := bson.M{
+		"$set": bson.M{
+			"active":           false,
+			"ended_at":         session.EndedAt,
+			"duration_seconds": session.DurationSeconds,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return wrapError("erro ao encerrar sessão de foco: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrFocusSessionNotFound
+	}
+
+	return nil
+}
+
+// DailyTotals soma o tempo das sessões encerradas iniciadas desde since,
+// agrupadas pelo dia de início no fuso informado. Dias sem sessões não são retornados.
+func (r *focusSessionRepository) DailyTotals(ctx context.Context, userID primitive.ObjectID, since time.Time, timezone string) ([]FocusDay, error) {
+	ctx, cancel := r.aggregateContext(ctx)
+	defer cancel()
+
+	pipeline := []bson.M{
+		{"$match": bson.M{
+			"user_id":    userID,
+			"active":     false,
+			"started_at": bson.M{"$gte": since},
+		}},
+		{"$group": bson.M{
+			"_id":      bson.M{"$dateTrunc": bson.M{"date": "$started_at", "unit": "day", "timezone": timezone}},
+			"seconds":  bson.M{"$sum": "$duration_seconds"},
+			"sessions": bson.M{"$sum": 1},
+		}},
+		{"$sort": bson.M{"_id": 1}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, wrapError("erro ao obter tempo de foco diário: %w", err)
+	}
+
+	var days []FocusDay
+	if err := cursor.All(ctx, &days); err != nil {
+		return nil, wrapError("erro ao decodificar tempo de foco diário: %w", err)
+	}
+
+	return days, nil
+}
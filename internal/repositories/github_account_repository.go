@@ -0,0 +1,229 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// GitHubAccountRepository interface define os métodos do repositório de contas do GitHub
+type GitHubAccountRepository interface {
+	SaveState(ctx context.Context, userID primitive.ObjectID, stateHash string, expiresAt time.Time) error
+	ConsumeState(ctx context.Context, userID primitive.ObjectID, stateHash string) error
+	SetToken(ctx context.Context, userID primitive.ObjectID, login, token, scopes string) error
+	GetByUserID(ctx context.Context, userID primitive.ObjectID) (*entities.GitHubAccount, error)
+	ListByRepo(ctx context.Context, fullName string) ([]*entities.GitHubAccount, error)
+	SaveRepo(ctx context.Context, userID primitive.ObjectID, repo entities.GitHubRepo) error
+	RemoveRepo(ctx context.Context, userID primitive.ObjectID, fullName string) error
+	Delete(ctx context.Context, userID primitive.ObjectID) error
+}
+
+// githubAccountRepository implementa GitHubAccountRepository
+type githubAccountRepository struct {
+	operationTimeouts
+	collection *mongo.Collection
+}
+
+// NewGitHubAccountRepository cria uma nova instância do repositório
+func NewGitHubAccountRepository(db database.Client) GitHubAccountRepository {
+	return &githubAccountRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		collection:        db.Collections().GitHubAccounts,
+	}
+}
+
+// SaveState grava o state do fluxo OAuth em andamento, substituindo o anterior
+func (r *githubAccountRepository) SaveState(ctx context.Context, userID primitive.ObjectID, stateHash string, expiresAt time.Time) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	now := time.Now()
+	update := bson.M{
+		"$set": bson.M{
+			"state_hash":       stateHash,
+			"state_expires_at": expiresAt,
+			"updated_at":       now,
+		},
+		"$setOnInsert": bson.M{
+			"_id":        primitive.NewObjectID(),
+			"created_at": now,
+		},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{"user_id": userID}, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return wrapError("erro ao salvar state do GitHub: %w", err)
+	}
+
+	return nil
+}
+
+// ConsumeState valida e descarta o state (uso único, dentro da validade)
+func (r *githubAccountRepository) ConsumeState(ctx context.Context, userID primitive.ObjectID, stateHash string) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{
+		"user_id":          userID,
+		"state_hash":       stateHash,
+		"state_expires_at": bson.M{"$gt": time.Now()},
+	}
+	update := bson.M{
+		"$unset": bson.M{"state_hash": "", "state_expires_at": ""},
+		"$set":   bson.M{"updated_at": time.Now()},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return wrapError("erro ao validar state do GitHub: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrGitHubAccountNotFound
+	}
+
+	return nil
+}
+
+// SetToken conclui a conexão com o token obtido no fluxo OAuth
+func (r *githubAccountRepository) SetToken(ctx context.Context, userID primitive.ObjectID, login, token, scopes string) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	now := time.Now()
+	update := bson.M{
+		"$set": bson.M{
+			"login":        login,
+			"access_token": token,
+			"scopes":       scopes,
+			"connected_at": now,
+			"updated_at":   now,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"user_id": userID}, update)
+	if err != nil {
+		return wrapError("erro ao salvar conexão com o GitHub: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrGitHubAccountNotFound
+	}
+
+	return nil
+}
+
+// GetByUserID busca a conta do GitHub do usuário
+func (r *githubAccountRepository) GetByUserID(ctx context.Context, userID primitive.ObjectID) (*entities.GitHubAccount, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	var account entities.GitHubAccount
+	if err := r.collection.FindOne(ctx, bson.M{"user_id": userID}).Decode(&account); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrGitHubAccountNotFound
+		}
+		return nil, wrapError("erro ao buscar conta do GitHub: %w", err)
+	}
+
+	return &account, nil
+}
+
+// ListByRepo lista as contas conectadas que vincularam o repositório
+func (r *githubAccountRepository) ListByRepo(ctx context.Context, fullName string) ([]*entities.GitHubAccount, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	filter := bson.M{
+		"repos.full_name": strings.ToLower(fullName),
+		"access_token":    bson.M{"$exists": true},
+	}
+
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, wrapError("erro ao listar contas do repositório: %w", err)
+	}
+
+	var accounts []*entities.GitHubAccount
+	if err := cursor.All(ctx, &accounts); err != nil {
+		return nil, wrapError("erro ao decodificar contas do GitHub: %w", err)
+	}
+
+	return accounts, nil
+}
+
+// SaveRepo vincula o repositório à conta, substituindo o vínculo anterior do mesmo repositório
+func (r *githubAccountRepository) SaveRepo(ctx context.Context, userID primitive.ObjectID, repo entities.GitHubRepo) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	repo.FullName = strings.ToLower(repo.FullName)
+
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"user_id": userID},
+		bson.M{"$pull": bson.M{"repos": bson.M{"full_name": repo.FullName}}}); err != nil {
+		return wrapError("erro ao vincular repositório: %w", err)
+	}
+
+	update := bson.M{
+		"$push": bson.M{"repos": repo},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"user_id": userID}, update)
+	if err != nil {
+		return wrapError("erro ao vincular repositório: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrGitHubAccountNotFound
+	}
+
+	return nil
+}
+
+// RemoveRepo desvincula o repositório da conta
+func (r *githubAccountRepository) RemoveRepo(ctx context.Context, userID primitive.ObjectID, fullName string) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	update := bson.M{
+		"$pull": bson.M{"repos": bson.M{"full_name": strings.ToLower(fullName)}},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"user_id": userID}, update)
+	if err != nil {
+		return wrapError("erro ao desvincular repositório: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrGitHubAccountNotFound
+	}
+
+	return nil
+}
+
+// Delete remove a conexão do usuário com o GitHub
+func (r *githubAccountRepository) Delete(ctx context.Context, userID primitive.ObjectID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	result, err := r.collection.DeleteOne(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return wrapError("erro ao remover conta do GitHub: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return ErrGitHubAccountNotFound
+	}
+
+	return nil
+}
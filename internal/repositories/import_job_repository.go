@@ -0,0 +1,102 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ImportJobRepository interface define os métodos do repositório de importações
+type ImportJobRepository interface {
+	Create(ctx context.Context, job *entities.ImportJob) error
+	GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.ImportJob, error)
+	HasActive(ctx context.Context, userID primitive.ObjectID, staleAfter time.Duration) (bool, error)
+	Save(ctx context.Context, job *entities.ImportJob) error
+}
+
+// importJobRepository implementa ImportJobRepository
+type importJobRepository struct {
+	operationTimeouts
+	collection *mongo.Collection
+}
+
+// NewImportJobRepository cria uma nova instância do repositório
+func NewImportJobRepository(db database.Client) ImportJobRepository {
+	return &importJobRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		collection:        db.Collections().ImportJobs,
+	}
+}
+
+// Create registra uma nova importação
+func (r *importJobRepository) Create(ctx context.Context, job *entities.ImportJob) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	if _, err := r.collection.InsertOne(ctx, job); err != nil {
+		return wrapError("erro ao criar importação: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID busca uma importação do usuário por ID
+func (r *importJobRepository) GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.ImportJob, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	var job entities.ImportJob
+	if err := r.collection.FindOne(ctx, ownedFilter(userID, id)).Decode(&job); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrImportJobNotFound
+		}
+		return nil, wrapError("erro ao buscar importação: %w", err)
+	}
+
+	return &job, nil
+}
+
+// HasActive verifica se o usuário tem importação em andamento. Importações sem
+// progresso há mais de staleAfter (ex.: processo encerrado à força) são ignoradas.
+func (r *importJobRepository) HasActive(ctx context.Context, userID primitive.ObjectID, staleAfter time.Duration) (bool, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	filter := bson.M{
+		"user_id":    userID,
+		"status":     bson.M{"$in": []entities.ImportJobStatus{entities.ImportJobPending, entities.ImportJobRunning}},
+		"updated_at": bson.M{"$gt": time.Now().Add(-staleAfter)},
+	}
+
+	count, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return false, wrapError("erro ao verificar importações em andamento: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// Save grava o estado atual da importação (progresso, avisos e resultado)
+func (r *importJobRepository) Save(ctx context.Context, job *entities.ImportJob) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	job.UpdatedAt = time.Now()
+
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": job.ID}, job)
+	if err != nil {
+		return wrapError("erro ao salvar importação: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrImportJobNotFound
+	}
+
+	return nil
+}
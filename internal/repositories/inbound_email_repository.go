@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// InboundEmailRepository interface define o registro de emails já convertidos em tarefa
+type InboundEmailRepository interface {
+	Claim(ctx context.Context, messageID string, userID primitive.ObjectID) (bool, error)
+	SetTask(ctx context.Context, messageID string, taskID primitive.ObjectID) error
+	Release(ctx context.Context, messageID string) error
+}
+
+// inboundEmailRepository implementa InboundEmailRepository
+type inboundEmailRepository struct {
+	operationTimeouts
+	collection *mongo.Collection
+}
+
+// NewInboundEmailRepository cria uma nova instância do repositório
+func NewInboundEmailRepository(db database.Client) InboundEmailRepository {
+	return &inboundEmailRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		collection:        db.Collections().InboundEmails,
+	}
+}
+
+// Claim reserva o Message-ID para processamento. Retorna false se o email já
+// foi (ou está sendo) processado.
+func (r *inboundEmailRepository) Claim(ctx context.Context, messageID string, userID primitive.ObjectID) (bool, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	record := &entities.InboundEmail{
+		ID:         primitive.NewObjectID(),
+		MessageID:  messageID,
+		UserID:     userID,
+		ReceivedAt: time.Now(),
+	}
+
+	if _, err := r.collection.InsertOne(ctx, record); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, wrapError("erro ao registrar email recebido: %w", err)
+	}
+
+	return true, nil
+}
+
+// SetTask associa a tarefa criada ao email
+func (r *inboundEmailRepository) SetTask(ctx context.Context, messageID string, taskID primitive.ObjectID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{"message_id": messageID}, bson.M{"$set": bson.M{"task_id": taskID}})
+	if err != nil {
+		return wrapError("erro ao atualizar email recebido: %w", err)
+	}
+
+	return nil
+}
+
+// Release libera o Message-ID quando a tarefa não pôde ser criada, permitindo nova tentativa
+func (r *inboundEmailRepository) Release(ctx context.Context, messageID string) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	if _, err := r.collection.DeleteOne(ctx, bson.M{"message_id": messageID}); err != nil {
+		return wrapError("erro ao liberar email recebido: %w", err)
+	}
+
+	return nil
+}
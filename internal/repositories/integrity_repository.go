@@ -0,0 +1,310 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// IntegrityScan é o resultado de uma verificação de integridade
+type IntegrityScan struct {
+	Collection string
+	Count      int64
+	// Sample são alguns dos documentos afetados, para inspeção
+	Sample []primitive.ObjectID
+}
+
+// IntegrityRepository interface define a verificação e o reparo de
+// inconsistências referenciais (entities.IntegrityChecks)
+type IntegrityRepository interface {
+	Scan(ctx context.Context, check string, sampleSize int64) (*IntegrityScan, error)
+	Repair(ctx context.Context, check string) (int64, error)
+}
+
+// integrityRepository implementa IntegrityRepository
+type integrityRepository struct {
+	operationTimeouts
+	users    *mongo.Collection
+	projects *mongo.Collection
+	tasks    *mongo.Collection
+	archive  *mongo.Collection
+}
+
+// NewIntegrityRepository cria uma nova instância do repositório
+func NewIntegrityRepository(db database.Client) IntegrityRepository {
+	collections := db.Collections()
+
+	return &integrityRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		users:             collections.Users,
+		projects:          collections.Projects,
+		tasks:             collections.Tasks,
+		archive:           collections.TasksArchive,
+	}
+}
+
+// issues retorna a collection e o filtro dos documentos afetados pela verificação
+func (r *integrityRepository) issues(ctx context.Context, check string) (*mongo.Collection, bson.M, error) {
+	switch check {
+	case entities.IntegrityOrphanTasks:
+		missing, err := r.missingReferences(ctx, "user_id", r.users)
+		if err != nil {
+			return nil, nil, err
+		}
+		return r.tasks, bson.M{"user_id": bson.M{"$in": missing}}, nil
+	case entities.IntegrityMissingProject:
+		missing, err := r.missingReferences(ctx, "project_id", r.projects)
+		if err != nil {
+			return nil, nil, err
+		}
+		return r.tasks, bson.M{"project_id": bson.M{"$in": missing}}, nil
+	case entities.IntegrityCompletedWithoutDate:
+		return r.tasks, bson.M{"status": enums.StatusCompleted, "completed_at": nil}, nil
+	case entities.IntegrityDateWithoutCompletion:
+		return r.tasks, bson.M{"status": bson.M{"$ne": enums.StatusCompleted}, "completed_at": bson.M{"$ne": nil}}, nil
+	case entities.IntegrityArchivedDuplicates:
+		duplicates, err := r.archivedDuplicates(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		return r.archive, bson.M{"_id": bson.M{"$in": duplicates}}, nil
+	case entities.IntegrityArchivedNotCompleted:
+		return r.archive, bson.M{"status": bson.M{"$ne": enums.StatusCompleted}}, nil
+	default:
+		return nil, nil, fmt.Errorf("verificação de integridade desconhecida: %s", check)
+	}
+}
+
+// missingReferences retorna os valores de field nas tarefas que não
+// correspondem a nenhum documento de target
+func (r *integrityRepository) missingReferences(ctx context.Context, field string, target *mongo.Collection) ([]primitive.ObjectID, error) {
+	ctx, cancel := r.aggregateContext(ctx)
+	defer cancel()
+
+	values, err := r.tasks.Distinct(ctx, field, bson.M{field: bson.M{"$type": "objectId"}})
+	if err != nil {
+		return nil, wrapError("erro ao listar referências de %s: %w", field, err)
+	}
+
+	referenced := make([]primitive.ObjectID, 0, len(values))
+	for _, value := range values {
+		if id, ok := value.(primitive.ObjectID); ok {
+			referenced = append(referenced, id)
+		}
+	}
+
+	missing := []primitive.ObjectID{}
+	if len(referenced) == 0 {
+		return missing, nil
+	}
+
+	cursor, err := target.Find(ctx, bson.M{"_id": bson.M{"$in": referenced}}, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, wrapError("erro ao verificar referências de %s: %w", field, err)
+	}
+
+	var found []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &found); err != nil {
+		return nil, wrapError("erro ao decodificar referências de %s: %w", field, err)
+	}
+
+	existing := make(map[primitive.ObjectID]bool, len(found))
+	for _, doc := range found {
+		existing[doc.ID] = true
+	}
+
+	for _, id := range referenced {
+		if !existing[id] {
+			missing = append(missing, id)
+		}
+	}
+
+	return missing, nil
+}
+
+// archivedDuplicates retorna as tarefas do histórico que ainda existem entre as
+// ativas (cópia de um arquivamento interrompido ou tarefa reaberta depois dele)
+func (r *integrityRepository) archivedDuplicates(ctx context.Context) ([]primitive.ObjectID, error) {
+	ctx, cancel := r.aggregateContext(ctx)
+	defer cancel()
+
+	pipeline := []bson.M{
+		{"$project": bson.M{"_id": 1}},
+		{"$lookup": bson.M{
+			"from":         r.tasks.Name(),
+			"localField":   "_id",
+			"foreignField": "_id",
+			"as":           "active",
+		}},
+		{"$match": bson.M{"active": bson.M{"$ne": bson.A{}}}},
+		{"$project": bson.M{"_id": 1}},
+	}
+
+	cursor, err := r.archive.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, wrapError("erro ao buscar duplicatas do histórico: %w", err)
+	}
+
+	var docs []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, wrapError("erro ao decodificar duplicatas do histórico: %w", err)
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(docs))
+	for _, doc := range docs {
+		ids = append(ids, doc.ID)
+	}
+
+	return ids, nil
+}
+
+// Scan conta os documentos afetados pela verificação e retorna uma amostra deles
+func (r *integrityRepository) Scan(ctx context.Context, check string, sampleSize int64) (*IntegrityScan, error) {
+	collection, filter, err := r.issues(ctx, check)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := r.aggregateContext(ctx)
+	defer cancel()
+
+	count, err := collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, wrapError("erro ao contar inconsistências (%s): %w", check, err)
+	}
+
+	scan := &IntegrityScan{Collection: collection.Name(), Count: count, Sample: []primitive.ObjectID{}}
+	if count == 0 || sampleSize <= 0 {
+		return scan, nil
+	}
+
+	opts := options.Find().SetProjection(bson.M{"_id": 1}).SetLimit(sampleSize)
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, wrapError("erro ao buscar inconsistências (%s): %w", check, err)
+	}
+
+	var docs []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, wrapError("erro ao decodificar inconsistências (%s): %w", check, err)
+	}
+
+	for _, doc := range docs {
+		scan.Sample = append(scan.Sample, doc.ID)
+	}
+
+	return scan, nil
+}
+
+// Repair corrige os documentos afetados pela verificação e retorna quantos foram corrigidos:
+//   - tarefas órfãs são removidas (o dono não existe mais para acessá-las);
+//   - o projeto inexistente é desvinculado da tarefa;
+//   - completed_at é preenchido (com updated_at) ou removido conforme o status;
+//   - a cópia do histórico de uma tarefa ainda ativa é removida;
+//   - tarefas não concluídas do histórico voltam às ativas, arquivadas.
+func (r *integrityRepository) Repair(ctx context.Context, check string) (int64, error) {
+	collection, filter, err := r.issues(ctx, check)
+	if err != nil {
+		return 0, err
+	}
+
+	if check == entities.IntegrityArchivedNotCompleted {
+		return r.restoreArchived(ctx, filter)
+	}
+
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	var affected int64
+	switch check {
+	case entities.IntegrityOrphanTasks, entities.IntegrityArchivedDuplicates:
+		var result *mongo.DeleteResult
+		result, err = collection.DeleteMany(ctx, filter)
+		if result != nil {
+			affected = result.DeletedCount
+		}
+	default:
+		var result *mongo.UpdateResult
+		result, err = collection.UpdateMany(ctx, filter, r.repairUpdate(check))
+		if result != nil {
+			affected = result.ModifiedCount
+		}
+	}
+	if err != nil {
+		return 0, wrapError("erro ao reparar inconsistências (%s): %w", check, err)
+	}
+
+	return affected, nil
+}
+
+// repairUpdate retorna a atualização que corrige as tarefas da verificação
+func (r *integrityRepository) repairUpdate(check string) interface{} {
+	switch check {
+	case entities.IntegrityMissingProject:
+		return bson.M{
+			"$unset": bson.M{"project_id": ""},
+			"$set":   bson.M{"updated_at": time.Now()},
+		}
+	case entities.IntegrityCompletedWithoutDate:
+		return bson.A{bson.M{"$set": bson.M{"completed_at": bson.M{"$ifNull": bson.A{"$updated_at", "$$NOW"}}}}}
+	default: // entities.IntegrityDateWithoutCompletion
+		return bson.M{"$unset": bson.M{"completed_at": ""}}
+	}
+}
+
+// restoreArchived devolve às tarefas ativas, arquivadas, as tarefas do
+// histórico que casam com filter, removendo-as do histórico
+func (r *integrityRepository) restoreArchived(ctx context.Context, filter bson.M) (int64, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	cursor, err := r.archive.Find(ctx, filter)
+	if err != nil {
+		return 0, wrapError("erro ao buscar tarefas do histórico: %w", err)
+	}
+
+	var docs []bson.M
+	if err := cursor.All(ctx, &docs); err != nil {
+		return 0, wrapError("erro ao decodificar tarefas do histórico: %w", err)
+	}
+
+	if len(docs) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]interface{}, 0, len(docs))
+	inserts := make([]interface{}, 0, len(docs))
+	for _, doc := range docs {
+		delete(doc, "moved_at")
+		doc["is_archived"] = true
+		ids = append(ids, doc["_id"])
+		inserts = append(inserts, doc)
+	}
+
+	// Tarefas que já existem entre as ativas geram chave duplicada e mantêm a versão ativa
+	_, err = r.tasks.InsertMany(ctx, inserts, options.InsertMany().SetOrdered(false))
+	if err != nil && !isOnlyDuplicateKeyError(err) {
+		return 0, wrapError("erro ao restaurar tarefas do histórico: %w", err)
+	}
+
+	result, err := r.archive.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return 0, wrapError("erro ao remover tarefas restauradas do histórico: %w", err)
+	}
+
+	return result.DeletedCount, nil
+}
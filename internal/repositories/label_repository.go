@@ -0,0 +1,160 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// LabelRepository interface define os métodos do repositório de etiquetas gerenciadas
+type LabelRepository interface {
+	Create(ctx context.Context, label *entities.Label) error
+	List(ctx context.Context, userID primitive.ObjectID) ([]*entities.Label, error)
+	Count(ctx context.Context, userID primitive.ObjectID) (int64, error)
+	GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.Label, error)
+	GetByIDs(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID) ([]*entities.Label, error)
+	Update(ctx context.Context, label *entities.Label) error
+	Delete(ctx context.Context, userID, id primitive.ObjectID) error
+}
+
+// labelRepository implementa LabelRepository
+type labelRepository struct {
+	operationTimeouts
+	collection *mongo.Collection
+}
+
+// NewLabelRepository cria uma nova instância do repositório
+func NewLabelRepository(db database.Client) LabelRepository {
+	return &labelRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		collection:        db.Collections().Labels,
+	}
+}
+
+// Create grava uma nova etiqueta do usuário
+func (r *labelRepository) Create(ctx context.Context, label *entities.Label) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	if _, err := r.collection.InsertOne(ctx, label); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrLabelExists
+		}
+		return wrapError("erro ao criar etiqueta: %w", err)
+	}
+
+	return nil
+}
+
+// List retorna as etiquetas do usuário em ordem alfabética
+func (r *labelRepository) List(ctx context.Context, userID primitive.ObjectID) ([]*entities.Label, error) {
+	return r.find(ctx, bson.M{"user_id": userID})
+}
+
+// Count retorna o número de etiquetas do usuário
+func (r *labelRepository) Count(ctx context.Context, userID primitive.ObjectID) (int64, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	count, err := r.collection.CountDocuments(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return 0, wrapError("erro ao contar etiquetas: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetByID busca uma etiqueta do usuário por ID
+func (r *labelRepository) GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.Label, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	var label entities.Label
+	if err := r.collection.FindOne(ctx, ownedFilter(userID, id)).Decode(&label); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrLabelNotFound
+		}
+		return nil, wrapError("erro ao buscar etiqueta: %w", err)
+	}
+
+	return &label, nil
+}
+
+// GetByIDs busca as etiquetas do usuário com os IDs informados; IDs
+// inexistentes ou de outro usuário são ignorados
+func (r *labelRepository) GetByIDs(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID) ([]*entities.Label, error) {
+	return r.find(ctx, bson.M{"user_id": userID, "_id": bson.M{"$in": ids}})
+}
+
+// Update altera nome, cor e ícone da etiqueta do usuário
+func (r *labelRepository) Update(ctx context.Context, label *entities.Label) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	label.PrepareForUpdate()
+
+	update := bson.M{
+		"$set": bson.M{
+			"name":       label.Name,
+			"color":      label.Color,
+			"icon":       label.Icon,
+			"updated_at": label.UpdatedAt,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, ownedFilter(label.UserID, label.ID), update)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrLabelExists
+		}
+		return wrapError("erro ao atualizar etiqueta: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrLabelNotFound
+	}
+
+	return nil
+}
+
+// Delete remove a etiqueta do usuário
+func (r *labelRepository) Delete(ctx context.Context, userID, id primitive.ObjectID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	result, err := r.collection.DeleteOne(ctx, ownedFilter(userID, id))
+	if err != nil {
+		return wrapError("erro ao remover etiqueta: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return ErrLabelNotFound
+	}
+
+	return nil
+}
+
+func (r *labelRepository) find(ctx context.Context, filter bson.M) ([]*entities.Label, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, wrapError("erro ao listar etiquetas: %w", err)
+	}
+
+	labels := []*entities.Label{}
+	if err := cursor.All(ctx, &labels); err != nil {
+		return nil, wrapError("erro ao decodificar etiquetas: %w", err)
+	}
+
+	return labels, nil
+}
@@ -0,0 +1,31 @@
+package repositories
+
+// Mocks (gomock) das interfaces de repositório, para testes de serviços e
+// handlers sem banco. Regenerar com: go generate ./internal/repositories/
+//go:generate go run go.uber.org/mock/mockgen -source=account_closure_repository.go -destination=mocks/account_closure_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=app_password_repository.go -destination=mocks/app_password_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=attachment_repository.go -destination=mocks/attachment_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=audit_log_repository.go -destination=mocks/audit_log_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=export_job_repository.go -destination=mocks/export_job_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=focus_session_repository.go -destination=mocks/focus_session_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=github_account_repository.go -destination=mocks/github_account_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=import_job_repository.go -destination=mocks/import_job_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=inbound_email_repository.go -destination=mocks/inbound_email_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=integrity_repository.go -destination=mocks/integrity_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=label_repository.go -destination=mocks/label_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=notification_repository.go -destination=mocks/notification_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=org_usage_repository.go -destination=mocks/org_usage_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=organization_repository.go -destination=mocks/organization_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=platform_metrics_repository.go -destination=mocks/platform_metrics_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=project_repository.go -destination=mocks/project_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=retention_repository.go -destination=mocks/retention_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=subscription_repository.go -destination=mocks/subscription_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=task_archive_repository.go -destination=mocks/task_archive_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=task_change_repository.go -destination=mocks/task_change_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=task_repository.go -destination=mocks/task_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=telegram_link_repository.go -destination=mocks/telegram_link_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=tombstone_repository.go -destination=mocks/tombstone_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=usage_repository.go -destination=mocks/usage_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=user_repository.go -destination=mocks/user_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=view_settings_repository.go -destination=mocks/view_settings_repository.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=webhook_delivery_repository.go -destination=mocks/webhook_delivery_repository.go -package=mocks
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: account_closure_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=account_closure_repository.go -destination=mocks/account_closure_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAccountClosureRepository is a mock of AccountClosureRepository interface.
+type MockAccountClosureRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountClosureRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAccountClosureRepositoryMockRecorder is the mock recorder for MockAccountClosureRepository.
+type MockAccountClosureRepositoryMockRecorder struct {
+	mock *MockAccountClosureRepository
+}
+
+// NewMockAccountClosureRepository creates a new mock instance.
+func NewMockAccountClosureRepository(ctrl *gomock.Controller) *MockAccountClosureRepository {
+	mock := &MockAccountClosureRepository{ctrl: ctrl}
+	mock.recorder = &MockAccountClosureRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountClosureRepository) EXPECT() *MockAccountClosureRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Anonymize mocks base method.
+func (m *MockAccountClosureRepository) Anonymize(ctx context.Context, userID primitive.ObjectID, placeholderEmail string) ([]primitive.ObjectID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Anonymize", ctx, userID, placeholderEmail)
+	ret0, _ := ret[0].([]primitive.ObjectID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Anonymize indicates an expected call of Anonymize.
+func (mr *MockAccountClosureRepositoryMockRecorder) Anonymize(ctx, userID, placeholderEmail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Anonymize", reflect.TypeOf((*MockAccountClosureRepository)(nil).Anonymize), ctx, userID, placeholderEmail)
+}
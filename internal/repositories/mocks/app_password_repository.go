@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: app_password_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=app_password_repository.go -destination=mocks/app_password_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAppPasswordRepository is a mock of AppPasswordRepository interface.
+type MockAppPasswordRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAppPasswordRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAppPasswordRepositoryMockRecorder is the mock recorder for MockAppPasswordRepository.
+type MockAppPasswordRepositoryMockRecorder struct {
+	mock *MockAppPasswordRepository
+}
+
+// NewMockAppPasswordRepository creates a new mock instance.
+func NewMockAppPasswordRepository(ctrl *gomock.Controller) *MockAppPasswordRepository {
+	mock := &MockAppPasswordRepository{ctrl: ctrl}
+	mock.recorder = &MockAppPasswordRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAppPasswordRepository) EXPECT() *MockAppPasswordRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByUser mocks base method.
+func (m *MockAppPasswordRepository) CountByUser(ctx context.Context, userID primitive.ObjectID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByUser", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByUser indicates an expected call of CountByUser.
+func (mr *MockAppPasswordRepositoryMockRecorder) CountByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByUser", reflect.TypeOf((*MockAppPasswordRepository)(nil).CountByUser), ctx, userID)
+}
+
+// Create mocks base method.
+func (m *MockAppPasswordRepository) Create(ctx context.Context, password *entities.AppPassword) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAppPasswordRepositoryMockRecorder) Create(ctx, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAppPasswordRepository)(nil).Create), ctx, password)
+}
+
+// Delete mocks base method.
+func (m *MockAppPasswordRepository) Delete(ctx context.Context, userID, id primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAppPasswordRepositoryMockRecorder) Delete(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAppPasswordRepository)(nil).Delete), ctx, userID, id)
+}
+
+// GetByTokenHash mocks base method.
+func (m *MockAppPasswordRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*entities.AppPassword, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByTokenHash", ctx, tokenHash)
+	ret0, _ := ret[0].(*entities.AppPassword)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByTokenHash indicates an expected call of GetByTokenHash.
+func (mr *MockAppPasswordRepositoryMockRecorder) GetByTokenHash(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTokenHash", reflect.TypeOf((*MockAppPasswordRepository)(nil).GetByTokenHash), ctx, tokenHash)
+}
+
+// ListByUser mocks base method.
+func (m *MockAppPasswordRepository) ListByUser(ctx context.Context, userID primitive.ObjectID) ([]*entities.AppPassword, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUser", ctx, userID)
+	ret0, _ := ret[0].([]*entities.AppPassword)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUser indicates an expected call of ListByUser.
+func (mr *MockAppPasswordRepositoryMockRecorder) ListByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockAppPasswordRepository)(nil).ListByUser), ctx, userID)
+}
+
+// TouchLastUsed mocks base method.
+func (m *MockAppPasswordRepository) TouchLastUsed(ctx context.Context, id primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchLastUsed", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchLastUsed indicates an expected call of TouchLastUsed.
+func (mr *MockAppPasswordRepositoryMockRecorder) TouchLastUsed(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchLastUsed", reflect.TypeOf((*MockAppPasswordRepository)(nil).TouchLastUsed), ctx, id)
+}
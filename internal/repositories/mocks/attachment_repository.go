@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: attachment_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=attachment_repository.go -destination=mocks/attachment_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	repositories "github.com/devgugga/todo-it/internal/repositories"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAttachmentRepository is a mock of AttachmentRepository interface.
+type MockAttachmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAttachmentRepositoryMockRecorder is the mock recorder for MockAttachmentRepository.
+type MockAttachmentRepositoryMockRecorder struct {
+	mock *MockAttachmentRepository
+}
+
+// NewMockAttachmentRepository creates a new mock instance.
+func NewMockAttachmentRepository(ctrl *gomock.Controller) *MockAttachmentRepository {
+	mock := &MockAttachmentRepository{ctrl: ctrl}
+	mock.recorder = &MockAttachmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentRepository) EXPECT() *MockAttachmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockAttachmentRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAttachmentRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAttachmentRepository)(nil).Delete), ctx, id)
+}
+
+// Open mocks base method.
+func (m *MockAttachmentRepository) Open(ctx context.Context, userID, id primitive.ObjectID) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", ctx, userID, id)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockAttachmentRepositoryMockRecorder) Open(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockAttachmentRepository)(nil).Open), ctx, userID, id)
+}
+
+// OpenFile mocks base method.
+func (m *MockAttachmentRepository) OpenFile(ctx context.Context, id primitive.ObjectID) (*repositories.AttachmentFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenFile", ctx, id)
+	ret0, _ := ret[0].(*repositories.AttachmentFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenFile indicates an expected call of OpenFile.
+func (mr *MockAttachmentRepositoryMockRecorder) OpenFile(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenFile", reflect.TypeOf((*MockAttachmentRepository)(nil).OpenFile), ctx, id)
+}
+
+// Ping mocks base method.
+func (m *MockAttachmentRepository) Ping(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockAttachmentRepositoryMockRecorder) Ping(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockAttachmentRepository)(nil).Ping), ctx)
+}
+
+// Upload mocks base method.
+func (m *MockAttachmentRepository) Upload(ctx context.Context, userID primitive.ObjectID, filename, contentType string, content io.Reader) (*entities.TaskAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, userID, filename, contentType, content)
+	ret0, _ := ret[0].(*entities.TaskAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockAttachmentRepositoryMockRecorder) Upload(ctx, userID, filename, contentType, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockAttachmentRepository)(nil).Upload), ctx, userID, filename, contentType, content)
+}
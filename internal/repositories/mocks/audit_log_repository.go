@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: audit_log_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=audit_log_repository.go -destination=mocks/audit_log_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	repositories "github.com/devgugga/todo-it/internal/repositories"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuditLogRepository is a mock of AuditLogRepository interface.
+type MockAuditLogRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLogRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditLogRepositoryMockRecorder is the mock recorder for MockAuditLogRepository.
+type MockAuditLogRepositoryMockRecorder struct {
+	mock *MockAuditLogRepository
+}
+
+// NewMockAuditLogRepository creates a new mock instance.
+func NewMockAuditLogRepository(ctrl *gomock.Controller) *MockAuditLogRepository {
+	mock := &MockAuditLogRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditLogRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogRepository) EXPECT() *MockAuditLogRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAuditLogRepository) Create(ctx context.Context, entry *entities.AuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAuditLogRepositoryMockRecorder) Create(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuditLogRepository)(nil).Create), ctx, entry)
+}
+
+// List mocks base method.
+func (m *MockAuditLogRepository) List(ctx context.Context, page, limit int64, filters *repositories.AuditLogFilters) ([]*entities.AuditLog, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, page, limit, filters)
+	ret0, _ := ret[0].([]*entities.AuditLog)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockAuditLogRepositoryMockRecorder) List(ctx, page, limit, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAuditLogRepository)(nil).List), ctx, page, limit, filters)
+}
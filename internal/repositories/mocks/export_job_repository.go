@@ -0,0 +1,147 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: export_job_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=export_job_repository.go -destination=mocks/export_job_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExportJobRepository is a mock of ExportJobRepository interface.
+type MockExportJobRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportJobRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockExportJobRepositoryMockRecorder is the mock recorder for MockExportJobRepository.
+type MockExportJobRepositoryMockRecorder struct {
+	mock *MockExportJobRepository
+}
+
+// NewMockExportJobRepository creates a new mock instance.
+func NewMockExportJobRepository(ctrl *gomock.Controller) *MockExportJobRepository {
+	mock := &MockExportJobRepository{ctrl: ctrl}
+	mock.recorder = &MockExportJobRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportJobRepository) EXPECT() *MockExportJobRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimNext mocks base method.
+func (m *MockExportJobRepository) ClaimNext(ctx context.Context, staleAfter time.Duration) (*entities.ExportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimNext", ctx, staleAfter)
+	ret0, _ := ret[0].(*entities.ExportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimNext indicates an expected call of ClaimNext.
+func (mr *MockExportJobRepositoryMockRecorder) ClaimNext(ctx, staleAfter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimNext", reflect.TypeOf((*MockExportJobRepository)(nil).ClaimNext), ctx, staleAfter)
+}
+
+// Create mocks base method.
+func (m *MockExportJobRepository) Create(ctx context.Context, job *entities.ExportJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockExportJobRepositoryMockRecorder) Create(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExportJobRepository)(nil).Create), ctx, job)
+}
+
+// GetByID mocks base method.
+func (m *MockExportJobRepository) GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.ExportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, userID, id)
+	ret0, _ := ret[0].(*entities.ExportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockExportJobRepositoryMockRecorder) GetByID(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockExportJobRepository)(nil).GetByID), ctx, userID, id)
+}
+
+// GetFile mocks base method.
+func (m *MockExportJobRepository) GetFile(ctx context.Context, userID, id primitive.ObjectID) (*entities.ExportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFile", ctx, userID, id)
+	ret0, _ := ret[0].(*entities.ExportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFile indicates an expected call of GetFile.
+func (mr *MockExportJobRepositoryMockRecorder) GetFile(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFile", reflect.TypeOf((*MockExportJobRepository)(nil).GetFile), ctx, userID, id)
+}
+
+// GetFileByID mocks base method.
+func (m *MockExportJobRepository) GetFileByID(ctx context.Context, id primitive.ObjectID) (*entities.ExportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileByID", ctx, id)
+	ret0, _ := ret[0].(*entities.ExportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileByID indicates an expected call of GetFileByID.
+func (mr *MockExportJobRepositoryMockRecorder) GetFileByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileByID", reflect.TypeOf((*MockExportJobRepository)(nil).GetFileByID), ctx, id)
+}
+
+// HasActive mocks base method.
+func (m *MockExportJobRepository) HasActive(ctx context.Context, userID primitive.ObjectID, staleAfter time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasActive", ctx, userID, staleAfter)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasActive indicates an expected call of HasActive.
+func (mr *MockExportJobRepositoryMockRecorder) HasActive(ctx, userID, staleAfter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasActive", reflect.TypeOf((*MockExportJobRepository)(nil).HasActive), ctx, userID, staleAfter)
+}
+
+// Save mocks base method.
+func (m *MockExportJobRepository) Save(ctx context.Context, job *entities.ExportJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockExportJobRepositoryMockRecorder) Save(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockExportJobRepository)(nil).Save), ctx, job)
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: focus_session_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=focus_session_repository.go -destination=mocks/focus_session_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	repositories "github.com/devgugga/todo-it/internal/repositories"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFocusSessionRepository is a mock of FocusSessionRepository interface.
+type MockFocusSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFocusSessionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockFocusSessionRepositoryMockRecorder is the mock recorder for MockFocusSessionRepository.
+type MockFocusSessionRepositoryMockRecorder struct {
+	mock *MockFocusSessionRepository
+}
+
+// NewMockFocusSessionRepository creates a new mock instance.
+func NewMockFocusSessionRepository(ctrl *gomock.Controller) *MockFocusSessionRepository {
+	mock := &MockFocusSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockFocusSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFocusSessionRepository) EXPECT() *MockFocusSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockFocusSessionRepository) Create(ctx context.Context, session *entities.FocusSession) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockFocusSessionRepositoryMockRecorder) Create(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFocusSessionRepository)(nil).Create), ctx, session)
+}
+
+// DailyTotals mocks base method.
+func (m *MockFocusSessionRepository) DailyTotals(ctx context.Context, userID primitive.ObjectID, since time.Time, timezone string) ([]repositories.FocusDay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DailyTotals", ctx, userID, since, timezone)
+	ret0, _ := ret[0].([]repositories.FocusDay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DailyTotals indicates an expected call of DailyTotals.
+func (mr *MockFocusSessionRepositoryMockRecorder) DailyTotals(ctx, userID, since, timezone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DailyTotals", reflect.TypeOf((*MockFocusSessionRepository)(nil).DailyTotals), ctx, userID, since, timezone)
+}
+
+// Finish mocks base method.
+func (m *MockFocusSessionRepository) Finish(ctx context.Context, session *entities.FocusSession) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Finish", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Finish indicates an expected call of Finish.
+func (mr *MockFocusSessionRepositoryMockRecorder) Finish(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Finish", reflect.TypeOf((*MockFocusSessionRepository)(nil).Finish), ctx, session)
+}
+
+// GetActive mocks base method.
+func (m *MockFocusSessionRepository) GetActive(ctx context.Context, userID primitive.ObjectID) (*entities.FocusSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActive", ctx, userID)
+	ret0, _ := ret[0].(*entities.FocusSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActive indicates an expected call of GetActive.
+func (mr *MockFocusSessionRepositoryMockRecorder) GetActive(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActive", reflect.TypeOf((*MockFocusSessionRepository)(nil).GetActive), ctx, userID)
+}
@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github_account_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=github_account_repository.go -destination=mocks/github_account_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGitHubAccountRepository is a mock of GitHubAccountRepository interface.
+type MockGitHubAccountRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGitHubAccountRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGitHubAccountRepositoryMockRecorder is the mock recorder for MockGitHubAccountRepository.
+type MockGitHubAccountRepositoryMockRecorder struct {
+	mock *MockGitHubAccountRepository
+}
+
+// NewMockGitHubAccountRepository creates a new mock instance.
+func NewMockGitHubAccountRepository(ctrl *gomock.Controller) *MockGitHubAccountRepository {
+	mock := &MockGitHubAccountRepository{ctrl: ctrl}
+	mock.recorder = &MockGitHubAccountRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGitHubAccountRepository) EXPECT() *MockGitHubAccountRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ConsumeState mocks base method.
+func (m *MockGitHubAccountRepository) ConsumeState(ctx context.Context, userID primitive.ObjectID, stateHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeState", ctx, userID, stateHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConsumeState indicates an expected call of ConsumeState.
+func (mr *MockGitHubAccountRepositoryMockRecorder) ConsumeState(ctx, userID, stateHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeState", reflect.TypeOf((*MockGitHubAccountRepository)(nil).ConsumeState), ctx, userID, stateHash)
+}
+
+// Delete mocks base method.
+func (m *MockGitHubAccountRepository) Delete(ctx context.Context, userID primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockGitHubAccountRepositoryMockRecorder) Delete(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockGitHubAccountRepository)(nil).Delete), ctx, userID)
+}
+
+// GetByUserID mocks base method.
+func (m *MockGitHubAccountRepository) GetByUserID(ctx context.Context, userID primitive.ObjectID) (*entities.GitHubAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
+	ret0, _ := ret[0].(*entities.GitHubAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockGitHubAccountRepositoryMockRecorder) GetByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockGitHubAccountRepository)(nil).GetByUserID), ctx, userID)
+}
+
+// ListByRepo mocks base method.
+func (m *MockGitHubAccountRepository) ListByRepo(ctx context.Context, fullName string) ([]*entities.GitHubAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByRepo", ctx, fullName)
+	ret0, _ := ret[0].([]*entities.GitHubAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByRepo indicates an expected call of ListByRepo.
+func (mr *MockGitHubAccountRepositoryMockRecorder) ListByRepo(ctx, fullName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByRepo", reflect.TypeOf((*MockGitHubAccountRepository)(nil).ListByRepo), ctx, fullName)
+}
+
+// RemoveRepo mocks base method.
+func (m *MockGitHubAccountRepository) RemoveRepo(ctx context.Context, userID primitive.ObjectID, fullName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRepo", ctx, userID, fullName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRepo indicates an expected call of RemoveRepo.
+func (mr *MockGitHubAccountRepositoryMockRecorder) RemoveRepo(ctx, userID, fullName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRepo", reflect.TypeOf((*MockGitHubAccountRepository)(nil).RemoveRepo), ctx, userID, fullName)
+}
+
+// SaveRepo mocks base method.
+func (m *MockGitHubAccountRepository) SaveRepo(ctx context.Context, userID primitive.ObjectID, repo entities.GitHubRepo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRepo", ctx, userID, repo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRepo indicates an expected call of SaveRepo.
+func (mr *MockGitHubAccountRepositoryMockRecorder) SaveRepo(ctx, userID, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRepo", reflect.TypeOf((*MockGitHubAccountRepository)(nil).SaveRepo), ctx, userID, repo)
+}
+
+// SaveState mocks base method.
+func (m *MockGitHubAccountRepository) SaveState(ctx context.Context, userID primitive.ObjectID, stateHash string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveState", ctx, userID, stateHash, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveState indicates an expected call of SaveState.
+func (mr *MockGitHubAccountRepositoryMockRecorder) SaveState(ctx, userID, stateHash, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveState", reflect.TypeOf((*MockGitHubAccountRepository)(nil).SaveState), ctx, userID, stateHash, expiresAt)
+}
+
+// SetToken mocks base method.
+func (m *MockGitHubAccountRepository) SetToken(ctx context.Context, userID primitive.ObjectID, login, token, scopes string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetToken", ctx, userID, login, token, scopes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetToken indicates an expected call of SetToken.
+func (mr *MockGitHubAccountRepositoryMockRecorder) SetToken(ctx, userID, login, token, scopes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetToken", reflect.TypeOf((*MockGitHubAccountRepository)(nil).SetToken), ctx, userID, login, token, scopes)
+}
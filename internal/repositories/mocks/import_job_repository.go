@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: import_job_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=import_job_repository.go -destination=mocks/import_job_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockImportJobRepository is a mock of ImportJobRepository interface.
+type MockImportJobRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportJobRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockImportJobRepositoryMockRecorder is the mock recorder for MockImportJobRepository.
+type MockImportJobRepositoryMockRecorder struct {
+	mock *MockImportJobRepository
+}
+
+// NewMockImportJobRepository creates a new mock instance.
+func NewMockImportJobRepository(ctrl *gomock.Controller) *MockImportJobRepository {
+	mock := &MockImportJobRepository{ctrl: ctrl}
+	mock.recorder = &MockImportJobRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportJobRepository) EXPECT() *MockImportJobRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockImportJobRepository) Create(ctx context.Context, job *entities.ImportJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockImportJobRepositoryMockRecorder) Create(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockImportJobRepository)(nil).Create), ctx, job)
+}
+
+// GetByID mocks base method.
+func (m *MockImportJobRepository) GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.ImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, userID, id)
+	ret0, _ := ret[0].(*entities.ImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockImportJobRepositoryMockRecorder) GetByID(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockImportJobRepository)(nil).GetByID), ctx, userID, id)
+}
+
+// HasActive mocks base method.
+func (m *MockImportJobRepository) HasActive(ctx context.Context, userID primitive.ObjectID, staleAfter time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasActive", ctx, userID, staleAfter)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasActive indicates an expected call of HasActive.
+func (mr *MockImportJobRepositoryMockRecorder) HasActive(ctx, userID, staleAfter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasActive", reflect.TypeOf((*MockImportJobRepository)(nil).HasActive), ctx, userID, staleAfter)
+}
+
+// Save mocks base method.
+func (m *MockImportJobRepository) Save(ctx context.Context, job *entities.ImportJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockImportJobRepositoryMockRecorder) Save(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockImportJobRepository)(nil).Save), ctx, job)
+}
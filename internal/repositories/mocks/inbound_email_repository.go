@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: inbound_email_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=inbound_email_repository.go -destination=mocks/inbound_email_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInboundEmailRepository is a mock of InboundEmailRepository interface.
+type MockInboundEmailRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInboundEmailRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockInboundEmailRepositoryMockRecorder is the mock recorder for MockInboundEmailRepository.
+type MockInboundEmailRepositoryMockRecorder struct {
+	mock *MockInboundEmailRepository
+}
+
+// NewMockInboundEmailRepository creates a new mock instance.
+func NewMockInboundEmailRepository(ctrl *gomock.Controller) *MockInboundEmailRepository {
+	mock := &MockInboundEmailRepository{ctrl: ctrl}
+	mock.recorder = &MockInboundEmailRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInboundEmailRepository) EXPECT() *MockInboundEmailRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockInboundEmailRepository) Claim(ctx context.Context, messageID string, userID primitive.ObjectID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, messageID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockInboundEmailRepositoryMockRecorder) Claim(ctx, messageID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockInboundEmailRepository)(nil).Claim), ctx, messageID, userID)
+}
+
+// Release mocks base method.
+func (m *MockInboundEmailRepository) Release(ctx context.Context, messageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockInboundEmailRepositoryMockRecorder) Release(ctx, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockInboundEmailRepository)(nil).Release), ctx, messageID)
+}
+
+// SetTask mocks base method.
+func (m *MockInboundEmailRepository) SetTask(ctx context.Context, messageID string, taskID primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTask", ctx, messageID, taskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTask indicates an expected call of SetTask.
+func (mr *MockInboundEmailRepositoryMockRecorder) SetTask(ctx, messageID, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTask", reflect.TypeOf((*MockInboundEmailRepository)(nil).SetTask), ctx, messageID, taskID)
+}
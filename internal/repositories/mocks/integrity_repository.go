@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: integrity_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=integrity_repository.go -destination=mocks/integrity_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repositories "github.com/devgugga/todo-it/internal/repositories"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIntegrityRepository is a mock of IntegrityRepository interface.
+type MockIntegrityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIntegrityRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIntegrityRepositoryMockRecorder is the mock recorder for MockIntegrityRepository.
+type MockIntegrityRepositoryMockRecorder struct {
+	mock *MockIntegrityRepository
+}
+
+// NewMockIntegrityRepository creates a new mock instance.
+func NewMockIntegrityRepository(ctrl *gomock.Controller) *MockIntegrityRepository {
+	mock := &MockIntegrityRepository{ctrl: ctrl}
+	mock.recorder = &MockIntegrityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIntegrityRepository) EXPECT() *MockIntegrityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Repair mocks base method.
+func (m *MockIntegrityRepository) Repair(ctx context.Context, check string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Repair", ctx, check)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Repair indicates an expected call of Repair.
+func (mr *MockIntegrityRepositoryMockRecorder) Repair(ctx, check any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Repair", reflect.TypeOf((*MockIntegrityRepository)(nil).Repair), ctx, check)
+}
+
+// Scan mocks base method.
+func (m *MockIntegrityRepository) Scan(ctx context.Context, check string, sampleSize int64) (*repositories.IntegrityScan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scan", ctx, check, sampleSize)
+	ret0, _ := ret[0].(*repositories.IntegrityScan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockIntegrityRepositoryMockRecorder) Scan(ctx, check, sampleSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockIntegrityRepository)(nil).Scan), ctx, check, sampleSize)
+}
@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: label_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=label_repository.go -destination=mocks/label_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLabelRepository is a mock of LabelRepository interface.
+type MockLabelRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLabelRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLabelRepositoryMockRecorder is the mock recorder for MockLabelRepository.
+type MockLabelRepositoryMockRecorder struct {
+	mock *MockLabelRepository
+}
+
+// NewMockLabelRepository creates a new mock instance.
+func NewMockLabelRepository(ctrl *gomock.Controller) *MockLabelRepository {
+	mock := &MockLabelRepository{ctrl: ctrl}
+	mock.recorder = &MockLabelRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLabelRepository) EXPECT() *MockLabelRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockLabelRepository) Count(ctx context.Context, userID primitive.ObjectID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockLabelRepositoryMockRecorder) Count(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockLabelRepository)(nil).Count), ctx, userID)
+}
+
+// Create mocks base method.
+func (m *MockLabelRepository) Create(ctx context.Context, label *entities.Label) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, label)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLabelRepositoryMockRecorder) Create(ctx, label any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLabelRepository)(nil).Create), ctx, label)
+}
+
+// Delete mocks base method.
+func (m *MockLabelRepository) Delete(ctx context.Context, userID, id primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLabelRepositoryMockRecorder) Delete(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLabelRepository)(nil).Delete), ctx, userID, id)
+}
+
+// GetByID mocks base method.
+func (m *MockLabelRepository) GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, userID, id)
+	ret0, _ := ret[0].(*entities.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockLabelRepositoryMockRecorder) GetByID(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockLabelRepository)(nil).GetByID), ctx, userID, id)
+}
+
+// GetByIDs mocks base method.
+func (m *MockLabelRepository) GetByIDs(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID) ([]*entities.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, userID, ids)
+	ret0, _ := ret[0].([]*entities.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockLabelRepositoryMockRecorder) GetByIDs(ctx, userID, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockLabelRepository)(nil).GetByIDs), ctx, userID, ids)
+}
+
+// List mocks base method.
+func (m *MockLabelRepository) List(ctx context.Context, userID primitive.ObjectID) ([]*entities.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userID)
+	ret0, _ := ret[0].([]*entities.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockLabelRepositoryMockRecorder) List(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLabelRepository)(nil).List), ctx, userID)
+}
+
+// Update mocks base method.
+func (m *MockLabelRepository) Update(ctx context.Context, label *entities.Label) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, label)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockLabelRepositoryMockRecorder) Update(ctx, label any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockLabelRepository)(nil).Update), ctx, label)
+}
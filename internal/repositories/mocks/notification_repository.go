@@ -0,0 +1,174 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notification_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=notification_repository.go -destination=mocks/notification_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationRepository is a mock of NotificationRepository interface.
+type MockNotificationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationRepositoryMockRecorder is the mock recorder for MockNotificationRepository.
+type MockNotificationRepositoryMockRecorder struct {
+	mock *MockNotificationRepository
+}
+
+// NewMockNotificationRepository creates a new mock instance.
+func NewMockNotificationRepository(ctrl *gomock.Controller) *MockNotificationRepository {
+	mock := &MockNotificationRepository{ctrl: ctrl}
+	mock.recorder = &MockNotificationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationRepository) EXPECT() *MockNotificationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockNotificationRepository) Create(ctx context.Context, notification *entities.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockNotificationRepositoryMockRecorder) Create(ctx, notification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockNotificationRepository)(nil).Create), ctx, notification)
+}
+
+// GetPendingByUser mocks base method.
+func (m *MockNotificationRepository) GetPendingByUser(ctx context.Context, userID primitive.ObjectID) ([]*entities.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingByUser", ctx, userID)
+	ret0, _ := ret[0].([]*entities.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingByUser indicates an expected call of GetPendingByUser.
+func (mr *MockNotificationRepositoryMockRecorder) GetPendingByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingByUser", reflect.TypeOf((*MockNotificationRepository)(nil).GetPendingByUser), ctx, userID)
+}
+
+// ListInbox mocks base method.
+func (m *MockNotificationRepository) ListInbox(ctx context.Context, userID primitive.ObjectID, page, limit int64, unreadOnly bool) ([]*entities.Notification, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInbox", ctx, userID, page, limit, unreadOnly)
+	ret0, _ := ret[0].([]*entities.Notification)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListInbox indicates an expected call of ListInbox.
+func (mr *MockNotificationRepositoryMockRecorder) ListInbox(ctx, userID, page, limit, unreadOnly any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInbox", reflect.TypeOf((*MockNotificationRepository)(nil).ListInbox), ctx, userID, page, limit, unreadOnly)
+}
+
+// MarkAllRead mocks base method.
+func (m *MockNotificationRepository) MarkAllRead(ctx context.Context, userID primitive.ObjectID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockNotificationRepositoryMockRecorder) MarkAllRead(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockNotificationRepository)(nil).MarkAllRead), ctx, userID)
+}
+
+// MarkDelivered mocks base method.
+func (m *MockNotificationRepository) MarkDelivered(ctx context.Context, ids []primitive.ObjectID, channel string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDelivered", ctx, ids, channel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDelivered indicates an expected call of MarkDelivered.
+func (mr *MockNotificationRepositoryMockRecorder) MarkDelivered(ctx, ids, channel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDelivered", reflect.TypeOf((*MockNotificationRepository)(nil).MarkDelivered), ctx, ids, channel)
+}
+
+// MarkFailed mocks base method.
+func (m *MockNotificationRepository) MarkFailed(ctx context.Context, ids []primitive.ObjectID, channel string, deliveryErr error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFailed", ctx, ids, channel, deliveryErr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkFailed indicates an expected call of MarkFailed.
+func (mr *MockNotificationRepositoryMockRecorder) MarkFailed(ctx, ids, channel, deliveryErr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockNotificationRepository)(nil).MarkFailed), ctx, ids, channel, deliveryErr)
+}
+
+// MarkRead mocks base method.
+func (m *MockNotificationRepository) MarkRead(ctx context.Context, userID, id primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockNotificationRepositoryMockRecorder) MarkRead(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockNotificationRepository)(nil).MarkRead), ctx, userID, id)
+}
+
+// ShowInInbox mocks base method.
+func (m *MockNotificationRepository) ShowInInbox(ctx context.Context, ids []primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowInInbox", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ShowInInbox indicates an expected call of ShowInInbox.
+func (mr *MockNotificationRepositoryMockRecorder) ShowInInbox(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowInInbox", reflect.TypeOf((*MockNotificationRepository)(nil).ShowInInbox), ctx, ids)
+}
+
+// UsersWithPending mocks base method.
+func (m *MockNotificationRepository) UsersWithPending(ctx context.Context, limit int64) ([]primitive.ObjectID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UsersWithPending", ctx, limit)
+	ret0, _ := ret[0].([]primitive.ObjectID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UsersWithPending indicates an expected call of UsersWithPending.
+func (mr *MockNotificationRepositoryMockRecorder) UsersWithPending(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsersWithPending", reflect.TypeOf((*MockNotificationRepository)(nil).UsersWithPending), ctx, limit)
+}
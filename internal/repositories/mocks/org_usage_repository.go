@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: org_usage_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=org_usage_repository.go -destination=mocks/org_usage_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOrgUsageRepository is a mock of OrgUsageRepository interface.
+type MockOrgUsageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrgUsageRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockOrgUsageRepositoryMockRecorder is the mock recorder for MockOrgUsageRepository.
+type MockOrgUsageRepositoryMockRecorder struct {
+	mock *MockOrgUsageRepository
+}
+
+// NewMockOrgUsageRepository creates a new mock instance.
+func NewMockOrgUsageRepository(ctrl *gomock.Controller) *MockOrgUsageRepository {
+	mock := &MockOrgUsageRepository{ctrl: ctrl}
+	mock.recorder = &MockOrgUsageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrgUsageRepository) EXPECT() *MockOrgUsageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountTasksByUser mocks base method.
+func (m *MockOrgUsageRepository) CountTasksByUser(ctx context.Context, userIDs []primitive.ObjectID) (map[primitive.ObjectID]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTasksByUser", ctx, userIDs)
+	ret0, _ := ret[0].(map[primitive.ObjectID]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTasksByUser indicates an expected call of CountTasksByUser.
+func (mr *MockOrgUsageRepositoryMockRecorder) CountTasksByUser(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTasksByUser", reflect.TypeOf((*MockOrgUsageRepository)(nil).CountTasksByUser), ctx, userIDs)
+}
+
+// Get mocks base method.
+func (m *MockOrgUsageRepository) Get(ctx context.Context, organizationID primitive.ObjectID) (*entities.OrgUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, organizationID)
+	ret0, _ := ret[0].(*entities.OrgUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockOrgUsageRepositoryMockRecorder) Get(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockOrgUsageRepository)(nil).Get), ctx, organizationID)
+}
+
+// Save mocks base method.
+func (m *MockOrgUsageRepository) Save(ctx context.Context, usage *entities.OrgUsage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, usage)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockOrgUsageRepositoryMockRecorder) Save(ctx, usage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockOrgUsageRepository)(nil).Save), ctx, usage)
+}
+
+// StorageByUser mocks base method.
+func (m *MockOrgUsageRepository) StorageByUser(ctx context.Context, userIDs []primitive.ObjectID) (map[primitive.ObjectID]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StorageByUser", ctx, userIDs)
+	ret0, _ := ret[0].(map[primitive.ObjectID]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StorageByUser indicates an expected call of StorageByUser.
+func (mr *MockOrgUsageRepositoryMockRecorder) StorageByUser(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorageByUser", reflect.TypeOf((*MockOrgUsageRepository)(nil).StorageByUser), ctx, userIDs)
+}
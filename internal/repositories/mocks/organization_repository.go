@@ -0,0 +1,174 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: organization_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=organization_repository.go -destination=mocks/organization_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOrganizationRepository is a mock of OrganizationRepository interface.
+type MockOrganizationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockOrganizationRepositoryMockRecorder is the mock recorder for MockOrganizationRepository.
+type MockOrganizationRepositoryMockRecorder struct {
+	mock *MockOrganizationRepository
+}
+
+// NewMockOrganizationRepository creates a new mock instance.
+func NewMockOrganizationRepository(ctrl *gomock.Controller) *MockOrganizationRepository {
+	mock := &MockOrganizationRepository{ctrl: ctrl}
+	mock.recorder = &MockOrganizationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationRepository) EXPECT() *MockOrganizationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AcceptInvite mocks base method.
+func (m *MockOrganizationRepository) AcceptInvite(ctx context.Context, id primitive.ObjectID, email string, member entities.OrganizationMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvite", ctx, id, email, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptInvite indicates an expected call of AcceptInvite.
+func (mr *MockOrganizationRepositoryMockRecorder) AcceptInvite(ctx, id, email, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvite", reflect.TypeOf((*MockOrganizationRepository)(nil).AcceptInvite), ctx, id, email, member)
+}
+
+// Create mocks base method.
+func (m *MockOrganizationRepository) Create(ctx context.Context, organization *entities.Organization) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, organization)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOrganizationRepositoryMockRecorder) Create(ctx, organization any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrganizationRepository)(nil).Create), ctx, organization)
+}
+
+// DeclineInvite mocks base method.
+func (m *MockOrganizationRepository) DeclineInvite(ctx context.Context, id primitive.ObjectID, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineInvite", ctx, id, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeclineInvite indicates an expected call of DeclineInvite.
+func (mr *MockOrganizationRepositoryMockRecorder) DeclineInvite(ctx, id, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineInvite", reflect.TypeOf((*MockOrganizationRepository)(nil).DeclineInvite), ctx, id, email)
+}
+
+// GetByID mocks base method.
+func (m *MockOrganizationRepository) GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, userID, id)
+	ret0, _ := ret[0].(*entities.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockOrganizationRepositoryMockRecorder) GetByID(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockOrganizationRepository)(nil).GetByID), ctx, userID, id)
+}
+
+// Invite mocks base method.
+func (m *MockOrganizationRepository) Invite(ctx context.Context, ownerID, id primitive.ObjectID, invite entities.OrganizationInvite) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invite", ctx, ownerID, id, invite)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Invite indicates an expected call of Invite.
+func (mr *MockOrganizationRepositoryMockRecorder) Invite(ctx, ownerID, id, invite any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invite", reflect.TypeOf((*MockOrganizationRepository)(nil).Invite), ctx, ownerID, id, invite)
+}
+
+// ListAll mocks base method.
+func (m *MockOrganizationRepository) ListAll(ctx context.Context) ([]*entities.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAll", ctx)
+	ret0, _ := ret[0].([]*entities.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAll indicates an expected call of ListAll.
+func (mr *MockOrganizationRepositoryMockRecorder) ListAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockOrganizationRepository)(nil).ListAll), ctx)
+}
+
+// ListByUser mocks base method.
+func (m *MockOrganizationRepository) ListByUser(ctx context.Context, userID primitive.ObjectID) ([]*entities.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUser", ctx, userID)
+	ret0, _ := ret[0].([]*entities.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUser indicates an expected call of ListByUser.
+func (mr *MockOrganizationRepositoryMockRecorder) ListByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockOrganizationRepository)(nil).ListByUser), ctx, userID)
+}
+
+// ListInvites mocks base method.
+func (m *MockOrganizationRepository) ListInvites(ctx context.Context, email string, now time.Time) ([]*entities.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvites", ctx, email, now)
+	ret0, _ := ret[0].([]*entities.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvites indicates an expected call of ListInvites.
+func (mr *MockOrganizationRepositoryMockRecorder) ListInvites(ctx, email, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvites", reflect.TypeOf((*MockOrganizationRepository)(nil).ListInvites), ctx, email, now)
+}
+
+// RemoveMember mocks base method.
+func (m *MockOrganizationRepository) RemoveMember(ctx context.Context, id, memberID primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, id, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockOrganizationRepositoryMockRecorder) RemoveMember(ctx, id, memberID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockOrganizationRepository)(nil).RemoveMember), ctx, id, memberID)
+}
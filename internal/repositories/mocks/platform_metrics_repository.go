@@ -0,0 +1,192 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: platform_metrics_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=platform_metrics_repository.go -destination=mocks/platform_metrics_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	repositories "github.com/devgugga/todo-it/internal/repositories"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPlatformMetricsRepository is a mock of PlatformMetricsRepository interface.
+type MockPlatformMetricsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlatformMetricsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPlatformMetricsRepositoryMockRecorder is the mock recorder for MockPlatformMetricsRepository.
+type MockPlatformMetricsRepositoryMockRecorder struct {
+	mock *MockPlatformMetricsRepository
+}
+
+// NewMockPlatformMetricsRepository creates a new mock instance.
+func NewMockPlatformMetricsRepository(ctrl *gomock.Controller) *MockPlatformMetricsRepository {
+	mock := &MockPlatformMetricsRepository{ctrl: ctrl}
+	mock.recorder = &MockPlatformMetricsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlatformMetricsRepository) EXPECT() *MockPlatformMetricsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountActiveUsers mocks base method.
+func (m *MockPlatformMetricsRepository) CountActiveUsers(ctx context.Context, since time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActiveUsers", ctx, since)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActiveUsers indicates an expected call of CountActiveUsers.
+func (mr *MockPlatformMetricsRepositoryMockRecorder) CountActiveUsers(ctx, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveUsers", reflect.TypeOf((*MockPlatformMetricsRepository)(nil).CountActiveUsers), ctx, since)
+}
+
+// CountDailyTasks mocks base method.
+func (m *MockPlatformMetricsRepository) CountDailyTasks(ctx context.Context, since time.Time) (*repositories.DailyTasks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDailyTasks", ctx, since)
+	ret0, _ := ret[0].(*repositories.DailyTasks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDailyTasks indicates an expected call of CountDailyTasks.
+func (mr *MockPlatformMetricsRepositoryMockRecorder) CountDailyTasks(ctx, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDailyTasks", reflect.TypeOf((*MockPlatformMetricsRepository)(nil).CountDailyTasks), ctx, since)
+}
+
+// CountOpenTasks mocks base method.
+func (m *MockPlatformMetricsRepository) CountOpenTasks(ctx context.Context, now time.Time) (int64, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOpenTasks", ctx, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CountOpenTasks indicates an expected call of CountOpenTasks.
+func (mr *MockPlatformMetricsRepositoryMockRecorder) CountOpenTasks(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOpenTasks", reflect.TypeOf((*MockPlatformMetricsRepository)(nil).CountOpenTasks), ctx, now)
+}
+
+// GetKPIs mocks base method.
+func (m *MockPlatformMetricsRepository) GetKPIs(ctx context.Context) (*entities.BusinessKPIs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKPIs", ctx)
+	ret0, _ := ret[0].(*entities.BusinessKPIs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKPIs indicates an expected call of GetKPIs.
+func (mr *MockPlatformMetricsRepositoryMockRecorder) GetKPIs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKPIs", reflect.TypeOf((*MockPlatformMetricsRepository)(nil).GetKPIs), ctx)
+}
+
+// GetSnapshot mocks base method.
+func (m *MockPlatformMetricsRepository) GetSnapshot(ctx context.Context) (*entities.PlatformMetrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshot", ctx)
+	ret0, _ := ret[0].(*entities.PlatformMetrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshot indicates an expected call of GetSnapshot.
+func (mr *MockPlatformMetricsRepositoryMockRecorder) GetSnapshot(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockPlatformMetricsRepository)(nil).GetSnapshot), ctx)
+}
+
+// IncrementCounters mocks base method.
+func (m *MockPlatformMetricsRepository) IncrementCounters(ctx context.Context, day, name string, counts map[string]int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementCounters", ctx, day, name, counts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementCounters indicates an expected call of IncrementCounters.
+func (mr *MockPlatformMetricsRepositoryMockRecorder) IncrementCounters(ctx, day, name, counts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementCounters", reflect.TypeOf((*MockPlatformMetricsRepository)(nil).IncrementCounters), ctx, day, name, counts)
+}
+
+// SaveKPIs mocks base method.
+func (m *MockPlatformMetricsRepository) SaveKPIs(ctx context.Context, kpis *entities.BusinessKPIs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveKPIs", ctx, kpis)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveKPIs indicates an expected call of SaveKPIs.
+func (mr *MockPlatformMetricsRepositoryMockRecorder) SaveKPIs(ctx, kpis any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveKPIs", reflect.TypeOf((*MockPlatformMetricsRepository)(nil).SaveKPIs), ctx, kpis)
+}
+
+// SaveSnapshot mocks base method.
+func (m *MockPlatformMetricsRepository) SaveSnapshot(ctx context.Context, snapshot *entities.PlatformMetrics) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSnapshot", ctx, snapshot)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSnapshot indicates an expected call of SaveSnapshot.
+func (mr *MockPlatformMetricsRepositoryMockRecorder) SaveSnapshot(ctx, snapshot any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSnapshot", reflect.TypeOf((*MockPlatformMetricsRepository)(nil).SaveSnapshot), ctx, snapshot)
+}
+
+// StorageUsage mocks base method.
+func (m *MockPlatformMetricsRepository) StorageUsage(ctx context.Context) (*entities.StorageUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StorageUsage", ctx)
+	ret0, _ := ret[0].(*entities.StorageUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StorageUsage indicates an expected call of StorageUsage.
+func (mr *MockPlatformMetricsRepositoryMockRecorder) StorageUsage(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorageUsage", reflect.TypeOf((*MockPlatformMetricsRepository)(nil).StorageUsage), ctx)
+}
+
+// SumCounters mocks base method.
+func (m *MockPlatformMetricsRepository) SumCounters(ctx context.Context, name, sinceDay string) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumCounters", ctx, name, sinceDay)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumCounters indicates an expected call of SumCounters.
+func (mr *MockPlatformMetricsRepositoryMockRecorder) SumCounters(ctx, name, sinceDay any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumCounters", reflect.TypeOf((*MockPlatformMetricsRepository)(nil).SumCounters), ctx, name, sinceDay)
+}
@@ -0,0 +1,187 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: project_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=project_repository.go -destination=mocks/project_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	enums "github.com/devgugga/todo-it/internal/enums"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProjectRepository is a mock of ProjectRepository interface.
+type MockProjectRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectRepositoryMockRecorder is the mock recorder for MockProjectRepository.
+type MockProjectRepositoryMockRecorder struct {
+	mock *MockProjectRepository
+}
+
+// NewMockProjectRepository creates a new mock instance.
+func NewMockProjectRepository(ctrl *gomock.Controller) *MockProjectRepository {
+	mock := &MockProjectRepository{ctrl: ctrl}
+	mock.recorder = &MockProjectRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectRepository) EXPECT() *MockProjectRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddMember mocks base method.
+func (m *MockProjectRepository) AddMember(ctx context.Context, userID, id primitive.ObjectID, member entities.ProjectMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, userID, id, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockProjectRepositoryMockRecorder) AddMember(ctx, userID, id, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockProjectRepository)(nil).AddMember), ctx, userID, id, member)
+}
+
+// Create mocks base method.
+func (m *MockProjectRepository) Create(ctx context.Context, project *entities.Project) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProjectRepositoryMockRecorder) Create(ctx, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectRepository)(nil).Create), ctx, project)
+}
+
+// Delete mocks base method.
+func (m *MockProjectRepository) Delete(ctx context.Context, userID, id primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockProjectRepositoryMockRecorder) Delete(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProjectRepository)(nil).Delete), ctx, userID, id)
+}
+
+// GetByID mocks base method.
+func (m *MockProjectRepository) GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, userID, id)
+	ret0, _ := ret[0].(*entities.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockProjectRepositoryMockRecorder) GetByID(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockProjectRepository)(nil).GetByID), ctx, userID, id)
+}
+
+// List mocks base method.
+func (m *MockProjectRepository) List(ctx context.Context, userID primitive.ObjectID) ([]*entities.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userID)
+	ret0, _ := ret[0].([]*entities.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockProjectRepositoryMockRecorder) List(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjectRepository)(nil).List), ctx, userID)
+}
+
+// ListShared mocks base method.
+func (m *MockProjectRepository) ListShared(ctx context.Context, userID primitive.ObjectID) ([]*entities.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShared", ctx, userID)
+	ret0, _ := ret[0].([]*entities.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShared indicates an expected call of ListShared.
+func (mr *MockProjectRepositoryMockRecorder) ListShared(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShared", reflect.TypeOf((*MockProjectRepository)(nil).ListShared), ctx, userID)
+}
+
+// RemoveMember mocks base method.
+func (m *MockProjectRepository) RemoveMember(ctx context.Context, id, memberID primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, id, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockProjectRepositoryMockRecorder) RemoveMember(ctx, id, memberID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockProjectRepository)(nil).RemoveMember), ctx, id, memberID)
+}
+
+// SetMemberRole mocks base method.
+func (m *MockProjectRepository) SetMemberRole(ctx context.Context, userID, id, memberID primitive.ObjectID, role enums.ProjectRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMemberRole", ctx, userID, id, memberID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMemberRole indicates an expected call of SetMemberRole.
+func (mr *MockProjectRepositoryMockRecorder) SetMemberRole(ctx, userID, id, memberID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMemberRole", reflect.TypeOf((*MockProjectRepository)(nil).SetMemberRole), ctx, userID, id, memberID, role)
+}
+
+// SetRollup mocks base method.
+func (m *MockProjectRepository) SetRollup(ctx context.Context, id primitive.ObjectID, rollup *entities.ProjectRollup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRollup", ctx, id, rollup)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRollup indicates an expected call of SetRollup.
+func (mr *MockProjectRepositoryMockRecorder) SetRollup(ctx, id, rollup any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRollup", reflect.TypeOf((*MockProjectRepository)(nil).SetRollup), ctx, id, rollup)
+}
+
+// Update mocks base method.
+func (m *MockProjectRepository) Update(ctx context.Context, userID primitive.ObjectID, project *entities.Project) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, userID, project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockProjectRepositoryMockRecorder) Update(ctx, userID, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProjectRepository)(nil).Update), ctx, userID, project)
+}
@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: retention_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=retention_repository.go -destination=mocks/retention_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	repositories "github.com/devgugga/todo-it/internal/repositories"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRetentionRepository is a mock of RetentionRepository interface.
+type MockRetentionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRetentionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRetentionRepositoryMockRecorder is the mock recorder for MockRetentionRepository.
+type MockRetentionRepositoryMockRecorder struct {
+	mock *MockRetentionRepository
+}
+
+// NewMockRetentionRepository creates a new mock instance.
+func NewMockRetentionRepository(ctrl *gomock.Controller) *MockRetentionRepository {
+	mock := &MockRetentionRepository{ctrl: ctrl}
+	mock.recorder = &MockRetentionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRetentionRepository) EXPECT() *MockRetentionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountExpired mocks base method.
+func (m *MockRetentionRepository) CountExpired(ctx context.Context, resource string, cutoff time.Time, scope repositories.RetentionScope) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountExpired", ctx, resource, cutoff, scope)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountExpired indicates an expected call of CountExpired.
+func (mr *MockRetentionRepositoryMockRecorder) CountExpired(ctx, resource, cutoff, scope any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountExpired", reflect.TypeOf((*MockRetentionRepository)(nil).CountExpired), ctx, resource, cutoff, scope)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockRetentionRepository) DeleteExpired(ctx context.Context, resource string, cutoff time.Time, scope repositories.RetentionScope, limit int64) (*repositories.PurgedBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx, resource, cutoff, scope, limit)
+	ret0, _ := ret[0].(*repositories.PurgedBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockRetentionRepositoryMockRecorder) DeleteExpired(ctx, resource, cutoff, scope, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockRetentionRepository)(nil).DeleteExpired), ctx, resource, cutoff, scope, limit)
+}
+
+// DeleteOverride mocks base method.
+func (m *MockRetentionRepository) DeleteOverride(ctx context.Context, userID primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOverride", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOverride indicates an expected call of DeleteOverride.
+func (mr *MockRetentionRepositoryMockRecorder) DeleteOverride(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOverride", reflect.TypeOf((*MockRetentionRepository)(nil).DeleteOverride), ctx, userID)
+}
+
+// ListOverrides mocks base method.
+func (m *MockRetentionRepository) ListOverrides(ctx context.Context) ([]*entities.RetentionOverride, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverrides", ctx)
+	ret0, _ := ret[0].([]*entities.RetentionOverride)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverrides indicates an expected call of ListOverrides.
+func (mr *MockRetentionRepositoryMockRecorder) ListOverrides(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverrides", reflect.TypeOf((*MockRetentionRepository)(nil).ListOverrides), ctx)
+}
+
+// SaveOverride mocks base method.
+func (m *MockRetentionRepository) SaveOverride(ctx context.Context, override *entities.RetentionOverride) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveOverride", ctx, override)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveOverride indicates an expected call of SaveOverride.
+func (mr *MockRetentionRepositoryMockRecorder) SaveOverride(ctx, override any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveOverride", reflect.TypeOf((*MockRetentionRepository)(nil).SaveOverride), ctx, override)
+}
@@ -0,0 +1,74 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: task_archive_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=task_archive_repository.go -destination=mocks/task_archive_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaskArchiveRepository is a mock of TaskArchiveRepository interface.
+type MockTaskArchiveRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskArchiveRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskArchiveRepositoryMockRecorder is the mock recorder for MockTaskArchiveRepository.
+type MockTaskArchiveRepositoryMockRecorder struct {
+	mock *MockTaskArchiveRepository
+}
+
+// NewMockTaskArchiveRepository creates a new mock instance.
+func NewMockTaskArchiveRepository(ctrl *gomock.Controller) *MockTaskArchiveRepository {
+	mock := &MockTaskArchiveRepository{ctrl: ctrl}
+	mock.recorder = &MockTaskArchiveRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskArchiveRepository) EXPECT() *MockTaskArchiveRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByUserID mocks base method.
+func (m *MockTaskArchiveRepository) GetByUserID(ctx context.Context, userID primitive.ObjectID) ([]*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockTaskArchiveRepositoryMockRecorder) GetByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockTaskArchiveRepository)(nil).GetByUserID), ctx, userID)
+}
+
+// MoveCompletedBefore mocks base method.
+func (m *MockTaskArchiveRepository) MoveCompletedBefore(ctx context.Context, cutoff time.Time, batchSize int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveCompletedBefore", ctx, cutoff, batchSize)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveCompletedBefore indicates an expected call of MoveCompletedBefore.
+func (mr *MockTaskArchiveRepositoryMockRecorder) MoveCompletedBefore(ctx, cutoff, batchSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveCompletedBefore", reflect.TypeOf((*MockTaskArchiveRepository)(nil).MoveCompletedBefore), ctx, cutoff, batchSize)
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: task_change_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=task_change_repository.go -destination=mocks/task_change_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaskChangeRepository is a mock of TaskChangeRepository interface.
+type MockTaskChangeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskChangeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskChangeRepositoryMockRecorder is the mock recorder for MockTaskChangeRepository.
+type MockTaskChangeRepositoryMockRecorder struct {
+	mock *MockTaskChangeRepository
+}
+
+// NewMockTaskChangeRepository creates a new mock instance.
+func NewMockTaskChangeRepository(ctrl *gomock.Controller) *MockTaskChangeRepository {
+	mock := &MockTaskChangeRepository{ctrl: ctrl}
+	mock.recorder = &MockTaskChangeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskChangeRepository) EXPECT() *MockTaskChangeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTaskChangeRepository) Create(ctx context.Context, change *entities.TaskChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTaskChangeRepositoryMockRecorder) Create(ctx, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTaskChangeRepository)(nil).Create), ctx, change)
+}
+
+// ListAfter mocks base method.
+func (m *MockTaskChangeRepository) ListAfter(ctx context.Context, userID, after, before primitive.ObjectID, types []string, limit int64) ([]*entities.TaskChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAfter", ctx, userID, after, before, types, limit)
+	ret0, _ := ret[0].([]*entities.TaskChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAfter indicates an expected call of ListAfter.
+func (mr *MockTaskChangeRepositoryMockRecorder) ListAfter(ctx, userID, after, before, types, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAfter", reflect.TypeOf((*MockTaskChangeRepository)(nil).ListAfter), ctx, userID, after, before, types, limit)
+}
+
+// ListByProject mocks base method.
+func (m *MockTaskChangeRepository) ListByProject(ctx context.Context, userID, projectID primitive.ObjectID, page, limit int64) ([]*entities.TaskChange, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByProject", ctx, userID, projectID, page, limit)
+	ret0, _ := ret[0].([]*entities.TaskChange)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByProject indicates an expected call of ListByProject.
+func (mr *MockTaskChangeRepositoryMockRecorder) ListByProject(ctx, userID, projectID, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByProject", reflect.TypeOf((*MockTaskChangeRepository)(nil).ListByProject), ctx, userID, projectID, page, limit)
+}
+
+// ListRecent mocks base method.
+func (m *MockTaskChangeRepository) ListRecent(ctx context.Context, userID primitive.ObjectID, types []string, limit int64) ([]*entities.TaskChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecent", ctx, userID, types, limit)
+	ret0, _ := ret[0].([]*entities.TaskChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecent indicates an expected call of ListRecent.
+func (mr *MockTaskChangeRepositoryMockRecorder) ListRecent(ctx, userID, types, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecent", reflect.TypeOf((*MockTaskChangeRepository)(nil).ListRecent), ctx, userID, types, limit)
+}
@@ -0,0 +1,532 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: task_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=task_repository.go -destination=mocks/task_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	enums "github.com/devgugga/todo-it/internal/enums"
+	repositories "github.com/devgugga/todo-it/internal/repositories"
+	bson "go.mongodb.org/mongo-driver/bson"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTodoRepository is a mock of TodoRepository interface.
+type MockTodoRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTodoRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTodoRepositoryMockRecorder is the mock recorder for MockTodoRepository.
+type MockTodoRepositoryMockRecorder struct {
+	mock *MockTodoRepository
+}
+
+// NewMockTodoRepository creates a new mock instance.
+func NewMockTodoRepository(ctrl *gomock.Controller) *MockTodoRepository {
+	mock := &MockTodoRepository{ctrl: ctrl}
+	mock.recorder = &MockTodoRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTodoRepository) EXPECT() *MockTodoRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddAttachments mocks base method.
+func (m *MockTodoRepository) AddAttachments(ctx context.Context, userID, id primitive.ObjectID, attachments []entities.TaskAttachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAttachments", ctx, userID, id, attachments)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAttachments indicates an expected call of AddAttachments.
+func (mr *MockTodoRepositoryMockRecorder) AddAttachments(ctx, userID, id, attachments any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAttachments", reflect.TypeOf((*MockTodoRepository)(nil).AddAttachments), ctx, userID, id, attachments)
+}
+
+// BulkDelete mocks base method.
+func (m *MockTodoRepository) BulkDelete(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkDelete", ctx, userID, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkDelete indicates an expected call of BulkDelete.
+func (mr *MockTodoRepositoryMockRecorder) BulkDelete(ctx, userID, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkDelete", reflect.TypeOf((*MockTodoRepository)(nil).BulkDelete), ctx, userID, ids)
+}
+
+// BulkUpdateStatus mocks base method.
+func (m *MockTodoRepository) BulkUpdateStatus(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID, status enums.TaskStatus) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpdateStatus", ctx, userID, ids, status)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkUpdateStatus indicates an expected call of BulkUpdateStatus.
+func (mr *MockTodoRepositoryMockRecorder) BulkUpdateStatus(ctx, userID, ids, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpdateStatus", reflect.TypeOf((*MockTodoRepository)(nil).BulkUpdateStatus), ctx, userID, ids, status)
+}
+
+// ClearCustomStatus mocks base method.
+func (m *MockTodoRepository) ClearCustomStatus(ctx context.Context, userID, statusID primitive.ObjectID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearCustomStatus", ctx, userID, statusID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearCustomStatus indicates an expected call of ClearCustomStatus.
+func (mr *MockTodoRepositoryMockRecorder) ClearCustomStatus(ctx, userID, statusID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearCustomStatus", reflect.TypeOf((*MockTodoRepository)(nil).ClearCustomStatus), ctx, userID, statusID)
+}
+
+// ClearProject mocks base method.
+func (m *MockTodoRepository) ClearProject(ctx context.Context, userID, projectID primitive.ObjectID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearProject", ctx, userID, projectID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearProject indicates an expected call of ClearProject.
+func (mr *MockTodoRepositoryMockRecorder) ClearProject(ctx, userID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearProject", reflect.TypeOf((*MockTodoRepository)(nil).ClearProject), ctx, userID, projectID)
+}
+
+// CompletedSeries mocks base method.
+func (m *MockTodoRepository) CompletedSeries(ctx context.Context, userID primitive.ObjectID, since time.Time, unit, timezone string) ([]repositories.SeriesPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompletedSeries", ctx, userID, since, unit, timezone)
+	ret0, _ := ret[0].([]repositories.SeriesPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompletedSeries indicates an expected call of CompletedSeries.
+func (mr *MockTodoRepositoryMockRecorder) CompletedSeries(ctx, userID, since, unit, timezone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompletedSeries", reflect.TypeOf((*MockTodoRepository)(nil).CompletedSeries), ctx, userID, since, unit, timezone)
+}
+
+// Create mocks base method.
+func (m *MockTodoRepository) Create(ctx context.Context, todo *entities.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, todo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTodoRepositoryMockRecorder) Create(ctx, todo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTodoRepository)(nil).Create), ctx, todo)
+}
+
+// Delete mocks base method.
+func (m *MockTodoRepository) Delete(ctx context.Context, userID, id primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTodoRepositoryMockRecorder) Delete(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTodoRepository)(nil).Delete), ctx, userID, id)
+}
+
+// DistinctTags mocks base method.
+func (m *MockTodoRepository) DistinctTags(ctx context.Context, userID primitive.ObjectID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DistinctTags", ctx, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DistinctTags indicates an expected call of DistinctTags.
+func (mr *MockTodoRepositoryMockRecorder) DistinctTags(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistinctTags", reflect.TypeOf((*MockTodoRepository)(nil).DistinctTags), ctx, userID)
+}
+
+// GetAllByUserID mocks base method.
+func (m *MockTodoRepository) GetAllByUserID(ctx context.Context, userID primitive.ObjectID) ([]*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByUserID indicates an expected call of GetAllByUserID.
+func (mr *MockTodoRepositoryMockRecorder) GetAllByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUserID", reflect.TypeOf((*MockTodoRepository)(nil).GetAllByUserID), ctx, userID)
+}
+
+// GetByCalDAVName mocks base method.
+func (m *MockTodoRepository) GetByCalDAVName(ctx context.Context, userID primitive.ObjectID, name string) (*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCalDAVName", ctx, userID, name)
+	ret0, _ := ret[0].(*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCalDAVName indicates an expected call of GetByCalDAVName.
+func (mr *MockTodoRepositoryMockRecorder) GetByCalDAVName(ctx, userID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCalDAVName", reflect.TypeOf((*MockTodoRepository)(nil).GetByCalDAVName), ctx, userID, name)
+}
+
+// GetByExternalID mocks base method.
+func (m *MockTodoRepository) GetByExternalID(ctx context.Context, userID primitive.ObjectID, source, externalID string) (*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByExternalID", ctx, userID, source, externalID)
+	ret0, _ := ret[0].(*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByExternalID indicates an expected call of GetByExternalID.
+func (mr *MockTodoRepositoryMockRecorder) GetByExternalID(ctx, userID, source, externalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByExternalID", reflect.TypeOf((*MockTodoRepository)(nil).GetByExternalID), ctx, userID, source, externalID)
+}
+
+// GetByID mocks base method.
+func (m *MockTodoRepository) GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, userID, id)
+	ret0, _ := ret[0].(*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTodoRepositoryMockRecorder) GetByID(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTodoRepository)(nil).GetByID), ctx, userID, id)
+}
+
+// GetByIDs mocks base method.
+func (m *MockTodoRepository) GetByIDs(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID) ([]*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, userID, ids)
+	ret0, _ := ret[0].([]*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockTodoRepositoryMockRecorder) GetByIDs(ctx, userID, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockTodoRepository)(nil).GetByIDs), ctx, userID, ids)
+}
+
+// GetByUserID mocks base method.
+func (m *MockTodoRepository) GetByUserID(ctx context.Context, userID primitive.ObjectID, page, limit int64, filters *repositories.TaskFilters) ([]*entities.Task, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID, page, limit, filters)
+	ret0, _ := ret[0].([]*entities.Task)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockTodoRepositoryMockRecorder) GetByUserID(ctx, userID, page, limit, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockTodoRepository)(nil).GetByUserID), ctx, userID, page, limit, filters)
+}
+
+// GetOverdueTodos mocks base method.
+func (m *MockTodoRepository) GetOverdueTodos(ctx context.Context, userID primitive.ObjectID) ([]*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverdueTodos", ctx, userID)
+	ret0, _ := ret[0].([]*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverdueTodos indicates an expected call of GetOverdueTodos.
+func (mr *MockTodoRepositoryMockRecorder) GetOverdueTodos(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverdueTodos", reflect.TypeOf((*MockTodoRepository)(nil).GetOverdueTodos), ctx, userID)
+}
+
+// GetStatsByUser mocks base method.
+func (m *MockTodoRepository) GetStatsByUser(ctx context.Context, userID primitive.ObjectID) (*repositories.TaskStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatsByUser", ctx, userID)
+	ret0, _ := ret[0].(*repositories.TaskStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatsByUser indicates an expected call of GetStatsByUser.
+func (mr *MockTodoRepositoryMockRecorder) GetStatsByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatsByUser", reflect.TypeOf((*MockTodoRepository)(nil).GetStatsByUser), ctx, userID)
+}
+
+// LinkLabel mocks base method.
+func (m *MockTodoRepository) LinkLabel(ctx context.Context, label *entities.Label) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkLabel", ctx, label)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LinkLabel indicates an expected call of LinkLabel.
+func (mr *MockTodoRepositoryMockRecorder) LinkLabel(ctx, label any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkLabel", reflect.TypeOf((*MockTodoRepository)(nil).LinkLabel), ctx, label)
+}
+
+// ListChangedSince mocks base method.
+func (m *MockTodoRepository) ListChangedSince(ctx context.Context, userID primitive.ObjectID, position repositories.SyncPosition, until time.Time, limit int64) ([]*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChangedSince", ctx, userID, position, until, limit)
+	ret0, _ := ret[0].([]*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChangedSince indicates an expected call of ListChangedSince.
+func (mr *MockTodoRepositoryMockRecorder) ListChangedSince(ctx, userID, position, until, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChangedSince", reflect.TypeOf((*MockTodoRepository)(nil).ListChangedSince), ctx, userID, position, until, limit)
+}
+
+// ListDueSoon mocks base method.
+func (m *MockTodoRepository) ListDueSoon(ctx context.Context, from, until time.Time, limit int64) ([]*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueSoon", ctx, from, until, limit)
+	ret0, _ := ret[0].([]*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueSoon indicates an expected call of ListDueSoon.
+func (mr *MockTodoRepositoryMockRecorder) ListDueSoon(ctx, from, until, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueSoon", reflect.TypeOf((*MockTodoRepository)(nil).ListDueSoon), ctx, from, until, limit)
+}
+
+// ListNearby mocks base method.
+func (m *MockTodoRepository) ListNearby(ctx context.Context, userID primitive.ObjectID, query repositories.NearbyQuery) ([]*repositories.NearbyTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNearby", ctx, userID, query)
+	ret0, _ := ret[0].([]*repositories.NearbyTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNearby indicates an expected call of ListNearby.
+func (mr *MockTodoRepositoryMockRecorder) ListNearby(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNearby", reflect.TypeOf((*MockTodoRepository)(nil).ListNearby), ctx, userID, query)
+}
+
+// MarkDueSoonNotified mocks base method.
+func (m *MockTodoRepository) MarkDueSoonNotified(ctx context.Context, ids []primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDueSoonNotified", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDueSoonNotified indicates an expected call of MarkDueSoonNotified.
+func (mr *MockTodoRepositoryMockRecorder) MarkDueSoonNotified(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDueSoonNotified", reflect.TypeOf((*MockTodoRepository)(nil).MarkDueSoonNotified), ctx, ids)
+}
+
+// PatchFields mocks base method.
+func (m *MockTodoRepository) PatchFields(ctx context.Context, userID, id primitive.ObjectID, fields bson.M) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchFields", ctx, userID, id, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchFields indicates an expected call of PatchFields.
+func (mr *MockTodoRepositoryMockRecorder) PatchFields(ctx, userID, id, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchFields", reflect.TypeOf((*MockTodoRepository)(nil).PatchFields), ctx, userID, id, fields)
+}
+
+// ProjectRollup mocks base method.
+func (m *MockTodoRepository) ProjectRollup(ctx context.Context, ownerID, projectID primitive.ObjectID) (*entities.ProjectRollup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectRollup", ctx, ownerID, projectID)
+	ret0, _ := ret[0].(*entities.ProjectRollup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectRollup indicates an expected call of ProjectRollup.
+func (mr *MockTodoRepositoryMockRecorder) ProjectRollup(ctx, ownerID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectRollup", reflect.TypeOf((*MockTodoRepository)(nil).ProjectRollup), ctx, ownerID, projectID)
+}
+
+// RenameCustomStatus mocks base method.
+func (m *MockTodoRepository) RenameCustomStatus(ctx context.Context, userID primitive.ObjectID, status *entities.CustomStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameCustomStatus", ctx, userID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameCustomStatus indicates an expected call of RenameCustomStatus.
+func (mr *MockTodoRepositoryMockRecorder) RenameCustomStatus(ctx, userID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameCustomStatus", reflect.TypeOf((*MockTodoRepository)(nil).RenameCustomStatus), ctx, userID, status)
+}
+
+// RenameLabel mocks base method.
+func (m *MockTodoRepository) RenameLabel(ctx context.Context, label *entities.Label, previousName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameLabel", ctx, label, previousName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameLabel indicates an expected call of RenameLabel.
+func (mr *MockTodoRepositoryMockRecorder) RenameLabel(ctx, label, previousName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameLabel", reflect.TypeOf((*MockTodoRepository)(nil).RenameLabel), ctx, label, previousName)
+}
+
+// SetChecklistItemDone mocks base method.
+func (m *MockTodoRepository) SetChecklistItemDone(ctx context.Context, userID, id, itemID primitive.ObjectID, done bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChecklistItemDone", ctx, userID, id, itemID, done)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetChecklistItemDone indicates an expected call of SetChecklistItemDone.
+func (mr *MockTodoRepositoryMockRecorder) SetChecklistItemDone(ctx, userID, id, itemID, done any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChecklistItemDone", reflect.TypeOf((*MockTodoRepository)(nil).SetChecklistItemDone), ctx, userID, id, itemID, done)
+}
+
+// SetCustomStatus mocks base method.
+func (m *MockTodoRepository) SetCustomStatus(ctx context.Context, userID, id primitive.ObjectID, status *entities.CustomStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCustomStatus", ctx, userID, id, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCustomStatus indicates an expected call of SetCustomStatus.
+func (mr *MockTodoRepositoryMockRecorder) SetCustomStatus(ctx, userID, id, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCustomStatus", reflect.TypeOf((*MockTodoRepository)(nil).SetCustomStatus), ctx, userID, id, status)
+}
+
+// SetPositions mocks base method.
+func (m *MockTodoRepository) SetPositions(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID) ([]repositories.TaskPosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPositions", ctx, userID, ids)
+	ret0, _ := ret[0].([]repositories.TaskPosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPositions indicates an expected call of SetPositions.
+func (mr *MockTodoRepositoryMockRecorder) SetPositions(ctx, userID, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPositions", reflect.TypeOf((*MockTodoRepository)(nil).SetPositions), ctx, userID, ids)
+}
+
+// Suggest mocks base method.
+func (m *MockTodoRepository) Suggest(ctx context.Context, userID primitive.ObjectID, query string, limit int64) (*repositories.TaskSuggestions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", ctx, userID, query, limit)
+	ret0, _ := ret[0].(*repositories.TaskSuggestions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockTodoRepositoryMockRecorder) Suggest(ctx, userID, query, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockTodoRepository)(nil).Suggest), ctx, userID, query, limit)
+}
+
+// UnlinkLabel mocks base method.
+func (m *MockTodoRepository) UnlinkLabel(ctx context.Context, userID, labelID primitive.ObjectID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlinkLabel", ctx, userID, labelID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnlinkLabel indicates an expected call of UnlinkLabel.
+func (mr *MockTodoRepositoryMockRecorder) UnlinkLabel(ctx, userID, labelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlinkLabel", reflect.TypeOf((*MockTodoRepository)(nil).UnlinkLabel), ctx, userID, labelID)
+}
+
+// Update mocks base method.
+func (m *MockTodoRepository) Update(ctx context.Context, userID primitive.ObjectID, todo *entities.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, userID, todo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTodoRepositoryMockRecorder) Update(ctx, userID, todo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTodoRepository)(nil).Update), ctx, userID, todo)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockTodoRepository) UpdateStatus(ctx context.Context, userID, id primitive.ObjectID, status enums.TaskStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, userID, id, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockTodoRepositoryMockRecorder) UpdateStatus(ctx, userID, id, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockTodoRepository)(nil).UpdateStatus), ctx, userID, id, status)
+}
@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: telegram_link_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=telegram_link_repository.go -destination=mocks/telegram_link_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTelegramLinkRepository is a mock of TelegramLinkRepository interface.
+type MockTelegramLinkRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTelegramLinkRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTelegramLinkRepositoryMockRecorder is the mock recorder for MockTelegramLinkRepository.
+type MockTelegramLinkRepositoryMockRecorder struct {
+	mock *MockTelegramLinkRepository
+}
+
+// NewMockTelegramLinkRepository creates a new mock instance.
+func NewMockTelegramLinkRepository(ctrl *gomock.Controller) *MockTelegramLinkRepository {
+	mock := &MockTelegramLinkRepository{ctrl: ctrl}
+	mock.recorder = &MockTelegramLinkRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTelegramLinkRepository) EXPECT() *MockTelegramLinkRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ConsumeCode mocks base method.
+func (m *MockTelegramLinkRepository) ConsumeCode(ctx context.Context, codeHash string, chatID int64, username string) (*entities.TelegramLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeCode", ctx, codeHash, chatID, username)
+	ret0, _ := ret[0].(*entities.TelegramLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeCode indicates an expected call of ConsumeCode.
+func (mr *MockTelegramLinkRepositoryMockRecorder) ConsumeCode(ctx, codeHash, chatID, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeCode", reflect.TypeOf((*MockTelegramLinkRepository)(nil).ConsumeCode), ctx, codeHash, chatID, username)
+}
+
+// DeleteByChatID mocks base method.
+func (m *MockTelegramLinkRepository) DeleteByChatID(ctx context.Context, chatID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByChatID", ctx, chatID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByChatID indicates an expected call of DeleteByChatID.
+func (mr *MockTelegramLinkRepositoryMockRecorder) DeleteByChatID(ctx, chatID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByChatID", reflect.TypeOf((*MockTelegramLinkRepository)(nil).DeleteByChatID), ctx, chatID)
+}
+
+// DeleteByUserID mocks base method.
+func (m *MockTelegramLinkRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserID", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserID indicates an expected call of DeleteByUserID.
+func (mr *MockTelegramLinkRepositoryMockRecorder) DeleteByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserID", reflect.TypeOf((*MockTelegramLinkRepository)(nil).DeleteByUserID), ctx, userID)
+}
+
+// GetByChatID mocks base method.
+func (m *MockTelegramLinkRepository) GetByChatID(ctx context.Context, chatID int64) (*entities.TelegramLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByChatID", ctx, chatID)
+	ret0, _ := ret[0].(*entities.TelegramLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByChatID indicates an expected call of GetByChatID.
+func (mr *MockTelegramLinkRepositoryMockRecorder) GetByChatID(ctx, chatID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByChatID", reflect.TypeOf((*MockTelegramLinkRepository)(nil).GetByChatID), ctx, chatID)
+}
+
+// GetByUserID mocks base method.
+func (m *MockTelegramLinkRepository) GetByUserID(ctx context.Context, userID primitive.ObjectID) (*entities.TelegramLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
+	ret0, _ := ret[0].(*entities.TelegramLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockTelegramLinkRepositoryMockRecorder) GetByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockTelegramLinkRepository)(nil).GetByUserID), ctx, userID)
+}
+
+// SaveCode mocks base method.
+func (m *MockTelegramLinkRepository) SaveCode(ctx context.Context, userID primitive.ObjectID, codeHash string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCode", ctx, userID, codeHash, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCode indicates an expected call of SaveCode.
+func (mr *MockTelegramLinkRepositoryMockRecorder) SaveCode(ctx, userID, codeHash, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCode", reflect.TypeOf((*MockTelegramLinkRepository)(nil).SaveCode), ctx, userID, codeHash, expiresAt)
+}
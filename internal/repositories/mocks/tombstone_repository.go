@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tombstone_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tombstone_repository.go -destination=mocks/tombstone_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTombstoneRepository is a mock of TombstoneRepository interface.
+type MockTombstoneRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTombstoneRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTombstoneRepositoryMockRecorder is the mock recorder for MockTombstoneRepository.
+type MockTombstoneRepositoryMockRecorder struct {
+	mock *MockTombstoneRepository
+}
+
+// NewMockTombstoneRepository creates a new mock instance.
+func NewMockTombstoneRepository(ctrl *gomock.Controller) *MockTombstoneRepository {
+	mock := &MockTombstoneRepository{ctrl: ctrl}
+	mock.recorder = &MockTombstoneRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTombstoneRepository) EXPECT() *MockTombstoneRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListSince mocks base method.
+func (m *MockTombstoneRepository) ListSince(ctx context.Context, userID primitive.ObjectID, entityType string, after, until time.Time) ([]*entities.Tombstone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSince", ctx, userID, entityType, after, until)
+	ret0, _ := ret[0].([]*entities.Tombstone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSince indicates an expected call of ListSince.
+func (mr *MockTombstoneRepositoryMockRecorder) ListSince(ctx, userID, entityType, after, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSince", reflect.TypeOf((*MockTombstoneRepository)(nil).ListSince), ctx, userID, entityType, after, until)
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usage_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=usage_repository.go -destination=mocks/usage_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsageRepository is a mock of UsageRepository interface.
+type MockUsageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsageRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUsageRepositoryMockRecorder is the mock recorder for MockUsageRepository.
+type MockUsageRepositoryMockRecorder struct {
+	mock *MockUsageRepository
+}
+
+// NewMockUsageRepository creates a new mock instance.
+func NewMockUsageRepository(ctrl *gomock.Controller) *MockUsageRepository {
+	mock := &MockUsageRepository{ctrl: ctrl}
+	mock.recorder = &MockUsageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsageRepository) EXPECT() *MockUsageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Decrement mocks base method.
+func (m *MockUsageRepository) Decrement(ctx context.Context, userID primitive.ObjectID, resource, period string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrement", ctx, userID, resource, period)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Decrement indicates an expected call of Decrement.
+func (mr *MockUsageRepositoryMockRecorder) Decrement(ctx, userID, resource, period any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrement", reflect.TypeOf((*MockUsageRepository)(nil).Decrement), ctx, userID, resource, period)
+}
+
+// Get mocks base method.
+func (m *MockUsageRepository) Get(ctx context.Context, userID primitive.ObjectID, resource, period string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID, resource, period)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockUsageRepositoryMockRecorder) Get(ctx, userID, resource, period any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUsageRepository)(nil).Get), ctx, userID, resource, period)
+}
+
+// Increment mocks base method.
+func (m *MockUsageRepository) Increment(ctx context.Context, userID primitive.ObjectID, resource, period string, limit int64, expiresAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Increment", ctx, userID, resource, period, limit, expiresAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Increment indicates an expected call of Increment.
+func (mr *MockUsageRepositoryMockRecorder) Increment(ctx, userID, resource, period, limit, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockUsageRepository)(nil).Increment), ctx, userID, resource, period, limit, expiresAt)
+}
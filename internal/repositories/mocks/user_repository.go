@@ -0,0 +1,347 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: user_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=user_repository.go -destination=mocks/user_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserRepository is a mock of UserRepository interface.
+type MockUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserRepositoryMockRecorder is the mock recorder for MockUserRepository.
+type MockUserRepositoryMockRecorder struct {
+	mock *MockUserRepository
+}
+
+// NewMockUserRepository creates a new mock instance.
+func NewMockUserRepository(ctrl *gomock.Controller) *MockUserRepository {
+	mock := &MockUserRepository{ctrl: ctrl}
+	mock.recorder = &MockUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddCustomStatus mocks base method.
+func (m *MockUserRepository) AddCustomStatus(ctx context.Context, id primitive.ObjectID, status entities.CustomStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCustomStatus", ctx, id, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCustomStatus indicates an expected call of AddCustomStatus.
+func (mr *MockUserRepositoryMockRecorder) AddCustomStatus(ctx, id, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCustomStatus", reflect.TypeOf((*MockUserRepository)(nil).AddCustomStatus), ctx, id, status)
+}
+
+// ClaimOverdueSummary mocks base method.
+func (m *MockUserRepository) ClaimOverdueSummary(ctx context.Context, id primitive.ObjectID, day string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimOverdueSummary", ctx, id, day)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimOverdueSummary indicates an expected call of ClaimOverdueSummary.
+func (mr *MockUserRepositoryMockRecorder) ClaimOverdueSummary(ctx, id, day any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimOverdueSummary", reflect.TypeOf((*MockUserRepository)(nil).ClaimOverdueSummary), ctx, id, day)
+}
+
+// Create mocks base method.
+func (m *MockUserRepository) Create(ctx context.Context, user *entities.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUserRepositoryMockRecorder) Create(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserRepository)(nil).Create), ctx, user)
+}
+
+// Delete mocks base method.
+func (m *MockUserRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserRepository)(nil).Delete), ctx, id)
+}
+
+// Exists mocks base method.
+func (m *MockUserRepository) Exists(ctx context.Context, email string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, email)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockUserRepositoryMockRecorder) Exists(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockUserRepository)(nil).Exists), ctx, email)
+}
+
+// GetByEmail mocks base method.
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEmail", ctx, email)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEmail indicates an expected call of GetByEmail.
+func (mr *MockUserRepositoryMockRecorder) GetByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmail", reflect.TypeOf((*MockUserRepository)(nil).GetByEmail), ctx, email)
+}
+
+// GetByFeedTokenHash mocks base method.
+func (m *MockUserRepository) GetByFeedTokenHash(ctx context.Context, hash string) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByFeedTokenHash", ctx, hash)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByFeedTokenHash indicates an expected call of GetByFeedTokenHash.
+func (mr *MockUserRepositoryMockRecorder) GetByFeedTokenHash(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByFeedTokenHash", reflect.TypeOf((*MockUserRepository)(nil).GetByFeedTokenHash), ctx, hash)
+}
+
+// GetByID mocks base method.
+func (m *MockUserRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockUserRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUserRepository)(nil).GetByID), ctx, id)
+}
+
+// GetByInboundAlias mocks base method.
+func (m *MockUserRepository) GetByInboundAlias(ctx context.Context, alias string) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByInboundAlias", ctx, alias)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByInboundAlias indicates an expected call of GetByInboundAlias.
+func (mr *MockUserRepositoryMockRecorder) GetByInboundAlias(ctx, alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByInboundAlias", reflect.TypeOf((*MockUserRepository)(nil).GetByInboundAlias), ctx, alias)
+}
+
+// List mocks base method.
+func (m *MockUserRepository) List(ctx context.Context, page, limit int64) ([]*entities.User, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, page, limit)
+	ret0, _ := ret[0].([]*entities.User)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockUserRepositoryMockRecorder) List(ctx, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserRepository)(nil).List), ctx, page, limit)
+}
+
+// ListOverdueSummarySubscribers mocks base method.
+func (m *MockUserRepository) ListOverdueSummarySubscribers(ctx context.Context, afterID primitive.ObjectID, limit int64) ([]*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverdueSummarySubscribers", ctx, afterID, limit)
+	ret0, _ := ret[0].([]*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverdueSummarySubscribers indicates an expected call of ListOverdueSummarySubscribers.
+func (mr *MockUserRepositoryMockRecorder) ListOverdueSummarySubscribers(ctx, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverdueSummarySubscribers", reflect.TypeOf((*MockUserRepository)(nil).ListOverdueSummarySubscribers), ctx, afterID, limit)
+}
+
+// RemoveCustomStatus mocks base method.
+func (m *MockUserRepository) RemoveCustomStatus(ctx context.Context, id, statusID primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCustomStatus", ctx, id, statusID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCustomStatus indicates an expected call of RemoveCustomStatus.
+func (mr *MockUserRepositoryMockRecorder) RemoveCustomStatus(ctx, id, statusID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCustomStatus", reflect.TypeOf((*MockUserRepository)(nil).RemoveCustomStatus), ctx, id, statusID)
+}
+
+// SetFeedTokenHash mocks base method.
+func (m *MockUserRepository) SetFeedTokenHash(ctx context.Context, id primitive.ObjectID, hash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFeedTokenHash", ctx, id, hash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFeedTokenHash indicates an expected call of SetFeedTokenHash.
+func (mr *MockUserRepositoryMockRecorder) SetFeedTokenHash(ctx, id, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeedTokenHash", reflect.TypeOf((*MockUserRepository)(nil).SetFeedTokenHash), ctx, id, hash)
+}
+
+// SetInboundAlias mocks base method.
+func (m *MockUserRepository) SetInboundAlias(ctx context.Context, id primitive.ObjectID, alias string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetInboundAlias", ctx, id, alias)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetInboundAlias indicates an expected call of SetInboundAlias.
+func (mr *MockUserRepositoryMockRecorder) SetInboundAlias(ctx, id, alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInboundAlias", reflect.TypeOf((*MockUserRepository)(nil).SetInboundAlias), ctx, id, alias)
+}
+
+// SetPhone mocks base method.
+func (m *MockUserRepository) SetPhone(ctx context.Context, id primitive.ObjectID, phone *entities.PhoneNumber) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPhone", ctx, id, phone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPhone indicates an expected call of SetPhone.
+func (mr *MockUserRepositoryMockRecorder) SetPhone(ctx, id, phone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPhone", reflect.TypeOf((*MockUserRepository)(nil).SetPhone), ctx, id, phone)
+}
+
+// Suspend mocks base method.
+func (m *MockUserRepository) Suspend(ctx context.Context, id primitive.ObjectID, reason string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suspend", ctx, id, reason, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Suspend indicates an expected call of Suspend.
+func (mr *MockUserRepositoryMockRecorder) Suspend(ctx, id, reason, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suspend", reflect.TypeOf((*MockUserRepository)(nil).Suspend), ctx, id, reason, at)
+}
+
+// Unsuspend mocks base method.
+func (m *MockUserRepository) Unsuspend(ctx context.Context, id primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsuspend", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unsuspend indicates an expected call of Unsuspend.
+func (mr *MockUserRepositoryMockRecorder) Unsuspend(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsuspend", reflect.TypeOf((*MockUserRepository)(nil).Unsuspend), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockUserRepository) Update(ctx context.Context, user *entities.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUserRepositoryMockRecorder) Update(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserRepository)(nil).Update), ctx, user)
+}
+
+// UpdateCustomStatus mocks base method.
+func (m *MockUserRepository) UpdateCustomStatus(ctx context.Context, id primitive.ObjectID, status *entities.CustomStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomStatus", ctx, id, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCustomStatus indicates an expected call of UpdateCustomStatus.
+func (mr *MockUserRepositoryMockRecorder) UpdateCustomStatus(ctx, id, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomStatus", reflect.TypeOf((*MockUserRepository)(nil).UpdateCustomStatus), ctx, id, status)
+}
+
+// UpdatePassword mocks base method.
+func (m *MockUserRepository) UpdatePassword(ctx context.Context, id primitive.ObjectID, hashedPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, id, hashedPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockUserRepositoryMockRecorder) UpdatePassword(ctx, id, hashedPassword any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUserRepository)(nil).UpdatePassword), ctx, id, hashedPassword)
+}
+
+// UpdatePreferences mocks base method.
+func (m *MockUserRepository) UpdatePreferences(ctx context.Context, id primitive.ObjectID, preferences entities.UserPreferences) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePreferences", ctx, id, preferences)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePreferences indicates an expected call of UpdatePreferences.
+func (mr *MockUserRepositoryMockRecorder) UpdatePreferences(ctx, id, preferences any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePreferences", reflect.TypeOf((*MockUserRepository)(nil).UpdatePreferences), ctx, id, preferences)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: view_settings_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=view_settings_repository.go -destination=mocks/view_settings_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockViewSettingsRepository is a mock of ViewSettingsRepository interface.
+type MockViewSettingsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockViewSettingsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockViewSettingsRepositoryMockRecorder is the mock recorder for MockViewSettingsRepository.
+type MockViewSettingsRepositoryMockRecorder struct {
+	mock *MockViewSettingsRepository
+}
+
+// NewMockViewSettingsRepository creates a new mock instance.
+func NewMockViewSettingsRepository(ctrl *gomock.Controller) *MockViewSettingsRepository {
+	mock := &MockViewSettingsRepository{ctrl: ctrl}
+	mock.recorder = &MockViewSettingsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockViewSettingsRepository) EXPECT() *MockViewSettingsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockViewSettingsRepository) Get(ctx context.Context, userID primitive.ObjectID, projectID *primitive.ObjectID) (*entities.ViewSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID, projectID)
+	ret0, _ := ret[0].(*entities.ViewSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockViewSettingsRepositoryMockRecorder) Get(ctx, userID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockViewSettingsRepository)(nil).Get), ctx, userID, projectID)
+}
+
+// Save mocks base method.
+func (m *MockViewSettingsRepository) Save(ctx context.Context, settings *entities.ViewSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockViewSettingsRepositoryMockRecorder) Save(ctx, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockViewSettingsRepository)(nil).Save), ctx, settings)
+}
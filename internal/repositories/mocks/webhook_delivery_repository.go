@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhook_delivery_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=webhook_delivery_repository.go -destination=mocks/webhook_delivery_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookDeliveryRepository is a mock of WebhookDeliveryRepository interface.
+type MockWebhookDeliveryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDeliveryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookDeliveryRepositoryMockRecorder is the mock recorder for MockWebhookDeliveryRepository.
+type MockWebhookDeliveryRepositoryMockRecorder struct {
+	mock *MockWebhookDeliveryRepository
+}
+
+// NewMockWebhookDeliveryRepository creates a new mock instance.
+func NewMockWebhookDeliveryRepository(ctrl *gomock.Controller) *MockWebhookDeliveryRepository {
+	mock := &MockWebhookDeliveryRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeliveryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookDeliveryRepository) EXPECT() *MockWebhookDeliveryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWebhookDeliveryRepository) Create(ctx context.Context, delivery *entities.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) Create(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).Create), ctx, delivery)
+}
+
+// GetByID mocks base method.
+func (m *MockWebhookDeliveryRepository) GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, userID, id)
+	ret0, _ := ret[0].(*entities.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) GetByID(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).GetByID), ctx, userID, id)
+}
+
+// ListByUser mocks base method.
+func (m *MockWebhookDeliveryRepository) ListByUser(ctx context.Context, userID primitive.ObjectID, page, limit int64) ([]*entities.WebhookDelivery, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUser", ctx, userID, page, limit)
+	ret0, _ := ret[0].([]*entities.WebhookDelivery)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByUser indicates an expected call of ListByUser.
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) ListByUser(ctx, userID, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).ListByUser), ctx, userID, page, limit)
+}
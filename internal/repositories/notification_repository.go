@@ -0,0 +1,237 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// maxDeliveryAttempts limita as tentativas de entrega nos canais externos
+const maxDeliveryAttempts = 5
+
+// NotificationRepository interface define os métodos do repositório de notificações
+type NotificationRepository interface {
+	Create(ctx context.Context, notification *entities.Notification) error
+	ListInbox(ctx context.Context, userID primitive.ObjectID, page, limit int64, unreadOnly bool) ([]*entities.Notification, int64, error)
+	MarkRead(ctx context.Context, userID, id primitive.ObjectID) error
+	MarkAllRead(ctx context.Context, userID primitive.ObjectID) (int64, error)
+	ShowInInbox(ctx context.Context, ids []primitive.ObjectID) error
+	UsersWithPending(ctx context.Context, limit int64) ([]primitive.ObjectID, error)
+	GetPendingByUser(ctx context.Context, userID primitive.ObjectID) ([]*entities.Notification, error)
+	MarkDelivered(ctx context.Context, ids []primitive.ObjectID, channel string) error
+	MarkFailed(ctx context.Context, ids []primitive.ObjectID, channel string, deliveryErr error) error
+}
+
+// notificationRepository implementa NotificationRepository
+type notificationRepository struct {
+	operationTimeouts
+	collection *mongo.Collection
+}
+
+// NewNotificationRepository cria uma nova instância do repositório
+func NewNotificationRepository(db database.Client) NotificationRepository {
+	return &notificationRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		collection:        db.Collections().Notifications,
+	}
+}
+
+// Create grava uma nova notificação. Eventos já notificados ao usuário são ignorados.
+func (r *notificationRepository) Create(ctx context.Context, notification *entities.Notification) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	notification.PrepareForCreate()
+
+	_, err := r.collection.InsertOne(ctx, notification)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil
+		}
+		return wrapError("erro ao criar notificação: %w", err)
+	}
+
+	return nil
+}
+
+// ListInbox lista as notificações da caixa de entrada do usuário
+func (r *notificationRepository) ListInbox(ctx context.Context, userID primitive.ObjectID, page, limit int64, unreadOnly bool) ([]*entities.Notification, int64, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"user_id": userID, "in_inbox": true}
+	if unreadOnly {
+		filter["read_at"] = bson.M{"$exists": false}
+	}
+
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, wrapError("erro ao contar notificações: %w", err)
+	}
+
+	opts := options.Find().
+		SetSkip((page - 1) * limit).
+		SetLimit(limit).
+		SetSort(bson.M{"created_at": -1})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, wrapError("erro ao listar notificações: %w", err)
+	}
+
+	var notifications []*entities.Notification
+	if err := cursor.All(ctx, &notifications); err != nil {
+		return nil, 0, wrapError("erro ao decodificar notificações: %w", err)
+	}
+
+	return notifications, total, nil
+}
+
+// MarkRead marca uma notificação do usuário como lida
+func (r *notificationRepository) MarkRead(ctx context.Context, userID, id primitive.ObjectID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"_id": id, "user_id": userID, "in_inbox": true}
+	update := bson.M{"$set": bson.M{"read_at": time.Now()}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return wrapError("erro ao marcar notificação como lida: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrNotificationNotFound
+	}
+
+	return nil
+}
+
+// MarkAllRead marca todas as notificações não lidas do usuário como lidas
+func (r *notificationRepository) MarkAllRead(ctx context.Context, userID primitive.ObjectID) (int64, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"user_id": userID, "in_inbox": true, "read_at": bson.M{"$exists": false}}
+	update := bson.M{"$set": bson.M{"read_at": time.Now()}}
+
+	result, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, wrapError("erro ao marcar notificações como lidas: %w", err)
+	}
+
+	return result.ModifiedCount, nil
+}
+
+// ShowInInbox torna as notificações visíveis na caixa de entrada
+func (r *notificationRepository) ShowInInbox(ctx context.Context, ids []primitive.ObjectID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	_, err := r.collection.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": ids}}, bson.M{"$set": bson.M{"in_inbox": true}})
+	if err != nil {
+		return wrapError("erro ao adicionar notificações à caixa de entrada: %w", err)
+	}
+
+	return nil
+}
+
+// UsersWithPending retorna usuários com notificações aguardando entrega externa
+func (r *notificationRepository) UsersWithPending(ctx context.Context, limit int64) ([]primitive.ObjectID, error) {
+	ctx, cancel := r.aggregateContext(ctx)
+	defer cancel()
+
+	pipeline := []bson.M{
+		{"$match": bson.M{
+			"pending_channels.0": bson.M{"$exists": true},
+			"attempts":           bson.M{"$lt": maxDeliveryAttempts},
+		}},
+		{"$group": bson.M{"_id": "$user_id"}},
+		{"$limit": limit},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, wrapError("erro ao buscar notificações pendentes: %w", err)
+	}
+
+	var results []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, wrapError("erro ao decodificar notificações pendentes: %w", err)
+	}
+
+	userIDs := make([]primitive.ObjectID, 0, len(results))
+	for _, result := range results {
+		userIDs = append(userIDs, result.ID)
+	}
+
+	return userIDs, nil
+}
+
+// GetPendingByUser retorna as notificações do usuário aguardando entrega externa
+func (r *notificationRepository) GetPendingByUser(ctx context.Context, userID primitive.ObjectID) ([]*entities.Notification, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	filter := bson.M{
+		"user_id":            userID,
+		"pending_channels.0": bson.M{"$exists": true},
+		"attempts":           bson.M{"$lt": maxDeliveryAttempts},
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, options.Find().SetSort(bson.M{"created_at": 1}))
+	if err != nil {
+		return nil, wrapError("erro ao buscar notificações pendentes: %w", err)
+	}
+
+	var notifications []*entities.Notification
+	if err := cursor.All(ctx, &notifications); err != nil {
+		return nil, wrapError("erro ao decodificar notificações pendentes: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// MarkDelivered remove o canal da lista de pendentes das notificações
+func (r *notificationRepository) MarkDelivered(ctx context.Context, ids []primitive.ObjectID, channel string) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	_, err := r.collection.UpdateMany(ctx,
+		bson.M{"_id": bson.M{"$in": ids}},
+		bson.M{"$pull": bson.M{"pending_channels": channel}},
+	)
+	if err != nil {
+		return wrapError("erro ao registrar entrega de notificações: %w", err)
+	}
+
+	return nil
+}
+
+// MarkFailed registra uma falha de entrega, mantendo o canal pendente para nova tentativa
+func (r *notificationRepository) MarkFailed(ctx context.Context, ids []primitive.ObjectID, channel string, deliveryErr error) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	_, err := r.collection.UpdateMany(ctx,
+		bson.M{"_id": bson.M{"$in": ids}},
+		bson.M{
+			"$inc": bson.M{"attempts": 1},
+			"$set": bson.M{"last_error": fmt.Sprintf("%s: %v", channel, deliveryErr)},
+		},
+	)
+	if err != nil {
+		return wrapError("erro ao registrar falha de entrega: %w", err)
+	}
+
+	return nil
+}
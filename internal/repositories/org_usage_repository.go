@@ -0,0 +1,125 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// OrgUsageRepository interface define as consultas de uso por usuário e o
+// armazenamento do uso calculado de cada organização
+type OrgUsageRepository interface {
+	CountTasksByUser(ctx context.Context, userIDs []primitive.ObjectID) (map[primitive.ObjectID]int64, error)
+	StorageByUser(ctx context.Context, userIDs []primitive.ObjectID) (map[primitive.ObjectID]int64, error)
+	Save(ctx context.Context, usage *entities.OrgUsage) error
+	Get(ctx context.Context, organizationID primitive.ObjectID) (*entities.OrgUsage, error)
+}
+
+// orgUsageRepository implementa OrgUsageRepository
+type orgUsageRepository struct {
+	operationTimeouts
+	collection *mongo.Collection
+	// tasks inclui o arquivo (tasks_archive): tarefas arquivadas continuam criadas
+	tasks       []*mongo.Collection
+	attachments *mongo.Collection
+}
+
+// NewOrgUsageRepository cria uma nova instância do repositório
+func NewOrgUsageRepository(db database.Client) OrgUsageRepository {
+	collections := db.Collections()
+
+	return &orgUsageRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		collection:        collections.OrgUsage,
+		tasks:             []*mongo.Collection{collections.Tasks, collections.TasksArchive},
+		attachments:       db.GetCollection(database.GetCollectionNames().Attachments + ".files"),
+	}
+}
+
+// CountTasksByUser conta as tarefas (não removidas) criadas por cada usuário,
+// inclusive as arquivadas
+func (r *orgUsageRepository) CountTasksByUser(ctx context.Context, userIDs []primitive.ObjectID) (map[primitive.ObjectID]int64, error) {
+	totals := make(map[primitive.ObjectID]int64, len(userIDs))
+	for _, tasks := range r.tasks {
+		counts, err := r.sumByUser(ctx, tasks, "user_id", 1, userIDs, "tarefas")
+		if err != nil {
+			return nil, err
+		}
+		for userID, count := range counts {
+			totals[userID] += count
+		}
+	}
+
+	return totals, nil
+}
+
+// StorageByUser soma o tamanho, em bytes, dos anexos de cada usuário
+func (r *orgUsageRepository) StorageByUser(ctx context.Context, userIDs []primitive.ObjectID) (map[primitive.ObjectID]int64, error) {
+	return r.sumByUser(ctx, r.attachments, "metadata.user_id", "$length", userIDs, "anexos")
+}
+
+// sumByUser agrupa a collection pelo campo do usuário somando value
+func (r *orgUsageRepository) sumByUser(ctx context.Context, collection *mongo.Collection, userField string, value interface{}, userIDs []primitive.ObjectID, resource string) (map[primitive.ObjectID]int64, error) {
+	ctx, cancel := r.aggregateContext(ctx)
+	defer cancel()
+
+	pipeline := []bson.M{
+		{"$match": bson.M{userField: bson.M{"$in": userIDs}}},
+		{"$group": bson.M{"_id": "$" + userField, "total": bson.M{"$sum": value}}},
+	}
+
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, wrapError("erro ao somar %s por usuário: %w", resource, err)
+	}
+
+	var results []struct {
+		UserID primitive.ObjectID `bson:"_id"`
+		Total  int64              `bson:"total"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, wrapError("erro ao decodificar %s por usuário: %w", resource, err)
+	}
+
+	totals := make(map[primitive.ObjectID]int64, len(results))
+	for _, result := range results {
+		totals[result.UserID] = result.Total
+	}
+
+	return totals, nil
+}
+
+// Save grava o uso calculado da organização, substituindo o anterior
+func (r *orgUsageRepository) Save(ctx context.Context, usage *entities.OrgUsage) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"_id": usage.OrganizationID}
+	if _, err := r.collection.ReplaceOne(ctx, filter, usage, options.Replace().SetUpsert(true)); err != nil {
+		return wrapError("erro ao salvar uso da organização: %w", err)
+	}
+
+	return nil
+}
+
+// Get retorna o último uso calculado da organização
+func (r *orgUsageRepository) Get(ctx context.Context, organizationID primitive.ObjectID) (*entities.OrgUsage, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	var usage entities.OrgUsage
+	if err := r.collection.FindOne(ctx, bson.M{"_id": organizationID}).Decode(&usage); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrOrgUsageNotFound
+		}
+		return nil, wrapError("erro ao buscar uso da organização: %w", err)
+	}
+
+	return &usage, nil
+}
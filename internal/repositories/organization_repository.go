@@ -0,0 +1,265 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/clock"
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// OrganizationRepository interface define os métodos do repositório de organizações
+type OrganizationRepository interface {
+	Create(ctx context.Context, organization *entities.Organization) error
+	GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.Organization, error)
+	ListByUser(ctx context.Context, userID primitive.ObjectID) ([]*entities.Organization, error)
+	ListAll(ctx context.Context) ([]*entities.Organization, error)
+	Invite(ctx context.Context, ownerID, id primitive.ObjectID, invite entities.OrganizationInvite) error
+	ListInvites(ctx context.Context, email string, now time.Time) ([]*entities.Organization, error)
+	AcceptInvite(ctx context.Context, id primitive.ObjectID, email string, member entities.OrganizationMember) error
+	DeclineInvite(ctx context.Context, id primitive.ObjectID, email string) error
+	RemoveMember(ctx context.Context, id, memberID primitive.ObjectID) error
+}
+
+// organizationRepository implementa OrganizationRepository
+type organizationRepository struct {
+	operationTimeouts
+	collection *mongo.Collection
+	clock      clock.Clock
+}
+
+// NewOrganizationRepository cria uma nova instância do repositório
+func NewOrganizationRepository(db database.Client) OrganizationRepository {
+	return &organizationRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		collection:        db.Collections().Organizations,
+		clock:             clock.System(),
+	}
+}
+
+// Create grava uma nova organização
+func (r *organizationRepository) Create(ctx context.Context, organization *entities.Organization) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	if _, err := r.collection.InsertOne(ctx, organization); err != nil {
+		return wrapError("erro ao criar organização: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID busca por ID uma organização de que o usuário é dono ou membro
+func (r *organizationRepository) GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.Organization, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	filter := bson.M{
+		"_id": id,
+		"$or": []bson.M{
+			{"owner_id": userID},
+			{"members.user_id": userID},
+		},
+	}
+
+	var organization entities.Organization
+	if err := r.collection.FindOne(ctx, filter).Decode(&organization); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrOrganizationNotFound
+		}
+		return nil, wrapError("erro ao buscar organização: %w", err)
+	}
+
+	return &organization, nil
+}
+
+// ListByUser lista as organizações de que o usuário é dono ou membro, em ordem alfabética
+func (r *organizationRepository) ListByUser(ctx context.Context, userID primitive.ObjectID) ([]*entities.Organization, error) {
+	return r.find(ctx, bson.M{"$or": []bson.M{
+		{"owner_id": userID},
+		{"members.user_id": userID},
+	}})
+}
+
+// ListAll lista todas as organizações, usado no cálculo periódico do uso
+func (r *organizationRepository) ListAll(ctx context.Context) ([]*entities.Organization, error) {
+	return r.find(ctx, bson.M{})
+}
+
+// find lista as organizações do filtro em ordem alfabética
+func (r *organizationRepository) find(ctx context.Context, filter bson.M) ([]*entities.Organization, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "name", Value: 1}}).
+		SetCollation(caseInsensitiveCollation)
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, wrapError("erro ao listar organizações: %w", err)
+	}
+
+	organizations := []*entities.Organization{}
+	if err := cursor.All(ctx, &organizations); err != nil {
+		return nil, wrapError("erro ao decodificar organizações: %w", err)
+	}
+
+	return organizations, nil
+}
+
+// Invite grava o convite na organização do dono. Um novo convite para o mesmo
+// email substitui o anterior; os expirados são descartados.
+func (r *organizationRepository) Invite(ctx context.Context, ownerID, id primitive.ObjectID, invite entities.OrganizationInvite) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	owned := bson.M{"_id": id, "owner_id": ownerID}
+
+	_, err := r.collection.UpdateOne(ctx, owned, bson.M{
+		"$pull": bson.M{"invites": bson.M{"$or": bson.A{
+			bson.M{"email": invite.Email},
+			bson.M{"expires_at": bson.M{"$lte": invite.InvitedAt}},
+		}}},
+	})
+	if err != nil {
+		return wrapError("erro ao descartar convites da organização: %w", err)
+	}
+
+	filter := bson.M{
+		"_id":      id,
+		"owner_id": ownerID,
+		"$expr": bson.M{"$lt": bson.A{
+			bson.M{"$size": bson.M{"$ifNull": bson.A{"$invites", bson.A{}}}},
+			entities.MaxOrganizationInvites,
+		}},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, bson.M{
+		"$push": bson.M{"invites": invite},
+		"$set":  bson.M{"updated_at": invite.InvitedAt},
+	})
+	if err != nil {
+		return wrapError("erro ao convidar para a organização: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		// Distingue organização inexistente de limite de convites
+		count, err := r.collection.CountDocuments(ctx, owned)
+		if err != nil {
+			return wrapError("erro ao buscar organização: %w", err)
+		}
+		if count == 0 {
+			return ErrOrganizationNotFound
+		}
+		return ErrOrganizationInvitesFull
+	}
+
+	return nil
+}
+
+// ListInvites lista as organizações com convite pendente (não expirado em now)
+// para o email
+func (r *organizationRepository) ListInvites(ctx context.Context, email string, now time.Time) ([]*entities.Organization, error) {
+	return r.find(ctx, bson.M{"invites": bson.M{"$elemMatch": bson.M{
+		"email":      email,
+		"expires_at": bson.M{"$gt": now},
+	}}})
+}
+
+// AcceptInvite troca o convite do email pelo membro se ainda houver assento
+// livre. A verificação dos assentos é feita no próprio update, sem corrida
+// entre aceites simultâneos.
+func (r *organizationRepository) AcceptInvite(ctx context.Context, id primitive.ObjectID, email string, member entities.OrganizationMember) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{
+		"_id":             id,
+		"owner_id":        bson.M{"$ne": member.UserID},
+		"members.user_id": bson.M{"$ne": member.UserID},
+		"invites": bson.M{"$elemMatch": bson.M{
+			"email":      email,
+			"expires_at": bson.M{"$gt": member.AddedAt},
+		}},
+		// O dono ocupa um assento
+		"$expr": bson.M{"$lt": bson.A{
+			bson.M{"$add": bson.A{1, bson.M{"$size": bson.M{"$ifNull": bson.A{"$members", bson.A{}}}}}},
+			"$seats",
+		}},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, bson.M{
+		"$pull": bson.M{"invites": bson.M{"email": email}},
+		"$push": bson.M{"members": member},
+		"$set":  bson.M{"updated_at": member.AddedAt},
+	})
+	if err != nil {
+		return wrapError("erro ao aceitar convite da organização: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		// Distingue convite inexistente, membro já adicionado e assentos esgotados
+		var organization entities.Organization
+		if err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&organization); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return ErrOrganizationInviteNotFound
+			}
+			return wrapError("erro ao buscar organização: %w", err)
+		}
+		if _, ok := organization.Invite(email, member.AddedAt); !ok {
+			return ErrOrganizationInviteNotFound
+		}
+		if organization.HasMember(member.UserID) {
+			return ErrOrganizationMemberExists
+		}
+		return ErrOrganizationSeatsFull
+	}
+
+	return nil
+}
+
+// DeclineInvite remove o convite do email
+func (r *organizationRepository) DeclineInvite(ctx context.Context, id primitive.ObjectID, email string) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": id, "invites.email": email}, bson.M{
+		"$pull": bson.M{"invites": bson.M{"email": email}},
+	})
+	if err != nil {
+		return wrapError("erro ao recusar convite da organização: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrOrganizationInviteNotFound
+	}
+
+	return nil
+}
+
+// RemoveMember libera o assento do membro na organização
+func (r *organizationRepository) RemoveMember(ctx context.Context, id, memberID primitive.ObjectID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": id, "members.user_id": memberID}, bson.M{
+		"$pull": bson.M{"members": bson.M{"user_id": memberID}},
+		"$set":  bson.M{"updated_at": r.clock.Now()},
+	})
+	if err != nil {
+		return wrapError("erro ao remover membro da organização: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrOrganizationMemberNotFound
+	}
+
+	return nil
+}
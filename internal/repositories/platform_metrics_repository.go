@@ -0,0 +1,370 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// metricsCounterRetention é por quanto tempo os contadores diários são mantidos
+const metricsCounterRetention = 90 * 24 * time.Hour
+
+// DailyTasks é o número de tarefas criadas e concluídas por dia (UTC, AAAA-MM-DD)
+type DailyTasks struct {
+	Created   map[string]int64
+	Completed map[string]int64
+}
+
+// PlatformMetricsRepository interface define as consultas globais do painel
+// administrativo e o armazenamento dos contadores das instâncias
+type PlatformMetricsRepository interface {
+	IncrementCounters(ctx context.Context, day, name string, counts map[string]int64) error
+	SumCounters(ctx context.Context, name, sinceDay string) (map[string]int64, error)
+	CountActiveUsers(ctx context.Context, since time.Time) (int64, error)
+	CountDailyTasks(ctx context.Context, since time.Time) (*DailyTasks, error)
+	CountOpenTasks(ctx context.Context, now time.Time) (open, overdue int64, err error)
+	StorageUsage(ctx context.Context) (*entities.StorageUsage, error)
+	SaveSnapshot(ctx context.Context, snapshot *entities.PlatformMetrics) error
+	GetSnapshot(ctx context.Context) (*entities.PlatformMetrics, error)
+	SaveKPIs(ctx context.Context, kpis *entities.BusinessKPIs) error
+	GetKPIs(ctx context.Context) (*entities.BusinessKPIs, error)
+}
+
+// platformMetricsRepository implementa PlatformMetricsRepository
+type platformMetricsRepository struct {
+	operationTimeouts
+	collection  *mongo.Collection
+	tasks       *mongo.Collection
+	changes     *mongo.Collection
+	auditLogs   string
+	attachments *mongo.Collection
+}
+
+// NewPlatformMetricsRepository cria uma nova instância do repositório
+func NewPlatformMetricsRepository(db database.Client) PlatformMetricsRepository {
+	collections := db.Collections()
+	names := database.GetCollectionNames()
+
+	return &platformMetricsRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		collection:        collections.PlatformMetrics,
+		tasks:             collections.Tasks,
+		changes:           collections.TaskChanges,
+		auditLogs:         names.AuditLogs,
+		attachments:       db.GetCollection(names.Attachments + ".files"),
+	}
+}
+
+// IncrementCounters soma os contadores de uma instância aos do dia
+func (r *platformMetricsRepository) IncrementCounters(ctx context.Context, day, name string, counts map[string]int64) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	inc := bson.M{}
+	for key, n := range counts {
+		inc["counts."+key] = n
+	}
+
+	dayStart, err := time.Parse(time.DateOnly, day)
+	if err != nil {
+		return wrapError("dia inválido: %w", err)
+	}
+
+	update := bson.M{
+		"$inc": inc,
+		"$setOnInsert": bson.M{
+			"day":        day,
+			"name":       name,
+			"expires_at": dayStart.Add(metricsCounterRetention),
+		},
+	}
+
+	_, err = r.collection.UpdateOne(ctx, bson.M{"_id": day + ":" + name}, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return wrapError("erro ao registrar contadores: %w", err)
+	}
+
+	return nil
+}
+
+// SumCounters soma, por chave, os contadores name a partir do dia sinceDay (inclusive)
+func (r *platformMetricsRepository) SumCounters(ctx context.Context, name, sinceDay string) (map[string]int64, error) {
+	ctx, cancel := r.aggregateContext(ctx)
+	defer cancel()
+
+	pipeline := []bson.M{
+		{"$match": bson.M{"name": name, "day": bson.M{"$gte": sinceDay}}},
+		{"$project": bson.M{"counts": bson.M{"$objectToArray": "$counts"}}},
+		{"$unwind": "$counts"},
+		{"$group": bson.M{"_id": "$counts.k", "count": bson.M{"$sum": "$counts.v"}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, wrapError("erro ao somar contadores: %w", err)
+	}
+
+	var results []struct {
+		Key   string `bson:"_id"`
+		Count int64  `bson:"count"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, wrapError("erro ao decodificar contadores: %w", err)
+	}
+
+	sums := make(map[string]int64, len(results))
+	for _, result := range results {
+		sums[result.Key] = result.Count
+	}
+
+	return sums, nil
+}
+
+// CountActiveUsers conta os usuários distintos que alteraram tarefas ou
+// fizeram login desde since
+func (r *platformMetricsRepository) CountActiveUsers(ctx context.Context, since time.Time) (int64, error) {
+	ctx, cancel := r.aggregateContext(ctx)
+	defer cancel()
+
+	pipeline := []bson.M{
+		{"$match": bson.M{"created_at": bson.M{"$gte": since}}},
+		{"$project": bson.M{"_id": 0, "user_id": 1}},
+		{"$unionWith": bson.M{
+			"coll": r.auditLogs,
+			"pipeline": []bson.M{
+				{"$match": bson.M{
+					"action":     entities.AuditActionLogin,
+					"outcome":    entities.AuditOutcomeSuccess,
+					"actor_id":   bson.M{"$exists": true},
+					"created_at": bson.M{"$gte": since},
+				}},
+				{"$project": bson.M{"_id": 0, "user_id": "$actor_id"}},
+			},
+		}},
+		{"$group": bson.M{"_id": "$user_id"}},
+		{"$count": "count"},
+	}
+
+	cursor, err := r.changes.Aggregate(ctx, pipeline)
+	if err != nil {
+		return 0, wrapError("erro ao contar usuários ativos: %w", err)
+	}
+
+	var results []struct {
+		Count int64 `bson:"count"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return 0, wrapError("erro ao decodificar usuários ativos: %w", err)
+	}
+	if len(results) == 0 {
+		return 0, nil
+	}
+
+	return results[0].Count, nil
+}
+
+// CountDailyTasks conta as tarefas criadas e concluídas por dia (UTC) desde since
+func (r *platformMetricsRepository) CountDailyTasks(ctx context.Context, since time.Time) (*DailyTasks, error) {
+	ctx, cancel := r.aggregateContext(ctx)
+	defer cancel()
+
+	countByDay := func(field string) []bson.M {
+		return []bson.M{
+			{"$match": bson.M{field: bson.M{"$gte": since}}},
+			{"$group": bson.M{
+				"_id":   bson.M{"$dateToString": bson.M{"format": "%Y-%m-%d", "date": "$" + field}},
+				"count": bson.M{"$sum": 1},
+			}},
+		}
+	}
+
+	pipeline := []bson.M{
+		{"$match": bson.M{"$or": bson.A{
+			bson.M{"created_at": bson.M{"$gte": since}},
+			bson.M{"completed_at": bson.M{"$gte": since}},
+		}}},
+		{"$facet": bson.M{
+			"created":   countByDay("created_at"),
+			"completed": countByDay("completed_at"),
+		}},
+	}
+
+	cursor, err := r.tasks.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, wrapError("erro ao contar tarefas por dia: %w", err)
+	}
+
+	type dayCount struct {
+		Day   string `bson:"_id"`
+		Count int64  `bson:"count"`
+	}
+	var results []struct {
+		Created   []dayCount `bson:"created"`
+		Completed []dayCount `bson:"completed"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, wrapError("erro ao decodificar tarefas por dia: %w", err)
+	}
+
+	daily := &DailyTasks{Created: map[string]int64{}, Completed: map[string]int64{}}
+	if len(results) == 0 {
+		return daily, nil
+	}
+	for _, day := range results[0].Created {
+		daily.Created[day.Day] = day.Count
+	}
+	for _, day := range results[0].Completed {
+		daily.Completed[day.Day] = day.Count
+	}
+
+	return daily, nil
+}
+
+// CountOpenTasks conta as tarefas não concluídas e não arquivadas e, entre
+// elas, as vencidas antes de now
+func (r *platformMetricsRepository) CountOpenTasks(ctx context.Context, now time.Time) (int64, int64, error) {
+	ctx, cancel := r.aggregateContext(ctx)
+	defer cancel()
+
+	pipeline := []bson.M{
+		{"$match": bson.M{"status": bson.M{"$ne": enums.StatusCompleted}, "is_archived": false}},
+		{"$group": bson.M{
+			"_id":  nil,
+			"open": bson.M{"$sum": 1},
+			"overdue": bson.M{"$sum": bson.M{"$cond": bson.A{
+				bson.M{"$and": bson.A{
+					bson.M{"$gt": bson.A{"$due_date", nil}},
+					bson.M{"$lt": bson.A{"$due_date", now}},
+				}},
+				1, 0,
+			}}},
+		}},
+	}
+
+	cursor, err := r.tasks.Aggregate(ctx, pipeline)
+	if err != nil {
+		return 0, 0, wrapError("erro ao contar tarefas abertas: %w", err)
+	}
+
+	var results []struct {
+		Open    int64 `bson:"open"`
+		Overdue int64 `bson:"overdue"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return 0, 0, wrapError("erro ao decodificar tarefas abertas: %w", err)
+	}
+	if len(results) == 0 {
+		return 0, 0, nil
+	}
+
+	return results[0].Open, results[0].Overdue, nil
+}
+
+// StorageUsage retorna o espaço ocupado pelo banco (dbStats) e pelos anexos
+func (r *platformMetricsRepository) StorageUsage(ctx context.Context) (*entities.StorageUsage, error) {
+	ctx, cancel := r.aggregateContext(ctx)
+	defer cancel()
+
+	var dbStats struct {
+		DataSize    float64 `bson:"dataSize"`
+		StorageSize float64 `bson:"storageSize"`
+		IndexSize   float64 `bson:"indexSize"`
+	}
+	if err := r.collection.Database().RunCommand(ctx, bson.D{{Key: "dbStats", Value: 1}}).Decode(&dbStats); err != nil {
+		return nil, wrapError("erro ao obter dbStats: %w", err)
+	}
+
+	usage := &entities.StorageUsage{
+		DataBytes:    int64(dbStats.DataSize),
+		StorageBytes: int64(dbStats.StorageSize),
+		IndexBytes:   int64(dbStats.IndexSize),
+	}
+
+	cursor, err := r.attachments.Aggregate(ctx, []bson.M{
+		{"$group": bson.M{"_id": nil, "bytes": bson.M{"$sum": "$length"}, "count": bson.M{"$sum": 1}}},
+	})
+	if err != nil {
+		return nil, wrapError("erro ao somar anexos: %w", err)
+	}
+
+	var attachments []struct {
+		Bytes int64 `bson:"bytes"`
+		Count int64 `bson:"count"`
+	}
+	if err := cursor.All(ctx, &attachments); err != nil {
+		return nil, wrapError("erro ao decodificar anexos: %w", err)
+	}
+	if len(attachments) > 0 {
+		usage.AttachmentBytes = attachments[0].Bytes
+		usage.AttachmentsCount = attachments[0].Count
+	}
+
+	return usage, nil
+}
+
+// SaveSnapshot grava o painel calculado, substituindo o anterior
+func (r *platformMetricsRepository) SaveSnapshot(ctx context.Context, snapshot *entities.PlatformMetrics) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"_id": entities.PlatformMetricsSnapshotID}
+	if _, err := r.collection.ReplaceOne(ctx, filter, snapshot, options.Replace().SetUpsert(true)); err != nil {
+		return wrapError("erro ao salvar métricas da plataforma: %w", err)
+	}
+
+	return nil
+}
+
+// GetSnapshot retorna o último painel calculado
+func (r *platformMetricsRepository) GetSnapshot(ctx context.Context) (*entities.PlatformMetrics, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	var snapshot entities.PlatformMetrics
+	err := r.collection.FindOne(ctx, bson.M{"_id": entities.PlatformMetricsSnapshotID}).Decode(&snapshot)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrPlatformMetricsNotFound
+		}
+		return nil, wrapError("erro ao buscar métricas da plataforma: %w", err)
+	}
+
+	return &snapshot, nil
+}
+
+// SaveKPIs grava os indicadores de negócio, substituindo os anteriores
+func (r *platformMetricsRepository) SaveKPIs(ctx context.Context, kpis *entities.BusinessKPIs) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"_id": entities.BusinessKPIsID}
+	if _, err := r.collection.ReplaceOne(ctx, filter, kpis, options.Replace().SetUpsert(true)); err != nil {
+		return wrapError("erro ao salvar indicadores de negócio: %w", err)
+	}
+
+	return nil
+}
+
+// GetKPIs retorna os últimos indicadores de negócio calculados
+func (r *platformMetricsRepository) GetKPIs(ctx context.Context) (*entities.BusinessKPIs, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	var kpis entities.BusinessKPIs
+	err := r.collection.FindOne(ctx, bson.M{"_id": entities.BusinessKPIsID}).Decode(&kpis)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrBusinessKPIsNotFound
+		}
+		return nil, wrapError("erro ao buscar indicadores de negócio: %w", err)
+	}
+
+	return &kpis, nil
+}
@@ -0,0 +1,254 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ProjectRepository interface define os métodos do repositório de projetos
+type ProjectRepository interface {
+	Create(ctx context.Context, project *entities.Project) error
+	GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.Project, error)
+	List(ctx context.Context, userID primitive.ObjectID) ([]*entities.Project, error)
+	ListShared(ctx context.Context, userID primitive.ObjectID) ([]*entities.Project, error)
+	Update(ctx context.Context, userID primitive.ObjectID, project *entities.Project) error
+	Delete(ctx context.Context, userID, id primitive.ObjectID) error
+	AddMember(ctx context.Context, userID, id primitive.ObjectID, member entities.ProjectMember) error
+	SetMemberRole(ctx context.Context, userID, id, memberID primitive.ObjectID, role enums.ProjectRole) error
+	RemoveMember(ctx context.Context, id, memberID primitive.ObjectID) error
+	SetRollup(ctx context.Context, id primitive.ObjectID, rollup *entities.ProjectRollup) error
+}
+
+// projectRepository implementa ProjectRepository
+type projectRepository struct {
+	operationTimeouts
+	tombstoneLog
+	collection *mongo.Collection
+}
+
+// NewProjectRepository cria uma nova instância do repositório
+func NewProjectRepository(db database.Client) ProjectRepository {
+	return &projectRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		tombstoneLog:      newTombstoneLog(db),
+		collection:        db.Collections().Projects,
+	}
+}
+
+// Create cria um novo projeto
+func (r *projectRepository) Create(ctx context.Context, project *entities.Project) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	if _, err := r.collection.InsertOne(ctx, project); err != nil {
+		return wrapError("erro ao criar projeto: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID busca por ID um projeto do usuário ou compartilhado com ele. O
+// papel do usuário (entities.Project.Role) deve ser verificado por quem chama.
+func (r *projectRepository) GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.Project, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	filter := bson.M{
+		"_id": id,
+		"$or": []bson.M{
+			{"user_id": userID},
+			{"members.user_id": userID},
+		},
+	}
+
+	var project entities.Project
+	if err := r.collection.FindOne(ctx, filter).Decode(&project); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, wrapError("erro ao buscar projeto: %w", err)
+	}
+
+	return &project, nil
+}
+
+// List lista os projetos do usuário em ordem alfabética
+func (r *projectRepository) List(ctx context.Context, userID primitive.ObjectID) ([]*entities.Project, error) {
+	return r.find(ctx, bson.M{"user_id": userID})
+}
+
+// ListShared lista os projetos de outros usuários compartilhados com o usuário,
+// em ordem alfabética
+func (r *projectRepository) ListShared(ctx context.Context, userID primitive.ObjectID) ([]*entities.Project, error) {
+	return r.find(ctx, bson.M{"members.user_id": userID})
+}
+
+// find lista os projetos do filtro em ordem alfabética
+func (r *projectRepository) find(ctx context.Context, filter bson.M) ([]*entities.Project, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "name", Value: 1}}).
+		SetCollation(caseInsensitiveCollation)
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, wrapError("erro ao listar projetos: %w", err)
+	}
+
+	projects := []*entities.Project{}
+	if err := cursor.All(ctx, &projects); err != nil {
+		return nil, wrapError("erro ao decodificar projetos: %w", err)
+	}
+
+	return projects, nil
+}
+
+// Update atualiza um projeto do usuário
+func (r *projectRepository) Update(ctx context.Context, userID primitive.ObjectID, project *entities.Project) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	project.PrepareForUpdate()
+
+	update := bson.M{
+		"$set": bson.M{
+			"name":       project.Name,
+			"color":      project.Color,
+			"statuses":   project.Statuses,
+			"updated_at": project.UpdatedAt,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, ownedFilter(userID, project.ID), update)
+	if err != nil {
+		return wrapError("erro ao atualizar projeto: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrProjectNotFound
+	}
+
+	return nil
+}
+
+// Delete remove um projeto do usuário
+func (r *projectRepository) Delete(ctx context.Context, userID, id primitive.ObjectID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	result, err := r.collection.DeleteOne(ctx, ownedFilter(userID, id))
+	if err != nil {
+		return wrapError("erro ao deletar projeto: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return ErrProjectNotFound
+	}
+
+	r.recordDeleted(ctx, entities.TombstoneProject, ownedDocs(userID, id)...)
+
+	return nil
+}
+
+// AddMember compartilha o projeto do usuário com member
+func (r *projectRepository) AddMember(ctx context.Context, userID, id primitive.ObjectID, member entities.ProjectMember) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := ownedFilter(userID, id)
+	filter["members.user_id"] = bson.M{"$ne": member.UserID}
+
+	result, err := r.collection.UpdateOne(ctx, filter, bson.M{
+		"$push": bson.M{"members": member},
+		"$set":  bson.M{"updated_at": time.Now()},
+	})
+	if err != nil {
+		return wrapError("erro ao adicionar membro ao projeto: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		// Distingue projeto inexistente de membro já adicionado
+		count, err := r.collection.CountDocuments(ctx, ownedFilter(userID, id))
+		if err != nil {
+			return wrapError("erro ao buscar projeto: %w", err)
+		}
+		if count == 0 {
+			return ErrProjectNotFound
+		}
+		return ErrProjectMemberExists
+	}
+
+	return nil
+}
+
+// SetMemberRole altera o papel de um membro do projeto do usuário
+func (r *projectRepository) SetMemberRole(ctx context.Context, userID, id, memberID primitive.ObjectID, role enums.ProjectRole) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := ownedFilter(userID, id)
+	filter["members.user_id"] = memberID
+
+	result, err := r.collection.UpdateOne(ctx, filter, bson.M{
+		"$set": bson.M{"members.$.role": role, "updated_at": time.Now()},
+	})
+	if err != nil {
+		return wrapError("erro ao alterar papel do membro: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrProjectMemberNotFound
+	}
+
+	return nil
+}
+
+// RemoveMember remove o acesso de um membro ao projeto. A permissão (dono ou o
+// próprio membro saindo) deve ser verificada por quem chama.
+func (r *projectRepository) RemoveMember(ctx context.Context, id, memberID primitive.ObjectID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": id, "members.user_id": memberID}, bson.M{
+		"$pull": bson.M{"members": bson.M{"user_id": memberID}},
+		"$set":  bson.M{"updated_at": time.Now()},
+	})
+	if err != nil {
+		return wrapError("erro ao remover membro do projeto: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrProjectMemberNotFound
+	}
+
+	return nil
+}
+
+// SetRollup grava o resumo das tarefas do projeto. Não altera updated_at, que
+// indica mudanças no próprio projeto.
+func (r *projectRepository) SetRollup(ctx context.Context, id primitive.ObjectID, rollup *entities.ProjectRollup) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"rollup": rollup}})
+	if err != nil {
+		return wrapError("erro ao gravar resumo do projeto: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrProjectNotFound
+	}
+
+	return nil
+}
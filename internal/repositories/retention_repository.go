@@ -0,0 +1,237 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// RetentionScope restringe a retenção a um usuário (UserID) ou a todos os
+// usuários exceto os de Except (que têm retenção própria para o recurso)
+type RetentionScope struct {
+	UserID *primitive.ObjectID
+	Except []primitive.ObjectID
+}
+
+// PurgedBatch é o resultado da remoção de um lote de dados expirados
+type PurgedBatch struct {
+	Deleted int64
+	// AttachmentIDs são os anexos (GridFS) das tarefas removidas
+	AttachmentIDs []primitive.ObjectID
+}
+
+// RetentionRepository interface define a remoção de dados expirados e o
+// armazenamento das retenções por usuário
+type RetentionRepository interface {
+	CountExpired(ctx context.Context, resource string, cutoff time.Time, scope RetentionScope) (int64, error)
+	DeleteExpired(ctx context.Context, resource string, cutoff time.Time, scope RetentionScope, limit int64) (*PurgedBatch, error)
+	ListOverrides(ctx context.Context) ([]*entities.RetentionOverride, error)
+	SaveOverride(ctx context.Context, override *entities.RetentionOverride) error
+	DeleteOverride(ctx context.Context, userID primitive.ObjectID) error
+}
+
+// retentionTarget é uma collection com dados de um recurso: userField
+// identifica o dono e dateField a data comparada com o corte. tombstone, se
+// definido, é o tipo registrado nas exclusões (collections sincronizadas).
+type retentionTarget struct {
+	collection *mongo.Collection
+	userField  string
+	dateField  string
+	filter     bson.M
+	tombstone  string
+}
+
+// retentionRepository implementa RetentionRepository
+type retentionRepository struct {
+	operationTimeouts
+	tombstoneLog
+	overrides *mongo.Collection
+	targets   map[string][]retentionTarget
+}
+
+// NewRetentionRepository cria uma nova instância do repositório
+func NewRetentionRepository(db database.Client) RetentionRepository {
+	collections := db.Collections()
+
+	return &retentionRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		tombstoneLog:      newTombstoneLog(db),
+		overrides:         collections.RetentionOverrides,
+		targets: map[string][]retentionTarget{
+			entities.RetentionCompletedTasks: {
+				{collection: collections.Tasks, userField: "user_id", dateField: "completed_at", filter: bson.M{"status": enums.StatusCompleted}, tombstone: entities.TombstoneTask},
+				{collection: collections.TasksArchive, userField: "user_id", dateField: "completed_at"},
+			},
+			entities.RetentionAuditLogs: {
+				{collection: collections.AuditLogs, userField: "actor_id", dateField: "created_at"},
+			},
+			entities.RetentionNotifications: {
+				{collection: collections.Notifications, userField: "user_id", dateField: "created_at"},
+			},
+		},
+	}
+}
+
+// expiredFilter monta o filtro dos documentos anteriores a cutoff no escopo
+func (t retentionTarget) expiredFilter(cutoff time.Time, scope RetentionScope) bson.M {
+	filter := bson.M{t.dateField: bson.M{"$lt": cutoff}}
+	for key, value := range t.filter {
+		filter[key] = value
+	}
+
+	switch {
+	case scope.UserID != nil:
+		filter[t.userField] = *scope.UserID
+	case len(scope.Except) > 0:
+		filter[t.userField] = bson.M{"$nin": scope.Except}
+	}
+
+	return filter
+}
+
+// resourceTargets retorna as collections do recurso
+func (r *retentionRepository) resourceTargets(resource string) ([]retentionTarget, error) {
+	targets, ok := r.targets[resource]
+	if !ok {
+		return nil, fmt.Errorf("recurso de retenção desconhecido: %s", resource)
+	}
+	return targets, nil
+}
+
+// CountExpired conta os documentos do recurso anteriores a cutoff no escopo
+func (r *retentionRepository) CountExpired(ctx context.Context, resource string, cutoff time.Time, scope RetentionScope) (int64, error) {
+	targets, err := r.resourceTargets(resource)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := r.aggregateContext(ctx)
+	defer cancel()
+
+	var total int64
+	for _, target := range targets {
+		count, err := target.collection.CountDocuments(ctx, target.expiredFilter(cutoff, scope))
+		if err != nil {
+			return 0, wrapError("erro ao contar %s expirados: %w", resource, err)
+		}
+		total += count
+	}
+
+	return total, nil
+}
+
+// DeleteExpired remove até limit documentos expirados de cada collection do
+// recurso. Deleted menor que limit indica que não há mais o que remover.
+func (r *retentionRepository) DeleteExpired(ctx context.Context, resource string, cutoff time.Time, scope RetentionScope, limit int64) (*PurgedBatch, error) {
+	targets, err := r.resourceTargets(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	batch := &PurgedBatch{}
+	opts := options.Find().
+		SetLimit(limit).
+		SetProjection(bson.M{"_id": 1, "user_id": 1, "attachments._id": 1})
+
+	for _, target := range targets {
+		cursor, err := target.collection.Find(ctx, target.expiredFilter(cutoff, scope), opts)
+		if err != nil {
+			return nil, wrapError("erro ao buscar %s expirados: %w", resource, err)
+		}
+
+		var docs []struct {
+			ID          primitive.ObjectID `bson:"_id"`
+			UserID      primitive.ObjectID `bson:"user_id"`
+			Attachments []struct {
+				ID primitive.ObjectID `bson:"_id"`
+			} `bson:"attachments"`
+		}
+		if err := cursor.All(ctx, &docs); err != nil {
+			return nil, wrapError("erro ao decodificar %s expirados: %w", resource, err)
+		}
+		if len(docs) == 0 {
+			continue
+		}
+
+		ids := make([]primitive.ObjectID, 0, len(docs))
+		deleted := make([]deletedDoc, 0, len(docs))
+		for _, doc := range docs {
+			ids = append(ids, doc.ID)
+			deleted = append(deleted, deletedDoc{ID: doc.ID, UserID: doc.UserID})
+			for _, attachment := range doc.Attachments {
+				batch.AttachmentIDs = append(batch.AttachmentIDs, attachment.ID)
+			}
+		}
+
+		result, err := target.collection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+		if err != nil {
+			return nil, wrapError("erro ao remover %s expirados: %w", resource, err)
+		}
+		batch.Deleted += result.DeletedCount
+
+		if target.tombstone != "" {
+			r.recordDeleted(ctx, target.tombstone, deleted...)
+		}
+	}
+
+	return batch, nil
+}
+
+// ListOverrides lista as retenções por usuário
+func (r *retentionRepository) ListOverrides(ctx context.Context) ([]*entities.RetentionOverride, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	cursor, err := r.overrides.Find(ctx, bson.M{}, options.Find().SetSort(bson.M{"updated_at": -1}))
+	if err != nil {
+		return nil, wrapError("erro ao listar retenções por usuário: %w", err)
+	}
+
+	overrides := []*entities.RetentionOverride{}
+	if err := cursor.All(ctx, &overrides); err != nil {
+		return nil, wrapError("erro ao decodificar retenções por usuário: %w", err)
+	}
+
+	return overrides, nil
+}
+
+// SaveOverride grava (substituindo) a retenção do usuário
+func (r *retentionRepository) SaveOverride(ctx context.Context, override *entities.RetentionOverride) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	opts := options.Replace().SetUpsert(true)
+	if _, err := r.overrides.ReplaceOne(ctx, bson.M{"_id": override.UserID}, override, opts); err != nil {
+		return wrapError("erro ao salvar retenção do usuário: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteOverride remove a retenção do usuário, que volta à política padrão
+func (r *retentionRepository) DeleteOverride(ctx context.Context, userID primitive.ObjectID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	result, err := r.overrides.DeleteOne(ctx, bson.M{"_id": userID})
+	if err != nil {
+		return wrapError("erro ao remover retenção do usuário: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return ErrRetentionOverrideNotFound
+	}
+
+	return nil
+}
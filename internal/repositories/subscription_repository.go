@@ -0,0 +1,77 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SubscriptionRepository interface define os métodos do repositório de assinaturas
+type SubscriptionRepository interface {
+	GetByUserID(ctx context.Context, userID primitive.ObjectID) (*entities.Subscription, error)
+	GetByProviderRef(ctx context.Context, provider, ref string) (*entities.Subscription, error)
+	Save(ctx context.Context, subscription *entities.Subscription) error
+}
+
+// subscriptionRepository implementa SubscriptionRepository
+type subscriptionRepository struct {
+	operationTimeouts
+	collection *mongo.Collection
+}
+
+// NewSubscriptionRepository cria uma nova instância do repositório
+func NewSubscriptionRepository(db database.Client) SubscriptionRepository {
+	collections := db.Collections()
+
+	return &subscriptionRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		collection:        collections.Subscriptions,
+	}
+}
+
+// GetByUserID busca a assinatura do usuário
+func (r *subscriptionRepository) GetByUserID(ctx context.Context, userID primitive.ObjectID) (*entities.Subscription, error) {
+	return r.findOne(ctx, bson.M{"_id": userID})
+}
+
+// GetByProviderRef busca a assinatura pela referência do provedor de pagamentos
+func (r *subscriptionRepository) GetByProviderRef(ctx context.Context, provider, ref string) (*entities.Subscription, error) {
+	return r.findOne(ctx, bson.M{"provider": provider, "provider_ref": ref})
+}
+
+// findOne busca a assinatura que casa com filter
+func (r *subscriptionRepository) findOne(ctx context.Context, filter bson.M) (*entities.Subscription, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	var subscription entities.Subscription
+	if err := r.collection.FindOne(ctx, filter).Decode(&subscription); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrSubscriptionNotFound
+		}
+		return nil, wrapError("erro ao buscar assinatura: %w", err)
+	}
+
+	return &subscription, nil
+}
+
+// Save grava a assinatura do usuário, substituindo a anterior
+func (r *subscriptionRepository) Save(ctx context.Context, subscription *entities.Subscription) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	opts := options.Replace().SetUpsert(true)
+	if _, err := r.collection.ReplaceOne(ctx, bson.M{"_id": subscription.UserID}, subscription, opts); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrSubscriptionRefInUse
+		}
+		return wrapError("erro ao salvar assinatura: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TaskArchiveRepository interface define os métodos do histórico de tarefas arquivadas
+type TaskArchiveRepository interface {
+	MoveCompletedBefore(ctx context.Context, cutoff time.Time, batchSize int64) (int64, error)
+	GetByUserID(ctx context.Context, userID primitive.ObjectID) ([]*entities.Task, error)
+}
+
+// taskArchiveRepository implementa TaskArchiveRepository
+type taskArchiveRepository struct {
+	operationTimeouts
+	fieldEncryption
+	tombstoneLog
+	tasks   *mongo.Collection
+	archive *mongo.Collection
+}
+
+// NewTaskArchiveRepository cria uma nova instância do repositório
+func NewTaskArchiveRepository(db database.Client) TaskArchiveRepository {
+	collections := db.Collections()
+
+	return &taskArchiveRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		fieldEncryption:   newFieldEncryption(db),
+		tombstoneLog:      newTombstoneLog(db),
+		tasks:             collections.Tasks,
+		archive:           collections.TasksArchive,
+	}
+}
+
+// MoveCompletedBefore move um lote de tarefas concluídas e arquivadas antes de cutoff
+// para a collection de histórico. Retorna quantas tarefas foram movidas.
+func (r *taskArchiveRepository) MoveCompletedBefore(ctx context.Context, cutoff time.Time, batchSize int64) (int64, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{
+		"status":       enums.StatusCompleted,
+		"is_archived":  true,
+		"completed_at": bson.M{"$lt": cutoff},
+	}
+
+	cursor, err := r.tasks.Find(ctx, filter, options.Find().SetLimit(batchSize))
+	if err != nil {
+		return 0, wrapError("erro ao buscar tarefas para arquivamento: %w", err)
+	}
+
+	var docs []bson.M
+	if err := cursor.All(ctx, &docs); err != nil {
+		return 0, wrapError("erro ao decodificar tarefas para arquivamento: %w", err)
+	}
+
+	if len(docs) == 0 {
+		return 0, nil
+	}
+
+	now := time.Now()
+	ids := make([]interface{}, 0, len(docs))
+	inserts := make([]interface{}, 0, len(docs))
+	moved := make([]deletedDoc, 0, len(docs))
+	for _, doc := range docs {
+		doc["moved_at"] = now
+		ids = append(ids, doc["_id"])
+		inserts = append(inserts, doc)
+
+		id, _ := doc["_id"].(primitive.ObjectID)
+		userID, _ := doc["user_id"].(primitive.ObjectID)
+		moved = append(moved, deletedDoc{ID: id, UserID: userID})
+	}
+
+	// Inserção não ordenada: documentos já copiados numa execução interrompida
+	// geram erro de chave duplicada, que pode ser ignorado
+	_, err = r.archive.InsertMany(ctx, inserts, options.InsertMany().SetOrdered(false))
+	if err != nil && !isOnlyDuplicateKeyError(err) {
+		return 0, wrapError("erro ao copiar tarefas para o histórico: %w", err)
+	}
+
+	result, err := r.tasks.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return 0, wrapError("erro ao remover tarefas arquivadas: %w", err)
+	}
+
+	// Para os clientes de sincronização, a tarefa movida ao histórico foi excluída
+	r.recordDeleted(ctx, entities.TombstoneTask, moved...)
+
+	return result.DeletedCount, nil
+}
+
+// GetByUserID retorna o histórico de tarefas arquivadas do usuário
+func (r *taskArchiveRepository) GetByUserID(ctx context.Context, userID primitive.ObjectID) ([]*entities.Task, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.M{"completed_at": -1})
+
+	cursor, err := r.archive.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, wrapError("erro ao buscar histórico: %w", err)
+	}
+
+	var tasks []*entities.Task
+	if err := cursor.All(ctx, &tasks); err != nil {
+		return nil, wrapError("erro ao decodificar histórico: %w", err)
+	}
+
+	if err := r.openTasks(tasks); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// isOnlyDuplicateKeyError verifica se todos os erros de escrita são de chave duplicada
+func isOnlyDuplicateKeyError(err error) bool {
+	bulkErr, ok := err.(mongo.BulkWriteException)
+	if !ok || bulkErr.WriteConcernError != nil {
+		return false
+	}
+
+	for _, writeErr := range bulkErr.WriteErrors {
+		if writeErr.Code != 11000 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,168 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TaskChangeRepository interface define os métodos do repositório do feed de mudanças
+type TaskChangeRepository interface {
+	Create(ctx context.Context, change *entities.TaskChange) error
+	ListAfter(ctx context.Context, userID primitive.ObjectID, after, before primitive.ObjectID, types []string, limit int64) ([]*entities.TaskChange, error)
+	ListRecent(ctx context.Context, userID primitive.ObjectID, types []string, limit int64) ([]*entities.TaskChange, error)
+	ListByProject(ctx context.Context, userID, projectID primitive.ObjectID, page, limit int64) ([]*entities.TaskChange, int64, error)
+}
+
+// taskChangeRepository implementa TaskChangeRepository
+type taskChangeRepository struct {
+	operationTimeouts
+	fieldEncryption
+	collection *mongo.Collection
+}
+
+// NewTaskChangeRepository cria uma nova instância do repositório
+func NewTaskChangeRepository(db database.Client) TaskChangeRepository {
+	return &taskChangeRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		fieldEncryption:   newFieldEncryption(db),
+		collection:        db.Collections().TaskChanges,
+	}
+}
+
+// Create grava a alteração. Eventos já registrados são ignorados.
+func (r *taskChangeRepository) Create(ctx context.Context, change *entities.TaskChange) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	change.PrepareForCreate()
+
+	// O estado da tarefa gravado no feed tem os mesmos campos cifrados da tarefa
+	task, err := r.sealTask(&change.Task)
+	if err != nil {
+		return err
+	}
+	doc := *change
+	doc.Task = *task
+
+	if _, err := r.collection.InsertOne(ctx, doc); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil
+		}
+		return wrapError("erro ao registrar alteração de tarefa: %w", err)
+	}
+
+	return nil
+}
+
+// ListAfter lista as alterações do usuário com _id entre after e before
+// (exclusivos), em ordem crescente. types vazio inclui todos os tipos.
+func (r *taskChangeRepository) ListAfter(ctx context.Context, userID primitive.ObjectID, after, before primitive.ObjectID, types []string, limit int64) ([]*entities.TaskChange, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	filter := bson.M{
+		"user_id": userID,
+		"_id":     bson.M{"$gt": after, "$lt": before},
+	}
+	if len(types) > 0 {
+		filter["type"] = bson.M{"$in": types}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(limit)
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, wrapError("erro ao listar alterações de tarefas: %w", err)
+	}
+
+	changes := []*entities.TaskChange{}
+	if err := cursor.All(ctx, &changes); err != nil {
+		return nil, wrapError("erro ao decodificar alterações de tarefas: %w", err)
+	}
+
+	if err := r.openChanges(changes); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// ListRecent lista as alterações mais recentes do usuário, da mais nova para a mais antiga
+func (r *taskChangeRepository) ListRecent(ctx context.Context, userID primitive.ObjectID, types []string, limit int64) ([]*entities.TaskChange, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"user_id": userID}
+	if len(types) > 0 {
+		filter["type"] = bson.M{"$in": types}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: -1}}).SetLimit(limit)
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, wrapError("erro ao listar alterações de tarefas: %w", err)
+	}
+
+	changes := []*entities.TaskChange{}
+	if err := cursor.All(ctx, &changes); err != nil {
+		return nil, wrapError("erro ao decodificar alterações de tarefas: %w", err)
+	}
+
+	if err := r.openChanges(changes); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// ListByProject lista as alterações das tarefas que estavam no projeto quando
+// foram registradas, da mais nova para a mais antiga
+func (r *taskChangeRepository) ListByProject(ctx context.Context, userID, projectID primitive.ObjectID, page, limit int64) ([]*entities.TaskChange, int64, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"user_id": userID, "task.project_id": projectID}
+
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, wrapError("erro ao contar atividades do projeto: %w", err)
+	}
+
+	opts := options.Find().
+		SetSkip((page - 1) * limit).
+		SetLimit(limit).
+		SetSort(bson.D{{Key: "_id", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, wrapError("erro ao listar atividades do projeto: %w", err)
+	}
+
+	changes := []*entities.TaskChange{}
+	if err := cursor.All(ctx, &changes); err != nil {
+		return nil, 0, wrapError("erro ao decodificar atividades do projeto: %w", err)
+	}
+
+	if err := r.openChanges(changes); err != nil {
+		return nil, 0, err
+	}
+
+	return changes, total, nil
+}
+
+// openChanges decifra o estado das tarefas registrado nas alterações
+func (r *taskChangeRepository) openChanges(changes []*entities.TaskChange) error {
+	for _, change := range changes {
+		if err := r.openTask(&change.Task); err != nil {
+			return err
+		}
+	}
+	return nil
+}
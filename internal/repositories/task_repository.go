@@ -2,9 +2,13 @@ package repositories
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"regexp"
+	"strings"
+	"sync/atomic"
 	"time"
 
+	"github.com/devgugga/todo-it/internal/clock"
 	"github.com/devgugga/todo-it/internal/database"
 	"github.com/devgugga/todo-it/internal/entities"
 	"github.com/devgugga/todo-it/internal/enums"
@@ -16,65 +20,219 @@ import (
 
 // TodoFilters representa filtros para busca de todos
 type TaskFilters struct {
-	Status     enums.TaskStatus   `json:"status"`
-	Priority   enums.TaskPriority `json:"priority"`
-	Tags       []string           `json:"tags"`
-	IsArchived *bool              `json:"is_archived"`
-	DueBefore  *time.Time         `json:"due_before"`
-	DueAfter   *time.Time         `json:"due_after"`
-	Search     string             `json:"search"`
+	Status     enums.TaskStatus    `json:"status"`
+	Priority   enums.TaskPriority  `json:"priority"`
+	Tags       []string            `json:"tags"`
+	ProjectID  *primitive.ObjectID `json:"project_id"`
+	IsArchived *bool               `json:"is_archived"`
+	DueBefore  *time.Time          `json:"due_before"`
+	DueAfter   *time.Time          `json:"due_after"`
+	Search     string              `json:"search"`
+	SortBy     string              `json:"sort_by"`
+	SortOrder  string              `json:"sort_order"`
+	Compact    bool                `json:"compact"`
 }
 
+// taskSortFields associa os campos aceitos para ordenação das listagens ao
+// campo gravado (a prioridade ordena pela ordem numérica, não pelo nome)
+var taskSortFields = map[string]string{
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+	"due_date":   "due_date",
+	"title":      "title",
+	"priority":   "priority_order",
+	"position":   "position",
+}
+
+// TaskSortRelevance ordena a busca pela pontuação do texto e, no empate, pelo
+// vencimento mais próximo. Sem texto buscado, ordena só pelo vencimento.
+const TaskSortRelevance = "relevance"
+
+// IsValidTaskSortField verifica se o campo pode ser usado na ordenação
+func IsValidTaskSortField(field string) bool {
+	_, ok := taskSortFields[field]
+	return ok || field == TaskSortRelevance
+}
+
+// taskListProjection remove das listagens compactas os campos de texto livre
+// e os que crescem com o uso da tarefa (anexos e estado de sincronização CalDAV)
+var taskListProjection = bson.M{
+	"description": 0,
+	"attachments": 0,
+	"caldav":      0,
+}
+
+// MaxTaskSearchLength limita o tamanho do texto de busca
+const MaxTaskSearchLength = 100
+
+// indexNotFoundCode é o código do MongoDB para $text sem índice de texto
+const indexNotFoundCode = 27
+
+// textSearchMissing indica que a coleção não tem o índice de texto (ex.: índices
+// ainda não sincronizados). A busca passa a usar regex até o processo reiniciar.
+var textSearchMissing atomic.Bool
+
+// caseInsensitiveCollation compara textos ignorando maiúsculas e acentos
+var caseInsensitiveCollation = &options.Collation{Locale: "pt", Strength: 1}
+
+// taskStatsTopTags é quantas etiquetas aparecem em TaskStats.TopTags
+const taskStatsTopTags = 10
+
 // TodoStats representa estatísticas dos todos
 type TaskStats struct {
-	Total      int64 `json:"total"`
-	Pending    int64 `json:"pending"`
-	InProgress int64 `json:"in_progress"`
-	Completed  int64 `json:"completed"`
-	Cancelled  int64 `json:"cancelled"`
-	Archived   int64 `json:"archived"`
-	Overdue    int64 `json:"overdue"`
+	Total      int64            `json:"total"`
+	Pending    int64            `json:"pending"`
+	InProgress int64            `json:"in_progress"`
+	Completed  int64            `json:"completed"`
+	Cancelled  int64            `json:"cancelled"`
+	Archived   int64            `json:"archived"`
+	Overdue    int64            `json:"overdue"`
+	ByPriority map[string]int64 `json:"by_priority"`
+	// TopTags são as etiquetas mais usadas, da mais frequente para a menos
+	TopTags []TagCount `json:"top_tags"`
+	// AvgCompletionSeconds é o tempo médio entre criação e conclusão das
+	// tarefas concluídas (0 quando não há nenhuma)
+	AvgCompletionSeconds int64     `json:"avg_completion_seconds"`
+	ComputedAt           time.Time `json:"computed_at"`
+}
+
+// TagCount é o número de tarefas com uma etiqueta
+type TagCount struct {
+	Tag   string `json:"tag" bson:"_id"`
+	Count int64  `json:"count" bson:"count"`
+}
+
+// SeriesPoint é a contagem de um intervalo de uma série temporal; Date é o
+// início do intervalo
+type SeriesPoint struct {
+	Date  time.Time `bson:"_id"`
+	Count int64     `bson:"count"`
+}
+
+// TaskSuggestions são as sugestões para o texto digitado na busca: títulos das
+// tarefas mais recentes e etiquetas mais usadas
+type TaskSuggestions struct {
+	Titles []string `json:"titles"`
+	Tags   []string `json:"tags"`
+}
+
+// TaskPosition é a posição de uma tarefa na sua coluna após a reordenação
+type TaskPosition struct {
+	ID       primitive.ObjectID `json:"id"`
+	Position int64              `json:"position"`
+}
+
+// NearbyQuery são os parâmetros da busca de tarefas próximas a um ponto
+type NearbyQuery struct {
+	Lat float64
+	Lng float64
+	// Radius é a distância máxima em metros
+	Radius float64
+	// Status filtra as tarefas; vazio considera só as em aberto
+	Status enums.TaskStatus
+	Limit  int64
+}
+
+// NearbyTask é uma tarefa com a distância, em metros, até o ponto buscado
+type NearbyTask struct {
+	entities.Task `bson:",inline"`
+	Distance      float64 `bson:"distance"`
 }
 
 // TodoRepository interface define os métodos do repositório de todos
 type TodoRepository interface {
 	Create(ctx context.Context, todo *entities.Task) error
-	GetByID(ctx context.Context, id primitive.ObjectID) (*entities.Task, error)
+	GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.Task, error)
+	GetByExternalID(ctx context.Context, userID primitive.ObjectID, source, externalID string) (*entities.Task, error)
+	GetByCalDAVName(ctx context.Context, userID primitive.ObjectID, name string) (*entities.Task, error)
 	GetByUserID(ctx context.Context, userID primitive.ObjectID, page, limit int64, filters *TaskFilters) ([]*entities.Task, int64, error)
-	Update(ctx context.Context, todo *entities.Task) error
-	Delete(ctx context.Context, id primitive.ObjectID) error
-	UpdateStatus(ctx context.Context, id primitive.ObjectID, status enums.TaskStatus) error
-	BulkUpdateStatus(ctx context.Context, ids []primitive.ObjectID, status enums.TaskStatus) (int64, error)
-	BulkDelete(ctx context.Context, ids []primitive.ObjectID) (int64, error)
+	GetAllByUserID(ctx context.Context, userID primitive.ObjectID) ([]*entities.Task, error)
+	GetByIDs(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID) ([]*entities.Task, error)
+	ListChangedSince(ctx context.Context, userID primitive.ObjectID, position SyncPosition, until time.Time, limit int64) ([]*entities.Task, error)
+	Update(ctx context.Context, userID primitive.ObjectID, todo *entities.Task) error
+	PatchFields(ctx context.Context, userID, id primitive.ObjectID, fields bson.M) error
+	Delete(ctx context.Context, userID, id primitive.ObjectID) error
+	UpdateStatus(ctx context.Context, userID, id primitive.ObjectID, status enums.TaskStatus) error
+	SetCustomStatus(ctx context.Context, userID, id primitive.ObjectID, status *entities.CustomStatus) error
+	RenameCustomStatus(ctx context.Context, userID primitive.ObjectID, status *entities.CustomStatus) error
+	ClearCustomStatus(ctx context.Context, userID, statusID primitive.ObjectID) (int64, error)
+	LinkLabel(ctx context.Context, label *entities.Label) (int64, error)
+	RenameLabel(ctx context.Context, label *entities.Label, previousName string) error
+	UnlinkLabel(ctx context.Context, userID, labelID primitive.ObjectID) (int64, error)
+	DistinctTags(ctx context.Context, userID primitive.ObjectID) ([]string, error)
+	Suggest(ctx context.Context, userID primitive.ObjectID, query string, limit int64) (*TaskSuggestions, error)
+	SetChecklistItemDone(ctx context.Context, userID, id, itemID primitive.ObjectID, done bool) error
+	AddAttachments(ctx context.Context, userID, id primitive.ObjectID, attachments []entities.TaskAttachment) error
+	ClearProject(ctx context.Context, userID, projectID primitive.ObjectID) (int64, error)
+	BulkUpdateStatus(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID, status enums.TaskStatus) (int64, error)
+	BulkDelete(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID) (int64, error)
+	SetPositions(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID) ([]TaskPosition, error)
 	GetStatsByUser(ctx context.Context, userID primitive.ObjectID) (*TaskStats, error)
+	ProjectRollup(ctx context.Context, ownerID, projectID primitive.ObjectID) (*entities.ProjectRollup, error)
+	CompletedSeries(ctx context.Context, userID primitive.ObjectID, since time.Time, unit, timezone string) ([]SeriesPoint, error)
 	GetOverdueTodos(ctx context.Context, userID primitive.ObjectID) ([]*entities.Task, error)
+	ListNearby(ctx context.Context, userID primitive.ObjectID, query NearbyQuery) ([]*NearbyTask, error)
+	ListDueSoon(ctx context.Context, from, until time.Time, limit int64) ([]*entities.Task, error)
+	MarkDueSoonNotified(ctx context.Context, ids []primitive.ObjectID) error
 }
 
 // todoRepository implementa TodoRepository
 type todoRepository struct {
+	operationTimeouts
+	fieldEncryption
+	tombstoneLog
 	collection *mongo.Collection
+	labels     *mongo.Collection
+	clock      clock.Clock
 }
 
 // NewTodoRepository cria uma nova instância do repositório
 func NewTodoRepository(db database.Client) TodoRepository {
-	collections := database.GetCollections(db)
+	return NewTodoRepositoryWithClock(db, clock.System())
+}
+
+// NewTodoRepositoryWithClock cria o repositório com o relógio informado, usado
+// em updated_at, completed_at e nas consultas de atraso
+func NewTodoRepositoryWithClock(db database.Client, clk clock.Clock) TodoRepository {
+	collections := db.Collections()
 
 	return &todoRepository{
-		collection: collections.Tasks,
+		operationTimeouts: newOperationTimeouts(db),
+		fieldEncryption:   newFieldEncryption(db),
+		tombstoneLog:      newTombstoneLog(db),
+		collection:        collections.Tasks,
+		labels:            collections.Labels,
+		clock:             clk,
 	}
 }
 
 // Create cria um novo todo
 func (r *todoRepository) Create(ctx context.Context, todo *entities.Task) error {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	labelIDs, err := r.labelIDs(ctx, todo.UserID, todo.Tags)
+	if err != nil {
+		return err
 	}
+	todo.LabelIDs = labelIDs
 
-	result, err := r.collection.InsertOne(ctx, todo)
+	doc, err := r.sealTask(todo)
 	if err != nil {
-		return fmt.Errorf("erro ao criar todo: %w", err)
+		return err
+	}
+
+	result, err := r.collection.InsertOne(ctx, doc)
+	if err != nil {
+		// ID gerado pelo cliente (sincronização offline) já usado
+		if isDuplicateIDError(err) {
+			return ErrTaskIDTaken
+		}
+		// Os demais índices únicos de tarefas são os do item de origem (external e caldav)
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrExternalTaskExists
+		}
+		return wrapError("erro ao criar todo: %w", err)
 	}
 
 	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
@@ -84,23 +242,68 @@ func (r *todoRepository) Create(ctx context.Context, todo *entities.Task) error
 	return nil
 }
 
-// GetByID busca todo por ID
-func (r *todoRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*entities.Task, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-	}
+// GetByID busca um todo do usuário por ID
+func (r *todoRepository) GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.Task, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
 
 	var todo entities.Task
-	filter := bson.M{"_id": id}
+	filter := ownedFilter(userID, id)
 
 	err := r.collection.FindOne(ctx, filter).Decode(&todo)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, fmt.Errorf("todo não encontrado")
+			return nil, ErrTodoNotFound
+		}
+		return nil, wrapError("erro ao buscar todo: %w", err)
+	}
+
+	if err := r.openTask(&todo); err != nil {
+		return nil, err
+	}
+
+	return &todo, nil
+}
+
+// GetByExternalID busca a tarefa do usuário sincronizada com o item de origem informado
+func (r *todoRepository) GetByExternalID(ctx context.Context, userID primitive.ObjectID, source, externalID string) (*entities.Task, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"user_id": userID, "external.source": source, "external.id": externalID}
+
+	var todo entities.Task
+	if err := r.collection.FindOne(ctx, filter).Decode(&todo); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrTodoNotFound
+		}
+		return nil, wrapError("erro ao buscar todo: %w", err)
+	}
+
+	if err := r.openTask(&todo); err != nil {
+		return nil, err
+	}
+
+	return &todo, nil
+}
+
+// GetByCalDAVName busca a tarefa do usuário criada por um cliente CalDAV com o nome de recurso informado
+func (r *todoRepository) GetByCalDAVName(ctx context.Context, userID primitive.ObjectID, name string) (*entities.Task, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"user_id": userID, "caldav.name": name}
+
+	var todo entities.Task
+	if err := r.collection.FindOne(ctx, filter).Decode(&todo); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrTodoNotFound
 		}
-		return nil, fmt.Errorf("erro ao buscar todo: %w", err)
+		return nil, wrapError("erro ao buscar todo: %w", err)
+	}
+
+	if err := r.openTask(&todo); err != nil {
+		return nil, err
 	}
 
 	return &todo, nil
@@ -108,24 +311,27 @@ func (r *todoRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*e
 
 // GetByUserID busca todos por usuário com filtros e paginação
 func (r *todoRepository) GetByUserID(ctx context.Context, userID primitive.ObjectID, page, limit int64, filters *TaskFilters) ([]*entities.Task, int64, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
+	if todos, total, ok, err := r.useAtlasSearch(ctx, userID, page, limit, filters); ok || err != nil {
+		return todos, total, err
 	}
 
-	// Constrói filtro base
-	filter := bson.M{"user_id": userID}
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
 
-	// Aplica filtros
-	if filters != nil {
-		r.applyFilters(filter, filters)
-	}
+	// Constrói filtro, com busca pelo índice de texto quando disponível. $text não
+	// aceita collation, então a ordenação por título busca por regex.
+	textSearch := !textSearchMissing.Load() && (filters == nil || filters.SortBy != "title")
+	filter := r.listFilter(userID, filters, textSearch)
 
 	// Conta total
-	total, err := r.collection.CountDocuments(ctx, filter)
+	total, err := r.collection.CountDocuments(ctx, filter, countOptions(filter))
+	if isIndexNotFound(err) {
+		textSearchMissing.Store(true)
+		filter = r.listFilter(userID, filters, false)
+		total, err = r.collection.CountDocuments(ctx, filter, countOptions(filter))
+	}
 	if err != nil {
-		return nil, 0, fmt.Errorf("erro ao contar todos: %w", err)
+		return nil, 0, wrapError("erro ao contar todos: %w", err)
 	}
 
 	// Calcula skip
@@ -135,29 +341,222 @@ func (r *todoRepository) GetByUserID(ctx context.Context, userID primitive.Objec
 	opts := options.Find().
 		SetSkip(skip).
 		SetLimit(limit).
-		SetSort(bson.M{"created_at": -1})
+		SetSort(listSort(filters))
+
+	_, text := filter["$text"]
+	if text && filters.SortBy == TaskSortRelevance {
+		opts.SetSort(relevanceSort("textScore"))
+	}
+	if hint := listHint(filter); hint != "" {
+		opts.SetHint(hint)
+	}
+
+	// Ordenação por título usa collation para ignorar maiúsculas/acentos
+	if filters != nil && filters.SortBy == "title" {
+		opts.SetCollation(caseInsensitiveCollation)
+	}
+
+	if filters != nil && filters.Compact {
+		opts.SetProjection(taskListProjection)
+	}
 
 	// Executa busca
 	cursor, err := r.collection.Find(ctx, filter, opts)
 	if err != nil {
-		return nil, 0, fmt.Errorf("erro ao listar todos: %w", err)
+		return nil, 0, wrapError("erro ao listar todos: %w", err)
 	}
 	defer cursor.Close(ctx)
 
 	var todos []*entities.Task
-	for cursor.Next(ctx) {
-		var todo entities.Task
-		if err := cursor.Decode(&todo); err != nil {
-			return nil, 0, fmt.Errorf("erro ao decodificar todo: %w", err)
-		}
-		todos = append(todos, &todo)
+	if err := cursor.All(ctx, &todos); err != nil {
+		return nil, 0, wrapError("erro ao decodificar todos: %w", err)
+	}
+
+	if err := r.openTasks(todos); err != nil {
+		return nil, 0, err
 	}
 
 	return todos, total, nil
 }
 
-// applyFilters aplica filtros na query
-func (r *todoRepository) applyFilters(filter bson.M, filters *TaskFilters) {
+// GetAllByUserID busca todas as tarefas do usuário (usado em exportações)
+func (r *todoRepository) GetAllByUserID(ctx context.Context, userID primitive.ObjectID) ([]*entities.Task, error) {
+	ctx, cancel := r.aggregateContext(ctx)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.M{"created_at": -1})
+
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, wrapError("erro ao listar todos: %w", err)
+	}
+
+	var todos []*entities.Task
+	if err := cursor.All(ctx, &todos); err != nil {
+		return nil, wrapError("erro ao decodificar todos: %w", err)
+	}
+
+	if err := r.openTasks(todos); err != nil {
+		return nil, err
+	}
+
+	return todos, nil
+}
+
+// GetByIDs busca as tarefas do usuário com os IDs informados (IDs de outros
+// usuários ou inexistentes são ignorados)
+func (r *todoRepository) GetByIDs(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID) ([]*entities.Task, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	cursor, err := r.collection.Find(ctx, bson.M{"_id": bson.M{"$in": ids}, "user_id": userID})
+	if err != nil {
+		return nil, wrapError("erro ao buscar todos: %w", err)
+	}
+
+	var todos []*entities.Task
+	if err := cursor.All(ctx, &todos); err != nil {
+		return nil, wrapError("erro ao decodificar todos: %w", err)
+	}
+
+	if err := r.openTasks(todos); err != nil {
+		return nil, err
+	}
+
+	return todos, nil
+}
+
+// SyncPosition é o ponto da sincronização a partir do qual as alterações são
+// lidas: tarefas com updated_at posterior a UpdatedAt ou, no mesmo instante,
+// com _id maior que AfterID (desempate entre tarefas alteradas juntas)
+type SyncPosition struct {
+	UpdatedAt time.Time
+	AfterID   primitive.ObjectID
+}
+
+// ListChangedSince lista as tarefas do usuário alteradas após position e até
+// until (inclusive), em ordem de alteração
+func (r *todoRepository) ListChangedSince(ctx context.Context, userID primitive.ObjectID, position SyncPosition, until time.Time, limit int64) ([]*entities.Task, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	filter := bson.M{
+		"user_id":    userID,
+		"updated_at": bson.M{"$lte": until},
+		"$or": []bson.M{
+			{"updated_at": bson.M{"$gt": position.UpdatedAt}},
+			{"updated_at": position.UpdatedAt, "_id": bson.M{"$gt": position.AfterID}},
+		},
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "updated_at", Value: 1}, {Key: "_id", Value: 1}}).
+		SetLimit(limit)
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, wrapError("erro ao buscar todos alterados: %w", err)
+	}
+
+	todos := []*entities.Task{}
+	if err := cursor.All(ctx, &todos); err != nil {
+		return nil, wrapError("erro ao decodificar todos: %w", err)
+	}
+
+	if err := r.openTasks(todos); err != nil {
+		return nil, err
+	}
+
+	return todos, nil
+}
+
+// isDuplicateIDError indica violação da chave primária (_id) na inserção
+func isDuplicateIDError(err error) bool {
+	var writeErr mongo.WriteException
+	if !errors.As(err, &writeErr) {
+		return false
+	}
+
+	for _, e := range writeErr.WriteErrors {
+		if e.Code == 11000 && strings.Contains(e.Message, "index: _id_ ") {
+			return true
+		}
+	}
+	return false
+}
+
+// ownedFilter restringe a busca ao documento do usuário informado
+func ownedFilter(userID, id primitive.ObjectID) bson.M {
+	return bson.M{"_id": id, "user_id": userID}
+}
+
+// listSort monta a ordenação da listagem (padrão: mais recentes primeiro)
+func listSort(filters *TaskFilters) bson.D {
+	if filters == nil || !IsValidTaskSortField(filters.SortBy) {
+		return bson.D{{Key: "created_at", Value: -1}}
+	}
+
+	order := 1
+	if filters.SortOrder == "desc" {
+		order = -1
+	}
+
+	// Sem pontuação de busca, a relevância fica só com o vencimento
+	if filters.SortBy == TaskSortRelevance {
+		return bson.D{{Key: "due_date", Value: 1}, {Key: "_id", Value: 1}}
+	}
+
+	// _id como desempate mantém a paginação estável
+	return bson.D{{Key: taskSortFields[filters.SortBy], Value: order}, {Key: "_id", Value: order}}
+}
+
+// relevanceSort ordena pela pontuação da busca (metadado textScore do $text ou
+// searchScore do Atlas Search), maior primeiro, e então pelo vencimento
+func relevanceSort(meta string) bson.D {
+	return bson.D{
+		{Key: "score", Value: bson.M{"$meta": meta}},
+		{Key: "due_date", Value: 1},
+		{Key: "_id", Value: 1},
+	}
+}
+
+// listHint escolhe o índice da listagem com busca por regex (sem índice de
+// texto): o $or da regex não usa índice e o planejador pode trocar o índice
+// do usuário por um de campo único. Consultas com $text sempre usam o índice
+// de texto e não aceitam hint.
+func listHint(filter bson.M) string {
+	if _, text := filter["$text"]; text {
+		return ""
+	}
+	if _, search := filter["$or"]; !search {
+		return ""
+	}
+	if _, status := filter["status"]; status {
+		return "user_status_compound_idx"
+	}
+	return "user_id_idx"
+}
+
+// countOptions aplica à contagem o mesmo índice da listagem
+func countOptions(filter bson.M) *options.CountOptions {
+	opts := options.Count()
+	if hint := listHint(filter); hint != "" {
+		opts.SetHint(hint)
+	}
+	return opts
+}
+
+// listFilter monta o filtro da listagem do usuário
+func (r *todoRepository) listFilter(userID primitive.ObjectID, filters *TaskFilters, textSearch bool) bson.M {
+	filter := bson.M{"user_id": userID}
+	if filters != nil {
+		r.applyFilters(filter, filters, textSearch)
+	}
+	return filter
+}
+
+// applyFilters aplica filtros na query. textSearch usa o índice de texto na
+// busca; sem ele, a busca é uma regex com o texto escapado.
+func (r *todoRepository) applyFilters(filter bson.M, filters *TaskFilters, textSearch bool) {
 	if filters.Status != "" {
 		filter["status"] = filters.Status
 	}
@@ -170,6 +569,10 @@ func (r *todoRepository) applyFilters(filter bson.M, filters *TaskFilters) {
 		filter["tags"] = bson.M{"$in": filters.Tags}
 	}
 
+	if filters.ProjectID != nil {
+		filter["project_id"] = *filters.ProjectID
+	}
+
 	if filters.IsArchived != nil {
 		filter["is_archived"] = *filters.IsArchived
 	}
@@ -188,236 +591,817 @@ func (r *todoRepository) applyFilters(filter bson.M, filters *TaskFilters) {
 		filter["due_date"].(bson.M)["$gte"] = *filters.DueAfter
 	}
 
-	if filters.Search != "" {
+	search := filters.Search
+	if runes := []rune(search); len(runes) > MaxTaskSearchLength {
+		search = string(runes[:MaxTaskSearchLength])
+	}
+
+	if query := textSearchQuery(search); textSearch && query != "" {
+		filter["$text"] = bson.M{"$search": query}
+	} else if search = strings.TrimSpace(search); search != "" {
+		pattern := regexp.QuoteMeta(search)
 		filter["$or"] = []bson.M{
-			{"title": bson.M{"$regex": filters.Search, "$options": "i"}},
-			{"description": bson.M{"$regex": filters.Search, "$options": "i"}},
+			{"title": bson.M{"$regex": pattern, "$options": "i"}},
+			{"description": bson.M{"$regex": pattern, "$options": "i"}},
 		}
 	}
 }
 
-// Update atualiza um todo
-func (r *todoRepository) Update(ctx context.Context, todo *entities.Task) error {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
+// textSearchQuery remove a sintaxe do $text (frases entre aspas e negação com
+// "-"), para que o texto do usuário seja buscado apenas como termos
+func textSearchQuery(search string) string {
+	terms := strings.Fields(strings.ReplaceAll(search, `"`, " "))
+	for i, term := range terms {
+		terms[i] = strings.TrimLeft(term, "-")
 	}
+	return strings.Join(strings.Fields(strings.Join(terms, " ")), " ")
+}
 
-	todo.PrepareForUpdate()
+// isIndexNotFound indica erro de consulta $text sem índice de texto na coleção
+func isIndexNotFound(err error) bool {
+	var serverErr mongo.ServerError
+	return errors.As(err, &serverErr) && serverErr.HasErrorCode(indexNotFoundCode)
+}
 
-	filter := bson.M{"_id": todo.ID}
+// Update atualiza um todo do usuário
+func (r *todoRepository) Update(ctx context.Context, userID primitive.ObjectID, todo *entities.Task) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	todo.PrepareForUpdateAt(r.clock.Now())
+
+	description, err := r.encrypt(todo.Description)
+	if err != nil {
+		return err
+	}
+
+	labelIDs, err := r.labelIDs(ctx, userID, todo.Tags)
+	if err != nil {
+		return err
+	}
+	todo.LabelIDs = labelIDs
+
+	filter := ownedFilter(userID, todo.ID)
 	update := bson.M{
 		"$set": bson.M{
-			"title":        todo.Title,
-			"description":  todo.Description,
-			"status":       todo.Status,
-			"priority":     todo.Priority,
-			"due_date":     todo.DueDate,
-			"tags":         todo.Tags,
-			"is_archived":  todo.IsArchived,
-			"updated_at":   todo.UpdatedAt,
-			"completed_at": todo.CompletedAt,
+			"title":          todo.Title,
+			"description":    description,
+			"status":         todo.Status,
+			"status_id":      todo.StatusID,
+			"priority":       todo.Priority,
+			"priority_order": todo.PriorityOrder,
+			"title_tokens":   todo.TitleTokens,
+			"due_date":       todo.DueDate,
+			"tags":           todo.Tags,
+			"checklist":      todo.Checklist,
+			"project_id":     todo.ProjectID,
+			"is_archived":    todo.IsArchived,
+			"updated_at":     todo.UpdatedAt,
 		},
 	}
+	unset := bson.M{}
+
+	if todo.CustomStatus != nil {
+		update["$set"].(bson.M)["custom_status"] = todo.CustomStatus
+	} else {
+		unset["custom_status"] = ""
+	}
+	if len(todo.LabelIDs) > 0 {
+		update["$set"].(bson.M)["label_ids"] = todo.LabelIDs
+	} else {
+		unset["label_ids"] = ""
+	}
+	if todo.Location != nil {
+		update["$set"].(bson.M)["location"] = todo.Location
+	} else {
+		unset["location"] = ""
+	}
+	if todo.EstimateMinutes > 0 {
+		update["$set"].(bson.M)["estimate_minutes"] = todo.EstimateMinutes
+	} else {
+		unset["estimate_minutes"] = ""
+	}
+
+	// PrepareForUpdate já deixou completed_at coerente com o status
+	if todo.CompletedAt != nil {
+		update["$set"].(bson.M)["completed_at"] = *todo.CompletedAt
+	} else {
+		unset["completed_at"] = ""
+	}
+
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
 
 	result, err := r.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
-		return fmt.Errorf("erro ao atualizar todo: %w", err)
+		return wrapError("erro ao atualizar todo: %w", err)
 	}
 
 	if result.MatchedCount == 0 {
-		return fmt.Errorf("todo não encontrado")
+		return ErrTodoNotFound
 	}
 
 	return nil
 }
 
-// Delete remove um todo
-func (r *todoRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
+// PatchFields altera apenas os campos informados da tarefa do usuário. Campos
+// com valor nil são removidos ($unset); updated_at é sempre atualizado.
+func (r *todoRepository) PatchFields(ctx context.Context, userID, id primitive.ObjectID, fields bson.M) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	set := bson.M{"updated_at": r.clock.Now()}
+	unset := bson.M{}
+	for key, value := range fields {
+		if value == nil {
+			unset[key] = ""
+			continue
+		}
+
+		if description, ok := value.(string); ok && key == "description" {
+			encrypted, err := r.encrypt(description)
+			if err != nil {
+				return err
+			}
+			value = encrypted
+		}
+		set[key] = value
+	}
+
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	result, err := r.collection.UpdateOne(ctx, ownedFilter(userID, id), update)
+	if err != nil {
+		return wrapError("erro ao atualizar todo: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrTodoNotFound
 	}
 
-	filter := bson.M{"_id": id}
+	return nil
+}
+
+// Delete remove um todo do usuário
+func (r *todoRepository) Delete(ctx context.Context, userID, id primitive.ObjectID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := ownedFilter(userID, id)
 	result, err := r.collection.DeleteOne(ctx, filter)
 	if err != nil {
-		return fmt.Errorf("erro ao deletar todo: %w", err)
+		return wrapError("erro ao deletar todo: %w", err)
 	}
 
 	if result.DeletedCount == 0 {
-		return fmt.Errorf("todo não encontrado")
+		return ErrTodoNotFound
 	}
 
+	r.recordDeleted(ctx, entities.TombstoneTask, ownedDocs(userID, id)...)
+
 	return nil
 }
 
-// UpdateStatus atualiza apenas o status de um todo
-func (r *todoRepository) UpdateStatus(ctx context.Context, id primitive.ObjectID, status enums.TaskStatus) error {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-	}
+// UpdateStatus atualiza apenas o status de um todo do usuário
+func (r *todoRepository) UpdateStatus(ctx context.Context, userID, id primitive.ObjectID, status enums.TaskStatus) error {
+	return r.setStatus(ctx, userID, id, status, nil)
+}
 
-	filter := bson.M{"_id": id}
+// SetCustomStatus atribui à tarefa do usuário um status personalizado, gravando
+// o status padrão associado a ele
+func (r *todoRepository) SetCustomStatus(ctx context.Context, userID, id primitive.ObjectID, status *entities.CustomStatus) error {
+	return r.setStatus(ctx, userID, id, status.MapsTo, status.Snapshot())
+}
+
+// setStatus grava o status da tarefa e o status personalizado (nil remove)
+func (r *todoRepository) setStatus(ctx context.Context, userID, id primitive.ObjectID, status enums.TaskStatus, custom *entities.TaskCustomStatus) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	now := r.clock.Now()
+	filter := ownedFilter(userID, id)
 	update := bson.M{
 		"$set": bson.M{
 			"status":     status,
-			"updated_at": time.Now(),
+			"updated_at": now,
 		},
 	}
 
-	// Se está marcando como concluído, adiciona completed_at
+	// A troca direta de status tira a tarefa do status personalizado do projeto
+	update["$unset"] = bson.M{"status_id": ""}
+	if custom != nil {
+		update["$set"].(bson.M)["custom_status"] = custom
+	} else {
+		update["$unset"].(bson.M)["custom_status"] = ""
+	}
 	if status == enums.StatusCompleted {
-		update["$set"].(bson.M)["completed_at"] = time.Now()
+		update["$set"].(bson.M)["completed_at"] = now
 	} else {
-		update["$unset"] = bson.M{"completed_at": ""}
+		update["$unset"].(bson.M)["completed_at"] = ""
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return wrapError("erro ao atualizar status: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrTodoNotFound
+	}
+
+	return nil
+}
+
+// RenameCustomStatus atualiza o nome e a cor do status personalizado nas
+// tarefas do usuário que o usam
+func (r *todoRepository) RenameCustomStatus(ctx context.Context, userID primitive.ObjectID, status *entities.CustomStatus) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"user_id": userID, "custom_status._id": status.ID}
+	update := bson.M{"$set": bson.M{"custom_status": status.Snapshot(), "updated_at": r.clock.Now()}}
+
+	if _, err := r.collection.UpdateMany(ctx, filter, update); err != nil {
+		return wrapError("erro ao atualizar status personalizado das tarefas: %w", err)
+	}
+
+	return nil
+}
+
+// ClearCustomStatus remove o status personalizado das tarefas do usuário,
+// que mantêm o status padrão associado a ele
+func (r *todoRepository) ClearCustomStatus(ctx context.Context, userID, statusID primitive.ObjectID) (int64, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"user_id": userID, "custom_status._id": statusID}
+	update := bson.M{
+		"$unset": bson.M{"custom_status": ""},
+		"$set":   bson.M{"updated_at": r.clock.Now()},
 	}
 
+	result, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, wrapError("erro ao remover status personalizado das tarefas: %w", err)
+	}
+
+	return result.ModifiedCount, nil
+}
+
+// SetChecklistItemDone marca ou desmarca um item da lista de verificação da
+// tarefa do usuário
+func (r *todoRepository) SetChecklistItemDone(ctx context.Context, userID, id, itemID primitive.ObjectID, done bool) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := ownedFilter(userID, id)
+	filter["checklist._id"] = itemID
+	update := bson.M{"$set": bson.M{"checklist.$.done": done, "updated_at": r.clock.Now()}}
+
 	result, err := r.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
-		return fmt.Errorf("erro ao atualizar status: %w", err)
+		return wrapError("erro ao atualizar item da lista de verificação: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrChecklistItemNotFound
+	}
+
+	return nil
+}
+
+// LinkLabel associa a etiqueta gerenciada às tarefas do usuário que já têm
+// uma etiqueta com o nome dela, retornando quantas foram associadas
+func (r *todoRepository) LinkLabel(ctx context.Context, label *entities.Label) (int64, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"user_id": label.UserID, "tags": label.Name, "label_ids": bson.M{"$ne": label.ID}}
+	update := bson.M{"$addToSet": bson.M{"label_ids": label.ID}}
+
+	result, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, wrapError("erro ao associar etiqueta às tarefas: %w", err)
+	}
+
+	return result.ModifiedCount, nil
+}
+
+// RenameLabel troca previousName pelo novo nome da etiqueta nas tarefas
+// associadas a ela e associa as tarefas que já usavam o novo nome
+func (r *todoRepository) RenameLabel(ctx context.Context, label *entities.Label, previousName string) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	// Tarefas que já tinham o novo nome só perdem o antigo, sem repetir a etiqueta
+	renamed := bson.M{"$cond": bson.A{
+		bson.M{"$in": bson.A{label.Name, "$tags"}},
+		bson.M{"$filter": bson.M{"input": "$tags", "cond": bson.M{"$ne": bson.A{"$$this", previousName}}}},
+		bson.M{"$map": bson.M{"input": "$tags", "in": bson.M{"$cond": bson.A{
+			bson.M{"$eq": bson.A{"$$this", previousName}}, label.Name, "$$this",
+		}}}},
+	}}
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{"tags": renamed, "updated_at": r.clock.Now()}}}}
+
+	filter := bson.M{"user_id": label.UserID, "label_ids": label.ID, "tags": previousName}
+	if _, err := r.collection.UpdateMany(ctx, filter, update); err != nil {
+		return wrapError("erro ao renomear etiqueta das tarefas: %w", err)
+	}
+
+	if _, err := r.LinkLabel(ctx, label); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UnlinkLabel desfaz a associação da etiqueta gerenciada com as tarefas do
+// usuário; o texto da etiqueta continua nas tarefas
+func (r *todoRepository) UnlinkLabel(ctx context.Context, userID, labelID primitive.ObjectID) (int64, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"user_id": userID, "label_ids": labelID}
+	update := bson.M{"$pull": bson.M{"label_ids": labelID}}
+
+	result, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, wrapError("erro ao desassociar etiqueta das tarefas: %w", err)
+	}
+
+	return result.ModifiedCount, nil
+}
+
+// DistinctTags retorna as etiquetas usadas nas tarefas do usuário
+func (r *todoRepository) DistinctTags(ctx context.Context, userID primitive.ObjectID) ([]string, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	values, err := r.collection.Distinct(ctx, "tags", bson.M{"user_id": userID})
+	if err != nil {
+		return nil, wrapError("erro ao listar etiquetas das tarefas: %w", err)
+	}
+
+	tags := make([]string, 0, len(values))
+	for _, value := range values {
+		if tag, ok := value.(string); ok {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags, nil
+}
+
+// Suggest sugere títulos e etiquetas para o texto digitado. Os termos completos
+// do texto devem estar no título e o último, ainda em digitação, é buscado como
+// prefixo em title_tokens; as etiquetas são buscadas pelo texto inteiro como prefixo.
+func (r *todoRepository) Suggest(ctx context.Context, userID primitive.ObjectID, query string, limit int64) (*TaskSuggestions, error) {
+	ctx, cancel := r.aggregateContext(ctx)
+	defer cancel()
+
+	suggestions := &TaskSuggestions{Titles: []string{}, Tags: []string{}}
+
+	if tokens := entities.TitleTokens(query); len(tokens) > 0 {
+		titles, err := r.suggestTitles(ctx, userID, tokens, limit)
+		if err != nil {
+			return nil, err
+		}
+		suggestions.Titles = titles
+	}
+
+	if tag := entities.NormalizeTag(query); tag != "" {
+		tags, err := r.suggestTags(ctx, userID, tag, limit)
+		if err != nil {
+			return nil, err
+		}
+		suggestions.Tags = tags
+	}
+
+	return suggestions, nil
+}
+
+// suggestTitles retorna os títulos distintos das tarefas alteradas mais
+// recentemente com os termos informados (o último como prefixo)
+func (r *todoRepository) suggestTitles(ctx context.Context, userID primitive.ObjectID, tokens []string, limit int64) ([]string, error) {
+	last := len(tokens) - 1
+	match := bson.M{"$regex": "^" + regexp.QuoteMeta(tokens[last])}
+	if last > 0 {
+		match["$all"] = tokens[:last]
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"user_id": userID, "title_tokens": match}}},
+		{{Key: "$group", Value: bson.M{"_id": "$title", "updated_at": bson.M{"$max": "$updated_at"}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "updated_at", Value: -1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$limit", Value: limit}},
+	}
+
+	return r.suggestValues(ctx, pipeline, "títulos")
+}
+
+// suggestTags retorna as etiquetas com o prefixo informado, das mais usadas
+// para as menos usadas
+func (r *todoRepository) suggestTags(ctx context.Context, userID primitive.ObjectID, prefix string, limit int64) ([]string, error) {
+	match := bson.M{"$regex": "^" + regexp.QuoteMeta(prefix)}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"user_id": userID, "tags": match}}},
+		{{Key: "$unwind", Value: "$tags"}},
+		{{Key: "$match", Value: bson.M{"tags": match}}},
+		{{Key: "$group", Value: bson.M{"_id": "$tags", "count": bson.M{"$sum": 1}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$limit", Value: limit}},
+	}
+
+	return r.suggestValues(ctx, pipeline, "etiquetas")
+}
+
+// suggestValues executa a agregação de sugestões e retorna os valores de _id
+func (r *todoRepository) suggestValues(ctx context.Context, pipeline mongo.Pipeline, kind string) ([]string, error) {
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, wrapError("erro ao sugerir %s: %w", kind, err)
+	}
+	defer cursor.Close(ctx)
+
+	var results []struct {
+		Value string `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, wrapError("erro ao decodificar sugestões de %s: %w", kind, err)
+	}
+
+	values := make([]string, 0, len(results))
+	for _, result := range results {
+		values = append(values, result.Value)
+	}
+
+	return values, nil
+}
+
+// labelIDs retorna os IDs das etiquetas gerenciadas do usuário com os nomes
+// das etiquetas da tarefa
+func (r *todoRepository) labelIDs(ctx context.Context, userID primitive.ObjectID, tags []string) ([]primitive.ObjectID, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+
+	opts := options.Find().SetProjection(bson.M{"name": 1})
+	cursor, err := r.labels.Find(ctx, bson.M{"user_id": userID, "name": bson.M{"$in": tags}}, opts)
+	if err != nil {
+		return nil, wrapError("erro ao buscar etiquetas da tarefa: %w", err)
+	}
+
+	var labels []*entities.Label
+	if err := cursor.All(ctx, &labels); err != nil {
+		return nil, wrapError("erro ao decodificar etiquetas da tarefa: %w", err)
+	}
+
+	return entities.LabelIDsForTags(labels, tags), nil
+}
+
+// AddAttachments adiciona anexos (já gravados no GridFS) à tarefa do usuário
+func (r *todoRepository) AddAttachments(ctx context.Context, userID, id primitive.ObjectID, attachments []entities.TaskAttachment) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	sealed, err := r.sealAttachments(attachments)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{
+		"$push": bson.M{"attachments": bson.M{"$each": sealed}},
+		"$set":  bson.M{"updated_at": r.clock.Now()},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, ownedFilter(userID, id), update)
+	if err != nil {
+		return wrapError("erro ao adicionar anexos: %w", err)
 	}
 
 	if result.MatchedCount == 0 {
-		return fmt.Errorf("todo não encontrado")
+		return ErrTodoNotFound
 	}
 
 	return nil
 }
 
-// BulkUpdateStatus atualiza status de múltiplos todos
-func (r *todoRepository) BulkUpdateStatus(ctx context.Context, ids []primitive.ObjectID, status enums.TaskStatus) (int64, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
+// ClearProject desvincula do projeto removido todas as tarefas do usuário
+func (r *todoRepository) ClearProject(ctx context.Context, userID, projectID primitive.ObjectID) (int64, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	update := bson.M{
+		"$unset": bson.M{"project_id": ""},
+		"$set":   bson.M{"updated_at": r.clock.Now()},
 	}
 
-	filter := bson.M{"_id": bson.M{"$in": ids}}
+	result, err := r.collection.UpdateMany(ctx, bson.M{"user_id": userID, "project_id": projectID}, update)
+	if err != nil {
+		return 0, wrapError("erro ao desvincular tarefas do projeto: %w", err)
+	}
+
+	return result.ModifiedCount, nil
+}
+
+// BulkUpdateStatus atualiza status de múltiplos todos do usuário.
+// IDs de outros usuários são ignorados.
+func (r *todoRepository) BulkUpdateStatus(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID, status enums.TaskStatus) (int64, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	now := r.clock.Now()
+	filter := bson.M{"_id": bson.M{"$in": ids}, "user_id": userID}
 	update := bson.M{
 		"$set": bson.M{
 			"status":     status,
-			"updated_at": time.Now(),
+			"updated_at": now,
 		},
 	}
 
+	// A troca direta de status tira a tarefa do status personalizado do projeto
+	update["$unset"] = bson.M{"status_id": "", "custom_status": ""}
 	if status == enums.StatusCompleted {
-		update["$set"].(bson.M)["completed_at"] = time.Now()
+		update["$set"].(bson.M)["completed_at"] = now
 	} else {
-		update["$unset"] = bson.M{"completed_at": ""}
+		update["$unset"].(bson.M)["completed_at"] = ""
 	}
 
 	result, err := r.collection.UpdateMany(ctx, filter, update)
 	if err != nil {
-		return 0, fmt.Errorf("erro ao atualizar status em lote: %w", err)
+		return 0, wrapError("erro ao atualizar status em lote: %w", err)
 	}
 
 	return result.ModifiedCount, nil
 }
 
-// BulkDelete remove múltiplos todos
-func (r *todoRepository) BulkDelete(ctx context.Context, ids []primitive.ObjectID) (int64, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-	}
+// BulkDelete remove múltiplos todos do usuário.
+// IDs de outros usuários são ignorados.
+func (r *todoRepository) BulkDelete(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID) (int64, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
 
-	filter := bson.M{"_id": bson.M{"$in": ids}}
+	filter := bson.M{"_id": bson.M{"$in": ids}, "user_id": userID}
 	result, err := r.collection.DeleteMany(ctx, filter)
 	if err != nil {
-		return 0, fmt.Errorf("erro ao deletar em lote: %w", err)
+		return 0, wrapError("erro ao deletar em lote: %w", err)
+	}
+
+	// IDs que não existiam também são registrados: exclusão repetida é inofensiva ao cliente
+	if result.DeletedCount > 0 {
+		r.recordDeleted(ctx, entities.TombstoneTask, ownedDocs(userID, ids...)...)
 	}
 
 	return result.DeletedCount, nil
 }
 
-// GetStatsByUser retorna estatísticas dos todos por usuário
+// SetPositions grava as posições 1..n das tarefas do usuário na ordem dos IDs,
+// em uma única escrita em lote. IDs de outros usuários são ignorados.
+func (r *todoRepository) SetPositions(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID) ([]TaskPosition, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	now := r.clock.Now()
+	positions := make([]TaskPosition, 0, len(ids))
+	writes := make([]mongo.WriteModel, 0, len(ids))
+	for i, id := range ids {
+		position := TaskPosition{ID: id, Position: int64(i + 1)}
+		positions = append(positions, position)
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(ownedFilter(userID, id)).
+			SetUpdate(bson.M{"$set": bson.M{"position": position.Position, "updated_at": now}}))
+	}
+
+	if _, err := r.collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false)); err != nil {
+		return nil, wrapError("erro ao reordenar tarefas: %w", err)
+	}
+
+	return positions, nil
+}
+
+// GetStatsByUser retorna estatísticas dos todos por usuário, calculadas em uma
+// única agregação ($facet)
 func (r *todoRepository) GetStatsByUser(ctx context.Context, userID primitive.ObjectID) (*TaskStats, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
+	ctx, cancel := r.aggregateContext(ctx)
+	defer cancel()
+
+	now := r.clock.Now()
+	countBy := func(field string) []bson.M {
+		return []bson.M{{"$group": bson.M{"_id": field, "count": bson.M{"$sum": 1}}}}
 	}
 
 	pipeline := []bson.M{
-		{
-			"$match": bson.M{"user_id": userID},
-		},
-		{
-			"$group": bson.M{
-				"_id":   "$status",
-				"count": bson.M{"$sum": 1},
+		{"$match": bson.M{"user_id": userID}},
+		{"$facet": bson.M{
+			"by_status":   countBy("$status"),
+			"by_priority": countBy("$priority"),
+			"top_tags": []bson.M{
+				{"$unwind": "$tags"},
+				{"$group": bson.M{"_id": "$tags", "count": bson.M{"$sum": 1}}},
+				{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}},
+				{"$limit": taskStatsTopTags},
 			},
-		},
+			"archived": []bson.M{
+				{"$match": bson.M{"is_archived": true}},
+				{"$count": "count"},
+			},
+			"overdue": []bson.M{
+				{"$match": bson.M{"due_date": bson.M{"$lt": now}, "status": bson.M{"$ne": enums.StatusCompleted}}},
+				{"$count": "count"},
+			},
+			"completion": []bson.M{
+				{"$match": bson.M{"status": enums.StatusCompleted, "completed_at": bson.M{"$ne": nil}}},
+				{"$group": bson.M{
+					"_id":    nil,
+					"avg_ms": bson.M{"$avg": bson.M{"$subtract": bson.A{"$completed_at", "$created_at"}}},
+				}},
+			},
+		}},
 	}
 
 	cursor, err := r.collection.Aggregate(ctx, pipeline)
 	if err != nil {
-		return nil, fmt.Errorf("erro ao obter estatísticas: %w", err)
+		return nil, wrapError("erro ao obter estatísticas: %w", err)
 	}
-	defer cursor.Close(ctx)
 
-	stats := &TaskStats{}
-	statusCounts := make(map[string]int64)
+	type groupCount struct {
+		ID    string `bson:"_id"`
+		Count int64  `bson:"count"`
+	}
+	var results []struct {
+		ByStatus   []groupCount `bson:"by_status"`
+		ByPriority []groupCount `bson:"by_priority"`
+		TopTags    []TagCount   `bson:"top_tags"`
+		Archived   []groupCount `bson:"archived"`
+		Overdue    []groupCount `bson:"overdue"`
+		Completion []struct {
+			AvgMs float64 `bson:"avg_ms"`
+		} `bson:"completion"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, wrapError("erro ao decodificar estatísticas: %w", err)
+	}
 
-	for cursor.Next(ctx) {
-		var result struct {
-			ID    string `bson:"_id"`
-			Count int64  `bson:"count"`
-		}
-		if err := cursor.Decode(&result); err != nil {
-			return nil, fmt.Errorf("erro ao decodificar estatística: %w", err)
-		}
-		statusCounts[result.ID] = result.Count
-		stats.Total += result.Count
+	stats := &TaskStats{
+		ByPriority: make(map[string]int64, len(enums.GetAllPriorities())),
+		TopTags:    []TagCount{},
+		ComputedAt: now,
+	}
+	for _, priority := range enums.GetAllPriorities() {
+		stats.ByPriority[string(priority)] = 0
 	}
+	if len(results) == 0 {
+		return stats, nil
+	}
+	result := results[0]
 
 	// Preenche estatísticas por status
+	statusCounts := make(map[string]int64, len(result.ByStatus))
+	for _, group := range result.ByStatus {
+		statusCounts[group.ID] = group.Count
+		stats.Total += group.Count
+	}
 	stats.Pending = statusCounts[string(enums.StatusPending)]
 	stats.InProgress = statusCounts[string(enums.StatusInProgress)]
 	stats.Completed = statusCounts[string(enums.StatusCompleted)]
 	stats.Cancelled = statusCounts[string(enums.StatusCancelled)]
 
-	// Conta arquivados
-	archivedCount, err := r.collection.CountDocuments(ctx, bson.M{
-		"user_id":     userID,
-		"is_archived": true,
-	})
+	for _, group := range result.ByPriority {
+		stats.ByPriority[group.ID] = group.Count
+	}
+	if result.TopTags != nil {
+		stats.TopTags = result.TopTags
+	}
+	if len(result.Archived) > 0 {
+		stats.Archived = result.Archived[0].Count
+	}
+	if len(result.Overdue) > 0 {
+		stats.Overdue = result.Overdue[0].Count
+	}
+	if len(result.Completion) > 0 {
+		stats.AvgCompletionSeconds = int64(result.Completion[0].AvgMs / 1000)
+	}
+
+	return stats, nil
+}
+
+// ProjectRollup resume as tarefas não arquivadas do projeto, que pertencem ao
+// dono (ownerID): abertas (pending ou in_progress), concluídas, atrasadas e a
+// estimativa restante das abertas
+func (r *todoRepository) ProjectRollup(ctx context.Context, ownerID, projectID primitive.ObjectID) (*entities.ProjectRollup, error) {
+	ctx, cancel := r.aggregateContext(ctx)
+	defer cancel()
+
+	now := r.clock.Now()
+	countIf := func(condition interface{}) bson.M {
+		return bson.M{"$sum": bson.M{"$cond": bson.A{condition, 1, 0}}}
+	}
+	open := bson.M{"$in": bson.A{"$status", bson.A{enums.StatusPending, enums.StatusInProgress}}}
+
+	pipeline := []bson.M{
+		{"$match": bson.M{"user_id": ownerID, "project_id": projectID, "is_archived": false}},
+		{"$group": bson.M{
+			"_id":       nil,
+			"total":     bson.M{"$sum": 1},
+			"open":      countIf(open),
+			"completed": countIf(bson.M{"$eq": bson.A{"$status", enums.StatusCompleted}}),
+			"cancelled": countIf(bson.M{"$eq": bson.A{"$status", enums.StatusCancelled}}),
+			// $gt null descarta tarefas sem vencimento, que seriam menores que now
+			"overdue": countIf(bson.M{"$and": bson.A{
+				open,
+				bson.M{"$gt": bson.A{"$due_date", nil}},
+				bson.M{"$lt": bson.A{"$due_date", now}},
+			}}),
+			"remaining": bson.M{"$sum": bson.M{"$cond": bson.A{open, bson.M{"$ifNull": bson.A{"$estimate_minutes", 0}}, 0}}},
+		}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
 	if err != nil {
-		return nil, fmt.Errorf("erro ao contar arquivados: %w", err)
+		return nil, wrapError("erro ao calcular resumo do projeto: %w", err)
+	}
+
+	var results []struct {
+		Total     int64 `bson:"total"`
+		Open      int64 `bson:"open"`
+		Completed int64 `bson:"completed"`
+		Cancelled int64 `bson:"cancelled"`
+		Overdue   int64 `bson:"overdue"`
+		Remaining int64 `bson:"remaining"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, wrapError("erro ao decodificar resumo do projeto: %w", err)
+	}
+
+	rollup := &entities.ProjectRollup{ComputedAt: now}
+	if len(results) == 0 {
+		return rollup, nil
+	}
+	result := results[0]
+
+	rollup.TotalTasks = result.Total
+	rollup.OpenTasks = result.Open
+	rollup.CompletedTasks = result.Completed
+	rollup.OverdueTasks = result.Overdue
+	rollup.RemainingEstimateMinutes = result.Remaining
+	if active := result.Total - result.Cancelled; active > 0 {
+		rollup.PercentComplete = int(result.Completed * 100 / active)
+	}
+
+	return rollup, nil
+}
+
+// CompletedSeries conta as tarefas concluídas desde since, agrupadas pelo
+// início do dia ou da semana (unit "day" ou "week", semanas começando na
+// segunda) no fuso informado. Intervalos sem conclusões não são retornados.
+func (r *todoRepository) CompletedSeries(ctx context.Context, userID primitive.ObjectID, since time.Time, unit, timezone string) ([]SeriesPoint, error) {
+	ctx, cancel := r.aggregateContext(ctx)
+	defer cancel()
+
+	trunc := bson.M{"date": "$completed_at", "unit": unit, "timezone": timezone}
+	if unit == "week" {
+		trunc["startOfWeek"] = "monday"
+	}
+
+	pipeline := []bson.M{
+		{"$match": bson.M{
+			"user_id":      userID,
+			"status":       enums.StatusCompleted,
+			"completed_at": bson.M{"$gte": since},
+		}},
+		{"$group": bson.M{
+			"_id":   bson.M{"$dateTrunc": trunc},
+			"count": bson.M{"$sum": 1},
+		}},
+		{"$sort": bson.M{"_id": 1}},
 	}
-	stats.Archived = archivedCount
 
-	// Conta atrasados
-	overdueCount, err := r.collection.CountDocuments(ctx, bson.M{
-		"user_id":  userID,
-		"due_date": bson.M{"$lt": time.Now()},
-		"status":   bson.M{"$ne": enums.StatusCompleted},
-	})
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
 	if err != nil {
-		return nil, fmt.Errorf("erro ao contar atrasados: %w", err)
+		return nil, wrapError("erro ao obter série de concluídas: %w", err)
 	}
-	stats.Overdue = overdueCount
 
-	return stats, nil
+	var points []SeriesPoint
+	if err := cursor.All(ctx, &points); err != nil {
+		return nil, wrapError("erro ao decodificar série de concluídas: %w", err)
+	}
+
+	return points, nil
 }
 
 // GetOverdueTodos busca todos atrasados
 func (r *todoRepository) GetOverdueTodos(ctx context.Context, userID primitive.ObjectID) ([]*entities.Task, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-	}
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
 
 	filter := bson.M{
 		"user_id":     userID,
-		"due_date":    bson.M{"$lt": time.Now()},
+		"due_date":    bson.M{"$lt": r.clock.Now()},
 		"status":      bson.M{"$ne": enums.StatusCompleted},
 		"is_archived": false,
 	}
@@ -426,18 +1410,117 @@ func (r *todoRepository) GetOverdueTodos(ctx context.Context, userID primitive.O
 
 	cursor, err := r.collection.Find(ctx, filter, opts)
 	if err != nil {
-		return nil, fmt.Errorf("erro ao buscar todos atrasados: %w", err)
+		return nil, wrapError("erro ao buscar todos atrasados: %w", err)
 	}
 	defer cursor.Close(ctx)
 
 	var todos []*entities.Task
-	for cursor.Next(ctx) {
-		var todo entities.Task
-		if err := cursor.Decode(&todo); err != nil {
-			return nil, fmt.Errorf("erro ao decodificar todo: %w", err)
+	if err := cursor.All(ctx, &todos); err != nil {
+		return nil, wrapError("erro ao decodificar todos: %w", err)
+	}
+
+	if err := r.openTasks(todos); err != nil {
+		return nil, err
+	}
+
+	return todos, nil
+}
+
+// ListNearby retorna as tarefas do usuário com local dentro do raio, da mais
+// próxima para a mais distante. Arquivadas não entram.
+func (r *todoRepository) ListNearby(ctx context.Context, userID primitive.ObjectID, query NearbyQuery) ([]*NearbyTask, error) {
+	ctx, cancel := r.aggregateContext(ctx)
+	defer cancel()
+
+	filter := bson.M{
+		"user_id":     userID,
+		"is_archived": false,
+		"status":      bson.M{"$nin": []enums.TaskStatus{enums.StatusCompleted, enums.StatusCancelled}},
+	}
+	if query.Status != "" {
+		filter["status"] = query.Status
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$geoNear", Value: bson.M{
+			"near":          entities.NewGeoPoint(query.Lat, query.Lng),
+			"key":           "location.point",
+			"distanceField": "distance",
+			"maxDistance":   query.Radius,
+			"spherical":     true,
+			"query":         filter,
+		}}},
+		{{Key: "$limit", Value: query.Limit}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, wrapError("erro ao buscar tarefas próximas: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var nearby []*NearbyTask
+	if err := cursor.All(ctx, &nearby); err != nil {
+		return nil, wrapError("erro ao decodificar tarefas próximas: %w", err)
+	}
+
+	for _, item := range nearby {
+		if err := r.openTask(&item.Task); err != nil {
+			return nil, err
 		}
-		todos = append(todos, &todo)
+	}
+
+	return nearby, nil
+}
+
+// ListDueSoon retorna, de todos os usuários, as tarefas abertas que vencem
+// entre from e until e ainda não foram avisadas para o vencimento atual,
+// ordenadas por usuário
+func (r *todoRepository) ListDueSoon(ctx context.Context, from, until time.Time, limit int64) ([]*entities.Task, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	filter := bson.M{
+		"due_date":    bson.M{"$gte": from, "$lte": until},
+		"status":      bson.M{"$nin": []enums.TaskStatus{enums.StatusCompleted, enums.StatusCancelled}},
+		"is_archived": false,
+		// O aviso vale para um vencimento: se ele mudar, a tarefa volta a ser elegível
+		"$expr": bson.M{"$ne": bson.A{"$due_soon_notified_for", "$due_date"}},
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "user_id", Value: 1}, {Key: "due_date", Value: 1}}).
+		SetLimit(limit)
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, wrapError("erro ao buscar tarefas vencendo em breve: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var todos []*entities.Task
+	if err := cursor.All(ctx, &todos); err != nil {
+		return nil, wrapError("erro ao decodificar todos: %w", err)
+	}
+
+	if err := r.openTasks(todos); err != nil {
+		return nil, err
 	}
 
 	return todos, nil
 }
+
+// MarkDueSoonNotified registra que as tarefas foram avisadas para o vencimento
+// atual. updated_at não muda: o aviso não é uma alteração da tarefa.
+func (r *todoRepository) MarkDueSoonNotified(ctx context.Context, ids []primitive.ObjectID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{"due_soon_notified_for": "$due_date"}}}}
+
+	if _, err := r.collection.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": ids}}, update); err != nil {
+		return wrapError("erro ao registrar aviso de vencimento: %w", err)
+	}
+
+	return nil
+}
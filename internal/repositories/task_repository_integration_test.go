@@ -0,0 +1,189 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"github.com/devgugga/todo-it/internal/testsupport"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// newTodoRepository cria o repositório sobre um banco de teste novo. Os testes
+// de integração usam Docker e são ignorados com -short.
+func newTodoRepository(t *testing.T) (repositories.TodoRepository, func(entities.Task) *entities.Task, primitive.ObjectID) {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("teste de integração ignorado com -short")
+	}
+
+	db := testsupport.NewDatabase(t)
+	user := testsupport.SeedUser(t, db)
+	seed := func(task entities.Task) *entities.Task {
+		return testsupport.SeedTask(t, db, user.ID, task)
+	}
+
+	return repositories.NewTodoRepository(db), seed, user.ID
+}
+
+func TestTaskRepositoryFilters(t *testing.T) {
+	repo, seed, userID := newTodoRepository(t)
+	ctx := context.Background()
+
+	projectID := primitive.NewObjectID()
+	tomorrow := time.Now().Add(24 * time.Hour)
+	nextMonth := time.Now().Add(30 * 24 * time.Hour)
+
+	seed(entities.Task{Title: "Pagar contas", Priority: enums.PriorityHigh, Tags: []string{"casa"}, DueDate: &tomorrow})
+	seed(entities.Task{Title: "Relatório mensal", Status: enums.StatusCompleted, Tags: []string{"trabalho"}, ProjectID: &projectID})
+	seed(entities.Task{Title: "Revisar contrato", Priority: enums.PriorityUrgent, Tags: []string{"trabalho"}, ProjectID: &projectID, DueDate: &nextMonth})
+	seed(entities.Task{Title: "Tarefa antiga", IsArchived: true})
+
+	archived := true
+	inTwoDays := time.Now().Add(48 * time.Hour)
+
+	tests := []struct {
+		name    string
+		filters *repositories.TaskFilters
+		want    int64
+	}{
+		{"sem filtros", &repositories.TaskFilters{}, 4},
+		{"status", &repositories.TaskFilters{Status: enums.StatusCompleted}, 1},
+		{"prioridade", &repositories.TaskFilters{Priority: enums.PriorityUrgent}, 1},
+		{"etiquetas", &repositories.TaskFilters{Tags: []string{"trabalho"}}, 2},
+		{"projeto", &repositories.TaskFilters{ProjectID: &projectID}, 2},
+		{"arquivadas", &repositories.TaskFilters{IsArchived: &archived}, 1},
+		{"vencimento", &repositories.TaskFilters{DueBefore: &inTwoDays}, 1},
+		{"busca", &repositories.TaskFilters{Search: "contrato"}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tasks, total, err := repo.GetByUserID(ctx, userID, 1, 20, tt.filters)
+			if err != nil {
+				t.Fatalf("GetByUserID: %v", err)
+			}
+			if total != tt.want || int64(len(tasks)) != tt.want {
+				t.Errorf("total %d (%d tarefas), esperado %d", total, len(tasks), tt.want)
+			}
+		})
+	}
+}
+
+func TestTaskRepositoryPagination(t *testing.T) {
+	repo, seed, userID := newTodoRepository(t)
+	ctx := context.Background()
+
+	for _, title := range []string{"Tarefa 01", "Tarefa 02", "Tarefa 03", "Tarefa 04", "Tarefa 05"} {
+		seed(entities.Task{Title: title})
+	}
+
+	filters := &repositories.TaskFilters{SortBy: "title", SortOrder: "asc"}
+	seen := make(map[primitive.ObjectID]bool)
+
+	for page, want := range map[int64][]string{1: {"Tarefa 01", "Tarefa 02"}, 2: {"Tarefa 03", "Tarefa 04"}, 3: {"Tarefa 05"}} {
+		tasks, total, err := repo.GetByUserID(ctx, userID, page, 2, filters)
+		if err != nil {
+			t.Fatalf("página %d: %v", page, err)
+		}
+		if total != 5 {
+			t.Errorf("página %d: total %d, esperado 5", page, total)
+		}
+		if len(tasks) != len(want) {
+			t.Fatalf("página %d: %d tarefas, esperado %d", page, len(tasks), len(want))
+		}
+		for i, task := range tasks {
+			if task.Title != want[i] {
+				t.Errorf("página %d, posição %d: %q, esperado %q", page, i, task.Title, want[i])
+			}
+			if seen[task.ID] {
+				t.Errorf("tarefa %q repetida entre páginas", task.Title)
+			}
+			seen[task.ID] = true
+		}
+	}
+}
+
+func TestTaskRepositoryStats(t *testing.T) {
+	repo, seed, userID := newTodoRepository(t)
+
+	yesterday := time.Now().Add(-24 * time.Hour)
+	seed(entities.Task{Title: "Atrasada", Priority: enums.PriorityHigh, DueDate: &yesterday, Tags: []string{"casa"}})
+	seed(entities.Task{Title: "Em andamento", Status: enums.StatusInProgress, Tags: []string{"casa", "trabalho"}})
+	seed(entities.Task{Title: "Concluída", Status: enums.StatusCompleted})
+	seed(entities.Task{Title: "Cancelada", Status: enums.StatusCancelled, IsArchived: true})
+
+	stats, err := repo.GetStatsByUser(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("GetStatsByUser: %v", err)
+	}
+
+	checks := []struct {
+		name      string
+		got, want int64
+	}{
+		{"total", stats.Total, 4},
+		{"pending", stats.Pending, 1},
+		{"in_progress", stats.InProgress, 1},
+		{"completed", stats.Completed, 1},
+		{"cancelled", stats.Cancelled, 1},
+		{"archived", stats.Archived, 1},
+		{"overdue", stats.Overdue, 1},
+		{"by_priority.high", stats.ByPriority[string(enums.PriorityHigh)], 1},
+	}
+	for _, c := range checks {
+		if c.got != c.want {
+			t.Errorf("%s = %d, esperado %d", c.name, c.got, c.want)
+		}
+	}
+
+	if len(stats.TopTags) == 0 || stats.TopTags[0].Tag != "casa" || stats.TopTags[0].Count != 2 {
+		t.Errorf("top_tags = %+v, esperado casa com 2 tarefas primeiro", stats.TopTags)
+	}
+}
+
+func TestTaskRepositoryBulkOperations(t *testing.T) {
+	repo, seed, userID := newTodoRepository(t)
+	ctx := context.Background()
+
+	first := seed(entities.Task{Title: "Primeira"})
+	second := seed(entities.Task{Title: "Segunda"})
+	third := seed(entities.Task{Title: "Terceira"})
+	// IDs de outros usuários são ignorados
+	foreign := primitive.NewObjectID()
+
+	updated, err := repo.BulkUpdateStatus(ctx, userID, []primitive.ObjectID{first.ID, second.ID, foreign}, enums.StatusCompleted)
+	if err != nil {
+		t.Fatalf("BulkUpdateStatus: %v", err)
+	}
+	if updated != 2 {
+		t.Errorf("BulkUpdateStatus alterou %d, esperado 2", updated)
+	}
+
+	task, err := repo.GetByID(ctx, userID, first.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if task.Status != enums.StatusCompleted || task.CompletedAt == nil {
+		t.Errorf("status %q, completed_at %v: esperado concluída com completed_at", task.Status, task.CompletedAt)
+	}
+
+	deleted, err := repo.BulkDelete(ctx, userID, []primitive.ObjectID{second.ID, third.ID, foreign})
+	if err != nil {
+		t.Fatalf("BulkDelete: %v", err)
+	}
+	if deleted != 2 {
+		t.Errorf("BulkDelete removeu %d, esperado 2", deleted)
+	}
+
+	_, total, err := repo.GetByUserID(ctx, userID, 1, 20, &repositories.TaskFilters{})
+	if err != nil {
+		t.Fatalf("GetByUserID: %v", err)
+	}
+	if total != 1 {
+		t.Errorf("restaram %d tarefas, esperado 1", total)
+	}
+}
@@ -0,0 +1,227 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TaskSearchIndexDefinition é a definição do índice do Atlas Search esperada
+// pela busca de tarefas: texto em português no título e na descrição e os
+// campos dos filtros da listagem. Deve ser criado na coleção tasks com o nome
+// configurado em ATLAS_SEARCH_INDEX.
+var TaskSearchIndexDefinition = bson.M{
+	"mappings": bson.M{
+		"dynamic": false,
+		"fields": bson.M{
+			"title":       bson.M{"type": "string", "analyzer": "lucene.portuguese"},
+			"description": bson.M{"type": "string", "analyzer": "lucene.portuguese"},
+			"user_id":     bson.M{"type": "objectId"},
+			"project_id":  bson.M{"type": "objectId"},
+			"status":      bson.M{"type": "token"},
+			"priority":    bson.M{"type": "token"},
+			"tags":        bson.M{"type": "token"},
+			"is_archived": bson.M{"type": "boolean"},
+			"due_date":    bson.M{"type": "date"},
+		},
+	},
+}
+
+// atlasSearchIndex é o índice do Atlas Search usado pela busca de tarefas (nil
+// = índice de texto padrão). Uma falha do $search volta ao padrão até o
+// processo reiniciar, como textSearchMissing.
+var atlasSearchIndex atomic.Pointer[string]
+
+// DetectAtlasSearch verifica se o índice do Atlas Search existe na coleção de
+// tarefas e já aceita consultas; se sim, a busca passa a usá-lo. Fora do Atlas
+// a listagem de índices de busca falha e a busca segue no índice de texto.
+func DetectAtlasSearch(ctx context.Context, db database.Client, index string) (bool, error) {
+	cursor, err := db.Collections().Tasks.SearchIndexes().List(ctx, options.SearchIndexes().SetName(index))
+	if err != nil {
+		return false, wrapError("erro ao listar índices do Atlas Search: %w", err)
+	}
+
+	var indexes []struct {
+		Name      string `bson:"name"`
+		Queryable bool   `bson:"queryable"`
+	}
+	if err := cursor.All(ctx, &indexes); err != nil {
+		return false, wrapError("erro ao decodificar índices do Atlas Search: %w", err)
+	}
+
+	for _, found := range indexes {
+		if found.Name == index && found.Queryable {
+			atlasSearchIndex.Store(&index)
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// atlasSearchHit é uma tarefa encontrada pelo $search com os trechos destacados
+type atlasSearchHit struct {
+	entities.Task `bson:",inline"`
+	Highlights    []entities.SearchHighlight `bson:"search_highlights"`
+}
+
+// searchTasks busca as tarefas do usuário pelo Atlas Search: termos com
+// tolerância a erros de digitação, trechos destacados e os filtros aplicados
+// no próprio índice. Sem ordenação informada, os resultados vêm por relevância;
+// com relevance, o vencimento desempata.
+func (r *todoRepository) searchTasks(ctx context.Context, index string, userID primitive.ObjectID, page, limit int64, filters *TaskFilters) ([]*entities.Task, int64, error) {
+	ctx, cancel := r.aggregateContext(ctx)
+	defer cancel()
+
+	search := filters.Search
+	if runes := []rune(search); len(runes) > MaxTaskSearchLength {
+		search = string(runes[:MaxTaskSearchLength])
+	}
+
+	// Descrição cifrada não é buscável
+	paths := []string{"title"}
+	if !r.cipher.Enabled() {
+		paths = append(paths, "description")
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$search", Value: bson.M{
+			"index": index,
+			"compound": bson.M{
+				"must": bson.A{bson.M{"text": bson.M{
+					"query": strings.TrimSpace(search),
+					"path":  paths,
+					"fuzzy": bson.M{"maxEdits": 1, "prefixLength": 1},
+				}}},
+				"filter": searchFilters(userID, filters),
+			},
+			"highlight": bson.M{"path": paths},
+		}}},
+		{{Key: "$addFields", Value: bson.M{"search_highlights": bson.M{"$meta": "searchHighlights"}}}},
+	}
+
+	switch {
+	case filters.SortBy == TaskSortRelevance:
+		pipeline = append(pipeline, bson.D{{Key: "$sort", Value: relevanceSort("searchScore")}})
+	case IsValidTaskSortField(filters.SortBy):
+		pipeline = append(pipeline, bson.D{{Key: "$sort", Value: listSort(filters)}})
+	}
+	if filters.Compact {
+		pipeline = append(pipeline, bson.D{{Key: "$project", Value: taskListProjection}})
+	}
+
+	pipeline = append(pipeline, bson.D{{Key: "$facet", Value: bson.M{
+		"tasks": bson.A{bson.M{"$skip": (page - 1) * limit}, bson.M{"$limit": limit}},
+		"total": bson.A{bson.M{"$count": "count"}},
+	}}})
+
+	opts := options.Aggregate()
+	if filters.SortBy == "title" {
+		opts.SetCollation(caseInsensitiveCollation)
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline, opts)
+	if err != nil {
+		return nil, 0, wrapError("erro ao buscar tarefas no Atlas Search: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var results []struct {
+		Tasks []atlasSearchHit `bson:"tasks"`
+		Total []struct {
+			Count int64 `bson:"count"`
+		} `bson:"total"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, 0, wrapError("erro ao decodificar busca de tarefas: %w", err)
+	}
+
+	todos := []*entities.Task{}
+	var total int64
+	if len(results) > 0 {
+		for _, hit := range results[0].Tasks {
+			task := hit.Task
+			task.SearchHighlights = hit.Highlights
+			todos = append(todos, &task)
+		}
+		if len(results[0].Total) > 0 {
+			total = results[0].Total[0].Count
+		}
+	}
+
+	if err := r.openTasks(todos); err != nil {
+		return nil, 0, err
+	}
+
+	return todos, total, nil
+}
+
+// searchFilters converte os filtros da listagem em cláusulas filter do $search
+func searchFilters(userID primitive.ObjectID, filters *TaskFilters) bson.A {
+	clauses := bson.A{bson.M{"equals": bson.M{"path": "user_id", "value": userID}}}
+
+	if filters.Status != "" {
+		clauses = append(clauses, bson.M{"equals": bson.M{"path": "status", "value": string(filters.Status)}})
+	}
+
+	if filters.Priority != "" {
+		clauses = append(clauses, bson.M{"equals": bson.M{"path": "priority", "value": string(filters.Priority)}})
+	}
+
+	if len(filters.Tags) > 0 {
+		clauses = append(clauses, bson.M{"in": bson.M{"path": "tags", "value": filters.Tags}})
+	}
+
+	if filters.ProjectID != nil {
+		clauses = append(clauses, bson.M{"equals": bson.M{"path": "project_id", "value": *filters.ProjectID}})
+	}
+
+	if filters.IsArchived != nil {
+		clauses = append(clauses, bson.M{"equals": bson.M{"path": "is_archived", "value": *filters.IsArchived}})
+	}
+
+	if filters.DueBefore != nil || filters.DueAfter != nil {
+		dueRange := bson.M{"path": "due_date"}
+		if filters.DueBefore != nil {
+			dueRange["lte"] = *filters.DueBefore
+		}
+		if filters.DueAfter != nil {
+			dueRange["gte"] = *filters.DueAfter
+		}
+		clauses = append(clauses, bson.M{"range": dueRange})
+	}
+
+	return clauses
+}
+
+// useAtlasSearch busca pelo Atlas Search quando habilitado e há texto de busca.
+// Erros do servidor (ex.: índice removido) desabilitam o Atlas Search e a
+// listagem segue no índice de texto (ok = false).
+func (r *todoRepository) useAtlasSearch(ctx context.Context, userID primitive.ObjectID, page, limit int64, filters *TaskFilters) ([]*entities.Task, int64, bool, error) {
+	index := atlasSearchIndex.Load()
+	if index == nil || filters == nil || strings.TrimSpace(filters.Search) == "" {
+		return nil, 0, false, nil
+	}
+
+	todos, total, err := r.searchTasks(ctx, *index, userID, page, limit, filters)
+	var serverErr mongo.ServerError
+	if errors.As(err, &serverErr) {
+		atlasSearchIndex.Store(nil)
+		slog.Warn("Atlas Search indisponível, busca voltou ao índice de texto", "index", *index, "error", err)
+		return nil, 0, false, nil
+	}
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	return todos, total, true, nil
+}
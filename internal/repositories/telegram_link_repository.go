@@ -0,0 +1,161 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TelegramLinkRepository interface define os métodos do repositório de vínculos com o Telegram
+type TelegramLinkRepository interface {
+	SaveCode(ctx context.Context, userID primitive.ObjectID, codeHash string, expiresAt time.Time) error
+	ConsumeCode(ctx context.Context, codeHash string, chatID int64, username string) (*entities.TelegramLink, error)
+	GetByUserID(ctx context.Context, userID primitive.ObjectID) (*entities.TelegramLink, error)
+	GetByChatID(ctx context.Context, chatID int64) (*entities.TelegramLink, error)
+	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
+	DeleteByChatID(ctx context.Context, chatID int64) error
+}
+
+// telegramLinkRepository implementa TelegramLinkRepository
+type telegramLinkRepository struct {
+	operationTimeouts
+	collection *mongo.Collection
+}
+
+// NewTelegramLinkRepository cria uma nova instância do repositório
+func NewTelegramLinkRepository(db database.Client) TelegramLinkRepository {
+	return &telegramLinkRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		collection:        db.Collections().TelegramLinks,
+	}
+}
+
+// SaveCode grava um novo código de vinculação para o usuário, substituindo o anterior.
+// Um vínculo já confirmado é mantido até que o código seja usado.
+func (r *telegramLinkRepository) SaveCode(ctx context.Context, userID primitive.ObjectID, codeHash string, expiresAt time.Time) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	now := time.Now()
+	update := bson.M{
+		"$set": bson.M{
+			"code_hash":       codeHash,
+			"code_expires_at": expiresAt,
+			"updated_at":      now,
+		},
+		"$setOnInsert": bson.M{
+			"_id":        primitive.NewObjectID(),
+			"created_at": now,
+		},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{"user_id": userID}, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return wrapError("erro ao salvar código de vinculação: %w", err)
+	}
+
+	return nil
+}
+
+// ConsumeCode confirma o vínculo do código (válido e não expirado) com o chat.
+// O chat deixa de estar vinculado a qualquer outro usuário.
+func (r *telegramLinkRepository) ConsumeCode(ctx context.Context, codeHash string, chatID int64, username string) (*entities.TelegramLink, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	now := time.Now()
+	filter := bson.M{"code_hash": codeHash, "code_expires_at": bson.M{"$gt": now}}
+
+	var pending entities.TelegramLink
+	if err := r.collection.FindOne(ctx, filter).Decode(&pending); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrTelegramLinkNotFound
+		}
+		return nil, wrapError("erro ao buscar código de vinculação: %w", err)
+	}
+
+	unlink := bson.M{"chat_id": chatID, "user_id": bson.M{"$ne": pending.UserID}}
+	if _, err := r.collection.DeleteMany(ctx, unlink); err != nil {
+		return nil, wrapError("erro ao remover vínculo anterior do chat: %w", err)
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"chat_id":    chatID,
+			"username":   username,
+			"linked_at":  now,
+			"updated_at": now,
+		},
+		"$unset": bson.M{"code_hash": "", "code_expires_at": ""},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var link entities.TelegramLink
+	if err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&link); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			// Código consumido por outra requisição entre a busca e a atualização
+			return nil, ErrTelegramLinkNotFound
+		}
+		return nil, wrapError("erro ao confirmar vinculação: %w", err)
+	}
+
+	return &link, nil
+}
+
+// GetByUserID busca o vínculo (confirmado ou pendente) do usuário
+func (r *telegramLinkRepository) GetByUserID(ctx context.Context, userID primitive.ObjectID) (*entities.TelegramLink, error) {
+	return r.findOne(ctx, bson.M{"user_id": userID})
+}
+
+// GetByChatID busca o vínculo confirmado do chat
+func (r *telegramLinkRepository) GetByChatID(ctx context.Context, chatID int64) (*entities.TelegramLink, error) {
+	return r.findOne(ctx, bson.M{"chat_id": chatID})
+}
+
+func (r *telegramLinkRepository) findOne(ctx context.Context, filter bson.M) (*entities.TelegramLink, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	var link entities.TelegramLink
+	if err := r.collection.FindOne(ctx, filter).Decode(&link); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrTelegramLinkNotFound
+		}
+		return nil, wrapError("erro ao buscar vínculo com o Telegram: %w", err)
+	}
+
+	return &link, nil
+}
+
+// DeleteByUserID remove o vínculo do usuário
+func (r *telegramLinkRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	return r.deleteOne(ctx, bson.M{"user_id": userID})
+}
+
+// DeleteByChatID remove o vínculo do chat
+func (r *telegramLinkRepository) DeleteByChatID(ctx context.Context, chatID int64) error {
+	return r.deleteOne(ctx, bson.M{"chat_id": chatID})
+}
+
+func (r *telegramLinkRepository) deleteOne(ctx context.Context, filter bson.M) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	result, err := r.collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return wrapError("erro ao remover vínculo com o Telegram: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return ErrTelegramLinkNotFound
+	}
+
+	return nil
+}
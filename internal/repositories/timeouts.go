@@ -0,0 +1,32 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/devgugga/todo-it/internal/database"
+)
+
+// operationTimeouts aplica ao contexto recebido o timeout do tipo de operação.
+// O deadline da requisição continua valendo quando for menor.
+type operationTimeouts struct {
+	timeouts database.OperationTimeouts
+}
+
+func newOperationTimeouts(db database.Client) operationTimeouts {
+	return operationTimeouts{timeouts: db.Timeouts()}
+}
+
+// readContext retorna o contexto para operações de leitura
+func (o operationTimeouts) readContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, o.timeouts.Read)
+}
+
+// writeContext retorna o contexto para operações de escrita
+func (o operationTimeouts) writeContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, o.timeouts.Write)
+}
+
+// aggregateContext retorna o contexto para agregações e contagens
+func (o operationTimeouts) aggregateContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, o.timeouts.Aggregate)
+}
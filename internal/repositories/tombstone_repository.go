@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/logging"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TombstoneRepository interface define a consulta das exclusões registradas
+type TombstoneRepository interface {
+	ListSince(ctx context.Context, userID primitive.ObjectID, entityType string, after, until time.Time) ([]*entities.Tombstone, error)
+}
+
+// tombstoneRepository implementa TombstoneRepository
+type tombstoneRepository struct {
+	operationTimeouts
+	collection *mongo.Collection
+}
+
+// NewTombstoneRepository cria uma nova instância do repositório
+func NewTombstoneRepository(db database.Client) TombstoneRepository {
+	return &tombstoneRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		collection:        db.Collections().Tombstones,
+	}
+}
+
+// ListSince lista as exclusões do tipo informado com deleted_at posterior a
+// after e até until (inclusive), em ordem de exclusão
+func (r *tombstoneRepository) ListSince(ctx context.Context, userID primitive.ObjectID, entityType string, after, until time.Time) ([]*entities.Tombstone, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	filter := bson.M{
+		"user_id":     userID,
+		"entity_type": entityType,
+		"deleted_at":  bson.M{"$gt": after, "$lte": until},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "deleted_at", Value: 1}, {Key: "_id", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, wrapError("erro ao listar exclusões: %w", err)
+	}
+
+	tombstones := []*entities.Tombstone{}
+	if err := cursor.All(ctx, &tombstones); err != nil {
+		return nil, wrapError("erro ao decodificar exclusões: %w", err)
+	}
+
+	return tombstones, nil
+}
+
+// deletedDoc identifica um documento removido e o seu dono
+type deletedDoc struct {
+	ID     primitive.ObjectID `bson:"_id"`
+	UserID primitive.ObjectID `bson:"user_id"`
+}
+
+// tombstoneLog registra as exclusões; embutido nos repositórios que removem
+// tarefas e projetos
+type tombstoneLog struct {
+	tombstones *mongo.Collection
+}
+
+func newTombstoneLog(db database.Client) tombstoneLog {
+	return tombstoneLog{tombstones: db.Collections().Tombstones}
+}
+
+// recordDeleted registra a exclusão dos documentos. Os documentos já foram
+// removidos, então uma falha aqui não desfaz a operação: só é registrada em
+// log (o cliente deixa de saber da exclusão até a próxima sincronização completa).
+func (l tombstoneLog) recordDeleted(ctx context.Context, entityType string, docs ...deletedDoc) {
+	if len(docs) == 0 {
+		return
+	}
+
+	now := time.Now()
+	records := make([]interface{}, 0, len(docs))
+	for _, doc := range docs {
+		records = append(records, entities.Tombstone{
+			ID:         primitive.NewObjectID(),
+			UserID:     doc.UserID,
+			EntityType: entityType,
+			EntityID:   doc.ID,
+			DeletedAt:  now,
+		})
+	}
+
+	if _, err := l.tombstones.InsertMany(ctx, records); err != nil {
+		logging.FromContext(ctx).Warn("erro ao registrar exclusão", "entity_type", entityType, "count", len(docs), "error", err)
+	}
+}
+
+// ownedDocs associa os IDs removidos ao usuário dono
+func ownedDocs(userID primitive.ObjectID, ids ...primitive.ObjectID) []deletedDoc {
+	docs := make([]deletedDoc, 0, len(ids))
+	for _, id := range ids {
+		docs = append(docs, deletedDoc{ID: id, UserID: userID})
+	}
+	return docs
+}
@@ -0,0 +1,107 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// UsageRepository interface define os métodos do repositório de contadores de cota
+type UsageRepository interface {
+	Increment(ctx context.Context, userID primitive.ObjectID, resource, period string, limit int64, expiresAt time.Time) (bool, error)
+	Decrement(ctx context.Context, userID primitive.ObjectID, resource, period string) error
+	Get(ctx context.Context, userID primitive.ObjectID, resource, period string) (int64, error)
+}
+
+// usageRepository implementa UsageRepository
+type usageRepository struct {
+	operationTimeouts
+	collection *mongo.Collection
+}
+
+// NewUsageRepository cria uma nova instância do repositório
+func NewUsageRepository(db database.Client) UsageRepository {
+	return &usageRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		collection:        db.Collections().UsageCounters,
+	}
+}
+
+// Increment soma um uso ao contador se ele ainda estiver abaixo do limite.
+// Retorna false quando o limite já foi atingido. O filtro por count e o índice
+// único tornam a verificação atômica: com o contador no limite, o upsert tenta
+// inserir um documento duplicado e falha.
+func (r *usageRepository) Increment(ctx context.Context, userID primitive.ObjectID, resource, period string, limit int64, expiresAt time.Time) (bool, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{
+		"user_id":  userID,
+		"resource": resource,
+		"period":   period,
+		"count":    bson.M{"$lt": limit},
+	}
+	update := bson.M{
+		"$inc":         bson.M{"count": 1},
+		"$set":         bson.M{"updated_at": time.Now()},
+		"$setOnInsert": bson.M{"expires_at": expiresAt},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, wrapError("erro ao registrar uso: %w", err)
+	}
+
+	return true, nil
+}
+
+// Decrement devolve um uso ao contador (ex.: envio que falhou)
+func (r *usageRepository) Decrement(ctx context.Context, userID primitive.ObjectID, resource, period string) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{
+		"user_id":  userID,
+		"resource": resource,
+		"period":   period,
+		"count":    bson.M{"$gt": 0},
+	}
+	update := bson.M{
+		"$inc": bson.M{"count": -1},
+		"$set": bson.M{"updated_at": time.Now()},
+	}
+
+	if _, err := r.collection.UpdateOne(ctx, filter, update); err != nil {
+		return wrapError("erro ao devolver uso: %w", err)
+	}
+
+	return nil
+}
+
+// Get retorna o uso registrado no período (zero se não houver contador)
+func (r *usageRepository) Get(ctx context.Context, userID primitive.ObjectID, resource, period string) (int64, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	var counter entities.UsageCounter
+	filter := bson.M{"user_id": userID, "resource": resource, "period": period}
+
+	err := r.collection.FindOne(ctx, filter).Decode(&counter)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return 0, nil
+		}
+		return 0, wrapError("erro ao buscar uso: %w", err)
+	}
+
+	return counter.Count, nil
+}
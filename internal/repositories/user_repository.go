@@ -2,7 +2,6 @@ package repositories
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/devgugga/todo-it/internal/database"
@@ -19,32 +18,45 @@ type UserRepository interface {
 	GetByID(ctx context.Context, id primitive.ObjectID) (*entities.User, error)
 	GetByEmail(ctx context.Context, email string) (*entities.User, error)
 	Update(ctx context.Context, user *entities.User) error
+	UpdatePassword(ctx context.Context, id primitive.ObjectID, hashedPassword string) error
+	UpdatePreferences(ctx context.Context, id primitive.ObjectID, preferences entities.UserPreferences) error
+	GetByInboundAlias(ctx context.Context, alias string) (*entities.User, error)
+	SetInboundAlias(ctx context.Context, id primitive.ObjectID, alias string) error
+	SetPhone(ctx context.Context, id primitive.ObjectID, phone *entities.PhoneNumber) error
+	GetByFeedTokenHash(ctx context.Context, hash string) (*entities.User, error)
+	SetFeedTokenHash(ctx context.Context, id primitive.ObjectID, hash string) error
+	AddCustomStatus(ctx context.Context, id primitive.ObjectID, status entities.CustomStatus) error
+	UpdateCustomStatus(ctx context.Context, id primitive.ObjectID, status *entities.CustomStatus) error
+	RemoveCustomStatus(ctx context.Context, id, statusID primitive.ObjectID) error
 	Delete(ctx context.Context, id primitive.ObjectID) error
+	Suspend(ctx context.Context, id primitive.ObjectID, reason string, at time.Time) error
+	Unsuspend(ctx context.Context, id primitive.ObjectID) error
 	List(ctx context.Context, page, limit int64) ([]*entities.User, int64, error)
 	Exists(ctx context.Context, email string) (bool, error)
+	ListOverdueSummarySubscribers(ctx context.Context, afterID primitive.ObjectID, limit int64) ([]*entities.User, error)
+	ClaimOverdueSummary(ctx context.Context, id primitive.ObjectID, day string) (bool, error)
 }
 
 // userRepository implementa UserRepository
 type userRepository struct {
+	operationTimeouts
 	collection *mongo.Collection
 }
 
 // NewUserRepository cria uma nova instância do repositório
 func NewUserRepository(db database.Client) UserRepository {
-	collections := database.GetCollections(db)
+	collections := db.Collections()
 
 	return &userRepository{
-		collection: collections.Users,
+		operationTimeouts: newOperationTimeouts(db),
+		collection:        collections.Users,
 	}
 }
 
 // Create cria um novo usuário
 func (r *userRepository) Create(ctx context.Context, user *entities.User) error {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-	}
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
 
 	// Prepara entidade para criação
 	user.PrepareForCreate()
@@ -53,9 +65,9 @@ func (r *userRepository) Create(ctx context.Context, user *entities.User) error
 	result, err := r.collection.InsertOne(ctx, user)
 	if err != nil {
 		if mongo.IsDuplicateKeyError(err) {
-			return fmt.Errorf("usuário com este email já existe")
+			return ErrEmailAlreadyExists
 		}
-		return fmt.Errorf("erro ao criar usuário: %w", err)
+		return wrapError("erro ao criar usuário: %w", err)
 	}
 
 	// Atualiza o ID na entidade
@@ -68,11 +80,8 @@ func (r *userRepository) Create(ctx context.Context, user *entities.User) error
 
 // GetByID busca usuário por ID
 func (r *userRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*entities.User, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-	}
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
 
 	var user entities.User
 	filter := bson.M{"_id": id}
@@ -80,9 +89,9 @@ func (r *userRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*e
 	err := r.collection.FindOne(ctx, filter).Decode(&user)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, fmt.Errorf("usuário não encontrado")
+			return nil, ErrUserNotFound
 		}
-		return nil, fmt.Errorf("erro ao buscar usuário: %w", err)
+		return nil, wrapError("erro ao buscar usuário: %w", err)
 	}
 
 	return &user, nil
@@ -90,21 +99,18 @@ func (r *userRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*e
 
 // GetByEmail busca usuário por email
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entities.User, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-	}
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
 
 	var user entities.User
-	filter := bson.M{"email": email}
+	filter := bson.M{"email": entities.NormalizeEmail(email)}
 
 	err := r.collection.FindOne(ctx, filter).Decode(&user)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, fmt.Errorf("usuário não encontrado")
+			return nil, ErrUserNotFound
 		}
-		return nil, fmt.Errorf("erro ao buscar usuário: %w", err)
+		return nil, wrapError("erro ao buscar usuário: %w", err)
 	}
 
 	return &user, nil
@@ -112,11 +118,8 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entitie
 
 // Update atualiza um usuário
 func (r *userRepository) Update(ctx context.Context, user *entities.User) error {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-	}
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
 
 	// Prepara entidade para atualização
 	user.PrepareForUpdate()
@@ -133,11 +136,180 @@ func (r *userRepository) Update(ctx context.Context, user *entities.User) error
 
 	result, err := r.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
-		return fmt.Errorf("erro ao deletar usuário: %w", err)
+		return wrapError("erro ao deletar usuário: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+// UpdatePassword atualiza a senha (já com hash) do usuário
+func (r *userRepository) UpdatePassword(ctx context.Context, id primitive.ObjectID, hashedPassword string) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"_id": id}
+	update := bson.M{
+		"$set": bson.M{
+			"password":   hashedPassword,
+			"updated_at": time.Now(),
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return wrapError("erro ao atualizar senha: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+// UpdatePreferences substitui as preferências do usuário
+func (r *userRepository) UpdatePreferences(ctx context.Context, id primitive.ObjectID, preferences entities.UserPreferences) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"_id": id}
+	update := bson.M{
+		"$set": bson.M{
+			"preferences": preferences,
+			"updated_at":  time.Now(),
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return wrapError("erro ao atualizar preferências: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+// GetByInboundAlias busca o usuário ativo dono do alias de email de entrada
+func (r *userRepository) GetByInboundAlias(ctx context.Context, alias string) (*entities.User, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	var user entities.User
+	filter := bson.M{"inbound_alias": alias, "is_active": true, "suspended_at": nil}
+
+	err := r.collection.FindOne(ctx, filter).Decode(&user)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrUserNotFound
+		}
+		return nil, wrapError("erro ao buscar usuário: %w", err)
+	}
+
+	return &user, nil
+}
+
+// SetInboundAlias define (ou substitui) o alias de email de entrada do usuário
+func (r *userRepository) SetInboundAlias(ctx context.Context, id primitive.ObjectID, alias string) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"_id": id}
+	update := bson.M{
+		"$set": bson.M{
+			"inbound_alias": alias,
+			"updated_at":    time.Now(),
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return wrapError("erro ao atualizar alias de email: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+// SetPhone define o telefone do usuário (nil remove)
+func (r *userRepository) SetPhone(ctx context.Context, id primitive.ObjectID, phone *entities.PhoneNumber) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"_id": id}
+	update := bson.M{
+		"$set": bson.M{"phone": phone, "updated_at": time.Now()},
+	}
+	if phone == nil {
+		update = bson.M{
+			"$set":   bson.M{"updated_at": time.Now()},
+			"$unset": bson.M{"phone": ""},
+		}
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return wrapError("erro ao atualizar telefone: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+// GetByFeedTokenHash busca o usuário ativo dono do token do feed de atividades
+func (r *userRepository) GetByFeedTokenHash(ctx context.Context, hash string) (*entities.User, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	var user entities.User
+	filter := bson.M{"feed_token_hash": hash, "is_active": true, "suspended_at": nil}
+
+	err := r.collection.FindOne(ctx, filter).Decode(&user)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrUserNotFound
+		}
+		return nil, wrapError("erro ao buscar usuário: %w", err)
+	}
+
+	return &user, nil
+}
+
+// SetFeedTokenHash define o hash do token do feed de atividades (vazio remove)
+func (r *userRepository) SetFeedTokenHash(ctx context.Context, id primitive.ObjectID, hash string) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"_id": id}
+	update := bson.M{
+		"$set": bson.M{"feed_token_hash": hash, "updated_at": time.Now()},
+	}
+	if hash == "" {
+		update = bson.M{
+			"$set":   bson.M{"updated_at": time.Now()},
+			"$unset": bson.M{"feed_token_hash": ""},
+		}
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return wrapError("erro ao atualizar token do feed: %w", err)
 	}
 
 	if result.MatchedCount == 0 {
-		return fmt.Errorf("usuário não encontrado")
+		return ErrUserNotFound
 	}
 
 	return nil
@@ -145,11 +317,8 @@ func (r *userRepository) Update(ctx context.Context, user *entities.User) error
 
 // List lista usuários com paginação
 func (r *userRepository) List(ctx context.Context, page, limit int64) ([]*entities.User, int64, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-	}
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
 
 	// Calcula skip
 	skip := (page - 1) * limit
@@ -160,7 +329,7 @@ func (r *userRepository) List(ctx context.Context, page, limit int64) ([]*entiti
 	// Conta total de documentos
 	total, err := r.collection.CountDocuments(ctx, filter)
 	if err != nil {
-		return nil, 0, fmt.Errorf("erro ao contar usuários: %w", err)
+		return nil, 0, wrapError("erro ao contar usuários: %w", err)
 	}
 
 	// Opções de busca
@@ -172,22 +341,14 @@ func (r *userRepository) List(ctx context.Context, page, limit int64) ([]*entiti
 	// Executa busca
 	cursor, err := r.collection.Find(ctx, filter, opts)
 	if err != nil {
-		return nil, 0, fmt.Errorf("erro ao listar usuários: %w", err)
+		return nil, 0, wrapError("erro ao listar usuários: %w", err)
 	}
 	defer cursor.Close(ctx)
 
 	// Decodifica resultados
 	var users []*entities.User
-	for cursor.Next(ctx) {
-		var user entities.User
-		if err := cursor.Decode(&user); err != nil {
-			return nil, 0, fmt.Errorf("erro ao decodificar usuário: %w", err)
-		}
-		users = append(users, &user)
-	}
-
-	if err := cursor.Err(); err != nil {
-		return nil, 0, fmt.Errorf("erro no cursor: %w", err)
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, 0, wrapError("erro ao decodificar usuários: %w", err)
 	}
 
 	return users, total, nil
@@ -195,16 +356,13 @@ func (r *userRepository) List(ctx context.Context, page, limit int64) ([]*entiti
 
 // Exists verifica se um usuário com o email existe
 func (r *userRepository) Exists(ctx context.Context, email string) (bool, error) {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), 3*time.Second)
-		defer cancel()
-	}
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
 
-	filter := bson.M{"email": email}
+	filter := bson.M{"email": entities.NormalizeEmail(email)}
 	count, err := r.collection.CountDocuments(ctx, filter)
 	if err != nil {
-		return false, fmt.Errorf("erro ao verificar existência do usuário: %w", err)
+		return false, wrapError("erro ao verificar existência do usuário: %w", err)
 	}
 
 	return count > 0, nil
@@ -212,11 +370,8 @@ func (r *userRepository) Exists(ctx context.Context, email string) (bool, error)
 
 // Delete remove um usuário (soft delete)
 func (r *userRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
-	if ctx == nil {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-	}
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
 
 	filter := bson.M{"_id": id}
 	update := bson.M{
@@ -228,11 +383,182 @@ func (r *userRepository) Delete(ctx context.Context, id primitive.ObjectID) erro
 
 	result, err := r.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
-		return fmt.Errorf("erro ao deletar usuário: %w", err)
+		return wrapError("erro ao deletar usuário: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+// Suspend suspende a conta e invalida os tokens de acesso emitidos até at
+func (r *userRepository) Suspend(ctx context.Context, id primitive.ObjectID, reason string, at time.Time) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	update := bson.M{
+		"$set": bson.M{
+			"suspended_at":        at,
+			"suspension_reason":   reason,
+			"sessions_revoked_at": at,
+			"updated_at":          at,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		return wrapError("erro ao suspender usuário: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+// Unsuspend reativa a conta suspensa. Os tokens invalidados na suspensão
+// continuam inválidos: o usuário precisa entrar novamente.
+func (r *userRepository) Unsuspend(ctx context.Context, id primitive.ObjectID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	update := bson.M{
+		"$set":   bson.M{"updated_at": time.Now()},
+		"$unset": bson.M{"suspended_at": "", "suspension_reason": ""},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		return wrapError("erro ao reativar usuário: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+// ListOverdueSummarySubscribers lista, em ordem de _id a partir de afterID, os
+// usuários ativos que habilitaram o resumo diário de tarefas atrasadas
+func (r *userRepository) ListOverdueSummarySubscribers(ctx context.Context, afterID primitive.ObjectID, limit int64) ([]*entities.User, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	filter := bson.M{
+		"_id":       bson.M{"$gt": afterID},
+		"is_active": true,
+		"preferences.notifications.overdue_summary": true,
+	}
+
+	opts := options.Find().SetSort(bson.M{"_id": 1}).SetLimit(limit)
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, wrapError("erro ao listar usuários do resumo de atrasadas: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var users []*entities.User
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, wrapError("erro ao decodificar usuários: %w", err)
+	}
+
+	return users, nil
+}
+
+// ClaimOverdueSummary registra o envio do resumo de atrasadas do dia local
+// informado. Retorna false se o resumo desse dia já foi registrado (outra
+// execução ou instância o enviou).
+func (r *userRepository) ClaimOverdueSummary(ctx context.Context, id primitive.ObjectID, day string) (bool, error) {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"_id": id, "overdue_summary_sent_on": bson.M{"$ne": day}}
+	update := bson.M{"$set": bson.M{"overdue_summary_sent_on": day}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, wrapError("erro ao registrar resumo de atrasadas: %w", err)
+	}
+
+	return result.ModifiedCount == 1, nil
+}
+
+// AddCustomStatus adiciona um status personalizado ao usuário. O limite de
+// status deve ser verificado por quem chama.
+func (r *userRepository) AddCustomStatus(ctx context.Context, id primitive.ObjectID, status entities.CustomStatus) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"_id": id, "custom_statuses.name": bson.M{"$ne": status.Name}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, bson.M{
+		"$push": bson.M{"custom_statuses": status},
+		"$set":  bson.M{"updated_at": time.Now()},
+	})
+	if err != nil {
+		return wrapError("erro ao adicionar status personalizado: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		// Distingue usuário inexistente de status com o mesmo nome
+		count, err := r.collection.CountDocuments(ctx, bson.M{"_id": id})
+		if err != nil {
+			return wrapError("erro ao buscar usuário: %w", err)
+		}
+		if count == 0 {
+			return ErrUserNotFound
+		}
+		return ErrCustomStatusExists
+	}
+
+	return nil
+}
+
+// UpdateCustomStatus altera o nome e a cor de um status personalizado do usuário
+func (r *userRepository) UpdateCustomStatus(ctx context.Context, id primitive.ObjectID, status *entities.CustomStatus) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"_id": id, "custom_statuses._id": status.ID}
+
+	result, err := r.collection.UpdateOne(ctx, filter, bson.M{
+		"$set": bson.M{
+			"custom_statuses.$.name":  status.Name,
+			"custom_statuses.$.color": status.Color,
+			"updated_at":              time.Now(),
+		},
+	})
+	if err != nil {
+		return wrapError("erro ao alterar status personalizado: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrCustomStatusNotFound
+	}
+
+	return nil
+}
+
+// RemoveCustomStatus remove um status personalizado do usuário
+func (r *userRepository) RemoveCustomStatus(ctx context.Context, id, statusID primitive.ObjectID) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": id, "custom_statuses._id": statusID}, bson.M{
+		"$pull": bson.M{"custom_statuses": bson.M{"_id": statusID}},
+		"$set":  bson.M{"updated_at": time.Now()},
+	})
+	if err != nil {
+		return wrapError("erro ao remover status personalizado: %w", err)
 	}
 
 	if result.MatchedCount == 0 {
-		return fmt.Errorf("usuário não encontrado")
+		return ErrCustomStatusNotFound
 	}
 
 	return nil
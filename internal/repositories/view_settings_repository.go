@@ -0,0 +1,105 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ViewSettingsRepository interface define os métodos do repositório de configurações de visualização
+type ViewSettingsRepository interface {
+	Get(ctx context.Context, userID primitive.ObjectID, projectID *primitive.ObjectID) (*entities.ViewSettings, error)
+	Save(ctx context.Context, settings *entities.ViewSettings) error
+}
+
+// viewSettingsRepository implementa ViewSettingsRepository
+type viewSettingsRepository struct {
+	operationTimeouts
+	collection *mongo.Collection
+}
+
+// NewViewSettingsRepository cria uma nova instância do repositório
+func NewViewSettingsRepository(db database.Client) ViewSettingsRepository {
+	return &viewSettingsRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		collection:        db.Collections().ViewSettings,
+	}
+}
+
+// Get busca a configuração do usuário para o projeto (nil = configuração padrão)
+func (r *viewSettingsRepository) Get(ctx context.Context, userID primitive.ObjectID, projectID *primitive.ObjectID) (*entities.ViewSettings, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	var settings entities.ViewSettings
+	if err := r.collection.FindOne(ctx, viewSettingsFilter(userID, projectID)).Decode(&settings); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrViewSettingsNotFound
+		}
+		return nil, wrapError("erro ao buscar configuração de visualização: %w", err)
+	}
+
+	return &settings, nil
+}
+
+// Save grava a configuração do usuário para o projeto, substituindo a anterior
+func (r *viewSettingsRepository) Save(ctx context.Context, settings *entities.ViewSettings) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	settings.UpdatedAt = time.Now()
+
+	update := bson.M{
+		"$set": bson.M{
+			"layout":           settings.Layout,
+			"column_order":     settings.ColumnOrder,
+			"collapsed_groups": settings.CollapsedGroups,
+			"sort_by":          settings.SortBy,
+			"sort_order":       settings.SortOrder,
+			"updated_at":       settings.UpdatedAt,
+		},
+		"$setOnInsert": bson.M{
+			"user_id":    settings.UserID,
+			"project_id": settings.ProjectID,
+		},
+	}
+
+	opts := options.FindOneAndUpdate().
+		SetUpsert(true).
+		SetReturnDocument(options.After).
+		SetProjection(bson.M{"_id": 1})
+
+	filter := viewSettingsFilter(settings.UserID, settings.ProjectID)
+
+	var saved struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&saved)
+	if mongo.IsDuplicateKeyError(err) {
+		// Upsert simultâneo de outro dispositivo: o documento já existe e é atualizado
+		err = r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&saved)
+	}
+	if err != nil {
+		return wrapError("erro ao salvar configuração de visualização: %w", err)
+	}
+
+	settings.ID = saved.ID
+	return nil
+}
+
+// viewSettingsFilter seleciona a configuração do usuário para o projeto; sem
+// projeto, a padrão (project_id nulo)
+func viewSettingsFilter(userID primitive.ObjectID, projectID *primitive.ObjectID) bson.M {
+	filter := bson.M{"user_id": userID, "project_id": nil}
+	if projectID != nil {
+		filter["project_id"] = *projectID
+	}
+	return filter
+}
@@ -0,0 +1,98 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// webhookDeliveryWithoutPayload omite o corpo enviado nas listagens
+var webhookDeliveryWithoutPayload = bson.M{"payload": 0}
+
+// WebhookDeliveryRepository interface define o registro das entregas de webhook
+type WebhookDeliveryRepository interface {
+	Create(ctx context.Context, delivery *entities.WebhookDelivery) error
+	ListByUser(ctx context.Context, userID primitive.ObjectID, page, limit int64) ([]*entities.WebhookDelivery, int64, error)
+	GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.WebhookDelivery, error)
+}
+
+// webhookDeliveryRepository implementa WebhookDeliveryRepository
+type webhookDeliveryRepository struct {
+	operationTimeouts
+	collection *mongo.Collection
+}
+
+// NewWebhookDeliveryRepository cria uma nova instância do repositório
+func NewWebhookDeliveryRepository(db database.Client) WebhookDeliveryRepository {
+	return &webhookDeliveryRepository{
+		operationTimeouts: newOperationTimeouts(db),
+		collection:        db.Collections().WebhookDeliveries,
+	}
+}
+
+// Create registra uma tentativa de entrega
+func (r *webhookDeliveryRepository) Create(ctx context.Context, delivery *entities.WebhookDelivery) error {
+	ctx, cancel := r.writeContext(ctx)
+	defer cancel()
+
+	delivery.PrepareForCreate()
+
+	if _, err := r.collection.InsertOne(ctx, delivery); err != nil {
+		return wrapError("erro ao registrar entrega de webhook: %w", err)
+	}
+
+	return nil
+}
+
+// ListByUser lista as entregas do usuário, das mais recentes para as mais antigas, sem o corpo enviado
+func (r *webhookDeliveryRepository) ListByUser(ctx context.Context, userID primitive.ObjectID, page, limit int64) ([]*entities.WebhookDelivery, int64, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	filter := bson.M{"user_id": userID}
+
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, wrapError("erro ao contar entregas de webhook: %w", err)
+	}
+
+	opts := options.Find().
+		SetProjection(webhookDeliveryWithoutPayload).
+		SetSkip((page - 1) * limit).
+		SetLimit(limit).
+		SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, wrapError("erro ao listar entregas de webhook: %w", err)
+	}
+
+	var deliveries []*entities.WebhookDelivery
+	if err := cursor.All(ctx, &deliveries); err != nil {
+		return nil, 0, wrapError("erro ao decodificar entregas de webhook: %w", err)
+	}
+
+	return deliveries, total, nil
+}
+
+// GetByID busca uma entrega do usuário, com o corpo enviado
+func (r *webhookDeliveryRepository) GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.WebhookDelivery, error) {
+	ctx, cancel := r.readContext(ctx)
+	defer cancel()
+
+	var delivery entities.WebhookDelivery
+	if err := r.collection.FindOne(ctx, ownedFilter(userID, id)).Decode(&delivery); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrWebhookDeliveryNotFound
+		}
+		return nil, wrapError("erro ao buscar entrega de webhook: %w", err)
+	}
+
+	return &delivery, nil
+}
@@ -0,0 +1,83 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// jobLock representa o documento de lock/lease de um job na collection scheduler_locks
+type jobLock struct {
+	Name         string     `bson:"_id" json:"name"`
+	Owner        string     `bson:"owner" json:"owner"`
+	LockedUntil  time.Time  `bson:"locked_until" json:"locked_until"`
+	NextRunAt    time.Time  `bson:"next_run_at" json:"next_run_at"`
+	LastRunAt    *time.Time `bson:"last_run_at,omitempty" json:"last_run_at,omitempty"`
+	LastDuration int64      `bson:"last_duration_ms" json:"last_duration_ms"`
+	LastError    string     `bson:"last_error,omitempty" json:"last_error,omitempty"`
+}
+
+// lockStore controla os leases dos jobs no MongoDB
+type lockStore struct {
+	collection *mongo.Collection
+	owner      string
+}
+
+// acquire tenta obter o lease do job. Só é obtido quando o job está vencido
+// (next_run_at <= agora) e nenhuma outra instância possui um lease válido.
+func (s *lockStore) acquire(ctx context.Context, name string, lease time.Duration) (bool, error) {
+	now := time.Now()
+
+	filter := bson.M{
+		"_id":          name,
+		"next_run_at":  bson.M{"$lte": now},
+		"locked_until": bson.M{"$lte": now},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"owner":        s.owner,
+			"locked_until": now.Add(lease),
+		},
+		"$setOnInsert": bson.M{
+			"next_run_at": now,
+		},
+	}
+
+	err := s.collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetUpsert(true)).Err()
+	switch {
+	case err == nil, errors.Is(err, mongo.ErrNoDocuments):
+		// ErrNoDocuments: documento inserido pelo upsert (sem retorno do anterior)
+		return true, nil
+	case mongo.IsDuplicateKeyError(err):
+		// O documento existe mas está bloqueado ou ainda não venceu
+		return false, nil
+	default:
+		return false, fmt.Errorf("erro ao obter lock do job %s: %w", name, err)
+	}
+}
+
+// release libera o lease e agenda a próxima execução
+func (s *lockStore) release(ctx context.Context, name string, startedAt time.Time, nextRunAt time.Time, runErr error) error {
+	set := bson.M{
+		"locked_until":     time.Now(),
+		"next_run_at":      nextRunAt,
+		"last_run_at":      startedAt,
+		"last_duration_ms": time.Since(startedAt).Milliseconds(),
+		"last_error":       "",
+	}
+	if runErr != nil {
+		set["last_error"] = runErr.Error()
+	}
+
+	_, err := s.collection.UpdateOne(ctx, bson.M{"_id": name, "owner": s.owner}, bson.M{"$set": set})
+	if err != nil {
+		return fmt.Errorf("erro ao liberar lock do job %s: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/instance"
+	"github.com/devgugga/todo-it/internal/leader"
+)
+
+// defaultJobTimeout é o lease padrão de um job quando Timeout não é informado
+const defaultJobTimeout = 10 * time.Minute
+
+// defaultPollInterval define a frequência com que cada instância verifica jobs vencidos
+const defaultPollInterval = 30 * time.Second
+
+// Job representa um job periódico
+type Job struct {
+	Name     string
+	Interval time.Duration
+	// Timeout limita a execução e define o lease do lock (padrão: 10 minutos)
+	Timeout time.Duration
+	Run     func(ctx context.Context) error
+}
+
+// Scheduler executa jobs periódicos garantindo, via lease no MongoDB, que apenas
+// uma instância da API execute cada job quando há várias réplicas. Com
+// RequireLeader, só a instância líder disputa os jobs.
+type Scheduler struct {
+	locks        *lockStore
+	pollInterval time.Duration
+	jobs         []Job
+	wg           sync.WaitGroup
+	// elector, se definido, restringe a execução à instância líder
+	elector *leader.Elector
+}
+
+// New cria um novo scheduler
+func New(db database.Client) *Scheduler {
+	return &Scheduler{
+		locks: &lockStore{
+			collection: db.Collections().SchedulerLocks,
+			owner:      instance.ID(),
+		},
+		pollInterval: defaultPollInterval,
+	}
+}
+
+// Register registra um job. Deve ser chamado antes de Start.
+func (s *Scheduler) Register(job Job) {
+	if job.Timeout <= 0 {
+		job.Timeout = defaultJobTimeout
+	}
+	s.jobs = append(s.jobs, job)
+}
+
+// RequireLeader faz apenas a instância líder da eleição executar os jobs.
+// O lease de cada job continua valendo, então uma troca de líder durante uma
+// execução não a duplica. Deve ser chamado antes de Start.
+func (s *Scheduler) RequireLeader(elector *leader.Elector) {
+	s.elector = elector
+}
+
+// IsLeader indica se esta instância executa os jobs (sempre, sem eleição)
+func (s *Scheduler) IsLeader() bool {
+	return s.elector == nil || s.elector.IsLeader()
+}
+
+// Start inicia a verificação dos jobs até ctx ser cancelado
+func (s *Scheduler) Start(ctx context.Context) {
+	if s.elector != nil {
+		s.elector.Start(ctx)
+	}
+
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, job)
+	}
+
+	slog.Info("scheduler iniciado", "jobs", len(s.jobs), "instance", s.locks.owner)
+}
+
+// Wait aguarda o término dos jobs em execução após o cancelamento do contexto
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+
+	// A renúncia vem depois dos jobs, para que a próxima líder não espere o lease
+	if s.elector != nil {
+		<-s.elector.Done()
+	}
+}
+
+// loop verifica periodicamente se o job está vencido e o executa
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	defer s.wg.Done()
+
+	poll := s.pollInterval
+	if job.Interval < poll {
+		poll = job.Interval
+	}
+
+	ticker := time.NewTicker(poll)
+	defer ticker.Stop()
+
+	for {
+		s.tryRun(ctx, job)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// tryRun executa o job se esta instância obtiver o lease
+func (s *Scheduler) tryRun(ctx context.Context, job Job) {
+	if !s.IsLeader() {
+		return
+	}
+
+	acquired, err := s.locks.acquire(ctx, job.Name, job.Timeout)
+	if err != nil {
+		if ctx.Err() == nil {
+			slog.Warn("erro ao obter lock do job", "job", job.Name, "error", err)
+		}
+		return
+	}
+	if !acquired {
+		return
+	}
+
+	startedAt := time.Now()
+	runErr := s.run(ctx, job)
+	if runErr != nil {
+		slog.Error("job falhou", "job", job.Name, "duration_ms", time.Since(startedAt).Milliseconds(), "error", runErr)
+	}
+
+	// Usa um contexto próprio para liberar o lock mesmo durante o shutdown
+	releaseCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := s.locks.release(releaseCtx, job.Name, startedAt, startedAt.Add(job.Interval), runErr); err != nil {
+		slog.Warn("erro ao liberar lock do job", "job", job.Name, "error", err)
+	}
+}
+
+// run executa o job limitado pelo lease, convertendo panics em erro
+func (s *Scheduler) run(ctx context.Context, job Job) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, job.Timeout)
+	defer cancel()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return job.Run(ctx)
+}
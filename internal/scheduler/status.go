@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// JobStatus representa a saúde de um job registrado
+type JobStatus struct {
+	Name         string     `json:"name"`
+	Interval     string     `json:"interval"`
+	Running      bool       `json:"running"`
+	Healthy      bool       `json:"healthy"`
+	LastRunAt    *time.Time `json:"last_run_at,omitempty"`
+	LastDuration int64      `json:"last_duration_ms"`
+	LastError    string     `json:"last_error,omitempty"`
+	NextRunAt    *time.Time `json:"next_run_at,omitempty"`
+}
+
+// Status retorna a situação de cada job registrado a partir dos leases no MongoDB.
+// Um job é considerado saudável quando a última execução não falhou e ele não
+// está atrasado em mais de um intervalo.
+func (s *Scheduler) Status(ctx context.Context) ([]JobStatus, error) {
+	names := make([]string, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		names = append(names, job.Name)
+	}
+
+	cursor, err := s.locks.collection.Find(ctx, bson.M{"_id": bson.M{"$in": names}})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar status dos jobs: %w", err)
+	}
+
+	var locks []jobLock
+	if err := cursor.All(ctx, &locks); err != nil {
+		return nil, fmt.Errorf("erro ao decodificar status dos jobs: %w", err)
+	}
+
+	byName := make(map[string]jobLock, len(locks))
+	for _, lock := range locks {
+		byName[lock.Name] = lock
+	}
+
+	now := time.Now()
+	statuses := make([]JobStatus, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		status := JobStatus{
+			Name:     job.Name,
+			Interval: job.Interval.String(),
+			Healthy:  true,
+		}
+
+		if lock, ok := byName[job.Name]; ok {
+			nextRunAt := lock.NextRunAt
+			status.Running = lock.LockedUntil.After(now)
+			status.LastRunAt = lock.LastRunAt
+			status.LastDuration = lock.LastDuration
+			status.LastError = lock.LastError
+			status.NextRunAt = &nextRunAt
+			status.Healthy = lock.LastError == "" && now.Before(nextRunAt.Add(job.Interval))
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
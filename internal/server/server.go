@@ -0,0 +1,128 @@
+package server
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// Config define como o servidor HTTP(S) é exposto
+type Config struct {
+	Port string
+
+	// Certificado e chave próprios (PEM)
+	CertFile string
+	KeyFile  string
+
+	// Certificados automáticos do Let's Encrypt para os domínios informados
+	AutocertDomains  []string
+	AutocertEmail    string
+	AutocertCacheDir string
+
+	// RedirectPort, quando definido com TLS ativo, sobe um listener HTTP que
+	// redireciona para HTTPS (e responde aos desafios http-01 do autocert)
+	RedirectPort string
+}
+
+// TLSEnabled indica se o servidor deve usar HTTPS
+func (c Config) TLSEnabled() bool {
+	return c.CertFile != "" || len(c.AutocertDomains) > 0
+}
+
+// Serve inicia o servidor (HTTP ou HTTPS conforme a configuração) e bloqueia
+// até o encerramento de app
+func Serve(app *fiber.App, cfg Config) error {
+	addr := ":" + cfg.Port
+
+	if !cfg.TLSEnabled() {
+		return app.Listen(addr)
+	}
+
+	var (
+		listener net.Listener
+		fallback http.Handler = redirectHandler(cfg.Port)
+		err      error
+	)
+
+	if len(cfg.AutocertDomains) > 0 {
+		manager := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(cfg.AutocertDomains...),
+			Cache:      autocert.DirCache(cfg.AutocertCacheDir),
+			Email:      cfg.AutocertEmail,
+		}
+
+		listener, err = tls.Listen("tcp", addr, &tls.Config{
+			GetCertificate: manager.GetCertificate,
+			NextProtos:     []string{"http/1.1", acme.ALPNProto},
+			MinVersion:     tls.VersionTLS12,
+		})
+
+		// O listener HTTP também precisa responder aos desafios http-01
+		fallback = manager.HTTPHandler(fallback)
+	} else {
+		var certificate tls.Certificate
+		certificate, err = tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return fmt.Errorf("erro ao carregar certificado TLS: %w", err)
+		}
+
+		listener, err = tls.Listen("tcp", addr, &tls.Config{
+			Certificates: []tls.Certificate{certificate},
+			MinVersion:   tls.VersionTLS12,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("erro ao abrir listener TLS: %w", err)
+	}
+
+	if cfg.RedirectPort != "" {
+		startRedirectServer(app, cfg.RedirectPort, fallback)
+	}
+
+	return app.Listener(listener)
+}
+
+// startRedirectServer sobe o listener HTTP de redirecionamento e o encerra
+// junto com a aplicação
+func startRedirectServer(app *fiber.App, port string, handler http.Handler) {
+	redirect := &http.Server{
+		Addr:              ":" + port,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		slog.Info("redirecionamento HTTP→HTTPS ativo", "port", port)
+		if err := redirect.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("erro no servidor de redirecionamento", "error", err)
+		}
+	}()
+
+	app.Hooks().OnShutdown(func() error {
+		return redirect.Close()
+	})
+}
+
+// redirectHandler redireciona a requisição para o mesmo caminho via HTTPS
+func redirectHandler(tlsPort string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		if tlsPort != "443" {
+			host = net.JoinHostPort(host, tlsPort)
+		}
+
+		http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusMovedPermanently)
+	})
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// activityFeedEntries é a quantidade de entradas do feed de atividades
+const activityFeedEntries = 50
+
+// activityFeedEvents são as alterações exibidas no feed: tarefas criadas e concluídas
+var activityFeedEvents = []string{events.TaskCreated, events.TaskCompleted}
+
+// ActivityFeedService interface define o feed Atom de atividades, acessado por um
+// token na URL (leitores de feed não suportam autenticação por header)
+type ActivityFeedService interface {
+	RotateToken(ctx context.Context, userID primitive.ObjectID) (string, error)
+	RevokeToken(ctx context.Context, userID primitive.ObjectID) error
+	Activity(ctx context.Context, token string) (*entities.User, []*entities.TaskChange, error)
+}
+
+// activityFeedService implementa ActivityFeedService
+type activityFeedService struct {
+	users   repositories.UserRepository
+	changes repositories.TaskChangeRepository
+}
+
+// NewActivityFeedService cria uma nova instância do serviço
+func NewActivityFeedService(users repositories.UserRepository, changes repositories.TaskChangeRepository) ActivityFeedService {
+	return &activityFeedService{users: users, changes: changes}
+}
+
+// RotateToken gera um novo token para o feed, invalidando o anterior. O token em
+// texto só é retornado aqui; apenas o hash é persistido.
+func (s *activityFeedService) RotateToken(ctx context.Context, userID primitive.ObjectID) (string, error) {
+	raw := make([]byte, 24)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("erro ao gerar token do feed: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	if err := s.users.SetFeedTokenHash(ctx, userID, hashFeedToken(token)); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// RevokeToken desativa o feed
+func (s *activityFeedService) RevokeToken(ctx context.Context, userID primitive.ObjectID) error {
+	return s.users.SetFeedTokenHash(ctx, userID, "")
+}
+
+// Activity retorna o dono do token e as atividades recentes, da mais nova para a mais antiga
+func (s *activityFeedService) Activity(ctx context.Context, token string) (*entities.User, []*entities.TaskChange, error) {
+	if token == "" {
+		return nil, nil, ErrFeedNotFound
+	}
+
+	user, err := s.users.GetByFeedTokenHash(ctx, hashFeedToken(token))
+	if err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return nil, nil, ErrFeedNotFound
+		}
+		return nil, nil, err
+	}
+
+	changes, err := s.changes.ListRecent(ctx, user.ID, activityFeedEvents, activityFeedEntries)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, changes, nil
+}
+
+// hashFeedToken calcula o hash SHA-256 do token do feed
+func hashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
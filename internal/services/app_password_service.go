@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// maxAppPasswords limita as senhas de aplicativo ativas por usuário
+	maxAppPasswords = 10
+	// appPasswordTouchInterval evita gravar o último uso a cada requisição
+	appPasswordTouchInterval = time.Minute
+)
+
+// AppPasswordService interface define as regras das senhas de aplicativo
+type AppPasswordService interface {
+	Create(ctx context.Context, userID primitive.ObjectID, name string) (*entities.AppPassword, string, error)
+	List(ctx context.Context, userID primitive.ObjectID) ([]*entities.AppPassword, error)
+	Revoke(ctx context.Context, userID, id primitive.ObjectID) error
+	Authenticate(ctx context.Context, email, password string) (*entities.User, error)
+}
+
+// appPasswordService implementa AppPasswordService
+type appPasswordService struct {
+	passwords repositories.AppPasswordRepository
+	users     repositories.UserRepository
+}
+
+// NewAppPasswordService cria uma nova instância do serviço
+func NewAppPasswordService(passwords repositories.AppPasswordRepository, users repositories.UserRepository) AppPasswordService {
+	return &appPasswordService{passwords: passwords, users: users}
+}
+
+// Create gera uma nova senha de aplicativo. A senha em texto só é retornada aqui;
+// apenas o hash é persistido.
+func (s *appPasswordService) Create(ctx context.Context, userID primitive.ObjectID, name string) (*entities.AppPassword, string, error) {
+	count, err := s.passwords.CountByUser(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	if count >= maxAppPasswords {
+		return nil, "", ErrAppPasswordLimit
+	}
+
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", fmt.Errorf("erro ao gerar senha de aplicativo: %w", err)
+	}
+
+	// Quatro grupos de 8 caracteres facilitam digitar a senha no cliente
+	encoded := hex.EncodeToString(raw)
+	plain := strings.Join([]string{encoded[0:8], encoded[8:16], encoded[16:24], encoded[24:32]}, "-")
+
+	password := &entities.AppPassword{
+		Name:      strings.TrimSpace(name),
+		TokenHash: hashAppPassword(plain),
+	}
+	password.PrepareForCreate(userID)
+
+	if err := s.passwords.Create(ctx, password); err != nil {
+		return nil, "", err
+	}
+
+	return password, plain, nil
+}
+
+// List lista as senhas de aplicativo do usuário
+func (s *appPasswordService) List(ctx context.Context, userID primitive.ObjectID) ([]*entities.AppPassword, error) {
+	return s.passwords.ListByUser(ctx, userID)
+}
+
+// Revoke revoga a senha de aplicativo do usuário
+func (s *appPasswordService) Revoke(ctx context.Context, userID, id primitive.ObjectID) error {
+	if err := s.passwords.Delete(ctx, userID, id); err != nil {
+		if errors.Is(err, repositories.ErrAppPasswordNotFound) {
+			return ErrAppPasswordNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// Authenticate valida o email e a senha de aplicativo (autenticação básica).
+// Retorna ErrInvalidCredentials sem distinguir email, senha ou conta inativa ou suspensa.
+func (s *appPasswordService) Authenticate(ctx context.Context, email, password string) (*entities.User, error) {
+	appPassword, err := s.passwords.GetByTokenHash(ctx, hashAppPassword(password))
+	if err != nil {
+		if errors.Is(err, repositories.ErrAppPasswordNotFound) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	user, err := s.users.GetByID(ctx, appPassword.UserID)
+	if err != nil || !user.IsActive || user.IsSuspended() || user.Email != entities.NormalizeEmail(email) {
+		return nil, ErrInvalidCredentials
+	}
+
+	if appPassword.LastUsedAt == nil || time.Since(*appPassword.LastUsedAt) > appPasswordTouchInterval {
+		if err := s.passwords.TouchLastUsed(ctx, appPassword.ID); err != nil {
+			logging.FromContext(ctx).Warn("erro ao registrar uso da senha de aplicativo", "error", err)
+		}
+	}
+
+	return user, nil
+}
+
+// hashAppPassword calcula o hash da senha, ignorando hífens, espaços e maiúsculas
+func hashAppPassword(password string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(password))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/repositories"
+)
+
+// AuditService interface define o registro e a consulta do log de auditoria
+type AuditService interface {
+	Record(ctx context.Context, entry *entities.AuditLog)
+	List(ctx context.Context, page, limit int64, filters *repositories.AuditLogFilters) ([]*entities.AuditLog, int64, error)
+}
+
+// auditService implementa AuditService
+type auditService struct {
+	logs repositories.AuditLogRepository
+}
+
+// NewAuditService cria uma nova instância do serviço
+func NewAuditService(logs repositories.AuditLogRepository) AuditService {
+	return &auditService{logs: logs}
+}
+
+// Record grava a entrada de auditoria. Falhas são registradas no log da
+// aplicação sem interromper a requisição.
+func (s *auditService) Record(ctx context.Context, entry *entities.AuditLog) {
+	// A gravação não deve ser perdida se o cliente encerrar a requisição
+	ctx = context.WithoutCancel(ctx)
+
+	if err := s.logs.Create(ctx, entry); err != nil {
+		logging.FromContext(ctx).Error("erro ao registrar auditoria", "action", entry.Action, "error", err)
+	}
+}
+
+// List consulta o log de auditoria
+func (s *auditService) List(ctx context.Context, page, limit int64, filters *repositories.AuditLogFilters) ([]*entities.AuditLog, int64, error) {
+	return s.logs.List(ctx, page, limit, filters)
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+
+	"github.com/devgugga/todo-it/internal/clock"
+	userreq "github.com/devgugga/todo-it/internal/dtos/requests/user"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CustomStatusService interface define os status personalizados do usuário:
+// nomes de exibição associados a um dos status padrão das tarefas
+type CustomStatusService interface {
+	List(ctx context.Context, userID primitive.ObjectID) ([]entities.CustomStatus, error)
+	Create(ctx context.Context, userID primitive.ObjectID, req *userreq.CreateCustomStatusRequest) (*entities.CustomStatus, error)
+	Update(ctx context.Context, userID, id primitive.ObjectID, req *userreq.UpdateCustomStatusRequest) (*entities.CustomStatus, error)
+	Delete(ctx context.Context, userID, id primitive.ObjectID) error
+}
+
+// customStatusService implementa CustomStatusService
+type customStatusService struct {
+	users repositories.UserRepository
+	todos repositories.TodoRepository
+	clock clock.Clock
+}
+
+// NewCustomStatusService cria uma nova instância do serviço
+func NewCustomStatusService(users repositories.UserRepository, todos repositories.TodoRepository) CustomStatusService {
+	return NewCustomStatusServiceWithClock(users, todos, clock.System())
+}
+
+// NewCustomStatusServiceWithClock cria o serviço com o relógio informado, usado
+// na criação dos status
+func NewCustomStatusServiceWithClock(users repositories.UserRepository, todos repositories.TodoRepository, clk clock.Clock) CustomStatusService {
+	return &customStatusService{users: users, todos: todos, clock: clk}
+}
+
+// List lista os status personalizados do usuário, na ordem de criação
+func (s *customStatusService) List(ctx context.Context, userID primitive.ObjectID) ([]entities.CustomStatus, error) {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return user.CustomStatuses, nil
+}
+
+// Create cria um status personalizado, com nome único entre os do usuário
+func (s *customStatusService) Create(ctx context.Context, userID primitive.ObjectID, req *userreq.CreateCustomStatusRequest) (*entities.CustomStatus, error) {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(user.CustomStatuses) >= entities.MaxCustomStatuses {
+		return nil, ErrCustomStatusLimit
+	}
+
+	status := req.ToEntity()
+	if user.HasCustomStatusNamed(status.Name, primitive.NilObjectID) {
+		return nil, repositories.ErrCustomStatusExists
+	}
+
+	status.ID = primitive.NewObjectID()
+	status.CreatedAt = s.clock.Now()
+
+	if err := s.users.AddCustomStatus(ctx, userID, status); err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// Update altera o nome e a cor do status, também nas tarefas que o usam
+func (s *customStatusService) Update(ctx context.Context, userID, id primitive.ObjectID, req *userreq.UpdateCustomStatusRequest) (*entities.CustomStatus, error) {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := user.CustomStatus(id)
+	if status == nil {
+		return nil, repositories.ErrCustomStatusNotFound
+	}
+
+	req.ApplyToEntity(status)
+	if user.HasCustomStatusNamed(status.Name, status.ID) {
+		return nil, repositories.ErrCustomStatusExists
+	}
+
+	if err := s.users.UpdateCustomStatus(ctx, userID, status); err != nil {
+		return nil, err
+	}
+
+	if err := s.todos.RenameCustomStatus(ctx, userID, status); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// Delete remove o status. As tarefas que o usavam mantêm o status padrão
+// associado a ele (removido delas antes, para que uma falha possa ser repetida).
+func (s *customStatusService) Delete(ctx context.Context, userID, id primitive.ObjectID) error {
+	cleared, err := s.todos.ClearCustomStatus(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.users.RemoveCustomStatus(ctx, userID, id); err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("status personalizado removido", "status_id", id.Hex(), "tasks", cleared)
+
+	return nil
+}
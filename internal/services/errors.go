@@ -0,0 +1,89 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/devgugga/todo-it/internal/apperrors"
+	"github.com/devgugga/todo-it/internal/entities"
+)
+
+var (
+	// ErrInvalidCredentials indica email ou senha incorretos
+	ErrInvalidCredentials = errors.New("email ou senha inválidos")
+	// ErrEmailAlreadyInUse indica que já existe usuário com o email informado
+	ErrEmailAlreadyInUse = apperrors.Duplicate("usuário com este email já existe")
+	// ErrAccountSuspended indica conta suspensa por um administrador (403 account_suspended)
+	ErrAccountSuspended = apperrors.Forbidden("conta suspensa")
+	// ErrSessionRevoked indica token de acesso emitido antes da última revogação das sessões
+	ErrSessionRevoked = errors.New("sessão encerrada; entre novamente")
+	// ErrUnknownPlan indica plano fora do catálogo
+	ErrUnknownPlan = errors.New("plano desconhecido")
+	// ErrWebhookNotConfigured indica reentrega sem URL de webhook nas preferências
+	ErrWebhookNotConfigured = apperrors.Conflict("nenhuma URL de webhook configurada")
+	// ErrInvalidPassword indica que a senha atual informada não confere
+	ErrInvalidPassword = errors.New("senha atual incorreta")
+	// ErrTaskNotFound indica tarefa inexistente ou de outro usuário
+	ErrTaskNotFound = apperrors.NotFound("tarefa não encontrada")
+	// ErrProjectNotFound indica projeto inexistente ou de outro usuário
+	ErrProjectNotFound = apperrors.NotFound("projeto não encontrado")
+	// ErrProjectForbidden indica membro do projeto sem o papel exigido pela operação
+	ErrProjectForbidden = apperrors.Forbidden("permissão insuficiente no projeto")
+	// ErrProjectOwnerMember indica o dono adicionado como membro do próprio projeto
+	ErrProjectOwnerMember = apperrors.Conflict("o dono já tem acesso ao projeto")
+	// ErrAppPasswordNotFound indica senha de aplicativo inexistente ou de outro usuário
+	ErrAppPasswordNotFound = apperrors.NotFound("senha de aplicativo não encontrada")
+	// ErrAppPasswordLimit indica que o usuário atingiu o limite de senhas de aplicativo
+	ErrAppPasswordLimit = apperrors.Conflict("limite de senhas de aplicativo atingido")
+	// ErrCustomStatusLimit indica que o usuário atingiu o limite de status personalizados
+	ErrCustomStatusLimit = apperrors.Conflict("limite de status personalizados atingido")
+	// ErrLabelLimit indica que o usuário atingiu o limite de etiquetas gerenciadas
+	ErrLabelLimit = apperrors.Conflict("limite de etiquetas atingido")
+	// ErrInvalidLabelName indica nome de etiqueta só com espaços
+	ErrInvalidLabelName = errors.New("o nome da etiqueta não pode ser vazio")
+	// ErrTaskTagLimit indica etiquetas e label_ids que, juntos, passam do limite da tarefa
+	ErrTaskTagLimit = fmt.Errorf("a tarefa pode ter no máximo %d etiquetas", entities.MaxTaskTags)
+	// ErrInvalidCursor indica since do feed de mudanças em formato desconhecido
+	ErrInvalidCursor = errors.New("since deve ser um cursor, uma data RFC 3339 ou um timestamp Unix")
+	// ErrInvalidSyncCursor indica since da sincronização em formato desconhecido
+	ErrInvalidSyncCursor = errors.New("since deve ser o cursor de uma sincronização anterior ou uma data RFC 3339")
+	// ErrSMSUnavailable indica envio de SMS não configurado no servidor
+	ErrSMSUnavailable = errors.New("envio de SMS não está disponível")
+	// ErrSMSQuotaExceeded indica que o usuário atingiu a cota mensal de SMS
+	ErrSMSQuotaExceeded = errors.New("cota mensal de SMS atingida")
+	// ErrPhoneNotFound indica usuário sem telefone cadastrado
+	ErrPhoneNotFound = apperrors.NotFound("telefone não cadastrado")
+	// ErrInvalidPhoneNumber indica número recusado pela operadora de SMS
+	ErrInvalidPhoneNumber = errors.New("número de telefone não pode receber SMS")
+	// ErrInvalidPhoneCode indica código de verificação incorreto, expirado ou com tentativas esgotadas
+	ErrInvalidPhoneCode = errors.New("código de verificação inválido ou expirado")
+	// ErrPhoneCodeCooldown indica novo código solicitado antes do intervalo mínimo
+	ErrPhoneCodeCooldown = errors.New("aguarde antes de solicitar um novo código")
+	// ErrPhoneNotVerified indica canal sms habilitado sem telefone verificado
+	ErrPhoneNotVerified = apperrors.Conflict("verifique um telefone antes de habilitar o canal sms")
+	// ErrExportInProgress indica que o usuário já tem uma exportação na fila ou em andamento
+	ErrExportInProgress = apperrors.Conflict("já existe uma exportação em andamento")
+	// ErrExportNotReady indica download de exportação que ainda não foi concluída
+	ErrExportNotReady = apperrors.Conflict("a exportação ainda não foi concluída")
+	// ErrExportTooLarge indica exportação acima do limite de tarefas
+	ErrExportTooLarge = fmt.Errorf("a exportação em PDF é limitada a %d tarefas; use filtros para reduzir a lista", MaxPDFTasks)
+	// ErrPastDueDate indica tarefa criada com vencimento no passado pelo usuário
+	// com a política reject
+	ErrPastDueDate = errors.New("a data de vencimento não pode estar no passado")
+	// ErrReorderMixedColumns indica reordenação com tarefas de projetos ou status diferentes
+	ErrReorderMixedColumns = apperrors.Conflict("as tarefas reordenadas devem ser do mesmo projeto e status")
+	// ErrTaskNotRecurring indica prévia de ocorrências de tarefa sem recorrência
+	ErrTaskNotRecurring = errors.New("a tarefa não se repete")
+	// ErrRecurrenceWithoutDueDate indica tarefa recorrente sem vencimento, que é a primeira ocorrência
+	ErrRecurrenceWithoutDueDate = errors.New("a tarefa recorrente não tem data de vencimento")
+	// ErrInvalidSnoozePreset indica atalho de adiamento desconhecido
+	ErrInvalidSnoozePreset = errors.New("atalho de adiamento inválido")
+	// ErrOrganizationNotFound indica organização inexistente ou de que o usuário não faz parte
+	ErrOrganizationNotFound = apperrors.NotFound("organização não encontrada")
+	// ErrOrganizationForbidden indica membro da organização em operação exclusiva do dono
+	ErrOrganizationForbidden = apperrors.Forbidden("somente o dono pode gerenciar a organização")
+	// ErrOrganizationInviteNotFound indica convite inexistente, expirado ou para outro email
+	ErrOrganizationInviteNotFound = apperrors.NotFound("convite da organização não encontrado")
+	// ErrFeedNotFound indica token de feed inexistente ou revogado
+	ErrFeedNotFound = apperrors.NotFound("feed não encontrado")
+)
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/logging"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// publish publica o evento sem interromper o fluxo em caso de falha
+func publish(ctx context.Context, bus events.Bus, event events.Event) {
+	if err := bus.Publish(ctx, event); err != nil {
+		logging.FromContext(ctx).Warn("erro ao publicar evento", "event", event.Type, "error", err)
+	}
+}
+
+// taskEventData monta os dados de uma tarefa incluídos nos eventos
+func taskEventData(task *entities.Task) map[string]interface{} {
+	data := map[string]interface{}{
+		"task_id":  task.ID.Hex(),
+		"title":    task.Title,
+		"status":   task.Status,
+		"priority": task.Priority,
+	}
+	if task.ProjectID != nil {
+		data["project_id"] = task.ProjectID.Hex()
+	}
+	return data
+}
+
+// taskProgressEventData monta os dados do evento de progresso da lista de
+// verificação. item é o item alterado, quando a mudança foi em um único item.
+func taskProgressEventData(task *entities.Task, previous entities.ChecklistProgress, item *entities.ChecklistItem) map[string]interface{} {
+	progress := task.ChecklistProgress()
+
+	data := taskEventData(task)
+	data["done"] = progress.Done
+	data["total"] = progress.Total
+	data["percent"] = progress.Percent()
+	data["previous_percent"] = previous.Percent()
+	data["thresholds_crossed"] = progress.CrossedThresholds(previous)
+	if item != nil {
+		data["item_id"] = item.ID.Hex()
+		data["item_done"] = item.Done
+	}
+	return data
+}
+
+// publishProgress publica o evento de progresso se o andamento da lista de
+// verificação mudou desde previous
+func publishProgress(ctx context.Context, bus events.Bus, userID primitive.ObjectID, task *entities.Task, previous entities.ChecklistProgress, item *entities.ChecklistItem) {
+	if task.ChecklistProgress() == previous {
+		return
+	}
+	publish(ctx, bus, events.New(events.TaskProgressChanged, userID, taskProgressEventData(task, previous, item)))
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/auth"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// FileLink é a assinatura de um download de curta duração (exp e sig da URL)
+type FileLink struct {
+	ID        string
+	ExpiresAt time.Time
+	Signature string
+}
+
+// DownloadFile é um arquivo aberto para download pela URL assinada
+type DownloadFile struct {
+	Name        string
+	ContentType string
+	Size        int64
+	Content     io.ReadCloser
+}
+
+// FileService interface define os downloads por URL assinada das exportações
+// e dos anexos
+type FileService interface {
+	ExportLink(ctx context.Context, userID, exportID primitive.ObjectID) (*FileLink, error)
+	AttachmentLink(ctx context.Context, userID, taskID, attachmentID primitive.ObjectID) (*FileLink, error)
+	Open(ctx context.Context, id primitive.ObjectID) (*DownloadFile, error)
+}
+
+// fileService implementa FileService
+type fileService struct {
+	exports     repositories.ExportJobRepository
+	attachments repositories.AttachmentRepository
+	tasks       TaskService
+	signer      *auth.URLSigner
+}
+
+// NewFileService cria uma nova instância do serviço
+func NewFileService(exports repositories.ExportJobRepository, attachments repositories.AttachmentRepository, tasks TaskService, signer *auth.URLSigner) FileService {
+	return &fileService{
+		exports:     exports,
+		attachments: attachments,
+		tasks:       tasks,
+		signer:      signer,
+	}
+}
+
+// ExportLink assina o download de uma exportação concluída do usuário
+func (s *fileService) ExportLink(ctx context.Context, userID, exportID primitive.ObjectID) (*FileLink, error) {
+	job, err := s.exports.GetByID(ctx, userID, exportID)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Status != entities.ExportJobCompleted {
+		return nil, ErrExportNotReady
+	}
+
+	return s.link(job.ID), nil
+}
+
+// AttachmentLink assina o download de um anexo de uma tarefa acessível ao usuário
+func (s *fileService) AttachmentLink(ctx context.Context, userID, taskID, attachmentID primitive.ObjectID) (*FileLink, error) {
+	task, err := s.tasks.GetByID(ctx, userID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, attachment := range task.Attachments {
+		if attachment.ID == attachmentID {
+			return s.link(attachment.ID), nil
+		}
+	}
+
+	return nil, repositories.ErrAttachmentNotFound
+}
+
+// link assina o ID do arquivo
+func (s *fileService) link(id primitive.ObjectID) *FileLink {
+	expiresAt, signature := s.signer.Sign(id.Hex())
+	return &FileLink{ID: id.Hex(), ExpiresAt: expiresAt, Signature: signature}
+}
+
+// Open abre o arquivo de uma URL assinada (já verificada): uma exportação
+// concluída ou um anexo
+func (s *fileService) Open(ctx context.Context, id primitive.ObjectID) (*DownloadFile, error) {
+	job, err := s.exports.GetFileByID(ctx, id)
+	switch {
+	case err == nil:
+		if job.Status != entities.ExportJobCompleted {
+			return nil, ErrExportNotReady
+		}
+		return &DownloadFile{
+			Name:        job.FileName,
+			ContentType: "application/pdf",
+			Size:        int64(len(job.File)),
+			Content:     io.NopCloser(bytes.NewReader(job.File)),
+		}, nil
+	case !errors.Is(err, repositories.ErrExportJobNotFound):
+		return nil, err
+	}
+
+	file, err := s.attachments.OpenFile(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DownloadFile{
+		Name:        file.Filename,
+		ContentType: file.ContentType,
+		Size:        file.Size,
+		Content:     file.Content,
+	}, nil
+}
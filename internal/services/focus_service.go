@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/clock"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// FocusService interface define as sessões de foco (pomodoro) nas tarefas
+type FocusService interface {
+	Start(ctx context.Context, userID, taskID primitive.ObjectID, planned time.Duration) (*entities.FocusSession, error)
+	Stop(ctx context.Context, userID primitive.ObjectID) (*entities.FocusSession, error)
+	Active(ctx context.Context, userID primitive.ObjectID) (*entities.FocusSession, error)
+	DailySummary(ctx context.Context, userID primitive.ObjectID, days int, loc *time.Location) (*FocusSummary, error)
+}
+
+// FocusSummary é o tempo de foco por dia no período, com todos os dias
+// (inclusive os sem sessões)
+type FocusSummary struct {
+	Timezone     string          `json:"timezone"`
+	From         string          `json:"from"`
+	To           string          `json:"to"`
+	TotalSeconds int64           `json:"total_seconds"`
+	Days         []FocusDayPoint `json:"days"`
+}
+
+// FocusDayPoint é o tempo de foco de um dia (AAAA-MM-DD no fuso do usuário)
+type FocusDayPoint struct {
+	Date     string `json:"date"`
+	Seconds  int64  `json:"seconds"`
+	Sessions int64  `json:"sessions"`
+}
+
+// focusService implementa FocusService
+type focusService struct {
+	sessions repositories.FocusSessionRepository
+	tasks    TaskService
+	clock    clock.Clock
+}
+
+// NewFocusService cria uma nova instância do serviço
+func NewFocusService(sessions repositories.FocusSessionRepository, tasks TaskService) FocusService {
+	return &focusService{sessions: sessions, tasks: tasks, clock: clock.System()}
+}
+
+// Start inicia uma sessão de foco na tarefa. planned é a duração escolhida no
+// cliente (0 = sem tempo definido), devolvida para que ele retome o cronômetro.
+func (s *focusService) Start(ctx context.Context, userID, taskID primitive.ObjectID, planned time.Duration) (*entities.FocusSession, error) {
+	if _, err := s.tasks.GetByID(ctx, userID, taskID); err != nil {
+		return nil, err
+	}
+
+	session := &entities.FocusSession{TaskID: taskID, PlannedSeconds: int64(planned / time.Second)}
+	session.PrepareForCreate(userID, s.clock.Now())
+
+	if err := s.sessions.Create(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// Stop encerra a sessão em andamento do usuário
+func (s *focusService) Stop(ctx context.Context, userID primitive.ObjectID) (*entities.FocusSession, error) {
+	session, err := s.sessions.GetActive(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	session.Finish(s.clock.Now())
+	if err := s.sessions.Finish(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// Active retorna a sessão em andamento do usuário
+func (s *focusService) Active(ctx context.Context, userID primitive.ObjectID) (*entities.FocusSession, error) {
+	return s.sessions.GetActive(ctx, userID)
+}
+
+// DailySummary retorna o tempo de foco por dia nos últimos days dias, no fuso loc
+func (s *focusService) DailySummary(ctx context.Context, userID primitive.ObjectID, days int, loc *time.Location) (*FocusSummary, error) {
+	now := s.clock.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	start := today.AddDate(0, 0, 1-days)
+
+	totals, err := s.sessions.DailyTotals(ctx, userID, start, loc.String())
+	if err != nil {
+		return nil, err
+	}
+
+	byDate := make(map[string]repositories.FocusDay, len(totals))
+	for _, day := range totals {
+		byDate[day.Date.In(loc).Format(time.DateOnly)] = day
+	}
+
+	summary := &FocusSummary{
+		Timezone: loc.String(),
+		From:     start.Format(time.DateOnly),
+		To:       today.Format(time.DateOnly),
+		Days:     make([]FocusDayPoint, 0, days),
+	}
+	for day := start; !day.After(today); day = day.AddDate(0, 0, 1) {
+		date := day.Format(time.DateOnly)
+		total := byDate[date]
+		summary.TotalSeconds += total.Seconds
+		summary.Days = append(summary.Days, FocusDayPoint{Date: date, Seconds: total.Seconds, Sessions: total.Sessions})
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/clock"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/repositories"
+)
+
+// integritySampleSize limita quantos documentos afetados são listados por verificação
+const integritySampleSize = 20
+
+// IntegrityReport resume a verificação de integridade dos dados
+type IntegrityReport struct {
+	// Repair indica que as inconsistências encontradas foram corrigidas
+	Repair      bool                   `json:"repair"`
+	HasIssues   bool                   `json:"has_issues"`
+	GeneratedAt time.Time              `json:"generated_at"`
+	Checks      []IntegrityCheckReport `json:"checks"`
+}
+
+// IntegrityCheckReport é o resultado de uma verificação (entities.IntegrityChecks)
+type IntegrityCheckReport struct {
+	Check      string `json:"check"`
+	Collection string `json:"collection"`
+	// Found é quantos documentos estavam inconsistentes e Repaired quantos foram corrigidos
+	Found    int64 `json:"found"`
+	Repaired int64 `json:"repaired"`
+	// Sample são os IDs de alguns dos documentos afetados
+	Sample []string `json:"sample"`
+}
+
+// IntegrityService interface define a verificação de integridade referencial
+type IntegrityService interface {
+	Report(ctx context.Context) (*IntegrityReport, error)
+	Repair(ctx context.Context) (*IntegrityReport, error)
+}
+
+// integrityService implementa IntegrityService
+type integrityService struct {
+	integrity repositories.IntegrityRepository
+	clock     clock.Clock
+}
+
+// NewIntegrityService cria uma nova instância do serviço
+func NewIntegrityService(integrity repositories.IntegrityRepository) IntegrityService {
+	return &integrityService{
+		integrity: integrity,
+		clock:     clock.System(),
+	}
+}
+
+// Report executa as verificações sem alterar nada
+func (s *integrityService) Report(ctx context.Context) (*IntegrityReport, error) {
+	return s.run(ctx, false)
+}
+
+// Repair executa as verificações e corrige as inconsistências encontradas
+func (s *integrityService) Repair(ctx context.Context) (*IntegrityReport, error) {
+	return s.run(ctx, true)
+}
+
+// run executa as verificações na ordem de entities.IntegrityChecks
+func (s *integrityService) run(ctx context.Context, repair bool) (*IntegrityReport, error) {
+	report := &IntegrityReport{Repair: repair, GeneratedAt: s.clock.Now()}
+
+	for _, check := range entities.IntegrityChecks {
+		scan, err := s.integrity.Scan(ctx, check, integritySampleSize)
+		if err != nil {
+			return nil, err
+		}
+
+		item := IntegrityCheckReport{
+			Check:      check,
+			Collection: scan.Collection,
+			Found:      scan.Count,
+			Sample:     make([]string, 0, len(scan.Sample)),
+		}
+		for _, id := range scan.Sample {
+			item.Sample = append(item.Sample, id.Hex())
+		}
+
+		if scan.Count > 0 {
+			report.HasIssues = true
+
+			if repair {
+				item.Repaired, err = s.integrity.Repair(ctx, check)
+				if err != nil {
+					return nil, err
+				}
+				slog.Info("inconsistências de dados corrigidas", "check", check, "found", item.Found, "repaired", item.Repaired)
+			}
+		}
+
+		report.Checks = append(report.Checks, item)
+	}
+
+	return report, nil
+}
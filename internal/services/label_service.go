@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	labelreq "github.com/devgugga/todo-it/internal/dtos/requests/label"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// LabelService interface define as etiquetas gerenciadas do usuário. As
+// tarefas continuam guardando o texto das etiquetas (Task.Tags); uma etiqueta
+// gerenciada dá cor e ícone ao texto com o mesmo nome e é associada às
+// tarefas por ID (Task.LabelIDs).
+type LabelService interface {
+	List(ctx context.Context, userID primitive.ObjectID) ([]*entities.Label, error)
+	Create(ctx context.Context, userID primitive.ObjectID, req *labelreq.CreateLabelRequest) (*entities.Label, error)
+	Update(ctx context.Context, userID, id primitive.ObjectID, req *labelreq.UpdateLabelRequest) (*entities.Label, error)
+	Delete(ctx context.Context, userID, id primitive.ObjectID) error
+	Migrate(ctx context.Context, userID primitive.ObjectID) (*LabelMigration, error)
+}
+
+// LabelMigration é o resultado da conversão das etiquetas das tarefas em
+// etiquetas gerenciadas
+type LabelMigration struct {
+	Created     []*entities.Label
+	Skipped     []string
+	LinkedTasks int64
+}
+
+// labelService implementa LabelService
+type labelService struct {
+	labels repositories.LabelRepository
+	todos  repositories.TodoRepository
+}
+
+// NewLabelService cria uma nova instância do serviço
+func NewLabelService(labels repositories.LabelRepository, todos repositories.TodoRepository) LabelService {
+	return &labelService{labels: labels, todos: todos}
+}
+
+// List lista as etiquetas do usuário em ordem alfabética
+func (s *labelService) List(ctx context.Context, userID primitive.ObjectID) ([]*entities.Label, error) {
+	return s.labels.List(ctx, userID)
+}
+
+// Create cria a etiqueta e a associa às tarefas que já usam o mesmo nome
+func (s *labelService) Create(ctx context.Context, userID primitive.ObjectID, req *labelreq.CreateLabelRequest) (*entities.Label, error) {
+	count, err := s.labels.Count(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= entities.MaxLabels {
+		return nil, ErrLabelLimit
+	}
+
+	label := req.ToEntity()
+	label.PrepareForCreate(userID)
+	if label.Name == "" {
+		return nil, ErrInvalidLabelName
+	}
+
+	if err := s.labels.Create(ctx, label); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.todos.LinkLabel(ctx, label); err != nil {
+		return nil, err
+	}
+
+	return label, nil
+}
+
+// Update altera a etiqueta; um novo nome também é aplicado às tarefas associadas
+func (s *labelService) Update(ctx context.Context, userID, id primitive.ObjectID, req *labelreq.UpdateLabelRequest) (*entities.Label, error) {
+	label, err := s.labels.GetByID(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	previousName := label.Name
+	req.ApplyToEntity(label)
+	if label.Name == "" {
+		return nil, ErrInvalidLabelName
+	}
+
+	if err := s.labels.Update(ctx, label); err != nil {
+		return nil, err
+	}
+
+	if label.Name != previousName {
+		if err := s.todos.RenameLabel(ctx, label, previousName); err != nil {
+			return nil, err
+		}
+	}
+
+	return label, nil
+}
+
+// Delete remove a etiqueta. As tarefas mantêm o texto da etiqueta, agora sem
+// cor e ícone (a associação é desfeita antes, para que uma falha possa ser repetida).
+func (s *labelService) Delete(ctx context.Context, userID, id primitive.ObjectID) error {
+	if _, err := s.labels.GetByID(ctx, userID, id); err != nil {
+		return err
+	}
+
+	unlinked, err := s.todos.UnlinkLabel(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.labels.Delete(ctx, userID, id); err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("etiqueta removida", "label_id", id.Hex(), "tasks", unlinked)
+
+	return nil
+}
+
+// Migrate cria etiquetas gerenciadas para as etiquetas das tarefas do usuário
+// que ainda não têm uma e as associa às tarefas. Pode ser repetido: etiquetas
+// existentes são mantidas e apenas associadas.
+func (s *labelService) Migrate(ctx context.Context, userID primitive.ObjectID) (*LabelMigration, error) {
+	tags, err := s.todos.DistinctTags(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := s.labels.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		existing[label.Name] = true
+	}
+
+	migration := &LabelMigration{Created: []*entities.Label{}}
+	for _, tag := range tags {
+		if existing[tag] {
+			continue
+		}
+		if len(labels)+len(migration.Created) >= entities.MaxLabels {
+			migration.Skipped = append(migration.Skipped, tag)
+			continue
+		}
+
+		label := &entities.Label{Name: tag}
+		label.PrepareForCreate(userID)
+
+		if err := s.labels.Create(ctx, label); err != nil {
+			// Criada em paralelo (ex.: migração repetida): só falta associar
+			if errors.Is(err, repositories.ErrLabelExists) {
+				continue
+			}
+			return nil, err
+		}
+		migration.Created = append(migration.Created, label)
+	}
+
+	// Associa também as etiquetas existentes, cobrindo tarefas gravadas por
+	// clientes antigos desde a última associação
+	all, err := s.labels.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, label := range all {
+		linked, err := s.todos.LinkLabel(ctx, label)
+		if err != nil {
+			return nil, err
+		}
+		migration.LinkedTasks += linked
+	}
+
+	logging.FromContext(ctx).Info("etiquetas migradas",
+		"created", len(migration.Created),
+		"skipped", len(migration.Skipped),
+		"tasks", migration.LinkedTasks,
+	)
+
+	return migration, nil
+}
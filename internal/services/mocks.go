@@ -0,0 +1,29 @@
+package services
+
+// Mocks (gomock) das interfaces de serviço, para testes de handlers sem
+// repositórios. Regenerar com: go generate ./internal/services/
+//go:generate go run go.uber.org/mock/mockgen -source=activity_feed_service.go -destination=mocks/activity_feed_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=app_password_service.go -destination=mocks/app_password_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=audit_service.go -destination=mocks/audit_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=custom_status_service.go -destination=mocks/custom_status_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=file_service.go -destination=mocks/file_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=focus_service.go -destination=mocks/focus_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=integrity_service.go -destination=mocks/integrity_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=label_service.go -destination=mocks/label_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=organization_service.go -destination=mocks/organization_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=phone_service.go -destination=mocks/phone_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=plan_service.go -destination=mocks/plan_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=platform_metrics_service.go -destination=mocks/platform_metrics_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=project_member_service.go -destination=mocks/project_member_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=project_rollup_service.go -destination=mocks/project_rollup_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=project_service.go -destination=mocks/project_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=retention_service.go -destination=mocks/retention_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=suspension_service.go -destination=mocks/suspension_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=sync_service.go -destination=mocks/sync_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=task_change_service.go -destination=mocks/task_change_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=task_pdf_service.go -destination=mocks/task_pdf_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=task_service.go -destination=mocks/task_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=task_stats_service.go -destination=mocks/task_stats_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=user_service.go -destination=mocks/user_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=view_settings_service.go -destination=mocks/view_settings_service.go -package=mocks
+//go:generate go run go.uber.org/mock/mockgen -source=webhook_delivery_service.go -destination=mocks/webhook_delivery_service.go -package=mocks
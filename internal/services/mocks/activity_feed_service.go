@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: activity_feed_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=activity_feed_service.go -destination=mocks/activity_feed_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockActivityFeedService is a mock of ActivityFeedService interface.
+type MockActivityFeedService struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityFeedServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockActivityFeedServiceMockRecorder is the mock recorder for MockActivityFeedService.
+type MockActivityFeedServiceMockRecorder struct {
+	mock *MockActivityFeedService
+}
+
+// NewMockActivityFeedService creates a new mock instance.
+func NewMockActivityFeedService(ctrl *gomock.Controller) *MockActivityFeedService {
+	mock := &MockActivityFeedService{ctrl: ctrl}
+	mock.recorder = &MockActivityFeedServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityFeedService) EXPECT() *MockActivityFeedServiceMockRecorder {
+	return m.recorder
+}
+
+// Activity mocks base method.
+func (m *MockActivityFeedService) Activity(ctx context.Context, token string) (*entities.User, []*entities.TaskChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Activity", ctx, token)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].([]*entities.TaskChange)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Activity indicates an expected call of Activity.
+func (mr *MockActivityFeedServiceMockRecorder) Activity(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activity", reflect.TypeOf((*MockActivityFeedService)(nil).Activity), ctx, token)
+}
+
+// RevokeToken mocks base method.
+func (m *MockActivityFeedService) RevokeToken(ctx context.Context, userID primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeToken", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeToken indicates an expected call of RevokeToken.
+func (mr *MockActivityFeedServiceMockRecorder) RevokeToken(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockActivityFeedService)(nil).RevokeToken), ctx, userID)
+}
+
+// RotateToken mocks base method.
+func (m *MockActivityFeedService) RotateToken(ctx context.Context, userID primitive.ObjectID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateToken", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateToken indicates an expected call of RotateToken.
+func (mr *MockActivityFeedServiceMockRecorder) RotateToken(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateToken", reflect.TypeOf((*MockActivityFeedService)(nil).RotateToken), ctx, userID)
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: app_password_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=app_password_service.go -destination=mocks/app_password_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAppPasswordService is a mock of AppPasswordService interface.
+type MockAppPasswordService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAppPasswordServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAppPasswordServiceMockRecorder is the mock recorder for MockAppPasswordService.
+type MockAppPasswordServiceMockRecorder struct {
+	mock *MockAppPasswordService
+}
+
+// NewMockAppPasswordService creates a new mock instance.
+func NewMockAppPasswordService(ctrl *gomock.Controller) *MockAppPasswordService {
+	mock := &MockAppPasswordService{ctrl: ctrl}
+	mock.recorder = &MockAppPasswordServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAppPasswordService) EXPECT() *MockAppPasswordServiceMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockAppPasswordService) Authenticate(ctx context.Context, email, password string) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, email, password)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockAppPasswordServiceMockRecorder) Authenticate(ctx, email, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAppPasswordService)(nil).Authenticate), ctx, email, password)
+}
+
+// Create mocks base method.
+func (m *MockAppPasswordService) Create(ctx context.Context, userID primitive.ObjectID, name string) (*entities.AppPassword, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, userID, name)
+	ret0, _ := ret[0].(*entities.AppPassword)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAppPasswordServiceMockRecorder) Create(ctx, userID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAppPasswordService)(nil).Create), ctx, userID, name)
+}
+
+// List mocks base method.
+func (m *MockAppPasswordService) List(ctx context.Context, userID primitive.ObjectID) ([]*entities.AppPassword, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userID)
+	ret0, _ := ret[0].([]*entities.AppPassword)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAppPasswordServiceMockRecorder) List(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAppPasswordService)(nil).List), ctx, userID)
+}
+
+// Revoke mocks base method.
+func (m *MockAppPasswordService) Revoke(ctx context.Context, userID, id primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockAppPasswordServiceMockRecorder) Revoke(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockAppPasswordService)(nil).Revoke), ctx, userID, id)
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: custom_status_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=custom_status_service.go -destination=mocks/custom_status_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	user "github.com/devgugga/todo-it/internal/dtos/requests/user"
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCustomStatusService is a mock of CustomStatusService interface.
+type MockCustomStatusService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomStatusServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCustomStatusServiceMockRecorder is the mock recorder for MockCustomStatusService.
+type MockCustomStatusServiceMockRecorder struct {
+	mock *MockCustomStatusService
+}
+
+// NewMockCustomStatusService creates a new mock instance.
+func NewMockCustomStatusService(ctrl *gomock.Controller) *MockCustomStatusService {
+	mock := &MockCustomStatusService{ctrl: ctrl}
+	mock.recorder = &MockCustomStatusServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomStatusService) EXPECT() *MockCustomStatusServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCustomStatusService) Create(ctx context.Context, userID primitive.ObjectID, req *user.CreateCustomStatusRequest) (*entities.CustomStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, userID, req)
+	ret0, _ := ret[0].(*entities.CustomStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCustomStatusServiceMockRecorder) Create(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCustomStatusService)(nil).Create), ctx, userID, req)
+}
+
+// Delete mocks base method.
+func (m *MockCustomStatusService) Delete(ctx context.Context, userID, id primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCustomStatusServiceMockRecorder) Delete(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCustomStatusService)(nil).Delete), ctx, userID, id)
+}
+
+// List mocks base method.
+func (m *MockCustomStatusService) List(ctx context.Context, userID primitive.ObjectID) ([]entities.CustomStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userID)
+	ret0, _ := ret[0].([]entities.CustomStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCustomStatusServiceMockRecorder) List(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCustomStatusService)(nil).List), ctx, userID)
+}
+
+// Update mocks base method.
+func (m *MockCustomStatusService) Update(ctx context.Context, userID, id primitive.ObjectID, req *user.UpdateCustomStatusRequest) (*entities.CustomStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, userID, id, req)
+	ret0, _ := ret[0].(*entities.CustomStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCustomStatusServiceMockRecorder) Update(ctx, userID, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCustomStatusService)(nil).Update), ctx, userID, id, req)
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: file_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=file_service.go -destination=mocks/file_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	services "github.com/devgugga/todo-it/internal/services"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFileService is a mock of FileService interface.
+type MockFileService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFileServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockFileServiceMockRecorder is the mock recorder for MockFileService.
+type MockFileServiceMockRecorder struct {
+	mock *MockFileService
+}
+
+// NewMockFileService creates a new mock instance.
+func NewMockFileService(ctrl *gomock.Controller) *MockFileService {
+	mock := &MockFileService{ctrl: ctrl}
+	mock.recorder = &MockFileServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFileService) EXPECT() *MockFileServiceMockRecorder {
+	return m.recorder
+}
+
+// AttachmentLink mocks base method.
+func (m *MockFileService) AttachmentLink(ctx context.Context, userID, taskID, attachmentID primitive.ObjectID) (*services.FileLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachmentLink", ctx, userID, taskID, attachmentID)
+	ret0, _ := ret[0].(*services.FileLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachmentLink indicates an expected call of AttachmentLink.
+func (mr *MockFileServiceMockRecorder) AttachmentLink(ctx, userID, taskID, attachmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachmentLink", reflect.TypeOf((*MockFileService)(nil).AttachmentLink), ctx, userID, taskID, attachmentID)
+}
+
+// ExportLink mocks base method.
+func (m *MockFileService) ExportLink(ctx context.Context, userID, exportID primitive.ObjectID) (*services.FileLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportLink", ctx, userID, exportID)
+	ret0, _ := ret[0].(*services.FileLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportLink indicates an expected call of ExportLink.
+func (mr *MockFileServiceMockRecorder) ExportLink(ctx, userID, exportID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportLink", reflect.TypeOf((*MockFileService)(nil).ExportLink), ctx, userID, exportID)
+}
+
+// Open mocks base method.
+func (m *MockFileService) Open(ctx context.Context, id primitive.ObjectID) (*services.DownloadFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", ctx, id)
+	ret0, _ := ret[0].(*services.DownloadFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockFileServiceMockRecorder) Open(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockFileService)(nil).Open), ctx, id)
+}
@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: focus_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=focus_service.go -destination=mocks/focus_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	services "github.com/devgugga/todo-it/internal/services"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFocusService is a mock of FocusService interface.
+type MockFocusService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFocusServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockFocusServiceMockRecorder is the mock recorder for MockFocusService.
+type MockFocusServiceMockRecorder struct {
+	mock *MockFocusService
+}
+
+// NewMockFocusService creates a new mock instance.
+func NewMockFocusService(ctrl *gomock.Controller) *MockFocusService {
+	mock := &MockFocusService{ctrl: ctrl}
+	mock.recorder = &MockFocusServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFocusService) EXPECT() *MockFocusServiceMockRecorder {
+	return m.recorder
+}
+
+// Active mocks base method.
+func (m *MockFocusService) Active(ctx context.Context, userID primitive.ObjectID) (*entities.FocusSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Active", ctx, userID)
+	ret0, _ := ret[0].(*entities.FocusSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Active indicates an expected call of Active.
+func (mr *MockFocusServiceMockRecorder) Active(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Active", reflect.TypeOf((*MockFocusService)(nil).Active), ctx, userID)
+}
+
+// DailySummary mocks base method.
+func (m *MockFocusService) DailySummary(ctx context.Context, userID primitive.ObjectID, days int, loc *time.Location) (*services.FocusSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DailySummary", ctx, userID, days, loc)
+	ret0, _ := ret[0].(*services.FocusSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DailySummary indicates an expected call of DailySummary.
+func (mr *MockFocusServiceMockRecorder) DailySummary(ctx, userID, days, loc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DailySummary", reflect.TypeOf((*MockFocusService)(nil).DailySummary), ctx, userID, days, loc)
+}
+
+// Start mocks base method.
+func (m *MockFocusService) Start(ctx context.Context, userID, taskID primitive.ObjectID, planned time.Duration) (*entities.FocusSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", ctx, userID, taskID, planned)
+	ret0, _ := ret[0].(*entities.FocusSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockFocusServiceMockRecorder) Start(ctx, userID, taskID, planned any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockFocusService)(nil).Start), ctx, userID, taskID, planned)
+}
+
+// Stop mocks base method.
+func (m *MockFocusService) Stop(ctx context.Context, userID primitive.ObjectID) (*entities.FocusSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", ctx, userID)
+	ret0, _ := ret[0].(*entities.FocusSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockFocusServiceMockRecorder) Stop(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockFocusService)(nil).Stop), ctx, userID)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: integrity_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=integrity_service.go -destination=mocks/integrity_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	services "github.com/devgugga/todo-it/internal/services"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIntegrityService is a mock of IntegrityService interface.
+type MockIntegrityService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIntegrityServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockIntegrityServiceMockRecorder is the mock recorder for MockIntegrityService.
+type MockIntegrityServiceMockRecorder struct {
+	mock *MockIntegrityService
+}
+
+// NewMockIntegrityService creates a new mock instance.
+func NewMockIntegrityService(ctrl *gomock.Controller) *MockIntegrityService {
+	mock := &MockIntegrityService{ctrl: ctrl}
+	mock.recorder = &MockIntegrityServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIntegrityService) EXPECT() *MockIntegrityServiceMockRecorder {
+	return m.recorder
+}
+
+// Repair mocks base method.
+func (m *MockIntegrityService) Repair(ctx context.Context) (*services.IntegrityReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Repair", ctx)
+	ret0, _ := ret[0].(*services.IntegrityReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Repair indicates an expected call of Repair.
+func (mr *MockIntegrityServiceMockRecorder) Repair(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Repair", reflect.TypeOf((*MockIntegrityService)(nil).Repair), ctx)
+}
+
+// Report mocks base method.
+func (m *MockIntegrityService) Report(ctx context.Context) (*services.IntegrityReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", ctx)
+	ret0, _ := ret[0].(*services.IntegrityReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockIntegrityServiceMockRecorder) Report(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockIntegrityService)(nil).Report), ctx)
+}
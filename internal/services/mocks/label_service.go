@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: label_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=label_service.go -destination=mocks/label_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	label "github.com/devgugga/todo-it/internal/dtos/requests/label"
+	entities "github.com/devgugga/todo-it/internal/entities"
+	services "github.com/devgugga/todo-it/internal/services"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLabelService is a mock of LabelService interface.
+type MockLabelService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLabelServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockLabelServiceMockRecorder is the mock recorder for MockLabelService.
+type MockLabelServiceMockRecorder struct {
+	mock *MockLabelService
+}
+
+// NewMockLabelService creates a new mock instance.
+func NewMockLabelService(ctrl *gomock.Controller) *MockLabelService {
+	mock := &MockLabelService{ctrl: ctrl}
+	mock.recorder = &MockLabelServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLabelService) EXPECT() *MockLabelServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockLabelService) Create(ctx context.Context, userID primitive.ObjectID, req *label.CreateLabelRequest) (*entities.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, userID, req)
+	ret0, _ := ret[0].(*entities.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLabelServiceMockRecorder) Create(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLabelService)(nil).Create), ctx, userID, req)
+}
+
+// Delete mocks base method.
+func (m *MockLabelService) Delete(ctx context.Context, userID, id primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLabelServiceMockRecorder) Delete(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLabelService)(nil).Delete), ctx, userID, id)
+}
+
+// List mocks base method.
+func (m *MockLabelService) List(ctx context.Context, userID primitive.ObjectID) ([]*entities.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userID)
+	ret0, _ := ret[0].([]*entities.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockLabelServiceMockRecorder) List(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLabelService)(nil).List), ctx, userID)
+}
+
+// Migrate mocks base method.
+func (m *MockLabelService) Migrate(ctx context.Context, userID primitive.ObjectID) (*services.LabelMigration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Migrate", ctx, userID)
+	ret0, _ := ret[0].(*services.LabelMigration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Migrate indicates an expected call of Migrate.
+func (mr *MockLabelServiceMockRecorder) Migrate(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrate", reflect.TypeOf((*MockLabelService)(nil).Migrate), ctx, userID)
+}
+
+// Update mocks base method.
+func (m *MockLabelService) Update(ctx context.Context, userID, id primitive.ObjectID, req *label.UpdateLabelRequest) (*entities.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, userID, id, req)
+	ret0, _ := ret[0].(*entities.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockLabelServiceMockRecorder) Update(ctx, userID, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockLabelService)(nil).Update), ctx, userID, id, req)
+}
@@ -0,0 +1,191 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: organization_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=organization_service.go -destination=mocks/organization_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	organization "github.com/devgugga/todo-it/internal/dtos/requests/organization"
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOrganizationService is a mock of OrganizationService interface.
+type MockOrganizationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockOrganizationServiceMockRecorder is the mock recorder for MockOrganizationService.
+type MockOrganizationServiceMockRecorder struct {
+	mock *MockOrganizationService
+}
+
+// NewMockOrganizationService creates a new mock instance.
+func NewMockOrganizationService(ctrl *gomock.Controller) *MockOrganizationService {
+	mock := &MockOrganizationService{ctrl: ctrl}
+	mock.recorder = &MockOrganizationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationService) EXPECT() *MockOrganizationServiceMockRecorder {
+	return m.recorder
+}
+
+// AcceptInvite mocks base method.
+func (m *MockOrganizationService) AcceptInvite(ctx context.Context, userID, id primitive.ObjectID) (*entities.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvite", ctx, userID, id)
+	ret0, _ := ret[0].(*entities.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvite indicates an expected call of AcceptInvite.
+func (mr *MockOrganizationServiceMockRecorder) AcceptInvite(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvite", reflect.TypeOf((*MockOrganizationService)(nil).AcceptInvite), ctx, userID, id)
+}
+
+// Create mocks base method.
+func (m *MockOrganizationService) Create(ctx context.Context, userID primitive.ObjectID, req *organization.CreateOrganizationRequest) (*entities.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, userID, req)
+	ret0, _ := ret[0].(*entities.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOrganizationServiceMockRecorder) Create(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrganizationService)(nil).Create), ctx, userID, req)
+}
+
+// DeclineInvite mocks base method.
+func (m *MockOrganizationService) DeclineInvite(ctx context.Context, userID, id primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineInvite", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeclineInvite indicates an expected call of DeclineInvite.
+func (mr *MockOrganizationServiceMockRecorder) DeclineInvite(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineInvite", reflect.TypeOf((*MockOrganizationService)(nil).DeclineInvite), ctx, userID, id)
+}
+
+// Get mocks base method.
+func (m *MockOrganizationService) Get(ctx context.Context, userID, id primitive.ObjectID) (*entities.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID, id)
+	ret0, _ := ret[0].(*entities.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockOrganizationServiceMockRecorder) Get(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockOrganizationService)(nil).Get), ctx, userID, id)
+}
+
+// Invite mocks base method.
+func (m *MockOrganizationService) Invite(ctx context.Context, userID, id primitive.ObjectID, req *organization.InviteOrganizationMemberRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invite", ctx, userID, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Invite indicates an expected call of Invite.
+func (mr *MockOrganizationServiceMockRecorder) Invite(ctx, userID, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invite", reflect.TypeOf((*MockOrganizationService)(nil).Invite), ctx, userID, id, req)
+}
+
+// Invites mocks base method.
+func (m *MockOrganizationService) Invites(ctx context.Context, userID primitive.ObjectID) ([]*entities.Organization, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invites", ctx, userID)
+	ret0, _ := ret[0].([]*entities.Organization)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Invites indicates an expected call of Invites.
+func (mr *MockOrganizationServiceMockRecorder) Invites(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invites", reflect.TypeOf((*MockOrganizationService)(nil).Invites), ctx, userID)
+}
+
+// List mocks base method.
+func (m *MockOrganizationService) List(ctx context.Context, userID primitive.ObjectID) ([]*entities.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userID)
+	ret0, _ := ret[0].([]*entities.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockOrganizationServiceMockRecorder) List(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOrganizationService)(nil).List), ctx, userID)
+}
+
+// RefreshUsage mocks base method.
+func (m *MockOrganizationService) RefreshUsage(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshUsage", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshUsage indicates an expected call of RefreshUsage.
+func (mr *MockOrganizationServiceMockRecorder) RefreshUsage(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshUsage", reflect.TypeOf((*MockOrganizationService)(nil).RefreshUsage), ctx)
+}
+
+// RemoveMember mocks base method.
+func (m *MockOrganizationService) RemoveMember(ctx context.Context, userID, id, memberID primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, userID, id, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockOrganizationServiceMockRecorder) RemoveMember(ctx, userID, id, memberID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockOrganizationService)(nil).RemoveMember), ctx, userID, id, memberID)
+}
+
+// Usage mocks base method.
+func (m *MockOrganizationService) Usage(ctx context.Context, userID, id primitive.ObjectID) (*entities.OrgUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Usage", ctx, userID, id)
+	ret0, _ := ret[0].(*entities.OrgUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Usage indicates an expected call of Usage.
+func (mr *MockOrganizationServiceMockRecorder) Usage(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Usage", reflect.TypeOf((*MockOrganizationService)(nil).Usage), ctx, userID, id)
+}
@@ -0,0 +1,104 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: phone_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=phone_service.go -destination=mocks/phone_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	quota "github.com/devgugga/todo-it/internal/quota"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPhoneService is a mock of PhoneService interface.
+type MockPhoneService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPhoneServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPhoneServiceMockRecorder is the mock recorder for MockPhoneService.
+type MockPhoneServiceMockRecorder struct {
+	mock *MockPhoneService
+}
+
+// NewMockPhoneService creates a new mock instance.
+func NewMockPhoneService(ctrl *gomock.Controller) *MockPhoneService {
+	mock := &MockPhoneService{ctrl: ctrl}
+	mock.recorder = &MockPhoneServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPhoneService) EXPECT() *MockPhoneServiceMockRecorder {
+	return m.recorder
+}
+
+// ConfirmVerification mocks base method.
+func (m *MockPhoneService) ConfirmVerification(ctx context.Context, userID primitive.ObjectID, code string) (*entities.PhoneNumber, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmVerification", ctx, userID, code)
+	ret0, _ := ret[0].(*entities.PhoneNumber)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmVerification indicates an expected call of ConfirmVerification.
+func (mr *MockPhoneServiceMockRecorder) ConfirmVerification(ctx, userID, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmVerification", reflect.TypeOf((*MockPhoneService)(nil).ConfirmVerification), ctx, userID, code)
+}
+
+// Get mocks base method.
+func (m *MockPhoneService) Get(ctx context.Context, userID primitive.ObjectID) (*entities.PhoneNumber, *quota.Usage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID)
+	ret0, _ := ret[0].(*entities.PhoneNumber)
+	ret1, _ := ret[1].(*quota.Usage)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPhoneServiceMockRecorder) Get(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPhoneService)(nil).Get), ctx, userID)
+}
+
+// Remove mocks base method.
+func (m *MockPhoneService) Remove(ctx context.Context, userID primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockPhoneServiceMockRecorder) Remove(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockPhoneService)(nil).Remove), ctx, userID)
+}
+
+// StartVerification mocks base method.
+func (m *MockPhoneService) StartVerification(ctx context.Context, userID primitive.ObjectID, number string) (*entities.PhoneNumber, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartVerification", ctx, userID, number)
+	ret0, _ := ret[0].(*entities.PhoneNumber)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartVerification indicates an expected call of StartVerification.
+func (mr *MockPhoneServiceMockRecorder) StartVerification(ctx, userID, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVerification", reflect.TypeOf((*MockPhoneService)(nil).StartVerification), ctx, userID, number)
+}
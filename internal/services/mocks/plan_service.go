@@ -0,0 +1,150 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: plan_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=plan_service.go -destination=mocks/plan_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	plans "github.com/devgugga/todo-it/internal/plans"
+	quota "github.com/devgugga/todo-it/internal/quota"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPlanService is a mock of PlanService interface.
+type MockPlanService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlanServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPlanServiceMockRecorder is the mock recorder for MockPlanService.
+type MockPlanServiceMockRecorder struct {
+	mock *MockPlanService
+}
+
+// NewMockPlanService creates a new mock instance.
+func NewMockPlanService(ctrl *gomock.Controller) *MockPlanService {
+	mock := &MockPlanService{ctrl: ctrl}
+	mock.recorder = &MockPlanServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlanService) EXPECT() *MockPlanServiceMockRecorder {
+	return m.recorder
+}
+
+// ApplyProviderChange mocks base method.
+func (m *MockPlanService) ApplyProviderChange(ctx context.Context, provider string, change *plans.SubscriptionChange) (*entities.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyProviderChange", ctx, provider, change)
+	ret0, _ := ret[0].(*entities.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyProviderChange indicates an expected call of ApplyProviderChange.
+func (mr *MockPlanServiceMockRecorder) ApplyProviderChange(ctx, provider, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyProviderChange", reflect.TypeOf((*MockPlanService)(nil).ApplyProviderChange), ctx, provider, change)
+}
+
+// Assign mocks base method.
+func (m *MockPlanService) Assign(ctx context.Context, userID primitive.ObjectID, planID plans.ID, periodEnd *time.Time) (*entities.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, userID, planID, periodEnd)
+	ret0, _ := ret[0].(*entities.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockPlanServiceMockRecorder) Assign(ctx, userID, planID, periodEnd any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockPlanService)(nil).Assign), ctx, userID, planID, periodEnd)
+}
+
+// Cancel mocks base method.
+func (m *MockPlanService) Cancel(ctx context.Context, userID primitive.ObjectID) (*entities.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, userID)
+	ret0, _ := ret[0].(*entities.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockPlanServiceMockRecorder) Cancel(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockPlanService)(nil).Cancel), ctx, userID)
+}
+
+// Catalog mocks base method.
+func (m *MockPlanService) Catalog() []*plans.Plan {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Catalog")
+	ret0, _ := ret[0].([]*plans.Plan)
+	return ret0
+}
+
+// Catalog indicates an expected call of Catalog.
+func (mr *MockPlanServiceMockRecorder) Catalog() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Catalog", reflect.TypeOf((*MockPlanService)(nil).Catalog))
+}
+
+// Effective mocks base method.
+func (m *MockPlanService) Effective(ctx context.Context, userID primitive.ObjectID) (*plans.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Effective", ctx, userID)
+	ret0, _ := ret[0].(*plans.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Effective indicates an expected call of Effective.
+func (mr *MockPlanServiceMockRecorder) Effective(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Effective", reflect.TypeOf((*MockPlanService)(nil).Effective), ctx, userID)
+}
+
+// Limits mocks base method.
+func (m *MockPlanService) Limits(ctx context.Context, userID primitive.ObjectID) (quota.Limits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limits", ctx, userID)
+	ret0, _ := ret[0].(quota.Limits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Limits indicates an expected call of Limits.
+func (mr *MockPlanServiceMockRecorder) Limits(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limits", reflect.TypeOf((*MockPlanService)(nil).Limits), ctx, userID)
+}
+
+// Subscription mocks base method.
+func (m *MockPlanService) Subscription(ctx context.Context, userID primitive.ObjectID) (*entities.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscription", ctx, userID)
+	ret0, _ := ret[0].(*entities.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscription indicates an expected call of Subscription.
+func (mr *MockPlanServiceMockRecorder) Subscription(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscription", reflect.TypeOf((*MockPlanService)(nil).Subscription), ctx, userID)
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: platform_metrics_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=platform_metrics_service.go -destination=mocks/platform_metrics_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPlatformMetricsService is a mock of PlatformMetricsService interface.
+type MockPlatformMetricsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlatformMetricsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPlatformMetricsServiceMockRecorder is the mock recorder for MockPlatformMetricsService.
+type MockPlatformMetricsServiceMockRecorder struct {
+	mock *MockPlatformMetricsService
+}
+
+// NewMockPlatformMetricsService creates a new mock instance.
+func NewMockPlatformMetricsService(ctrl *gomock.Controller) *MockPlatformMetricsService {
+	mock := &MockPlatformMetricsService{ctrl: ctrl}
+	mock.recorder = &MockPlatformMetricsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlatformMetricsService) EXPECT() *MockPlatformMetricsServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockPlatformMetricsService) Get(ctx context.Context) (*entities.PlatformMetrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].(*entities.PlatformMetrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPlatformMetricsServiceMockRecorder) Get(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPlatformMetricsService)(nil).Get), ctx)
+}
+
+// KPIs mocks base method.
+func (m *MockPlatformMetricsService) KPIs(ctx context.Context) (*entities.BusinessKPIs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KPIs", ctx)
+	ret0, _ := ret[0].(*entities.BusinessKPIs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// KPIs indicates an expected call of KPIs.
+func (mr *MockPlatformMetricsServiceMockRecorder) KPIs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KPIs", reflect.TypeOf((*MockPlatformMetricsService)(nil).KPIs), ctx)
+}
+
+// Refresh mocks base method.
+func (m *MockPlatformMetricsService) Refresh(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockPlatformMetricsServiceMockRecorder) Refresh(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockPlatformMetricsService)(nil).Refresh), ctx)
+}
+
+// RefreshKPIs mocks base method.
+func (m *MockPlatformMetricsService) RefreshKPIs(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshKPIs", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshKPIs indicates an expected call of RefreshKPIs.
+func (mr *MockPlatformMetricsServiceMockRecorder) RefreshKPIs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshKPIs", reflect.TypeOf((*MockPlatformMetricsService)(nil).RefreshKPIs), ctx)
+}
@@ -0,0 +1,165 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: project_member_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=project_member_service.go -destination=mocks/project_member_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	project "github.com/devgugga/todo-it/internal/dtos/requests/project"
+	task "github.com/devgugga/todo-it/internal/dtos/requests/task"
+	entities "github.com/devgugga/todo-it/internal/entities"
+	enums "github.com/devgugga/todo-it/internal/enums"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProjectMemberService is a mock of ProjectMemberService interface.
+type MockProjectMemberService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectMemberServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectMemberServiceMockRecorder is the mock recorder for MockProjectMemberService.
+type MockProjectMemberServiceMockRecorder struct {
+	mock *MockProjectMemberService
+}
+
+// NewMockProjectMemberService creates a new mock instance.
+func NewMockProjectMemberService(ctrl *gomock.Controller) *MockProjectMemberService {
+	mock := &MockProjectMemberService{ctrl: ctrl}
+	mock.recorder = &MockProjectMemberServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectMemberService) EXPECT() *MockProjectMemberServiceMockRecorder {
+	return m.recorder
+}
+
+// AddMember mocks base method.
+func (m *MockProjectMemberService) AddMember(ctx context.Context, userID, projectID primitive.ObjectID, req *project.AddProjectMemberRequest) (*entities.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, userID, projectID, req)
+	ret0, _ := ret[0].(*entities.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockProjectMemberServiceMockRecorder) AddMember(ctx, userID, projectID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockProjectMemberService)(nil).AddMember), ctx, userID, projectID, req)
+}
+
+// GetTask mocks base method.
+func (m *MockProjectMemberService) GetTask(ctx context.Context, userID, projectID, taskID primitive.ObjectID) (*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTask", ctx, userID, projectID, taskID)
+	ret0, _ := ret[0].(*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTask indicates an expected call of GetTask.
+func (mr *MockProjectMemberServiceMockRecorder) GetTask(ctx, userID, projectID, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTask", reflect.TypeOf((*MockProjectMemberService)(nil).GetTask), ctx, userID, projectID, taskID)
+}
+
+// Members mocks base method.
+func (m *MockProjectMemberService) Members(ctx context.Context, userID, projectID primitive.ObjectID) (*entities.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Members", ctx, userID, projectID)
+	ret0, _ := ret[0].(*entities.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Members indicates an expected call of Members.
+func (mr *MockProjectMemberServiceMockRecorder) Members(ctx, userID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Members", reflect.TypeOf((*MockProjectMemberService)(nil).Members), ctx, userID, projectID)
+}
+
+// RemoveMember mocks base method.
+func (m *MockProjectMemberService) RemoveMember(ctx context.Context, userID, projectID, memberID primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, userID, projectID, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockProjectMemberServiceMockRecorder) RemoveMember(ctx, userID, projectID, memberID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockProjectMemberService)(nil).RemoveMember), ctx, userID, projectID, memberID)
+}
+
+// SetRole mocks base method.
+func (m *MockProjectMemberService) SetRole(ctx context.Context, userID, projectID, memberID primitive.ObjectID, role enums.ProjectRole) (*entities.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRole", ctx, userID, projectID, memberID, role)
+	ret0, _ := ret[0].(*entities.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRole indicates an expected call of SetRole.
+func (mr *MockProjectMemberServiceMockRecorder) SetRole(ctx, userID, projectID, memberID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRole", reflect.TypeOf((*MockProjectMemberService)(nil).SetRole), ctx, userID, projectID, memberID, role)
+}
+
+// Tasks mocks base method.
+func (m *MockProjectMemberService) Tasks(ctx context.Context, userID, projectID primitive.ObjectID, page, limit int64) ([]*entities.Task, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tasks", ctx, userID, projectID, page, limit)
+	ret0, _ := ret[0].([]*entities.Task)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Tasks indicates an expected call of Tasks.
+func (mr *MockProjectMemberServiceMockRecorder) Tasks(ctx, userID, projectID, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tasks", reflect.TypeOf((*MockProjectMemberService)(nil).Tasks), ctx, userID, projectID, page, limit)
+}
+
+// UpdateTask mocks base method.
+func (m *MockProjectMemberService) UpdateTask(ctx context.Context, userID, projectID, taskID primitive.ObjectID, req *task.UpdateTaskRequest) (*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTask", ctx, userID, projectID, taskID, req)
+	ret0, _ := ret[0].(*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTask indicates an expected call of UpdateTask.
+func (mr *MockProjectMemberServiceMockRecorder) UpdateTask(ctx, userID, projectID, taskID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTask", reflect.TypeOf((*MockProjectMemberService)(nil).UpdateTask), ctx, userID, projectID, taskID, req)
+}
+
+// UpdateTaskStatus mocks base method.
+func (m *MockProjectMemberService) UpdateTaskStatus(ctx context.Context, userID, projectID, taskID primitive.ObjectID, status enums.TaskStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskStatus", ctx, userID, projectID, taskID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTaskStatus indicates an expected call of UpdateTaskStatus.
+func (mr *MockProjectMemberServiceMockRecorder) UpdateTaskStatus(ctx, userID, projectID, taskID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskStatus", reflect.TypeOf((*MockProjectMemberService)(nil).UpdateTaskStatus), ctx, userID, projectID, taskID, status)
+}
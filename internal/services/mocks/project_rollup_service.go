@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: project_rollup_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=project_rollup_service.go -destination=mocks/project_rollup_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	events "github.com/devgugga/todo-it/internal/events"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProjectRollupService is a mock of ProjectRollupService interface.
+type MockProjectRollupService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectRollupServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectRollupServiceMockRecorder is the mock recorder for MockProjectRollupService.
+type MockProjectRollupServiceMockRecorder struct {
+	mock *MockProjectRollupService
+}
+
+// NewMockProjectRollupService creates a new mock instance.
+func NewMockProjectRollupService(ctrl *gomock.Controller) *MockProjectRollupService {
+	mock := &MockProjectRollupService{ctrl: ctrl}
+	mock.recorder = &MockProjectRollupServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectRollupService) EXPECT() *MockProjectRollupServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockProjectRollupService) Get(ctx context.Context, userID, projectID primitive.ObjectID, fresh bool) (*entities.ProjectRollup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID, projectID, fresh)
+	ret0, _ := ret[0].(*entities.ProjectRollup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockProjectRollupServiceMockRecorder) Get(ctx, userID, projectID, fresh any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProjectRollupService)(nil).Get), ctx, userID, projectID, fresh)
+}
+
+// Subscribe mocks base method.
+func (m *MockProjectRollupService) Subscribe(bus events.Bus) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Subscribe", bus)
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockProjectRollupServiceMockRecorder) Subscribe(bus any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockProjectRollupService)(nil).Subscribe), bus)
+}
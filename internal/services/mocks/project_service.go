@@ -0,0 +1,134 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: project_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=project_service.go -destination=mocks/project_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	project "github.com/devgugga/todo-it/internal/dtos/requests/project"
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProjectService is a mock of ProjectService interface.
+type MockProjectService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectServiceMockRecorder is the mock recorder for MockProjectService.
+type MockProjectServiceMockRecorder struct {
+	mock *MockProjectService
+}
+
+// NewMockProjectService creates a new mock instance.
+func NewMockProjectService(ctrl *gomock.Controller) *MockProjectService {
+	mock := &MockProjectService{ctrl: ctrl}
+	mock.recorder = &MockProjectServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectService) EXPECT() *MockProjectServiceMockRecorder {
+	return m.recorder
+}
+
+// Activity mocks base method.
+func (m *MockProjectService) Activity(ctx context.Context, userID, id primitive.ObjectID, page, limit int64) ([]*entities.TaskChange, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Activity", ctx, userID, id, page, limit)
+	ret0, _ := ret[0].([]*entities.TaskChange)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Activity indicates an expected call of Activity.
+func (mr *MockProjectServiceMockRecorder) Activity(ctx, userID, id, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activity", reflect.TypeOf((*MockProjectService)(nil).Activity), ctx, userID, id, page, limit)
+}
+
+// Create mocks base method.
+func (m *MockProjectService) Create(ctx context.Context, userID primitive.ObjectID, req *project.CreateProjectRequest) (*entities.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, userID, req)
+	ret0, _ := ret[0].(*entities.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProjectServiceMockRecorder) Create(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectService)(nil).Create), ctx, userID, req)
+}
+
+// Delete mocks base method.
+func (m *MockProjectService) Delete(ctx context.Context, userID, id primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockProjectServiceMockRecorder) Delete(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProjectService)(nil).Delete), ctx, userID, id)
+}
+
+// GetByID mocks base method.
+func (m *MockProjectService) GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, userID, id)
+	ret0, _ := ret[0].(*entities.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockProjectServiceMockRecorder) GetByID(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockProjectService)(nil).GetByID), ctx, userID, id)
+}
+
+// List mocks base method.
+func (m *MockProjectService) List(ctx context.Context, userID primitive.ObjectID) ([]*entities.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userID)
+	ret0, _ := ret[0].([]*entities.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockProjectServiceMockRecorder) List(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjectService)(nil).List), ctx, userID)
+}
+
+// Update mocks base method.
+func (m *MockProjectService) Update(ctx context.Context, userID, id primitive.ObjectID, req *project.UpdateProjectRequest) (*entities.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, userID, id, req)
+	ret0, _ := ret[0].(*entities.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockProjectServiceMockRecorder) Update(ctx, userID, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProjectService)(nil).Update), ctx, userID, id, req)
+}
@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: retention_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=retention_service.go -destination=mocks/retention_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	services "github.com/devgugga/todo-it/internal/services"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRetentionService is a mock of RetentionService interface.
+type MockRetentionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRetentionServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockRetentionServiceMockRecorder is the mock recorder for MockRetentionService.
+type MockRetentionServiceMockRecorder struct {
+	mock *MockRetentionService
+}
+
+// NewMockRetentionService creates a new mock instance.
+func NewMockRetentionService(ctrl *gomock.Controller) *MockRetentionService {
+	mock := &MockRetentionService{ctrl: ctrl}
+	mock.recorder = &MockRetentionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRetentionService) EXPECT() *MockRetentionServiceMockRecorder {
+	return m.recorder
+}
+
+// Apply mocks base method.
+func (m *MockRetentionService) Apply(ctx context.Context) (*services.RetentionReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Apply", ctx)
+	ret0, _ := ret[0].(*services.RetentionReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Apply indicates an expected call of Apply.
+func (mr *MockRetentionServiceMockRecorder) Apply(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockRetentionService)(nil).Apply), ctx)
+}
+
+// DeleteOverride mocks base method.
+func (m *MockRetentionService) DeleteOverride(ctx context.Context, userID primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOverride", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOverride indicates an expected call of DeleteOverride.
+func (mr *MockRetentionServiceMockRecorder) DeleteOverride(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOverride", reflect.TypeOf((*MockRetentionService)(nil).DeleteOverride), ctx, userID)
+}
+
+// ListOverrides mocks base method.
+func (m *MockRetentionService) ListOverrides(ctx context.Context) ([]*entities.RetentionOverride, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverrides", ctx)
+	ret0, _ := ret[0].([]*entities.RetentionOverride)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverrides indicates an expected call of ListOverrides.
+func (mr *MockRetentionServiceMockRecorder) ListOverrides(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverrides", reflect.TypeOf((*MockRetentionService)(nil).ListOverrides), ctx)
+}
+
+// Report mocks base method.
+func (m *MockRetentionService) Report(ctx context.Context) (*services.RetentionReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", ctx)
+	ret0, _ := ret[0].(*services.RetentionReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockRetentionServiceMockRecorder) Report(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockRetentionService)(nil).Report), ctx)
+}
+
+// SetOverride mocks base method.
+func (m *MockRetentionService) SetOverride(ctx context.Context, userID primitive.ObjectID, days map[string]int) (*entities.RetentionOverride, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOverride", ctx, userID, days)
+	ret0, _ := ret[0].(*entities.RetentionOverride)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetOverride indicates an expected call of SetOverride.
+func (mr *MockRetentionServiceMockRecorder) SetOverride(ctx, userID, days any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOverride", reflect.TypeOf((*MockRetentionService)(nil).SetOverride), ctx, userID, days)
+}
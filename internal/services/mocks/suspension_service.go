@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: suspension_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=suspension_service.go -destination=mocks/suspension_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSuspensionService is a mock of SuspensionService interface.
+type MockSuspensionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSuspensionServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSuspensionServiceMockRecorder is the mock recorder for MockSuspensionService.
+type MockSuspensionServiceMockRecorder struct {
+	mock *MockSuspensionService
+}
+
+// NewMockSuspensionService creates a new mock instance.
+func NewMockSuspensionService(ctrl *gomock.Controller) *MockSuspensionService {
+	mock := &MockSuspensionService{ctrl: ctrl}
+	mock.recorder = &MockSuspensionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSuspensionService) EXPECT() *MockSuspensionServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckAccess mocks base method.
+func (m *MockSuspensionService) CheckAccess(ctx context.Context, userID primitive.ObjectID, issuedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAccess", ctx, userID, issuedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAccess indicates an expected call of CheckAccess.
+func (mr *MockSuspensionServiceMockRecorder) CheckAccess(ctx, userID, issuedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccess", reflect.TypeOf((*MockSuspensionService)(nil).CheckAccess), ctx, userID, issuedAt)
+}
+
+// Suspend mocks base method.
+func (m *MockSuspensionService) Suspend(ctx context.Context, userID primitive.ObjectID, reason string) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suspend", ctx, userID, reason)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suspend indicates an expected call of Suspend.
+func (mr *MockSuspensionServiceMockRecorder) Suspend(ctx, userID, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suspend", reflect.TypeOf((*MockSuspensionService)(nil).Suspend), ctx, userID, reason)
+}
+
+// Unsuspend mocks base method.
+func (m *MockSuspensionService) Unsuspend(ctx context.Context, userID primitive.ObjectID) (*entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsuspend", ctx, userID)
+	ret0, _ := ret[0].(*entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unsuspend indicates an expected call of Unsuspend.
+func (mr *MockSuspensionServiceMockRecorder) Unsuspend(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsuspend", reflect.TypeOf((*MockSuspensionService)(nil).Unsuspend), ctx, userID)
+}
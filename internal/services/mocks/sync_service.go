@@ -0,0 +1,89 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sync_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=sync_service.go -destination=mocks/sync_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	task "github.com/devgugga/todo-it/internal/dtos/requests/task"
+	services "github.com/devgugga/todo-it/internal/services"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSyncService is a mock of SyncService interface.
+type MockSyncService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSyncServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSyncServiceMockRecorder is the mock recorder for MockSyncService.
+type MockSyncServiceMockRecorder struct {
+	mock *MockSyncService
+}
+
+// NewMockSyncService creates a new mock instance.
+func NewMockSyncService(ctrl *gomock.Controller) *MockSyncService {
+	mock := &MockSyncService{ctrl: ctrl}
+	mock.recorder = &MockSyncServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSyncService) EXPECT() *MockSyncServiceMockRecorder {
+	return m.recorder
+}
+
+// Deleted mocks base method.
+func (m *MockSyncService) Deleted(ctx context.Context, userID primitive.ObjectID, entityType, since string) (*services.TombstonePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deleted", ctx, userID, entityType, since)
+	ret0, _ := ret[0].(*services.TombstonePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deleted indicates an expected call of Deleted.
+func (mr *MockSyncServiceMockRecorder) Deleted(ctx, userID, entityType, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deleted", reflect.TypeOf((*MockSyncService)(nil).Deleted), ctx, userID, entityType, since)
+}
+
+// Pull mocks base method.
+func (m *MockSyncService) Pull(ctx context.Context, userID primitive.ObjectID, since string, limit int64) (*services.SyncPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pull", ctx, userID, since, limit)
+	ret0, _ := ret[0].(*services.SyncPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pull indicates an expected call of Pull.
+func (mr *MockSyncServiceMockRecorder) Pull(ctx, userID, since, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pull", reflect.TypeOf((*MockSyncService)(nil).Pull), ctx, userID, since, limit)
+}
+
+// Push mocks base method.
+func (m *MockSyncService) Push(ctx context.Context, userID primitive.ObjectID, changes []task.SyncChangeRequest) ([]services.SyncResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Push", ctx, userID, changes)
+	ret0, _ := ret[0].([]services.SyncResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Push indicates an expected call of Push.
+func (mr *MockSyncServiceMockRecorder) Push(ctx, userID, changes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockSyncService)(nil).Push), ctx, userID, changes)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: task_change_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=task_change_service.go -destination=mocks/task_change_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	events "github.com/devgugga/todo-it/internal/events"
+	services "github.com/devgugga/todo-it/internal/services"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaskChangeService is a mock of TaskChangeService interface.
+type MockTaskChangeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskChangeServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskChangeServiceMockRecorder is the mock recorder for MockTaskChangeService.
+type MockTaskChangeServiceMockRecorder struct {
+	mock *MockTaskChangeService
+}
+
+// NewMockTaskChangeService creates a new mock instance.
+func NewMockTaskChangeService(ctrl *gomock.Controller) *MockTaskChangeService {
+	mock := &MockTaskChangeService{ctrl: ctrl}
+	mock.recorder = &MockTaskChangeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskChangeService) EXPECT() *MockTaskChangeServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockTaskChangeService) List(ctx context.Context, userID primitive.ObjectID, since string, types []string, limit int64) (*services.TaskChangePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userID, since, types, limit)
+	ret0, _ := ret[0].(*services.TaskChangePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockTaskChangeServiceMockRecorder) List(ctx, userID, since, types, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTaskChangeService)(nil).List), ctx, userID, since, types, limit)
+}
+
+// Subscribe mocks base method.
+func (m *MockTaskChangeService) Subscribe(bus events.Bus) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Subscribe", bus)
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockTaskChangeServiceMockRecorder) Subscribe(bus any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockTaskChangeService)(nil).Subscribe), bus)
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: task_pdf_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=task_pdf_service.go -destination=mocks/task_pdf_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	services "github.com/devgugga/todo-it/internal/services"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaskPDFService is a mock of TaskPDFService interface.
+type MockTaskPDFService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskPDFServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskPDFServiceMockRecorder is the mock recorder for MockTaskPDFService.
+type MockTaskPDFServiceMockRecorder struct {
+	mock *MockTaskPDFService
+}
+
+// NewMockTaskPDFService creates a new mock instance.
+func NewMockTaskPDFService(ctrl *gomock.Controller) *MockTaskPDFService {
+	mock := &MockTaskPDFService{ctrl: ctrl}
+	mock.recorder = &MockTaskPDFServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskPDFService) EXPECT() *MockTaskPDFServiceMockRecorder {
+	return m.recorder
+}
+
+// Download mocks base method.
+func (m *MockTaskPDFService) Download(ctx context.Context, userID, id primitive.ObjectID) (*entities.ExportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", ctx, userID, id)
+	ret0, _ := ret[0].(*entities.ExportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockTaskPDFServiceMockRecorder) Download(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockTaskPDFService)(nil).Download), ctx, userID, id)
+}
+
+// Export mocks base method.
+func (m *MockTaskPDFService) Export(ctx context.Context, userID primitive.ObjectID, query entities.ExportQuery) (*services.PDFExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, userID, query)
+	ret0, _ := ret[0].(*services.PDFExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockTaskPDFServiceMockRecorder) Export(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockTaskPDFService)(nil).Export), ctx, userID, query)
+}
+
+// Job mocks base method.
+func (m *MockTaskPDFService) Job(ctx context.Context, userID, id primitive.ObjectID) (*entities.ExportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Job", ctx, userID, id)
+	ret0, _ := ret[0].(*entities.ExportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Job indicates an expected call of Job.
+func (mr *MockTaskPDFServiceMockRecorder) Job(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockTaskPDFService)(nil).Job), ctx, userID, id)
+}
+
+// ProcessPending mocks base method.
+func (m *MockTaskPDFService) ProcessPending(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessPending", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessPending indicates an expected call of ProcessPending.
+func (mr *MockTaskPDFServiceMockRecorder) ProcessPending(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessPending", reflect.TypeOf((*MockTaskPDFService)(nil).ProcessPending), ctx)
+}
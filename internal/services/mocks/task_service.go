@@ -0,0 +1,360 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: task_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=task_service.go -destination=mocks/task_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	task "github.com/devgugga/todo-it/internal/dtos/requests/task"
+	entities "github.com/devgugga/todo-it/internal/entities"
+	enums "github.com/devgugga/todo-it/internal/enums"
+	repositories "github.com/devgugga/todo-it/internal/repositories"
+	services "github.com/devgugga/todo-it/internal/services"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaskService is a mock of TaskService interface.
+type MockTaskService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskServiceMockRecorder is the mock recorder for MockTaskService.
+type MockTaskServiceMockRecorder struct {
+	mock *MockTaskService
+}
+
+// NewMockTaskService creates a new mock instance.
+func NewMockTaskService(ctrl *gomock.Controller) *MockTaskService {
+	mock := &MockTaskService{ctrl: ctrl}
+	mock.recorder = &MockTaskServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskService) EXPECT() *MockTaskServiceMockRecorder {
+	return m.recorder
+}
+
+// BulkDelete mocks base method.
+func (m *MockTaskService) BulkDelete(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkDelete", ctx, userID, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkDelete indicates an expected call of BulkDelete.
+func (mr *MockTaskServiceMockRecorder) BulkDelete(ctx, userID, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkDelete", reflect.TypeOf((*MockTaskService)(nil).BulkDelete), ctx, userID, ids)
+}
+
+// BulkUpdateStatus mocks base method.
+func (m *MockTaskService) BulkUpdateStatus(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID, status enums.TaskStatus) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpdateStatus", ctx, userID, ids, status)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkUpdateStatus indicates an expected call of BulkUpdateStatus.
+func (mr *MockTaskServiceMockRecorder) BulkUpdateStatus(ctx, userID, ids, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpdateStatus", reflect.TypeOf((*MockTaskService)(nil).BulkUpdateStatus), ctx, userID, ids, status)
+}
+
+// Complete mocks base method.
+func (m *MockTaskService) Complete(ctx context.Context, userID, id primitive.ObjectID) (*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, userID, id)
+	ret0, _ := ret[0].(*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockTaskServiceMockRecorder) Complete(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockTaskService)(nil).Complete), ctx, userID, id)
+}
+
+// Create mocks base method.
+func (m *MockTaskService) Create(ctx context.Context, userID primitive.ObjectID, req *task.CreateTaskRequest) (*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, userID, req)
+	ret0, _ := ret[0].(*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTaskServiceMockRecorder) Create(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTaskService)(nil).Create), ctx, userID, req)
+}
+
+// CreateWithID mocks base method.
+func (m *MockTaskService) CreateWithID(ctx context.Context, userID, id primitive.ObjectID, req *task.CreateTaskRequest) (*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithID", ctx, userID, id, req)
+	ret0, _ := ret[0].(*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWithID indicates an expected call of CreateWithID.
+func (mr *MockTaskServiceMockRecorder) CreateWithID(ctx, userID, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithID", reflect.TypeOf((*MockTaskService)(nil).CreateWithID), ctx, userID, id, req)
+}
+
+// Delete mocks base method.
+func (m *MockTaskService) Delete(ctx context.Context, userID, id primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTaskServiceMockRecorder) Delete(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaskService)(nil).Delete), ctx, userID, id)
+}
+
+// Export mocks base method.
+func (m *MockTaskService) Export(ctx context.Context, userID primitive.ObjectID, includeArchived bool) (*services.TaskExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, userID, includeArchived)
+	ret0, _ := ret[0].(*services.TaskExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockTaskServiceMockRecorder) Export(ctx, userID, includeArchived any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockTaskService)(nil).Export), ctx, userID, includeArchived)
+}
+
+// GetByID mocks base method.
+func (m *MockTaskService) GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, userID, id)
+	ret0, _ := ret[0].(*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTaskServiceMockRecorder) GetByID(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTaskService)(nil).GetByID), ctx, userID, id)
+}
+
+// GetOverdue mocks base method.
+func (m *MockTaskService) GetOverdue(ctx context.Context, userID primitive.ObjectID) ([]*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverdue", ctx, userID)
+	ret0, _ := ret[0].([]*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverdue indicates an expected call of GetOverdue.
+func (mr *MockTaskServiceMockRecorder) GetOverdue(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverdue", reflect.TypeOf((*MockTaskService)(nil).GetOverdue), ctx, userID)
+}
+
+// List mocks base method.
+func (m *MockTaskService) List(ctx context.Context, userID primitive.ObjectID, page, limit int64, filters *repositories.TaskFilters) ([]*entities.Task, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userID, page, limit, filters)
+	ret0, _ := ret[0].([]*entities.Task)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockTaskServiceMockRecorder) List(ctx, userID, page, limit, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTaskService)(nil).List), ctx, userID, page, limit, filters)
+}
+
+// ListNearby mocks base method.
+func (m *MockTaskService) ListNearby(ctx context.Context, userID primitive.ObjectID, query repositories.NearbyQuery) ([]*repositories.NearbyTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNearby", ctx, userID, query)
+	ret0, _ := ret[0].([]*repositories.NearbyTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNearby indicates an expected call of ListNearby.
+func (mr *MockTaskServiceMockRecorder) ListNearby(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNearby", reflect.TypeOf((*MockTaskService)(nil).ListNearby), ctx, userID, query)
+}
+
+// Occurrences mocks base method.
+func (m *MockTaskService) Occurrences(ctx context.Context, userID, id primitive.ObjectID, count int) ([]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Occurrences", ctx, userID, id, count)
+	ret0, _ := ret[0].([]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Occurrences indicates an expected call of Occurrences.
+func (mr *MockTaskServiceMockRecorder) Occurrences(ctx, userID, id, count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Occurrences", reflect.TypeOf((*MockTaskService)(nil).Occurrences), ctx, userID, id, count)
+}
+
+// Reopen mocks base method.
+func (m *MockTaskService) Reopen(ctx context.Context, userID, id primitive.ObjectID) (*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reopen", ctx, userID, id)
+	ret0, _ := ret[0].(*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reopen indicates an expected call of Reopen.
+func (mr *MockTaskServiceMockRecorder) Reopen(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reopen", reflect.TypeOf((*MockTaskService)(nil).Reopen), ctx, userID, id)
+}
+
+// Reorder mocks base method.
+func (m *MockTaskService) Reorder(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID) ([]repositories.TaskPosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", ctx, userID, ids)
+	ret0, _ := ret[0].([]repositories.TaskPosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockTaskServiceMockRecorder) Reorder(ctx, userID, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockTaskService)(nil).Reorder), ctx, userID, ids)
+}
+
+// Save mocks base method.
+func (m *MockTaskService) Save(ctx context.Context, userID primitive.ObjectID, arg2 *entities.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, userID, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockTaskServiceMockRecorder) Save(ctx, userID, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockTaskService)(nil).Save), ctx, userID, arg2)
+}
+
+// SetChecklistItemDone mocks base method.
+func (m *MockTaskService) SetChecklistItemDone(ctx context.Context, userID, id, itemID primitive.ObjectID, done bool) (*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChecklistItemDone", ctx, userID, id, itemID, done)
+	ret0, _ := ret[0].(*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetChecklistItemDone indicates an expected call of SetChecklistItemDone.
+func (mr *MockTaskServiceMockRecorder) SetChecklistItemDone(ctx, userID, id, itemID, done any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChecklistItemDone", reflect.TypeOf((*MockTaskService)(nil).SetChecklistItemDone), ctx, userID, id, itemID, done)
+}
+
+// SetCustomStatus mocks base method.
+func (m *MockTaskService) SetCustomStatus(ctx context.Context, userID, id, statusID primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCustomStatus", ctx, userID, id, statusID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCustomStatus indicates an expected call of SetCustomStatus.
+func (mr *MockTaskServiceMockRecorder) SetCustomStatus(ctx, userID, id, statusID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCustomStatus", reflect.TypeOf((*MockTaskService)(nil).SetCustomStatus), ctx, userID, id, statusID)
+}
+
+// Snooze mocks base method.
+func (m *MockTaskService) Snooze(ctx context.Context, userID, id primitive.ObjectID, preset string) (*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snooze", ctx, userID, id, preset)
+	ret0, _ := ret[0].(*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Snooze indicates an expected call of Snooze.
+func (mr *MockTaskServiceMockRecorder) Snooze(ctx, userID, id, preset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snooze", reflect.TypeOf((*MockTaskService)(nil).Snooze), ctx, userID, id, preset)
+}
+
+// Suggest mocks base method.
+func (m *MockTaskService) Suggest(ctx context.Context, userID primitive.ObjectID, query string, limit int64) (*repositories.TaskSuggestions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", ctx, userID, query, limit)
+	ret0, _ := ret[0].(*repositories.TaskSuggestions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockTaskServiceMockRecorder) Suggest(ctx, userID, query, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockTaskService)(nil).Suggest), ctx, userID, query, limit)
+}
+
+// Update mocks base method.
+func (m *MockTaskService) Update(ctx context.Context, userID, id primitive.ObjectID, req *task.UpdateTaskRequest) (*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, userID, id, req)
+	ret0, _ := ret[0].(*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTaskServiceMockRecorder) Update(ctx, userID, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaskService)(nil).Update), ctx, userID, id, req)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockTaskService) UpdateStatus(ctx context.Context, userID, id primitive.ObjectID, status enums.TaskStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, userID, id, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockTaskServiceMockRecorder) UpdateStatus(ctx, userID, id, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockTaskService)(nil).UpdateStatus), ctx, userID, id, status)
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: task_stats_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=task_stats_service.go -destination=mocks/task_stats_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	events "github.com/devgugga/todo-it/internal/events"
+	repositories "github.com/devgugga/todo-it/internal/repositories"
+	services "github.com/devgugga/todo-it/internal/services"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaskStatsService is a mock of TaskStatsService interface.
+type MockTaskStatsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskStatsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskStatsServiceMockRecorder is the mock recorder for MockTaskStatsService.
+type MockTaskStatsServiceMockRecorder struct {
+	mock *MockTaskStatsService
+}
+
+// NewMockTaskStatsService creates a new mock instance.
+func NewMockTaskStatsService(ctrl *gomock.Controller) *MockTaskStatsService {
+	mock := &MockTaskStatsService{ctrl: ctrl}
+	mock.recorder = &MockTaskStatsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskStatsService) EXPECT() *MockTaskStatsServiceMockRecorder {
+	return m.recorder
+}
+
+// CompletedSeries mocks base method.
+func (m *MockTaskStatsService) CompletedSeries(ctx context.Context, userID primitive.ObjectID, granularity services.SeriesGranularity, days int, loc *time.Location) (*services.CompletedSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompletedSeries", ctx, userID, granularity, days, loc)
+	ret0, _ := ret[0].(*services.CompletedSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompletedSeries indicates an expected call of CompletedSeries.
+func (mr *MockTaskStatsServiceMockRecorder) CompletedSeries(ctx, userID, granularity, days, loc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompletedSeries", reflect.TypeOf((*MockTaskStatsService)(nil).CompletedSeries), ctx, userID, granularity, days, loc)
+}
+
+// Get mocks base method.
+func (m *MockTaskStatsService) Get(ctx context.Context, userID primitive.ObjectID, fresh bool) (*repositories.TaskStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID, fresh)
+	ret0, _ := ret[0].(*repositories.TaskStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTaskStatsServiceMockRecorder) Get(ctx, userID, fresh any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTaskStatsService)(nil).Get), ctx, userID, fresh)
+}
+
+// Subscribe mocks base method.
+func (m *MockTaskStatsService) Subscribe(bus events.Bus) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Subscribe", bus)
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockTaskStatsServiceMockRecorder) Subscribe(bus any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockTaskStatsService)(nil).Subscribe), bus)
+}
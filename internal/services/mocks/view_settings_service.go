@@ -0,0 +1,74 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: view_settings_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=view_settings_service.go -destination=mocks/view_settings_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	view "github.com/devgugga/todo-it/internal/dtos/requests/view"
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockViewSettingsService is a mock of ViewSettingsService interface.
+type MockViewSettingsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockViewSettingsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockViewSettingsServiceMockRecorder is the mock recorder for MockViewSettingsService.
+type MockViewSettingsServiceMockRecorder struct {
+	mock *MockViewSettingsService
+}
+
+// NewMockViewSettingsService creates a new mock instance.
+func NewMockViewSettingsService(ctrl *gomock.Controller) *MockViewSettingsService {
+	mock := &MockViewSettingsService{ctrl: ctrl}
+	mock.recorder = &MockViewSettingsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockViewSettingsService) EXPECT() *MockViewSettingsServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockViewSettingsService) Get(ctx context.Context, userID primitive.ObjectID, projectID *primitive.ObjectID) (*entities.ViewSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID, projectID)
+	ret0, _ := ret[0].(*entities.ViewSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockViewSettingsServiceMockRecorder) Get(ctx, userID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockViewSettingsService)(nil).Get), ctx, userID, projectID)
+}
+
+// Save mocks base method.
+func (m *MockViewSettingsService) Save(ctx context.Context, userID primitive.ObjectID, projectID *primitive.ObjectID, req *view.UpdateViewSettingsRequest) (*entities.ViewSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, userID, projectID, req)
+	ret0, _ := ret[0].(*entities.ViewSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockViewSettingsServiceMockRecorder) Save(ctx, userID, projectID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockViewSettingsService)(nil).Save), ctx, userID, projectID, req)
+}
@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhook_delivery_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=webhook_delivery_service.go -destination=mocks/webhook_delivery_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/devgugga/todo-it/internal/entities"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookSender is a mock of WebhookSender interface.
+type MockWebhookSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSenderMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookSenderMockRecorder is the mock recorder for MockWebhookSender.
+type MockWebhookSenderMockRecorder struct {
+	mock *MockWebhookSender
+}
+
+// NewMockWebhookSender creates a new mock instance.
+func NewMockWebhookSender(ctrl *gomock.Controller) *MockWebhookSender {
+	mock := &MockWebhookSender{ctrl: ctrl}
+	mock.recorder = &MockWebhookSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookSender) EXPECT() *MockWebhookSenderMockRecorder {
+	return m.recorder
+}
+
+// Deliver mocks base method.
+func (m *MockWebhookSender) Deliver(ctx context.Context, userID primitive.ObjectID, target, eventID string, payload []byte, redeliveryOf *primitive.ObjectID) *entities.WebhookDelivery {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deliver", ctx, userID, target, eventID, payload, redeliveryOf)
+	ret0, _ := ret[0].(*entities.WebhookDelivery)
+	return ret0
+}
+
+// Deliver indicates an expected call of Deliver.
+func (mr *MockWebhookSenderMockRecorder) Deliver(ctx, userID, target, eventID, payload, redeliveryOf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deliver", reflect.TypeOf((*MockWebhookSender)(nil).Deliver), ctx, userID, target, eventID, payload, redeliveryOf)
+}
+
+// MockWebhookDeliveryService is a mock of WebhookDeliveryService interface.
+type MockWebhookDeliveryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDeliveryServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookDeliveryServiceMockRecorder is the mock recorder for MockWebhookDeliveryService.
+type MockWebhookDeliveryServiceMockRecorder struct {
+	mock *MockWebhookDeliveryService
+}
+
+// NewMockWebhookDeliveryService creates a new mock instance.
+func NewMockWebhookDeliveryService(ctrl *gomock.Controller) *MockWebhookDeliveryService {
+	mock := &MockWebhookDeliveryService{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeliveryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookDeliveryService) EXPECT() *MockWebhookDeliveryServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockWebhookDeliveryService) Get(ctx context.Context, userID, id primitive.ObjectID) (*entities.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID, id)
+	ret0, _ := ret[0].(*entities.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWebhookDeliveryServiceMockRecorder) Get(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWebhookDeliveryService)(nil).Get), ctx, userID, id)
+}
+
+// List mocks base method.
+func (m *MockWebhookDeliveryService) List(ctx context.Context, userID primitive.ObjectID, page, limit int64) ([]*entities.WebhookDelivery, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userID, page, limit)
+	ret0, _ := ret[0].([]*entities.WebhookDelivery)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockWebhookDeliveryServiceMockRecorder) List(ctx, userID, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWebhookDeliveryService)(nil).List), ctx, userID, page, limit)
+}
+
+// Redeliver mocks base method.
+func (m *MockWebhookDeliveryService) Redeliver(ctx context.Context, userID, id primitive.ObjectID) (*entities.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeliver", ctx, userID, id)
+	ret0, _ := ret[0].(*entities.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeliver indicates an expected call of Redeliver.
+func (mr *MockWebhookDeliveryServiceMockRecorder) Redeliver(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeliver", reflect.TypeOf((*MockWebhookDeliveryService)(nil).Redeliver), ctx, userID, id)
+}
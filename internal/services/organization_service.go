@@ -0,0 +1,260 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/devgugga/todo-it/internal/clock"
+	orgreq "github.com/devgugga/todo-it/internal/dtos/requests/organization"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/policy"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// OrganizationService interface define as organizações: os assentos, os
+// membros que os ocupam e o relatório de uso, calculado periodicamente pelo
+// job org-usage. As tarefas e anexos continuam pertencendo a cada usuário.
+// Um usuário só passa a ocupar um assento (e a ter o uso relatado ao dono)
+// depois de aceitar o convite.
+type OrganizationService interface {
+	Create(ctx context.Context, userID primitive.ObjectID, req *orgreq.CreateOrganizationRequest) (*entities.Organization, error)
+	List(ctx context.Context, userID primitive.ObjectID) ([]*entities.Organization, error)
+	Get(ctx context.Context, userID, id primitive.ObjectID) (*entities.Organization, error)
+	Invite(ctx context.Context, userID, id primitive.ObjectID, req *orgreq.InviteOrganizationMemberRequest) error
+	Invites(ctx context.Context, userID primitive.ObjectID) ([]*entities.Organization, string, error)
+	AcceptInvite(ctx context.Context, userID, id primitive.ObjectID) (*entities.Organization, error)
+	DeclineInvite(ctx context.Context, userID, id primitive.ObjectID) error
+	RemoveMember(ctx context.Context, userID, id, memberID primitive.ObjectID) error
+	Usage(ctx context.Context, userID, id primitive.ObjectID) (*entities.OrgUsage, error)
+	RefreshUsage(ctx context.Context) error
+}
+
+// organizationService implementa OrganizationService
+type organizationService struct {
+	organizations repositories.OrganizationRepository
+	usage         repositories.OrgUsageRepository
+	users         repositories.UserRepository
+	clock         clock.Clock
+}
+
+// NewOrganizationService cria uma nova instância do serviço
+func NewOrganizationService(organizations repositories.OrganizationRepository, usage repositories.OrgUsageRepository, users repositories.UserRepository) OrganizationService {
+	return NewOrganizationServiceWithClock(organizations, usage, users, clock.System())
+}
+
+// NewOrganizationServiceWithClock cria o serviço com o relógio informado, usado
+// na criação, nos convites e no cálculo do uso
+func NewOrganizationServiceWithClock(organizations repositories.OrganizationRepository, usage repositories.OrgUsageRepository, users repositories.UserRepository, clk clock.Clock) OrganizationService {
+	return &organizationService{organizations: organizations, usage: usage, users: users, clock: clk}
+}
+
+// Create cria a organização com o usuário como dono
+func (s *organizationService) Create(ctx context.Context, userID primitive.ObjectID, req *orgreq.CreateOrganizationRequest) (*entities.Organization, error) {
+	organization := req.ToEntity()
+	organization.PrepareForCreateAt(userID, s.clock.Now())
+
+	if err := s.organizations.Create(ctx, organization); err != nil {
+		return nil, err
+	}
+
+	return organization, nil
+}
+
+// List lista as organizações de que o usuário é dono ou membro
+func (s *organizationService) List(ctx context.Context, userID primitive.ObjectID) ([]*entities.Organization, error) {
+	return s.organizations.ListByUser(ctx, userID)
+}
+
+// Get busca uma organização visível ao usuário (dono ou membro)
+func (s *organizationService) Get(ctx context.Context, userID, id primitive.ObjectID) (*entities.Organization, error) {
+	return s.authorizedOrganization(ctx, userID, id, policy.CanViewOrganization)
+}
+
+// Invite convida o email para a organização. O resultado não depende de o
+// email ter conta, para que o convite não revele quais emails estão cadastrados.
+func (s *organizationService) Invite(ctx context.Context, userID, id primitive.ObjectID, req *orgreq.InviteOrganizationMemberRequest) error {
+	organization, err := s.authorizedOrganization(ctx, userID, id, policy.CanManageOrganization)
+	if err != nil {
+		return err
+	}
+	if organization.SeatsUsed() >= organization.Seats {
+		return repositories.ErrOrganizationSeatsFull
+	}
+
+	now := s.clock.Now()
+	invite := entities.OrganizationInvite{
+		Email:     entities.NormalizeEmail(req.Email),
+		InvitedAt: now,
+		ExpiresAt: now.Add(entities.OrganizationInviteTTL),
+	}
+
+	return organizationError(s.organizations.Invite(ctx, organization.OwnerID, id, invite))
+}
+
+// Invites lista as organizações com convite pendente para o email do usuário
+// e retorna também o email, usado para localizar o convite em cada uma
+func (s *organizationService) Invites(ctx context.Context, userID primitive.ObjectID) ([]*entities.Organization, string, error) {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	email := entities.NormalizeEmail(user.Email)
+	organizations, err := s.organizations.ListInvites(ctx, email, s.clock.Now())
+	if err != nil {
+		return nil, "", err
+	}
+
+	return organizations, email, nil
+}
+
+// AcceptInvite ocupa um assento da organização com o usuário convidado
+func (s *organizationService) AcceptInvite(ctx context.Context, userID, id primitive.ObjectID) (*entities.Organization, error) {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	member := entities.OrganizationMember{UserID: userID, AddedAt: s.clock.Now()}
+	err = s.organizations.AcceptInvite(ctx, id, entities.NormalizeEmail(user.Email), member)
+	if errors.Is(err, repositories.ErrOrganizationInviteNotFound) {
+		return nil, ErrOrganizationInviteNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.organizations.GetByID(ctx, userID, id)
+}
+
+// DeclineInvite recusa o convite da organização para o email do usuário
+func (s *organizationService) DeclineInvite(ctx context.Context, userID, id primitive.ObjectID) error {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	err = s.organizations.DeclineInvite(ctx, id, entities.NormalizeEmail(user.Email))
+	if errors.Is(err, repositories.ErrOrganizationInviteNotFound) {
+		return ErrOrganizationInviteNotFound
+	}
+	return err
+}
+
+// RemoveMember libera o assento de um membro: o dono remove qualquer membro e
+// cada membro pode sair da organização
+func (s *organizationService) RemoveMember(ctx context.Context, userID, id, memberID primitive.ObjectID) error {
+	allowed := policy.CanManageOrganization
+	if userID == memberID {
+		allowed = policy.CanViewOrganization
+	}
+
+	if _, err := s.authorizedOrganization(ctx, userID, id, allowed); err != nil {
+		return err
+	}
+
+	return organizationError(s.organizations.RemoveMember(ctx, id, memberID))
+}
+
+// Usage retorna o último uso calculado pelo job (somente o dono); se ainda
+// não houver, calcula agora
+func (s *organizationService) Usage(ctx context.Context, userID, id primitive.ObjectID) (*entities.OrgUsage, error) {
+	organization, err := s.authorizedOrganization(ctx, userID, id, policy.CanManageOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := s.usage.Get(ctx, id)
+	if errors.Is(err, repositories.ErrOrgUsageNotFound) {
+		return s.refresh(ctx, organization)
+	}
+	return usage, err
+}
+
+// RefreshUsage recalcula e grava o uso de todas as organizações. Uma falha não
+// interrompe o cálculo das demais.
+func (s *organizationService) RefreshUsage(ctx context.Context) error {
+	organizations, err := s.organizations.ListAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, organization := range organizations {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if _, err := s.refresh(ctx, organization); err != nil {
+			logging.FromContext(ctx).Error("erro ao calcular uso da organização", "organization_id", organization.ID.Hex(), "error", err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("erro ao calcular o uso de %d de %d organizações", failed, len(organizations))
+	}
+	return nil
+}
+
+// refresh calcula o uso da organização e o grava para as próximas consultas
+func (s *organizationService) refresh(ctx context.Context, organization *entities.Organization) (*entities.OrgUsage, error) {
+	userIDs := organization.UserIDs()
+
+	tasks, err := s.usage.CountTasksByUser(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	storage, err := s.usage.StorageByUser(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &entities.OrgUsage{
+		OrganizationID: organization.ID,
+		Seats:          organization.Seats,
+		SeatsUsed:      organization.SeatsUsed(),
+		Members:        make([]entities.MemberUsage, 0, len(userIDs)),
+		ComputedAt:     s.clock.Now(),
+	}
+	for _, userID := range userIDs {
+		member := entities.MemberUsage{UserID: userID, TasksCreated: tasks[userID], StorageBytes: storage[userID]}
+		usage.Members = append(usage.Members, member)
+		usage.TasksCreated += member.TasksCreated
+		usage.StorageBytes += member.StorageBytes
+	}
+
+	if err := s.usage.Save(ctx, usage); err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+// authorizedOrganization busca a organização e aplica a regra de autorização informada
+func (s *organizationService) authorizedOrganization(ctx context.Context, userID, id primitive.ObjectID, allowed func(primitive.ObjectID, *entities.Organization) bool) (*entities.Organization, error) {
+	organization, err := s.organizations.GetByID(ctx, userID, id)
+	if err != nil {
+		return nil, organizationError(err)
+	}
+
+	if !policy.CanViewOrganization(userID, organization) {
+		return nil, ErrOrganizationNotFound
+	}
+
+	if !allowed(userID, organization) {
+		return nil, ErrOrganizationForbidden
+	}
+
+	return organization, nil
+}
+
+// organizationError converte o not-found do repositório no erro de domínio
+func organizationError(err error) error {
+	if errors.Is(err, repositories.ErrOrganizationNotFound) {
+		return ErrOrganizationNotFound
+	}
+	return err
+}
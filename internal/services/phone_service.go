@@ -0,0 +1,204 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/integrations/twilio"
+	"github.com/devgugga/todo-it/internal/quota"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// phoneCodeTTL é a validade do código de verificação
+	phoneCodeTTL = 10 * time.Minute
+	// phoneCodeCooldown é o intervalo mínimo entre envios de código
+	phoneCodeCooldown = time.Minute
+	// maxPhoneCodeAttempts limita as tentativas de confirmação por código
+	maxPhoneCodeAttempts = 5
+)
+
+// PhoneService interface define o cadastro e a verificação do telefone usado
+// nos lembretes por SMS
+type PhoneService interface {
+	Get(ctx context.Context, userID primitive.ObjectID) (*entities.PhoneNumber, *quota.Usage, error)
+	StartVerification(ctx context.Context, userID primitive.ObjectID, number string) (*entities.PhoneNumber, error)
+	ConfirmVerification(ctx context.Context, userID primitive.ObjectID, code string) (*entities.PhoneNumber, error)
+	Remove(ctx context.Context, userID primitive.ObjectID) error
+}
+
+// phoneService implementa PhoneService
+type phoneService struct {
+	users  repositories.UserRepository
+	sms    twilio.Client
+	quotas quota.Quota
+}
+
+// NewPhoneService cria uma nova instância do serviço. sms nil indica SMS não
+// configurado: o telefone pode ser consultado e removido, mas não verificado.
+func NewPhoneService(users repositories.UserRepository, sms twilio.Client, quotas quota.Quota) PhoneService {
+	return &phoneService{users: users, sms: sms, quotas: quotas}
+}
+
+// Get retorna o telefone cadastrado e o uso da cota de SMS no mês
+func (s *phoneService) Get(ctx context.Context, userID primitive.ObjectID) (*entities.PhoneNumber, *quota.Usage, error) {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if user.Phone == nil {
+		return nil, nil, ErrPhoneNotFound
+	}
+
+	usage, err := s.quotas.Usage(ctx, userID, quota.ResourceSMS)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user.Phone, usage, nil
+}
+
+// StartVerification cadastra o número (E.164) e envia o código de verificação
+// por SMS. Trocar de número desabilita os lembretes até a nova confirmação. O
+// SMS do código conta na cota mensal do usuário.
+func (s *phoneService) StartVerification(ctx context.Context, userID primitive.ObjectID, number string) (*entities.PhoneNumber, error) {
+	if s.sms == nil {
+		return nil, ErrSMSUnavailable
+	}
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if current := user.Phone; current != nil {
+		if current.Number == number && current.IsVerified() {
+			return current, nil
+		}
+		if current.CodeSentAt != nil && now.Sub(*current.CodeSentAt) < phoneCodeCooldown {
+			return nil, ErrPhoneCodeCooldown
+		}
+	}
+
+	code, err := newPhoneCode()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.quotas.Consume(ctx, userID, quota.ResourceSMS); err != nil {
+		if errors.Is(err, quota.ErrExceeded) {
+			return nil, ErrSMSQuotaExceeded
+		}
+		return nil, err
+	}
+
+	body := fmt.Sprintf("Todo It: seu código de verificação é %s. Ele expira em %d minutos.", code, int(phoneCodeTTL.Minutes()))
+	if err := s.sms.SendSMS(ctx, number, body); err != nil {
+		_ = s.quotas.Release(ctx, userID, quota.ResourceSMS)
+		if errors.Is(err, twilio.ErrInvalidNumber) {
+			return nil, ErrInvalidPhoneNumber
+		}
+		return nil, fmt.Errorf("erro ao enviar código de verificação: %w", err)
+	}
+
+	expiresAt := now.Add(phoneCodeTTL)
+	phone := &entities.PhoneNumber{
+		Number:        number,
+		CodeHash:      hashPhoneCode(userID, code),
+		CodeSentAt:    &now,
+		CodeExpiresAt: &expiresAt,
+	}
+
+	if err := s.users.SetPhone(ctx, userID, phone); err != nil {
+		return nil, err
+	}
+
+	return phone, nil
+}
+
+// ConfirmVerification confirma o número com o código recebido por SMS
+func (s *phoneService) ConfirmVerification(ctx context.Context, userID primitive.ObjectID, code string) (*entities.PhoneNumber, error) {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	phone := user.Phone
+	if phone == nil {
+		return nil, ErrPhoneNotFound
+	}
+	if phone.CodeHash == "" || phone.CodeExpiresAt == nil || time.Now().After(*phone.CodeExpiresAt) ||
+		phone.Attempts >= maxPhoneCodeAttempts {
+		return nil, ErrInvalidPhoneCode
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashPhoneCode(userID, code)), []byte(phone.CodeHash)) != 1 {
+		phone.Attempts++
+		if err := s.users.SetPhone(ctx, userID, phone); err != nil {
+			return nil, err
+		}
+		return nil, ErrInvalidPhoneCode
+	}
+
+	now := time.Now()
+	verified := &entities.PhoneNumber{Number: phone.Number, VerifiedAt: &now}
+	if err := s.users.SetPhone(ctx, userID, verified); err != nil {
+		return nil, err
+	}
+
+	return verified, nil
+}
+
+// Remove apaga o telefone e desabilita o canal sms nas preferências
+func (s *phoneService) Remove(ctx context.Context, userID primitive.ObjectID) error {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.Phone == nil {
+		return ErrPhoneNotFound
+	}
+
+	if err := s.users.SetPhone(ctx, userID, nil); err != nil {
+		return err
+	}
+
+	preferences := user.Preferences
+	channels := make([]string, 0, len(preferences.Notifications.Channels))
+	for _, channel := range preferences.Notifications.Channels {
+		if channel != entities.ChannelSMS {
+			channels = append(channels, channel)
+		}
+	}
+	if len(channels) == len(preferences.Notifications.Channels) {
+		return nil
+	}
+
+	preferences.Notifications.Channels = channels
+	return s.users.UpdatePreferences(ctx, userID, preferences)
+}
+
+// newPhoneCode gera um código numérico de 6 dígitos
+func newPhoneCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", fmt.Errorf("erro ao gerar código de verificação: %w", err)
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// hashPhoneCode calcula o hash do código, vinculado ao usuário
+func hashPhoneCode(userID primitive.ObjectID, code string) string {
+	sum := sha256.Sum256([]byte(userID.Hex() + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
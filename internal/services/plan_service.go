@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/clock"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/plans"
+	"github.com/devgugga/todo-it/internal/quota"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PlanService interface define o plano efetivo de cada usuário e as assinaturas.
+// Também é o quota.LimitSource das cotas: os limites seguem o plano.
+type PlanService interface {
+	Catalog() []*plans.Plan
+	Effective(ctx context.Context, userID primitive.ObjectID) (*plans.Plan, error)
+	Limits(ctx context.Context, userID primitive.ObjectID) (quota.Limits, error)
+	Subscription(ctx context.Context, userID primitive.ObjectID) (*entities.Subscription, error)
+	Assign(ctx context.Context, userID primitive.ObjectID, planID plans.ID, periodEnd *time.Time) (*entities.Subscription, error)
+	Cancel(ctx context.Context, userID primitive.ObjectID) (*entities.Subscription, error)
+	ApplyProviderChange(ctx context.Context, provider string, change *plans.SubscriptionChange) (*entities.Subscription, error)
+}
+
+// planService implementa PlanService
+type planService struct {
+	catalog       *plans.Catalog
+	subscriptions repositories.SubscriptionRepository
+	users         repositories.UserRepository
+	clock         clock.Clock
+}
+
+// NewPlanService cria uma nova instância do serviço
+func NewPlanService(catalog *plans.Catalog, subscriptions repositories.SubscriptionRepository, users repositories.UserRepository) PlanService {
+	return &planService{
+		catalog:       catalog,
+		subscriptions: subscriptions,
+		users:         users,
+		clock:         clock.System(),
+	}
+}
+
+// Catalog lista os planos disponíveis
+func (s *planService) Catalog() []*plans.Plan {
+	return s.catalog.List()
+}
+
+// Effective retorna o plano que vale para o usuário: o anexado à requisição
+// (middleware.AttachPlan) ou o da assinatura válida; sem ela, o gratuito
+func (s *planService) Effective(ctx context.Context, userID primitive.ObjectID) (*plans.Plan, error) {
+	if plan, ok := plans.FromContext(ctx, userID); ok {
+		return plan, nil
+	}
+
+	subscription, err := s.Subscription(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.planFor(ctx, subscription, s.clock.Now()), nil
+}
+
+// planFor retorna o plano que a assinatura concede em now (o gratuito se nenhum)
+func (s *planService) planFor(ctx context.Context, subscription *entities.Subscription, now time.Time) *plans.Plan {
+	if subscription == nil || !subscription.GrantsPlan(now) {
+		return s.catalog.Default()
+	}
+
+	plan, ok := s.catalog.Get(plans.ID(subscription.PlanID))
+	if !ok {
+		logging.FromContext(ctx).Warn("assinatura com plano desconhecido", "plan_id", subscription.PlanID)
+		return s.catalog.Default()
+	}
+
+	return plan
+}
+
+// Limits retorna os limites mensais do plano efetivo do usuário. No mês em que
+// o plano mudou, os limites são proporcionais ao tempo em cada plano (ver
+// plans.Prorate): quem sai do pro mantém a parte da cota já paga.
+func (s *planService) Limits(ctx context.Context, userID primitive.ObjectID) (quota.Limits, error) {
+	subscription, err := s.Subscription(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	plan := s.planFor(ctx, subscription, now)
+	if subscription == nil || subscription.PlanChangedAt == nil {
+		return plan.Limits, nil
+	}
+
+	start, end := quota.Period(now)
+	changedAt := *subscription.PlanChangedAt
+	previous, ok := s.catalog.Get(plans.ID(subscription.PreviousPlanID))
+	if !ok || changedAt.Before(start) {
+		return plan.Limits, nil
+	}
+
+	elapsed := float64(changedAt.Sub(start)) / float64(end.Sub(start))
+	return plans.Prorate(previous.Limits, plan.Limits, elapsed), nil
+}
+
+// Subscription retorna a assinatura do usuário (nil se nunca teve uma)
+func (s *planService) Subscription(ctx context.Context, userID primitive.ObjectID) (*entities.Subscription, error) {
+	subscription, err := s.subscriptions.GetByUserID(ctx, userID)
+	if errors.Is(err, repositories.ErrSubscriptionNotFound) {
+		return nil, nil
+	}
+	return subscription, err
+}
+
+// Assign atribui o plano ao usuário sem cobrança (provider manual), até
+// periodEnd (nil não vence)
+func (s *planService) Assign(ctx context.Context, userID primitive.ObjectID, planID plans.ID, periodEnd *time.Time) (*entities.Subscription, error) {
+	if _, ok := s.catalog.Get(planID); !ok {
+		return nil, ErrUnknownPlan
+	}
+
+	if _, err := s.users.GetByID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return s.save(ctx, userID, &entities.Subscription{
+		PlanID:           string(planID),
+		Status:           entities.SubscriptionActive,
+		Provider:         entities.SubscriptionProviderManual,
+		CurrentPeriodEnd: periodEnd,
+	})
+}
+
+// Cancel cancela a assinatura do usuário, que volta ao plano gratuito
+func (s *planService) Cancel(ctx context.Context, userID primitive.ObjectID) (*entities.Subscription, error) {
+	subscription, err := s.subscriptions.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.Status = entities.SubscriptionCanceled
+	return s.save(ctx, userID, subscription)
+}
+
+// ApplyProviderChange aplica a mudança de assinatura notificada pelo provedor
+// de pagamentos. É o ponto de entrada dos webhooks de um plans.Provider.
+// Mudanças mais antigas que a última aplicada são ignoradas.
+func (s *planService) ApplyProviderChange(ctx context.Context, provider string, change *plans.SubscriptionChange) (*entities.Subscription, error) {
+	if _, ok := s.catalog.Get(change.Plan); !ok {
+		return nil, ErrUnknownPlan
+	}
+
+	userID := change.UserID
+	existing, err := s.subscriptions.GetByProviderRef(ctx, provider, change.ProviderRef)
+	switch {
+	case err == nil:
+		userID = existing.UserID
+		if existing.ProviderEventAt != nil && change.OccurredAt.Before(*existing.ProviderEventAt) {
+			return existing, nil
+		}
+	case !errors.Is(err, repositories.ErrSubscriptionNotFound):
+		return nil, err
+	}
+
+	if userID.IsZero() {
+		return nil, repositories.ErrUserNotFound
+	}
+	if existing == nil {
+		if _, err := s.users.GetByID(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	occurredAt := change.OccurredAt
+	return s.save(ctx, userID, &entities.Subscription{
+		PlanID:           string(change.Plan),
+		Status:           change.Status,
+		Provider:         provider,
+		ProviderRef:      change.ProviderRef,
+		CurrentPeriodEnd: change.CurrentPeriodEnd,
+		ProviderEventAt:  &occurredAt,
+	})
+}
+
+// save grava a assinatura do usuário. Preserva a data de criação da anterior e
+// registra a mudança de plano efetivo, usada no cálculo proporcional das cotas.
+func (s *planService) save(ctx context.Context, userID primitive.ObjectID, subscription *entities.Subscription) (*entities.Subscription, error) {
+	previous, err := s.Subscription(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	subscription.UserID = userID
+	subscription.UpdatedAt = now
+	subscription.CreatedAt = now
+
+	if previous != nil {
+		subscription.CreatedAt = previous.CreatedAt
+		subscription.PreviousPlanID = previous.PreviousPlanID
+		subscription.PlanChangedAt = previous.PlanChangedAt
+	}
+
+	before := s.planFor(ctx, previous, now)
+	if after := s.planFor(ctx, subscription, now); after.ID != before.ID {
+		subscription.PreviousPlanID = string(before.ID)
+		subscription.PlanChangedAt = &now
+	}
+
+	if err := s.subscriptions.Save(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
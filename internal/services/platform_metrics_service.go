@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/clock"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/metrics"
+	"github.com/devgugga/todo-it/internal/repositories"
+)
+
+const (
+	// platformMetricsWindowDays é o período das séries e contadores do painel
+	platformMetricsWindowDays = 30
+	// platformMetricsTopErrors é quantos status de erro aparecem no painel
+	platformMetricsTopErrors = 10
+)
+
+// PlatformMetricsService interface define o painel de métricas globais
+type PlatformMetricsService interface {
+	Refresh(ctx context.Context) error
+	Get(ctx context.Context) (*entities.PlatformMetrics, error)
+	RefreshKPIs(ctx context.Context) error
+	KPIs(ctx context.Context) (*entities.BusinessKPIs, error)
+}
+
+// platformMetricsService implementa PlatformMetricsService
+type platformMetricsService struct {
+	metrics repositories.PlatformMetricsRepository
+	clock   clock.Clock
+}
+
+// NewPlatformMetricsService cria uma nova instância do serviço
+func NewPlatformMetricsService(metrics repositories.PlatformMetricsRepository) PlatformMetricsService {
+	return &platformMetricsService{metrics: metrics, clock: clock.System()}
+}
+
+// Get retorna o último painel calculado pelo job; se ainda não houver, calcula agora
+func (s *platformMetricsService) Get(ctx context.Context) (*entities.PlatformMetrics, error) {
+	snapshot, err := s.metrics.GetSnapshot(ctx)
+	if errors.Is(err, repositories.ErrPlatformMetricsNotFound) {
+		if err := s.Refresh(ctx); err != nil {
+			return nil, err
+		}
+		return s.metrics.GetSnapshot(ctx)
+	}
+	return snapshot, err
+}
+
+// Refresh recalcula o painel e o grava para as próximas consultas
+func (s *platformMetricsService) Refresh(ctx context.Context) error {
+	now := s.clock.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	windowStart := today.AddDate(0, 0, 1-platformMetricsWindowDays)
+
+	snapshot := &entities.PlatformMetrics{WindowDays: platformMetricsWindowDays, ComputedAt: now}
+
+	var err error
+	if snapshot.ActiveUsers7d, err = s.metrics.CountActiveUsers(ctx, now.AddDate(0, 0, -7)); err != nil {
+		return err
+	}
+	if snapshot.ActiveUsers30d, err = s.metrics.CountActiveUsers(ctx, now.AddDate(0, 0, -30)); err != nil {
+		return err
+	}
+
+	daily, err := s.metrics.CountDailyTasks(ctx, windowStart)
+	if err != nil {
+		return err
+	}
+	for day := windowStart; !day.After(today); day = day.AddDate(0, 0, 1) {
+		date := day.Format(time.DateOnly)
+		snapshot.Tasks = append(snapshot.Tasks, entities.DailyTaskCount{
+			Date:      date,
+			Created:   daily.Created[date],
+			Completed: daily.Completed[date],
+		})
+	}
+
+	sinceDay := windowStart.Format(time.DateOnly)
+	errorCounts, err := s.metrics.SumCounters(ctx, metrics.HTTPErrors, sinceDay)
+	if err != nil {
+		return err
+	}
+	snapshot.TopErrorCodes = topErrorCodes(errorCounts, platformMetricsTopErrors)
+
+	deliveries, err := s.metrics.SumCounters(ctx, metrics.Deliveries, sinceDay)
+	if err != nil {
+		return err
+	}
+	snapshot.Webhooks = deliveryRate(deliveries, entities.ChannelWebhook)
+
+	storage, err := s.metrics.StorageUsage(ctx)
+	if err != nil {
+		return err
+	}
+	snapshot.Storage = *storage
+
+	return s.metrics.SaveSnapshot(ctx, snapshot)
+}
+
+// KPIs retorna os últimos indicadores de negócio calculados pelo job; se ainda
+// não houver, calcula agora
+func (s *platformMetricsService) KPIs(ctx context.Context) (*entities.BusinessKPIs, error) {
+	kpis, err := s.metrics.GetKPIs(ctx)
+	if errors.Is(err, repositories.ErrBusinessKPIsNotFound) {
+		if err := s.RefreshKPIs(ctx); err != nil {
+			return nil, err
+		}
+		return s.metrics.GetKPIs(ctx)
+	}
+	return kpis, err
+}
+
+// RefreshKPIs recalcula os indicadores de negócio do dia (UTC) e os grava
+func (s *platformMetricsService) RefreshKPIs(ctx context.Context) error {
+	now := s.clock.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	kpis := &entities.BusinessKPIs{ComputedAt: now}
+
+	var err error
+	if kpis.DailyActiveUsers, err = s.metrics.CountActiveUsers(ctx, today); err != nil {
+		return err
+	}
+
+	daily, err := s.metrics.CountDailyTasks(ctx, today)
+	if err != nil {
+		return err
+	}
+	kpis.TasksCompletedToday = daily.Completed[today.Format(time.DateOnly)]
+
+	if kpis.OpenTasks, kpis.OverdueTasks, err = s.metrics.CountOpenTasks(ctx, now); err != nil {
+		return err
+	}
+	if kpis.OpenTasks > 0 {
+		kpis.OverdueRatio = float64(kpis.OverdueTasks) / float64(kpis.OpenTasks)
+	}
+
+	return s.metrics.SaveKPIs(ctx, kpis)
+}
+
+// topErrorCodes ordena os status pela contagem (maior primeiro) e retorna os limit primeiros
+func topErrorCodes(counts map[string]int64, limit int) []entities.ErrorCodeCount {
+	codes := make([]entities.ErrorCodeCount, 0, len(counts))
+	for status, count := range counts {
+		codes = append(codes, entities.ErrorCodeCount{Status: status, Count: count})
+	}
+	sort.Slice(codes, func(i, j int) bool {
+		if codes[i].Count != codes[j].Count {
+			return codes[i].Count > codes[j].Count
+		}
+		return codes[i].Status < codes[j].Status
+	})
+
+	return codes[:min(len(codes), limit)]
+}
+
+// deliveryRate resume as entregas do canal a partir do contador metrics.Deliveries
+func deliveryRate(counts map[string]int64, channel string) entities.DeliveryRate {
+	rate := entities.DeliveryRate{SuccessRate: 1}
+	for key, count := range counts {
+		name, outcome, _ := strings.Cut(key, ":")
+		if name != channel {
+			continue
+		}
+		switch outcome {
+		case metrics.OutcomeDelivered:
+			rate.Delivered += count
+		case metrics.OutcomeFailed:
+			rate.Failed += count
+		}
+	}
+
+	if total := rate.Delivered + rate.Failed; total > 0 {
+		rate.SuccessRate = float64(rate.Delivered) / float64(total)
+	}
+	return rate
+}
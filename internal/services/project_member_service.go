@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+
+	"github.com/devgugga/todo-it/internal/clock"
+	projectreq "github.com/devgugga/todo-it/internal/dtos/requests/project"
+	taskreq "github.com/devgugga/todo-it/internal/dtos/requests/task"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"github.com/devgugga/todo-it/internal/policy"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ProjectMemberService interface define o compartilhamento de projetos: os
+// membros, os seus papéis e o acesso deles às tarefas do projeto. As tarefas
+// continuam pertencendo ao dono do projeto.
+type ProjectMemberService interface {
+	Members(ctx context.Context, userID, projectID primitive.ObjectID) (*entities.Project, error)
+	AddMember(ctx context.Context, userID, projectID primitive.ObjectID, req *projectreq.AddProjectMemberRequest) (*entities.ProjectMember, error)
+	SetRole(ctx context.Context, userID, projectID, memberID primitive.ObjectID, role enums.ProjectRole) (*entities.ProjectMember, error)
+	RemoveMember(ctx context.Context, userID, projectID, memberID primitive.ObjectID) error
+	Tasks(ctx context.Context, userID, projectID primitive.ObjectID, page, limit int64) ([]*entities.Task, int64, error)
+	GetTask(ctx context.Context, userID, projectID, taskID primitive.ObjectID) (*entities.Task, error)
+	UpdateTask(ctx context.Context, userID, projectID, taskID primitive.ObjectID, req *taskreq.UpdateTaskRequest) (*entities.Task, error)
+	UpdateTaskStatus(ctx context.Context, userID, projectID, taskID primitive.ObjectID, status enums.TaskStatus) error
+}
+
+// projectMemberService implementa ProjectMemberService
+type projectMemberService struct {
+	projects repositories.ProjectRepository
+	users    repositories.UserRepository
+	tasks    TaskService
+	clock    clock.Clock
+}
+
+// NewProjectMemberService cria uma nova instância do serviço
+func NewProjectMemberService(projects repositories.ProjectRepository, users repositories.UserRepository, tasks TaskService) ProjectMemberService {
+	return NewProjectMemberServiceWithClock(projects, users, tasks, clock.System())
+}
+
+// NewProjectMemberServiceWithClock cria o serviço com o relógio informado, usado
+// na entrada de membros
+func NewProjectMemberServiceWithClock(projects repositories.ProjectRepository, users repositories.UserRepository, tasks TaskService, clk clock.Clock) ProjectMemberService {
+	return &projectMemberService{projects: projects, users: users, tasks: tasks, clock: clk}
+}
+
+// Members retorna o projeto com os membros, visível ao dono e a qualquer membro
+func (s *projectMemberService) Members(ctx context.Context, userID, projectID primitive.ObjectID) (*entities.Project, error) {
+	return authorizedProject(ctx, s.projects, userID, projectID, policy.CanViewProject)
+}
+
+// AddMember compartilha o projeto com o usuário do email informado
+func (s *projectMemberService) AddMember(ctx context.Context, userID, projectID primitive.ObjectID, req *projectreq.AddProjectMemberRequest) (*entities.ProjectMember, error) {
+	project, err := authorizedProject(ctx, s.projects, userID, projectID, policy.CanManageProjectMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.users.GetByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsActive {
+		return nil, repositories.ErrUserNotFound
+	}
+	if user.ID == project.UserID {
+		return nil, ErrProjectOwnerMember
+	}
+
+	member := entities.ProjectMember{UserID: user.ID, Role: req.Role, AddedAt: s.clock.Now()}
+	if err := s.projects.AddMember(ctx, project.UserID, projectID, member); err != nil {
+		return nil, projectError(err)
+	}
+
+	return &member, nil
+}
+
+// SetRole altera o papel de um membro do projeto
+func (s *projectMemberService) SetRole(ctx context.Context, userID, projectID, memberID primitive.ObjectID, role enums.ProjectRole) (*entities.ProjectMember, error) {
+	project, err := authorizedProject(ctx, s.projects, userID, projectID, policy.CanManageProjectMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.projects.SetMemberRole(ctx, project.UserID, projectID, memberID, role); err != nil {
+		return nil, projectError(err)
+	}
+
+	for _, member := range project.Members {
+		if member.UserID == memberID {
+			member.Role = role
+			return &member, nil
+		}
+	}
+
+	return &entities.ProjectMember{UserID: memberID, Role: role}, nil
+}
+
+// RemoveMember remove o acesso de um membro: o dono remove qualquer membro e
+// cada membro pode sair do projeto
+func (s *projectMemberService) RemoveMember(ctx context.Context, userID, projectID, memberID primitive.ObjectID) error {
+	allowed := policy.CanManageProjectMembers
+	if userID == memberID {
+		allowed = policy.CanViewProject
+	}
+
+	if _, err := authorizedProject(ctx, s.projects, userID, projectID, allowed); err != nil {
+		return err
+	}
+
+	return projectError(s.projects.RemoveMember(ctx, projectID, memberID))
+}
+
+// Tasks lista as tarefas do projeto
+func (s *projectMemberService) Tasks(ctx context.Context, userID, projectID primitive.ObjectID, page, limit int64) ([]*entities.Task, int64, error) {
+	project, err := authorizedProject(ctx, s.projects, userID, projectID, policy.CanViewProject)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return s.tasks.List(ctx, project.UserID, page, limit, &repositories.TaskFilters{ProjectID: &project.ID})
+}
+
+// GetTask busca uma tarefa do projeto
+func (s *projectMemberService) GetTask(ctx context.Context, userID, projectID, taskID primitive.ObjectID) (*entities.Task, error) {
+	_, task, err := s.projectTask(ctx, userID, projectID, taskID, policy.CanViewProjectTask)
+	return task, err
+}
+
+// UpdateTask altera uma tarefa do projeto (dono ou editor). Só o dono pode
+// tirar a tarefa do projeto.
+func (s *projectMemberService) UpdateTask(ctx context.Context, userID, projectID, taskID primitive.ObjectID, req *taskreq.UpdateTaskRequest) (*entities.Task, error) {
+	project, _, err := s.projectTask(ctx, userID, projectID, taskID, policy.CanModifyProjectTask)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ProjectID.Set && !policy.CanModifyProject(userID, project) {
+		return nil, ErrProjectForbidden
+	}
+
+	return s.tasks.Update(ctx, project.UserID, taskID, req)
+}
+
+// UpdateTaskStatus altera o status de uma tarefa do projeto (dono ou editor)
+func (s *projectMemberService) UpdateTaskStatus(ctx context.Context, userID, projectID, taskID primitive.ObjectID, status enums.TaskStatus) error {
+	project, _, err := s.projectTask(ctx, userID, projectID, taskID, policy.CanModifyProjectTask)
+	if err != nil {
+		return err
+	}
+
+	return s.tasks.UpdateStatus(ctx, project.UserID, taskID, status)
+}
+
+// projectTask busca o projeto e a tarefa e aplica a regra de autorização
+// informada. Tarefas fora do projeto são tratadas como inexistentes.
+func (s *projectMemberService) projectTask(ctx context.Context, userID, projectID, taskID primitive.ObjectID, allowed func(primitive.ObjectID, *entities.Project, *entities.Task) bool) (*entities.Project, *entities.Task, error) {
+	project, err := authorizedProject(ctx, s.projects, userID, projectID, policy.CanViewProject)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task, err := s.tasks.GetByID(ctx, project.UserID, taskID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !policy.CanViewProjectTask(userID, project, task) {
+		return nil, nil, ErrTaskNotFound
+	}
+
+	if !allowed(userID, project, task) {
+		return nil, nil, ErrProjectForbidden
+	}
+
+	return project, task, nil
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/clock"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/policy"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// projectRollupTTL é o tempo máximo em que o resumo gravado é servido sem
+// recálculo. Limita a defasagem das tarefas que passam a atrasar, que não gera evento.
+const projectRollupTTL = 30 * time.Second
+
+// ProjectRollupService interface define o resumo das tarefas dos projetos
+type ProjectRollupService interface {
+	Subscribe(bus events.Bus)
+	Get(ctx context.Context, userID, projectID primitive.ObjectID, fresh bool) (*entities.ProjectRollup, error)
+}
+
+// projectRollupService implementa ProjectRollupService, gravando no projeto o
+// último resumo calculado
+type projectRollupService struct {
+	projects repositories.ProjectRepository
+	todos    repositories.TodoRepository
+	clock    clock.Clock
+
+	mu sync.Mutex
+	// pending marca os projetos com recálculo em andamento; true indica que
+	// houve nova alteração durante o cálculo e ele deve ser refeito
+	pending map[primitive.ObjectID]bool
+}
+
+// NewProjectRollupService cria uma nova instância do serviço
+func NewProjectRollupService(projects repositories.ProjectRepository, todos repositories.TodoRepository) ProjectRollupService {
+	return NewProjectRollupServiceWithClock(projects, todos, clock.System())
+}
+
+// NewProjectRollupServiceWithClock cria o serviço com o relógio informado, usado
+// na validade do resumo gravado
+func NewProjectRollupServiceWithClock(projects repositories.ProjectRepository, todos repositories.TodoRepository, clk clock.Clock) ProjectRollupService {
+	return &projectRollupService{projects: projects, todos: todos, clock: clk, pending: make(map[primitive.ObjectID]bool)}
+}
+
+// Subscribe recalcula o resumo do projeto a cada alteração das suas tarefas
+// feita nesta instância
+func (s *projectRollupService) Subscribe(bus events.Bus) {
+	for _, eventType := range taskChangeEvents {
+		bus.Subscribe(eventType, s.handle)
+	}
+}
+
+// Get retorna o resumo gravado no projeto enquanto ainda válido. fresh ignora o
+// resumo gravado e recalcula.
+func (s *projectRollupService) Get(ctx context.Context, userID, projectID primitive.ObjectID, fresh bool) (*entities.ProjectRollup, error) {
+	project, err := authorizedProject(ctx, s.projects, userID, projectID, policy.CanViewProject)
+	if err != nil {
+		return nil, err
+	}
+
+	// O resumo é mantido pelos eventos de tarefa; é calculado aqui se ainda não
+	// existir (projetos anteriores ao resumo ou sem tarefas alteradas) ou se
+	// vencido, já que as atrasadas mudam com o tempo
+	rollup := project.Rollup
+	if !fresh && rollup != nil && s.clock.Now().Before(rollup.ComputedAt.Add(projectRollupTTL)) {
+		return rollup, nil
+	}
+
+	return s.update(ctx, project)
+}
+
+// handle recalcula o projeto da tarefa e, se ela mudou de projeto, também o anterior
+func (s *projectRollupService) handle(ctx context.Context, event events.Event) {
+	for _, key := range []string{"project_id", "previous_project_id"} {
+		projectID, err := primitive.ObjectIDFromHex(eventString(event, key))
+		if err != nil {
+			continue
+		}
+		s.refresh(ctx, event.UserID, projectID)
+	}
+}
+
+// refresh recalcula o resumo do projeto. Alterações que chegam durante um
+// cálculo em andamento (ex.: operações em lote) são agrupadas em um só recálculo.
+func (s *projectRollupService) refresh(ctx context.Context, userID, projectID primitive.ObjectID) {
+	s.mu.Lock()
+	if _, running := s.pending[projectID]; running {
+		s.pending[projectID] = true
+		s.mu.Unlock()
+		return
+	}
+	s.pending[projectID] = false
+	s.mu.Unlock()
+
+	for {
+		project, err := s.projects.GetByID(ctx, userID, projectID)
+		if err == nil {
+			_, err = s.update(ctx, project)
+		}
+		if err != nil {
+			logging.FromContext(ctx).Warn("erro ao atualizar resumo do projeto", "project_id", projectID.Hex(), "error", err)
+		}
+
+		s.mu.Lock()
+		if !s.pending[projectID] {
+			delete(s.pending, projectID)
+			s.mu.Unlock()
+			return
+		}
+		s.pending[projectID] = false
+		s.mu.Unlock()
+	}
+}
+
+// update calcula e grava o resumo do projeto
+func (s *projectRollupService) update(ctx context.Context, project *entities.Project) (*entities.ProjectRollup, error) {
+	rollup, err := s.todos.ProjectRollup(ctx, project.UserID, project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.projects.SetRollup(ctx, project.ID, rollup); err != nil {
+		return nil, err
+	}
+	project.Rollup = rollup
+
+	return rollup, nil
+}
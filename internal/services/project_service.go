@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	projectreq "github.com/devgugga/todo-it/internal/dtos/requests/project"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/policy"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ProjectService interface define as regras de negócio dos projetos
+type ProjectService interface {
+	Create(ctx context.Context, userID primitive.ObjectID, req *projectreq.CreateProjectRequest) (*entities.Project, error)
+	GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.Project, error)
+	List(ctx context.Context, userID primitive.ObjectID) ([]*entities.Project, error)
+	Update(ctx context.Context, userID, id primitive.ObjectID, req *projectreq.UpdateProjectRequest) (*entities.Project, error)
+	Delete(ctx context.Context, userID, id primitive.ObjectID) error
+	Activity(ctx context.Context, userID, id primitive.ObjectID, page, limit int64) ([]*entities.TaskChange, int64, error)
+}
+
+// projectService implementa ProjectService
+type projectService struct {
+	projects repositories.ProjectRepository
+	todos    repositories.TodoRepository
+	changes  repositories.TaskChangeRepository
+}
+
+// NewProjectService cria uma nova instância do serviço
+func NewProjectService(projects repositories.ProjectRepository, todos repositories.TodoRepository, changes repositories.TaskChangeRepository) ProjectService {
+	return &projectService{projects: projects, todos: todos, changes: changes}
+}
+
+// Create cria um novo projeto para o usuário
+func (s *projectService) Create(ctx context.Context, userID primitive.ObjectID, req *projectreq.CreateProjectRequest) (*entities.Project, error) {
+	project := req.ToEntity(userID)
+
+	if err := s.projects.Create(ctx, project); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// GetByID busca um projeto do usuário
+func (s *projectService) GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.Project, error) {
+	return authorizedProject(ctx, s.projects, userID, id, policy.CanViewProject)
+}
+
+// List lista os projetos do usuário seguidos dos compartilhados com ele
+func (s *projectService) List(ctx context.Context, userID primitive.ObjectID) ([]*entities.Project, error) {
+	projects, err := s.projects.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	shared, err := s.projects.ListShared(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(projects, shared...), nil
+}
+
+// Update atualiza um projeto do usuário
+func (s *projectService) Update(ctx context.Context, userID, id primitive.ObjectID, req *projectreq.UpdateProjectRequest) (*entities.Project, error) {
+	project, err := authorizedProject(ctx, s.projects, userID, id, policy.CanModifyProject)
+	if err != nil {
+		return nil, err
+	}
+
+	req.ApplyToEntity(project)
+
+	if err := s.projects.Update(ctx, userID, project); err != nil {
+		return nil, projectError(err)
+	}
+
+	return project, nil
+}
+
+// Delete remove um projeto do usuário; as tarefas são mantidas, sem projeto
+func (s *projectService) Delete(ctx context.Context, userID, id primitive.ObjectID) error {
+	if _, err := authorizedProject(ctx, s.projects, userID, id, policy.CanModifyProject); err != nil {
+		return err
+	}
+
+	if err := s.projects.Delete(ctx, userID, id); err != nil {
+		return projectError(err)
+	}
+
+	// O projeto já foi removido; tarefas que falharem continuam apontando para um ID inexistente
+	if _, err := s.todos.ClearProject(ctx, userID, id); err != nil {
+		logging.FromContext(ctx).Warn("erro ao desvincular tarefas do projeto removido",
+			"project_id", id.Hex(), "error", err)
+	}
+
+	return nil
+}
+
+// Activity lista o histórico das tarefas do projeto, do mais recente para o
+// mais antigo. Vem do feed de mudanças, que guarda os últimos 30 dias; cada
+// alteração conta para o projeto em que a tarefa estava quando ocorreu.
+func (s *projectService) Activity(ctx context.Context, userID, id primitive.ObjectID, page, limit int64) ([]*entities.TaskChange, int64, error) {
+	project, err := authorizedProject(ctx, s.projects, userID, id, policy.CanViewProject)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// O histórico é registrado em nome do dono das tarefas, o dono do projeto
+	return s.changes.ListByProject(ctx, project.UserID, id, page, limit)
+}
+
+// authorizedProject busca o projeto e aplica a regra de autorização informada.
+// Projetos que o usuário não pode ver são tratados como inexistentes; os que
+// ele vê sem a permissão pedida (ex.: viewer alterando) retornam ErrProjectForbidden.
+func authorizedProject(ctx context.Context, projects repositories.ProjectRepository, userID, id primitive.ObjectID, allowed func(primitive.ObjectID, *entities.Project) bool) (*entities.Project, error) {
+	project, err := projects.GetByID(ctx, userID, id)
+	if err != nil {
+		return nil, projectError(err)
+	}
+
+	if !policy.CanViewProject(userID, project) {
+		return nil, ErrProjectNotFound
+	}
+
+	if !allowed(userID, project) {
+		return nil, ErrProjectForbidden
+	}
+
+	return project, nil
+}
+
+// projectError converte o not-found do repositório no erro de domínio
+func projectError(err error) error {
+	if errors.Is(err, repositories.ErrProjectNotFound) {
+		return ErrProjectNotFound
+	}
+	return err
+}
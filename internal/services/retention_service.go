@@ -0,0 +1,193 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/clock"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// retentionBatchSize limita quantos documentos são removidos por operação
+const retentionBatchSize = 500
+
+// RetentionPolicy define a retenção padrão em dias por recurso
+// (entities.RetentionResources). Recurso ausente ou com 0 é mantido para sempre.
+type RetentionPolicy map[string]int
+
+// RetentionReport resume a avaliação da política de retenção
+type RetentionReport struct {
+	// DryRun indica que nada foi removido: Count é o que seria removido
+	DryRun      bool                      `json:"dry_run"`
+	GeneratedAt time.Time                 `json:"generated_at"`
+	Resources   []RetentionResourceReport `json:"resources"`
+}
+
+// RetentionResourceReport é o resultado da retenção de um recurso
+type RetentionResourceReport struct {
+	Resource string `json:"resource"`
+	// Days é a retenção padrão (0 mantém para sempre) e Cutoff a data limite correspondente
+	Days   int        `json:"days"`
+	Cutoff *time.Time `json:"cutoff,omitempty"`
+	// Overrides é quantos usuários têm retenção própria para o recurso
+	Overrides int `json:"overrides"`
+	// Count é quantos documentos expiraram (dry-run) ou foram removidos
+	Count int64 `json:"count"`
+}
+
+// RetentionService interface define a política de retenção de dados
+type RetentionService interface {
+	Report(ctx context.Context) (*RetentionReport, error)
+	Apply(ctx context.Context) (*RetentionReport, error)
+	ListOverrides(ctx context.Context) ([]*entities.RetentionOverride, error)
+	SetOverride(ctx context.Context, userID primitive.ObjectID, days map[string]int) (*entities.RetentionOverride, error)
+	DeleteOverride(ctx context.Context, userID primitive.ObjectID) error
+}
+
+// retentionService implementa RetentionService
+type retentionService struct {
+	retention   repositories.RetentionRepository
+	users       repositories.UserRepository
+	attachments repositories.AttachmentRepository
+	policy      RetentionPolicy
+	clock       clock.Clock
+}
+
+// NewRetentionService cria uma nova instância do serviço
+func NewRetentionService(retention repositories.RetentionRepository, users repositories.UserRepository, attachments repositories.AttachmentRepository, policy RetentionPolicy) RetentionService {
+	return &retentionService{
+		retention:   retention,
+		users:       users,
+		attachments: attachments,
+		policy:      policy,
+		clock:       clock.System(),
+	}
+}
+
+// retentionRule é um escopo de usuários com a data de corte aplicada a ele
+type retentionRule struct {
+	scope  repositories.RetentionScope
+	cutoff time.Time
+}
+
+// Report conta o que a política removeria agora, sem remover nada
+func (s *retentionService) Report(ctx context.Context) (*RetentionReport, error) {
+	return s.run(ctx, true)
+}
+
+// Apply remove os dados expirados de todos os recursos
+func (s *retentionService) Apply(ctx context.Context) (*RetentionReport, error) {
+	return s.run(ctx, false)
+}
+
+// run avalia cada recurso: usuários com retenção própria seguem a sua, e os
+// demais a retenção padrão
+func (s *retentionService) run(ctx context.Context, dryRun bool) (*RetentionReport, error) {
+	overrides, err := s.retention.ListOverrides(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	report := &RetentionReport{DryRun: dryRun, GeneratedAt: now}
+
+	for _, resource := range entities.RetentionResources {
+		item := RetentionResourceReport{Resource: resource, Days: s.policy[resource]}
+
+		var rules []retentionRule
+		var except []primitive.ObjectID
+		for _, override := range overrides {
+			days, ok := override.Days[resource]
+			if !ok {
+				continue
+			}
+
+			userID := override.UserID
+			except = append(except, userID)
+			item.Overrides++
+			if days > 0 {
+				rules = append(rules, retentionRule{
+					scope:  repositories.RetentionScope{UserID: &userID},
+					cutoff: now.AddDate(0, 0, -days),
+				})
+			}
+		}
+
+		if item.Days > 0 {
+			cutoff := now.AddDate(0, 0, -item.Days)
+			item.Cutoff = &cutoff
+			rules = append(rules, retentionRule{scope: repositories.RetentionScope{Except: except}, cutoff: cutoff})
+		}
+
+		for _, rule := range rules {
+			count, err := s.applyRule(ctx, resource, rule, dryRun)
+			item.Count += count
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		report.Resources = append(report.Resources, item)
+	}
+
+	return report, nil
+}
+
+// applyRule conta (dry-run) ou remove em lotes os documentos expirados da regra
+func (s *retentionService) applyRule(ctx context.Context, resource string, rule retentionRule, dryRun bool) (int64, error) {
+	if dryRun {
+		return s.retention.CountExpired(ctx, resource, rule.cutoff, rule.scope)
+	}
+
+	var total int64
+	for {
+		batch, err := s.retention.DeleteExpired(ctx, resource, rule.cutoff, rule.scope, retentionBatchSize)
+		if err != nil {
+			return total, err
+		}
+
+		// Os documentos já foram removidos; falha ao apagar um anexo só deixa o arquivo órfão
+		for _, id := range batch.AttachmentIDs {
+			if err := s.attachments.Delete(ctx, id); err != nil {
+				slog.Warn("erro ao remover anexo de tarefa expirada", "attachment_id", id.Hex(), "error", err)
+			}
+		}
+
+		total += batch.Deleted
+		if batch.Deleted == 0 {
+			return total, nil
+		}
+	}
+}
+
+// ListOverrides lista as retenções por usuário
+func (s *retentionService) ListOverrides(ctx context.Context) ([]*entities.RetentionOverride, error) {
+	return s.retention.ListOverrides(ctx)
+}
+
+// SetOverride define a retenção própria do usuário, substituindo a anterior.
+// Recursos fora de days seguem a política padrão.
+func (s *retentionService) SetOverride(ctx context.Context, userID primitive.ObjectID, days map[string]int) (*entities.RetentionOverride, error) {
+	if _, err := s.users.GetByID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	override := &entities.RetentionOverride{
+		UserID:    userID,
+		Days:      days,
+		UpdatedAt: s.clock.Now(),
+	}
+	if err := s.retention.SaveOverride(ctx, override); err != nil {
+		return nil, err
+	}
+
+	return override, nil
+}
+
+// DeleteOverride remove a retenção própria do usuário
+func (s *retentionService) DeleteOverride(ctx context.Context, userID primitive.ObjectID) error {
+	return s.retention.DeleteOverride(ctx, userID)
+}
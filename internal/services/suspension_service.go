@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/clock"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SuspensionService interface define a suspensão de contas por abuso
+type SuspensionService interface {
+	Suspend(ctx context.Context, userID primitive.ObjectID, reason string) (*entities.User, error)
+	Unsuspend(ctx context.Context, userID primitive.ObjectID) (*entities.User, error)
+	CheckAccess(ctx context.Context, userID primitive.ObjectID, issuedAt time.Time) error
+}
+
+// suspensionService implementa SuspensionService
+type suspensionService struct {
+	users repositories.UserRepository
+	clock clock.Clock
+}
+
+// NewSuspensionService cria uma nova instância do serviço
+func NewSuspensionService(users repositories.UserRepository) SuspensionService {
+	return &suspensionService{
+		users: users,
+		clock: clock.System(),
+	}
+}
+
+// Suspend suspende a conta e encerra as sessões abertas: os tokens de acesso
+// emitidos até agora deixam de valer, mesmo depois da reativação
+func (s *suspensionService) Suspend(ctx context.Context, userID primitive.ObjectID, reason string) (*entities.User, error) {
+	if err := s.users.Suspend(ctx, userID, reason, s.clock.Now()); err != nil {
+		return nil, err
+	}
+	return s.users.GetByID(ctx, userID)
+}
+
+// Unsuspend reativa a conta suspensa
+func (s *suspensionService) Unsuspend(ctx context.Context, userID primitive.ObjectID) (*entities.User, error) {
+	if err := s.users.Unsuspend(ctx, userID); err != nil {
+		return nil, err
+	}
+	return s.users.GetByID(ctx, userID)
+}
+
+// CheckAccess bloqueia contas suspensas (ErrAccountSuspended) e tokens emitidos
+// antes da revogação das sessões ou de usuários que não existem mais (ErrSessionRevoked)
+func (s *suspensionService) CheckAccess(ctx context.Context, userID primitive.ObjectID, issuedAt time.Time) error {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrUserNotFound) {
+			return ErrSessionRevoked
+		}
+		return err
+	}
+
+	switch {
+	case user.IsSuspended():
+		return ErrAccountSuspended
+	case user.SessionRevoked(issuedAt):
+		return ErrSessionRevoked
+	}
+
+	return nil
+}
@@ -0,0 +1,327 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/apperrors"
+	"github.com/devgugga/todo-it/internal/clock"
+	taskreq "github.com/devgugga/todo-it/internal/dtos/requests/task"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MaxSyncTasks limita as tarefas retornadas por consulta de sincronização
+const MaxSyncTasks = 500
+
+// Resultados de uma alteração enviada pelo cliente
+const (
+	// SyncApplied indica alteração gravada (inclusive quando venceu um conflito)
+	SyncApplied = "applied"
+	// SyncConflict indica que a versão do servidor é mais recente e foi mantida
+	SyncConflict = "conflict"
+	// SyncDeleted indica que a tarefa foi excluída no servidor
+	SyncDeleted = "deleted"
+	// SyncRejected indica alteração recusada pelos dados (ex.: projeto inexistente)
+	SyncRejected = "rejected"
+)
+
+// SyncPage é uma página da sincronização: tarefas alteradas em ordem de
+// updated_at e as excluídas no mesmo período
+type SyncPage struct {
+	Tasks          []*entities.Task
+	DeletedTaskIDs []primitive.ObjectID
+	// Reset indica sincronização completa (sem cursor ou cursor antigo demais)
+	Reset bool
+	// Cursor é o since da próxima consulta
+	Cursor     string
+	HasMore    bool
+	ServerTime time.Time
+}
+
+// TombstonePage são as exclusões registradas desde o cursor
+type TombstonePage struct {
+	Tombstones []*entities.Tombstone
+	// Reset indica cursor mais antigo que o registro de exclusões: algumas
+	// podem ter sido perdidas e o cliente deve recarregar tudo
+	Reset      bool
+	Cursor     string
+	ServerTime time.Time
+}
+
+// SyncResult é o resultado de uma alteração enviada pelo cliente
+type SyncResult struct {
+	ID     primitive.ObjectID
+	Op     string
+	Status string
+	// Err explica a recusa (SyncRejected)
+	Err error
+	// Task é a versão do servidor após a alteração ou a que prevaleceu no conflito
+	Task *entities.Task
+}
+
+// SyncService interface define a sincronização de clientes offline
+type SyncService interface {
+	Pull(ctx context.Context, userID primitive.ObjectID, since string, limit int64) (*SyncPage, error)
+	Push(ctx context.Context, userID primitive.ObjectID, changes []taskreq.SyncChangeRequest) ([]SyncResult, error)
+	Deleted(ctx context.Context, userID primitive.ObjectID, entityType, since string) (*TombstonePage, error)
+}
+
+// syncService implementa SyncService
+type syncService struct {
+	tasks      TaskService
+	todos      repositories.TodoRepository
+	tombstones repositories.TombstoneRepository
+	clock      clock.Clock
+}
+
+// NewSyncService cria uma nova instância do serviço
+func NewSyncService(tasks TaskService, todos repositories.TodoRepository, tombstones repositories.TombstoneRepository) SyncService {
+	return &syncService{tasks: tasks, todos: todos, tombstones: tombstones, clock: clock.System()}
+}
+
+// Pull retorna as tarefas alteradas e excluídas depois de since (cursor de
+// uma consulta anterior ou data RFC 3339)
+func (s *syncService) Pull(ctx context.Context, userID primitive.ObjectID, since string, limit int64) (*SyncPage, error) {
+	now := s.clock.Now()
+
+	position, reset, err := parseSyncCursor(since, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > MaxSyncTasks {
+		limit = MaxSyncTasks
+	}
+
+	until := syncUntil(now)
+
+	tasks, err := s.todos.ListChangedSince(ctx, userID, position, until, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &SyncPage{Tasks: tasks, Reset: reset, ServerTime: now}
+	next := repositories.SyncPosition{UpdatedAt: until}
+	if int64(len(tasks)) > limit {
+		page.Tasks = tasks[:limit]
+		page.HasMore = true
+
+		last := page.Tasks[limit-1]
+		next = repositories.SyncPosition{UpdatedAt: last.UpdatedAt, AfterID: last.ID}
+	}
+	page.Cursor = formatSyncCursor(next)
+
+	// Na sincronização completa o cliente descarta o que tem: exclusões não importam
+	if !reset {
+		tombstones, err := s.tombstones.ListSince(ctx, userID, entities.TombstoneTask, position.UpdatedAt, until)
+		if err != nil {
+			return nil, err
+		}
+		for _, tombstone := range tombstones {
+			page.DeletedTaskIDs = append(page.DeletedTaskIDs, tombstone.EntityID)
+		}
+	}
+
+	return page, nil
+}
+
+// Deleted retorna as exclusões do tipo informado (entities.TombstoneTask ou
+// TombstoneProject) desde since, para integrações que consultam por polling
+func (s *syncService) Deleted(ctx context.Context, userID primitive.ObjectID, entityType, since string) (*TombstonePage, error) {
+	now := s.clock.Now()
+
+	position, reset, err := parseSyncCursor(since, now)
+	if err != nil {
+		return nil, err
+	}
+
+	until := syncUntil(now)
+	tombstones, err := s.tombstones.ListSince(ctx, userID, entityType, position.UpdatedAt, until)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TombstonePage{
+		Tombstones: tombstones,
+		// Sem since não há o que perder: é a primeira consulta
+		Reset:      reset && since != "",
+		Cursor:     formatSyncCursor(repositories.SyncPosition{UpdatedAt: until}),
+		ServerTime: now,
+	}, nil
+}
+
+// syncUntil é o limite das consultas: como no feed de mudanças, os últimos
+// segundos só são entregues na consulta seguinte. O banco guarda
+// milissegundos, então o limite precisa ser representável no cursor.
+func syncUntil(now time.Time) time.Time {
+	return now.Add(-changeFeedSettle).Truncate(time.Millisecond)
+}
+
+// parseSyncCursor converte since na posição da sincronização, indicando se
+// ela deve ser completa
+func parseSyncCursor(since string, now time.Time) (repositories.SyncPosition, bool, error) {
+	if since == "" {
+		return repositories.SyncPosition{}, true, nil
+	}
+
+	position, ok := decodeSyncCursor(since)
+	if !ok {
+		ts, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return repositories.SyncPosition{}, false, ErrInvalidSyncCursor
+		}
+		position = repositories.SyncPosition{UpdatedAt: ts}
+	}
+
+	// A continuação de uma página (AfterID) nunca reinicia a sincronização
+	if position.AfterID.IsZero() && position.UpdatedAt.Before(now.Add(-entities.TombstoneRetention)) {
+		return repositories.SyncPosition{}, true, nil
+	}
+
+	return position, false, nil
+}
+
+// formatSyncCursor codifica a posição como "<unix ms>-<último _id>"
+func formatSyncCursor(position repositories.SyncPosition) string {
+	return strconv.FormatInt(position.UpdatedAt.UnixMilli(), 10) + "-" + position.AfterID.Hex()
+}
+
+// decodeSyncCursor decodifica um cursor gerado por formatSyncCursor
+func decodeSyncCursor(cursor string) (repositories.SyncPosition, bool) {
+	millis, hex, ok := strings.Cut(cursor, "-")
+	if !ok {
+		return repositories.SyncPosition{}, false
+	}
+
+	unix, err := strconv.ParseInt(millis, 10, 64)
+	if err != nil {
+		return repositories.SyncPosition{}, false
+	}
+
+	afterID, err := primitive.ObjectIDFromHex(hex)
+	if err != nil {
+		return repositories.SyncPosition{}, false
+	}
+
+	return repositories.SyncPosition{UpdatedAt: time.UnixMilli(unix).UTC(), AfterID: afterID}, true
+}
+
+// Push aplica as alterações feitas offline, na ordem recebida. Conflitos
+// (tarefa alterada no servidor depois da versão base do cliente) são
+// decididos pela alteração mais recente: a do cliente (modified_at) ou a do
+// servidor (updated_at). Erros de dados recusam só a alteração; falhas do
+// servidor interrompem o envio, que pode ser repetido.
+func (s *syncService) Push(ctx context.Context, userID primitive.ObjectID, changes []taskreq.SyncChangeRequest) ([]SyncResult, error) {
+	results := make([]SyncResult, 0, len(changes))
+
+	for i := range changes {
+		result, err := s.apply(ctx, userID, &changes[i])
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// apply aplica uma alteração do cliente
+func (s *syncService) apply(ctx context.Context, userID primitive.ObjectID, change *taskreq.SyncChangeRequest) (SyncResult, error) {
+	id, err := primitive.ObjectIDFromHex(change.ID)
+	if err != nil {
+		return SyncResult{}, ErrTaskNotFound
+	}
+	result := SyncResult{ID: id, Op: change.Op}
+
+	current, err := s.tasks.GetByID(ctx, userID, id)
+	if err != nil && !errors.Is(err, ErrTaskNotFound) {
+		return result, err
+	}
+
+	if change.Op == taskreq.SyncOpCreate {
+		// Reenvio de uma criação já aplicada (a resposta anterior se perdeu)
+		if current != nil {
+			result.Status = SyncApplied
+			result.Task = current
+			return result, nil
+		}
+
+		task, err := s.tasks.CreateWithID(ctx, userID, id, change.Task)
+		return s.finish(result, task, err)
+	}
+
+	if current == nil {
+		result.Status = SyncDeleted
+		return result, nil
+	}
+
+	if s.serverWins(current, change) {
+		result.Status = SyncConflict
+		result.Task = current
+		return result, nil
+	}
+
+	if change.Op == taskreq.SyncOpDelete {
+		err := s.tasks.Delete(ctx, userID, id)
+		if errors.Is(err, ErrTaskNotFound) {
+			result.Status = SyncDeleted
+			return result, nil
+		}
+		return s.finish(result, nil, err)
+	}
+
+	if change.Update != nil {
+		if _, err := s.tasks.Update(ctx, userID, id, change.Update); err != nil {
+			return s.finish(result, nil, err)
+		}
+	}
+	if change.Status != nil && *change.Status != current.Status {
+		if err := s.tasks.UpdateStatus(ctx, userID, id, *change.Status); err != nil {
+			return s.finish(result, nil, err)
+		}
+	}
+
+	// Relê a tarefa para devolver o updated_at gravado, a nova versão base do cliente
+	task, err := s.tasks.GetByID(ctx, userID, id)
+	return s.finish(result, task, err)
+}
+
+// serverWins indica conflito em que a versão do servidor prevalece: a tarefa
+// mudou depois da versão base e essa mudança é mais recente que a do cliente.
+// modified_at no futuro (relógio do aparelho adiantado) vale como agora.
+func (s *syncService) serverWins(current *entities.Task, change *taskreq.SyncChangeRequest) bool {
+	// O banco guarda milissegundos; a versão base vem de uma leitura dele
+	if change.BaseUpdatedAt != nil && current.UpdatedAt.Truncate(time.Millisecond).Equal(change.BaseUpdatedAt.Truncate(time.Millisecond)) {
+		return false
+	}
+
+	modifiedAt := change.ModifiedAt
+	if now := s.clock.Now(); modifiedAt.After(now) {
+		modifiedAt = now
+	}
+
+	return !modifiedAt.After(current.UpdatedAt)
+}
+
+// finish preenche o resultado: erros de domínio recusam a alteração e os
+// demais são falhas do servidor
+func (s *syncService) finish(result SyncResult, task *entities.Task, err error) (SyncResult, error) {
+	switch {
+	case err == nil:
+		result.Status = SyncApplied
+		result.Task = task
+		return result, nil
+	case errors.Is(err, apperrors.ErrNotFound), errors.Is(err, apperrors.ErrDuplicate), errors.Is(err, apperrors.ErrConflict),
+		errors.Is(err, apperrors.ErrForbidden):
+		result.Status = SyncRejected
+		result.Err = err
+		return result, nil
+	default:
+		return result, err
+	}
+}
@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// changeFeedSettle só entrega segundos já encerrados há este intervalo. IDs
+	// gerados no mesmo segundo por instâncias diferentes não seguem a ordem de
+	// gravação; esperar evita que o cursor passe por uma alteração ainda não gravada.
+	changeFeedSettle = 5 * time.Second
+	// changeFeedDefaultWindow é o período consultado quando since não é informado
+	changeFeedDefaultWindow = 24 * time.Hour
+	// MaxTaskChanges limita as alterações retornadas por consulta
+	MaxTaskChanges = 100
+)
+
+// taskChangeEvents são os eventos registrados no feed de mudanças
+var taskChangeEvents = []string{events.TaskCreated, events.TaskUpdated, events.TaskCompleted, events.TaskDeleted}
+
+// IsTaskChangeType indica se o tipo pode ser usado no filtro do feed
+func IsTaskChangeType(eventType string) bool {
+	for _, known := range taskChangeEvents {
+		if eventType == known {
+			return true
+		}
+	}
+	return false
+}
+
+// TaskChangePage é uma página do feed de mudanças
+type TaskChangePage struct {
+	Changes []*entities.TaskChange
+	// Cursor é o since da próxima consulta (igual ao recebido quando não há novidades)
+	Cursor  string
+	HasMore bool
+}
+
+// TaskChangeService interface define o feed de mudanças de tarefas
+type TaskChangeService interface {
+	Subscribe(bus events.Bus)
+	List(ctx context.Context, userID primitive.ObjectID, since string, types []string, limit int64) (*TaskChangePage, error)
+}
+
+// taskChangeService implementa TaskChangeService
+type taskChangeService struct {
+	changes repositories.TaskChangeRepository
+	todos   repositories.TodoRepository
+}
+
+// NewTaskChangeService cria uma nova instância do serviço
+func NewTaskChangeService(changes repositories.TaskChangeRepository, todos repositories.TodoRepository) TaskChangeService {
+	return &taskChangeService{changes: changes, todos: todos}
+}
+
+// Subscribe registra as alterações de tarefas publicadas por esta instância
+func (s *taskChangeService) Subscribe(bus events.Bus) {
+	for _, eventType := range taskChangeEvents {
+		bus.Subscribe(eventType, s.record)
+	}
+}
+
+// record grava a alteração com o estado atual da tarefa
+func (s *taskChangeService) record(ctx context.Context, event events.Event) {
+	taskID, _ := event.Data["task_id"].(string)
+	id, err := primitive.ObjectIDFromHex(taskID)
+	if err != nil || event.UserID.IsZero() {
+		return
+	}
+
+	change := &entities.TaskChange{
+		EventID:    event.ID,
+		UserID:     event.UserID,
+		Type:       event.Type,
+		TaskID:     id,
+		OccurredAt: event.OccurredAt,
+	}
+
+	task, err := s.todos.GetByID(ctx, event.UserID, id)
+	switch {
+	case err == nil && event.Type != events.TaskDeleted:
+		change.Task = *task
+	case err == nil || errors.Is(err, repositories.ErrTodoNotFound):
+		// Tarefa excluída: o evento traz título, status e prioridade
+		change.Task = entities.Task{ID: id, UserID: event.UserID}
+		change.Task.Title = eventString(event, "title")
+		change.Task.Status = enums.TaskStatus(eventString(event, "status"))
+		change.Task.Priority = enums.TaskPriority(eventString(event, "priority"))
+	default:
+		logging.FromContext(ctx).Warn("erro ao buscar tarefa para o feed de mudanças", "task_id", taskID, "error", err)
+		return
+	}
+
+	if err := s.changes.Create(ctx, change); err != nil {
+		logging.FromContext(ctx).Warn("erro ao registrar alteração de tarefa", "event", event.Type, "task_id", taskID, "error", err)
+	}
+}
+
+// eventString lê um campo do evento como texto (tipos nomeados ou string vinda do JSON)
+func eventString(event events.Event, key string) string {
+	value, ok := event.Data[key]
+	if !ok || value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// List retorna as alterações posteriores a since (cursor de uma consulta anterior,
+// data RFC 3339 ou timestamp Unix), em ordem crescente. Sem since, considera as
+// últimas 24 horas.
+func (s *taskChangeService) List(ctx context.Context, userID primitive.ObjectID, since string, types []string, limit int64) (*TaskChangePage, error) {
+	after, err := parseChangeCursor(since, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > MaxTaskChanges {
+		limit = MaxTaskChanges
+	}
+
+	before := timestampCursor(time.Now().Add(-changeFeedSettle))
+
+	changes, err := s.changes.ListAfter(ctx, userID, after, before, types, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &TaskChangePage{Changes: changes, Cursor: after.Hex()}
+	if int64(len(changes)) > limit {
+		page.Changes = changes[:limit]
+		page.HasMore = true
+	}
+	if len(page.Changes) > 0 {
+		page.Cursor = page.Changes[len(page.Changes)-1].ID.Hex()
+	}
+
+	return page, nil
+}
+
+// parseChangeCursor converte since no ObjectID a partir do qual o feed é lido
+func parseChangeCursor(since string, now time.Time) (primitive.ObjectID, error) {
+	if since == "" {
+		return timestampCursor(now.Add(-changeFeedDefaultWindow)), nil
+	}
+
+	if cursor, err := primitive.ObjectIDFromHex(since); err == nil {
+		return cursor, nil
+	}
+
+	if ts, err := time.Parse(time.RFC3339, since); err == nil {
+		return timestampCursor(ts), nil
+	}
+
+	if unix, err := strconv.ParseInt(since, 10, 64); err == nil && unix >= 0 {
+		return timestampCursor(time.Unix(unix, 0)), nil
+	}
+
+	return primitive.NilObjectID, ErrInvalidCursor
+}
+
+// timestampCursor retorna o menor ObjectID do segundo informado (NewObjectIDFromTimestamp
+// preenche o restante com contador e valor aleatório, o que pularia parte do segundo)
+func timestampCursor(t time.Time) primitive.ObjectID {
+	var id primitive.ObjectID
+	binary.BigEndian.PutUint32(id[:4], uint32(t.Unix()))
+	return id
+}
@@ -0,0 +1,275 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/apperrors"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/exporter"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/policy"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// PDFSyncTaskLimit é o máximo de tarefas de um PDF gerado na própria
+	// requisição; exportações maiores são enfileiradas
+	PDFSyncTaskLimit = 200
+	// MaxPDFTasks limita as tarefas de uma exportação em PDF
+	MaxPDFTasks = 5000
+	// ExportFormatPDF é o formato das exportações em PDF
+	ExportFormatPDF = "pdf"
+)
+
+const (
+	// pdfExportPageSize é a quantidade de tarefas lidas por consulta
+	pdfExportPageSize = 500
+	// pdfExportStaleAfter é o tempo em andamento após o qual uma exportação é
+	// considerada abandonada (ex.: processo encerrado) e volta a ser processada
+	pdfExportStaleAfter = 10 * time.Minute
+)
+
+// PDFExport é o resultado de uma exportação em PDF: o arquivo, quando gerado
+// na hora, ou a exportação enfileirada (Job), para listas grandes
+type PDFExport struct {
+	File     []byte
+	FileName string
+	Job      *entities.ExportJob
+}
+
+// TaskPDFService interface define a exportação das tarefas em PDF imprimível
+type TaskPDFService interface {
+	Export(ctx context.Context, userID primitive.ObjectID, query entities.ExportQuery) (*PDFExport, error)
+	Job(ctx context.Context, userID, id primitive.ObjectID) (*entities.ExportJob, error)
+	Download(ctx context.Context, userID, id primitive.ObjectID) (*entities.ExportJob, error)
+	ProcessPending(ctx context.Context) error
+}
+
+// taskPDFService implementa TaskPDFService
+type taskPDFService struct {
+	todos    repositories.TodoRepository
+	projects repositories.ProjectRepository
+	users    repositories.UserRepository
+	jobs     repositories.ExportJobRepository
+}
+
+// NewTaskPDFService cria uma nova instância do serviço
+func NewTaskPDFService(todos repositories.TodoRepository, projects repositories.ProjectRepository, users repositories.UserRepository, jobs repositories.ExportJobRepository) TaskPDFService {
+	return &taskPDFService{todos: todos, projects: projects, users: users, jobs: jobs}
+}
+
+// pdfSource são as tarefas de uma exportação: as do dono (o do projeto, quando
+// exportado por um membro) que atendem aos filtros
+type pdfSource struct {
+	owner   primitive.ObjectID
+	project *entities.Project
+	filters *repositories.TaskFilters
+	title   string
+}
+
+// Export exporta as tarefas da consulta (um projeto ou a lista filtrada do
+// usuário). Até PDFSyncTaskLimit tarefas o PDF é gerado na hora; acima disso
+// a exportação é enfileirada e processada pelo job agendado.
+func (s *taskPDFService) Export(ctx context.Context, userID primitive.ObjectID, query entities.ExportQuery) (*PDFExport, error) {
+	source, err := s.source(ctx, userID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	_, total, err := s.todos.GetByUserID(ctx, source.owner, 1, 1, source.filters)
+	if err != nil {
+		return nil, err
+	}
+	if total > MaxPDFTasks {
+		return nil, ErrExportTooLarge
+	}
+
+	if total <= PDFSyncTaskLimit {
+		file, name, _, err := s.render(ctx, userID, source)
+		if err != nil {
+			return nil, err
+		}
+		return &PDFExport{File: file, FileName: name}, nil
+	}
+
+	active, err := s.jobs.HasActive(ctx, userID, pdfExportStaleAfter)
+	if err != nil {
+		return nil, err
+	}
+	if active {
+		return nil, ErrExportInProgress
+	}
+
+	job := &entities.ExportJob{}
+	job.PrepareForCreate(userID, ExportFormatPDF, query)
+	job.Tasks = int(total)
+
+	if err := s.jobs.Create(ctx, job); err != nil {
+		return nil, err
+	}
+
+	return &PDFExport{Job: job}, nil
+}
+
+// Job retorna o andamento de uma exportação do usuário
+func (s *taskPDFService) Job(ctx context.Context, userID, id primitive.ObjectID) (*entities.ExportJob, error) {
+	return s.jobs.GetByID(ctx, userID, id)
+}
+
+// Download retorna uma exportação concluída, com o arquivo gerado
+func (s *taskPDFService) Download(ctx context.Context, userID, id primitive.ObjectID) (*entities.ExportJob, error) {
+	job, err := s.jobs.GetFile(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Status != entities.ExportJobCompleted {
+		return nil, ErrExportNotReady
+	}
+
+	return job, nil
+}
+
+// ProcessPending gera os PDFs das exportações enfileiradas, uma por vez, até
+// a fila esvaziar. Falhas de uma exportação ficam registradas nela.
+func (s *taskPDFService) ProcessPending(ctx context.Context) error {
+	for ctx.Err() == nil {
+		job, err := s.jobs.ClaimNext(ctx, pdfExportStaleAfter)
+		if errors.Is(err, repositories.ErrExportJobNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		s.process(ctx, job)
+	}
+
+	return ctx.Err()
+}
+
+// process gera o PDF de uma exportação e grava o resultado
+func (s *taskPDFService) process(ctx context.Context, job *entities.ExportJob) {
+	logger := logging.FromContext(ctx).With("export_id", job.ID.Hex(), "user_id", job.UserID.Hex())
+	startedAt := time.Now()
+
+	// O acesso é conferido de novo: o usuário pode ter saído do projeto
+	source, err := s.source(ctx, job.UserID, job.Query)
+	if err == nil {
+		job.File, job.FileName, job.Tasks, err = s.render(ctx, job.UserID, source)
+	}
+
+	finishedAt := time.Now()
+	job.FinishedAt = &finishedAt
+	job.Status = entities.ExportJobCompleted
+
+	var domainErr *apperrors.Error
+	switch {
+	case errors.As(err, &domainErr):
+		job.Status = entities.ExportJobFailed
+		job.Error = err.Error()
+	case err != nil:
+		job.Status = entities.ExportJobFailed
+		job.Error = "erro ao gerar o PDF"
+	}
+
+	// O resultado é gravado mesmo com o contexto cancelado pelo shutdown
+	if saveErr := s.jobs.Save(context.WithoutCancel(ctx), job); saveErr != nil {
+		logger.Error("erro ao gravar exportação", "error", saveErr)
+		return
+	}
+
+	logger.Info("exportação finalizada",
+		"status", job.Status,
+		"tasks", job.Tasks,
+		"bytes", len(job.File),
+		"duration", finishedAt.Sub(startedAt).String(),
+		logging.Err(err),
+	)
+}
+
+// source resolve o dono das tarefas e os filtros da consulta. Projetos podem
+// ser exportados pelo dono e por qualquer membro.
+func (s *taskPDFService) source(ctx context.Context, userID primitive.ObjectID, query entities.ExportQuery) (*pdfSource, error) {
+	source := &pdfSource{
+		owner: userID,
+		title: "Tarefas",
+		filters: &repositories.TaskFilters{
+			Status:   query.Status,
+			Priority: query.Priority,
+			Tags:     query.Tags,
+			Search:   query.Search,
+			Compact:  true,
+		},
+	}
+
+	if query.ProjectID != nil {
+		project, err := authorizedProject(ctx, s.projects, userID, *query.ProjectID, policy.CanViewProject)
+		if err != nil {
+			return nil, err
+		}
+
+		source.owner = project.UserID
+		source.project = project
+		source.title = project.Name
+		source.filters.ProjectID = &project.ID
+	}
+
+	return source, nil
+}
+
+// render gera o PDF com as datas no fuso do usuário que exportou
+func (s *taskPDFService) render(ctx context.Context, userID primitive.ObjectID, source *pdfSource) ([]byte, string, int, error) {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	tasks, err := s.load(ctx, source)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	now := time.Now()
+	input := &exporter.PDFInput{
+		Title:    source.title,
+		Project:  source.project,
+		Tasks:    tasks,
+		Location: user.Preferences.Location(),
+	}
+
+	var buf bytes.Buffer
+	if err := exporter.WriteTaskListPDF(&buf, input, now); err != nil {
+		return nil, "", 0, fmt.Errorf("erro ao gerar PDF: %w", err)
+	}
+
+	return buf.Bytes(), exporter.PDFFileName(source.title, now.In(input.Location)), len(tasks), nil
+}
+
+// load lê as tarefas da exportação em páginas, até MaxPDFTasks
+func (s *taskPDFService) load(ctx context.Context, source *pdfSource) ([]*entities.Task, error) {
+	var tasks []*entities.Task
+
+	for page := int64(1); len(tasks) < MaxPDFTasks; page++ {
+		batch, total, err := s.todos.GetByUserID(ctx, source.owner, page, pdfExportPageSize, source.filters)
+		if err != nil {
+			return nil, err
+		}
+
+		tasks = append(tasks, batch...)
+		if len(batch) < pdfExportPageSize || int64(len(tasks)) >= total {
+			break
+		}
+	}
+
+	if len(tasks) > MaxPDFTasks {
+		tasks = tasks[:MaxPDFTasks]
+	}
+
+	return tasks, nil
+}
@@ -0,0 +1,829 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/calendar"
+	"github.com/devgugga/todo-it/internal/clock"
+	taskreq "github.com/devgugga/todo-it/internal/dtos/requests/task"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/enums"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/policy"
+	"github.com/devgugga/todo-it/internal/recurrence"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Atalhos para adiar o vencimento de uma tarefa
+const (
+	SnoozeTomorrow        = "tomorrow"
+	SnoozeNextBusinessDay = "next_business_day"
+	SnoozeNextWeek        = "next_week"
+)
+
+// TaskService interface define as regras de negócio das tarefas
+type TaskService interface {
+	Create(ctx context.Context, userID primitive.ObjectID, req *taskreq.CreateTaskRequest) (*entities.Task, error)
+	CreateWithID(ctx context.Context, userID, id primitive.ObjectID, req *taskreq.CreateTaskRequest) (*entities.Task, error)
+	GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.Task, error)
+	List(ctx context.Context, userID primitive.ObjectID, page, limit int64, filters *repositories.TaskFilters) ([]*entities.Task, int64, error)
+	Update(ctx context.Context, userID, id primitive.ObjectID, req *taskreq.UpdateTaskRequest) (*entities.Task, error)
+	SetChecklistItemDone(ctx context.Context, userID, id, itemID primitive.ObjectID, done bool) (*entities.Task, error)
+	UpdateStatus(ctx context.Context, userID, id primitive.ObjectID, status enums.TaskStatus) error
+	SetCustomStatus(ctx context.Context, userID, id, statusID primitive.ObjectID) error
+	Complete(ctx context.Context, userID, id primitive.ObjectID) (*entities.Task, error)
+	Reopen(ctx context.Context, userID, id primitive.ObjectID) (*entities.Task, error)
+	Snooze(ctx context.Context, userID, id primitive.ObjectID, preset string) (*entities.Task, error)
+	Save(ctx context.Context, userID primitive.ObjectID, task *entities.Task) error
+	Delete(ctx context.Context, userID, id primitive.ObjectID) error
+	BulkUpdateStatus(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID, status enums.TaskStatus) (int64, error)
+	BulkDelete(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID) (int64, error)
+	Reorder(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID) ([]repositories.TaskPosition, error)
+	GetOverdue(ctx context.Context, userID primitive.ObjectID) ([]*entities.Task, error)
+	ListNearby(ctx context.Context, userID primitive.ObjectID, query repositories.NearbyQuery) ([]*repositories.NearbyTask, error)
+	Suggest(ctx context.Context, userID primitive.ObjectID, query string, limit int64) (*repositories.TaskSuggestions, error)
+	Occurrences(ctx context.Context, userID, id primitive.ObjectID, count int) ([]time.Time, error)
+	Export(ctx context.Context, userID primitive.ObjectID, includeArchived bool) (*TaskExport, error)
+}
+
+// TaskExport reúne os projetos e as tarefas do usuário e, opcionalmente, o
+// histórico arquivado
+type TaskExport struct {
+	Projects []*entities.Project
+	Tasks    []*entities.Task
+	Archived []*entities.Task
+}
+
+// taskService implementa TaskService
+type taskService struct {
+	todos       repositories.TodoRepository
+	users       repositories.UserRepository
+	projects    repositories.ProjectRepository
+	archive     repositories.TaskArchiveRepository
+	attachments repositories.AttachmentRepository
+	labels      repositories.LabelRepository
+	bus         events.Bus
+	clock       clock.Clock
+}
+
+// NewTaskService cria uma nova instância do serviço
+func NewTaskService(todos repositories.TodoRepository, users repositories.UserRepository, projects repositories.ProjectRepository, archive repositories.TaskArchiveRepository, attachments repositories.AttachmentRepository, labels repositories.LabelRepository, bus events.Bus) TaskService {
+	return NewTaskServiceWithClock(todos, users, projects, archive, attachments, labels, bus, clock.System())
+}
+
+// NewTaskServiceWithClock cria o serviço com o relógio informado (ex.: um
+// clock.Frozen em testes)
+func NewTaskServiceWithClock(todos repositories.TodoRepository, users repositories.UserRepository, projects repositories.ProjectRepository, archive repositories.TaskArchiveRepository, attachments repositories.AttachmentRepository, labels repositories.LabelRepository, bus events.Bus, clk clock.Clock) TaskService {
+	return &taskService{
+		todos:       todos,
+		users:       users,
+		projects:    projects,
+		archive:     archive,
+		attachments: attachments,
+		labels:      labels,
+		bus:         bus,
+		clock:       clk,
+	}
+}
+
+// Create cria uma nova tarefa para o usuário
+func (s *taskService) Create(ctx context.Context, userID primitive.ObjectID, req *taskreq.CreateTaskRequest) (*entities.Task, error) {
+	return s.CreateWithID(ctx, userID, primitive.NilObjectID, req)
+}
+
+// CreateWithID cria a tarefa com o ID gerado pelo cliente (sincronização
+// offline), para que o reenvio da mesma criação seja reconhecido. ID vazio
+// gera um novo.
+func (s *taskService) CreateWithID(ctx context.Context, userID, id primitive.ObjectID, req *taskreq.CreateTaskRequest) (*entities.Task, error) {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	task := req.ToEntity(userID, &user.Preferences, s.clock.Now())
+	if !id.IsZero() {
+		task.ID = id
+	}
+
+	if err := s.checkDueDate(task, user.Preferences.TaskDefaults.DueDatePolicy()); err != nil {
+		return nil, err
+	}
+
+	if req.CustomStatusID != "" {
+		statusID, _ := primitive.ObjectIDFromHex(req.CustomStatusID)
+		custom := user.CustomStatus(statusID)
+		if custom == nil {
+			return nil, repositories.ErrCustomStatusNotFound
+		}
+		task.SetCustomStatusAt(custom, task.CreatedAt)
+	}
+
+	if len(req.LabelIDs) > 0 {
+		labels, err := s.requestedLabels(ctx, userID, req.LabelIDs)
+		if err != nil {
+			return nil, err
+		}
+		if task.Tags, err = withLabels(task.Tags, labels); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.checkProject(ctx, userID, task.ProjectID); err != nil {
+		// Projeto padrão excluído depois de configurado: a tarefa fica sem projeto
+		if req.ProjectID != "" || !errors.Is(err, ErrProjectNotFound) {
+			return nil, err
+		}
+		task.ProjectID = nil
+	}
+
+	if err := s.todos.Create(ctx, task); err != nil {
+		return nil, err
+	}
+
+	publish(ctx, s.bus, events.New(events.TaskCreated, userID, taskEventData(task)))
+	if task.Status == enums.StatusCompleted {
+		publish(ctx, s.bus, events.New(events.TaskCompleted, userID, taskEventData(task)))
+	}
+
+	return task, nil
+}
+
+// checkDueDate aplica a política do usuário ao vencimento no passado: reject
+// recusa a tarefa e warn a cria com um aviso. Tarefas criadas já concluídas
+// (ex.: registro do que foi feito) não são verificadas.
+func (s *taskService) checkDueDate(task *entities.Task, policy string) error {
+	if task.DueDate == nil || task.Status == enums.StatusCompleted || !task.DueDate.Before(s.clock.Now()) {
+		return nil
+	}
+
+	switch policy {
+	case entities.DueDatePolicyReject:
+		return ErrPastDueDate
+	case entities.DueDatePolicyWarn:
+		task.Warnings = append(task.Warnings, "a data de vencimento está no passado")
+	}
+	return nil
+}
+
+// GetByID busca uma tarefa do usuário
+func (s *taskService) GetByID(ctx context.Context, userID, id primitive.ObjectID) (*entities.Task, error) {
+	return s.authorizedTask(ctx, userID, id, policy.CanViewTask)
+}
+
+// dueDateLocation retorna o fuso do usuário quando o vencimento enviado tem só
+// a data (vence no fim do dia local); nos demais casos o fuso não é usado
+func (s *taskService) dueDateLocation(ctx context.Context, userID primitive.ObjectID, dueDate *taskreq.DueDate) (*time.Location, error) {
+	if dueDate == nil || !dueDate.DateOnly {
+		return time.UTC, nil
+	}
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return user.Preferences.Location(), nil
+}
+
+// authorizedTask busca a tarefa e aplica a regra de autorização informada.
+// Tarefas sem permissão são tratadas como inexistentes para não revelar IDs de outros usuários.
+func (s *taskService) authorizedTask(ctx context.Context, userID, id primitive.ObjectID, allowed func(primitive.ObjectID, *entities.Task) bool) (*entities.Task, error) {
+	task, err := s.todos.GetByID(ctx, userID, id)
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	if !allowed(userID, task) {
+		return nil, ErrTaskNotFound
+	}
+
+	return task, nil
+}
+
+// List lista as tarefas do usuário com filtros e paginação
+func (s *taskService) List(ctx context.Context, userID primitive.ObjectID, page, limit int64, filters *repositories.TaskFilters) ([]*entities.Task, int64, error) {
+	return s.todos.GetByUserID(ctx, userID, page, limit, filters)
+}
+
+// Update atualiza uma tarefa do usuário
+func (s *taskService) Update(ctx context.Context, userID, id primitive.ObjectID, req *taskreq.UpdateTaskRequest) (*entities.Task, error) {
+	task, err := s.authorizedTask(ctx, userID, id, policy.CanModifyTask)
+	if err != nil {
+		return nil, err
+	}
+
+	previousProject := task.ProjectID
+	previousProgress := task.ChecklistProgress()
+	loc, err := s.dueDateLocation(ctx, userID, req.DueDate.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := req.ApplyToEntity(task, loc, s.clock.Now())
+	if err := s.applyLabels(ctx, userID, task, req, fields); err != nil {
+		return nil, err
+	}
+
+	if task.ProjectID != nil && (previousProject == nil || *previousProject != *task.ProjectID) {
+		if err := s.checkProject(ctx, userID, task.ProjectID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.todos.PatchFields(ctx, userID, id, fields); err != nil {
+		return nil, taskError(err)
+	}
+
+	data := taskEventData(task)
+	if previousProject != nil && (task.ProjectID == nil || *previousProject != *task.ProjectID) {
+		data["previous_project_id"] = previousProject.Hex()
+	}
+	publish(ctx, s.bus, events.New(events.TaskUpdated, userID, data))
+	publishProgress(ctx, s.bus, userID, task, previousProgress, nil)
+
+	return task, nil
+}
+
+// SetChecklistItemDone marca ou desmarca um item da lista de verificação da
+// tarefa, mantendo os IDs dos itens (diferente de Update, que substitui a lista)
+func (s *taskService) SetChecklistItemDone(ctx context.Context, userID, id, itemID primitive.ObjectID, done bool) (*entities.Task, error) {
+	task, err := s.authorizedTask(ctx, userID, id, policy.CanModifyTask)
+	if err != nil {
+		return nil, err
+	}
+
+	item := task.ChecklistItem(itemID)
+	if item == nil {
+		return nil, repositories.ErrChecklistItemNotFound
+	}
+	if item.Done == done {
+		return task, nil
+	}
+
+	if err := s.todos.SetChecklistItemDone(ctx, userID, id, itemID, done); err != nil {
+		return nil, taskError(err)
+	}
+
+	previousProgress := task.ChecklistProgress()
+	item.Done = done
+	task.PrepareForUpdateAt(s.clock.Now())
+
+	publish(ctx, s.bus, events.New(events.TaskUpdated, userID, taskEventData(task)))
+	publishProgress(ctx, s.bus, userID, task, previousProgress, item)
+
+	return task, nil
+}
+
+// UpdateStatus atualiza o status de uma tarefa do usuário
+func (s *taskService) UpdateStatus(ctx context.Context, userID, id primitive.ObjectID, status enums.TaskStatus) error {
+	return s.changeStatus(ctx, userID, id, status, nil)
+}
+
+// SetCustomStatus atribui à tarefa um status personalizado do usuário
+func (s *taskService) SetCustomStatus(ctx context.Context, userID, id, statusID primitive.ObjectID) error {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	custom := user.CustomStatus(statusID)
+	if custom == nil {
+		return repositories.ErrCustomStatusNotFound
+	}
+
+	return s.changeStatus(ctx, userID, id, custom.MapsTo, custom)
+}
+
+// changeStatus grava o status da tarefa e, se informado, o status
+// personalizado, publicando os eventos da alteração
+func (s *taskService) changeStatus(ctx context.Context, userID, id primitive.ObjectID, status enums.TaskStatus, custom *entities.CustomStatus) error {
+	task, err := s.authorizedTask(ctx, userID, id, policy.CanModifyTask)
+	if err != nil {
+		return err
+	}
+
+	if custom != nil {
+		err = s.todos.SetCustomStatus(ctx, userID, id, custom)
+	} else {
+		err = s.todos.UpdateStatus(ctx, userID, id, status)
+	}
+	if err != nil {
+		return taskError(err)
+	}
+
+	previous := task.Status
+	if custom != nil {
+		task.SetCustomStatusAt(custom, s.clock.Now())
+	} else {
+		task.SetStatusAt(status, s.clock.Now())
+		task.StatusID = ""
+		task.CustomStatus = nil
+	}
+
+	publish(ctx, s.bus, events.New(events.TaskUpdated, userID, taskEventData(task)))
+	if status == enums.StatusCompleted && previous != enums.StatusCompleted {
+		publish(ctx, s.bus, events.New(events.TaskCompleted, userID, taskEventData(task)))
+	}
+
+	return nil
+}
+
+// Complete conclui a tarefa do usuário. É idempotente: a tarefa já concluída é
+// retornada sem alteração (nem eventos)
+func (s *taskService) Complete(ctx context.Context, userID, id primitive.ObjectID) (*entities.Task, error) {
+	return s.setCompleted(ctx, userID, id, true)
+}
+
+// Reopen volta a tarefa concluída do usuário para pendente. É idempotente: a
+// tarefa não concluída é retornada sem alteração (nem eventos)
+func (s *taskService) Reopen(ctx context.Context, userID, id primitive.ObjectID) (*entities.Task, error) {
+	return s.setCompleted(ctx, userID, id, false)
+}
+
+// setCompleted conclui ou reabre a tarefa quando o estado atual for diferente
+// do pedido, retornando a tarefa no estado final
+func (s *taskService) setCompleted(ctx context.Context, userID, id primitive.ObjectID, completed bool) (*entities.Task, error) {
+	task, err := s.authorizedTask(ctx, userID, id, policy.CanModifyTask)
+	if err != nil {
+		return nil, err
+	}
+
+	if (task.Status == enums.StatusCompleted) == completed {
+		return task, nil
+	}
+
+	status := enums.StatusPending
+	if completed {
+		status = enums.StatusCompleted
+	}
+	if err := s.todos.UpdateStatus(ctx, userID, id, status); err != nil {
+		return nil, taskError(err)
+	}
+
+	if completed {
+		task.MarkAsCompletedAt(s.clock.Now())
+	} else {
+		task.MarkAsPendingAt(s.clock.Now())
+	}
+	task.StatusID = ""
+	task.CustomStatus = nil
+
+	publish(ctx, s.bus, events.New(events.TaskUpdated, userID, taskEventData(task)))
+	if completed {
+		publish(ctx, s.bus, events.New(events.TaskCompleted, userID, taskEventData(task)))
+	}
+
+	return task, nil
+}
+
+// Snooze adia o vencimento da tarefa para o dia do atalho, contado a partir de
+// hoje no fuso do usuário, mantendo a hora do vencimento atual (fim do dia para
+// tarefas sem vencimento). O lembrete acompanha o vencimento.
+func (s *taskService) Snooze(ctx context.Context, userID, id primitive.ObjectID, preset string) (*entities.Task, error) {
+	task, err := s.authorizedTask(ctx, userID, id, policy.CanModifyTask)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := user.Preferences.Location()
+	now := s.clock.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 23, 59, 59, 0, loc)
+	if task.DueDate != nil {
+		due := task.DueDate.In(loc)
+		today = time.Date(now.Year(), now.Month(), now.Day(), due.Hour(), due.Minute(), due.Second(), 0, loc)
+	}
+
+	dueDate, err := snoozeDate(today, preset, user.Preferences.Calendar.HolidayLocale)
+	if err != nil {
+		return nil, err
+	}
+	dueDate = dueDate.UTC()
+
+	fields := bson.M{"due_date": dueDate}
+	if task.ReminderAt != nil && task.DueDate != nil {
+		reminder := task.ReminderAt.Add(dueDate.Sub(*task.DueDate))
+		task.ReminderAt = &reminder
+		fields["reminder_at"] = reminder
+	}
+	task.DueDate = &dueDate
+
+	if err := s.todos.PatchFields(ctx, userID, id, fields); err != nil {
+		return nil, taskError(err)
+	}
+	task.PrepareForUpdateAt(s.clock.Now())
+
+	publish(ctx, s.bus, events.New(events.TaskUpdated, userID, taskEventData(task)))
+
+	return task, nil
+}
+
+// snoozeDate aplica o atalho de adiamento ao dia informado
+func snoozeDate(today time.Time, preset, locale string) (time.Time, error) {
+	switch preset {
+	case SnoozeTomorrow:
+		return today.AddDate(0, 0, 1), nil
+	case SnoozeNextBusinessDay:
+		return calendar.Default().NextBusinessDay(today, locale), nil
+	case SnoozeNextWeek:
+		// Próxima segunda-feira (uma semana depois, se hoje for segunda)
+		days := (8 - int(today.Weekday())) % 7
+		if days == 0 {
+			days = 7
+		}
+		return today.AddDate(0, 0, days), nil
+	default:
+		return time.Time{}, ErrInvalidSnoozePreset
+	}
+}
+
+// Save cria a tarefa (ID vazio) ou grava por inteiro a tarefa já alterada pelo
+// chamador. Usado por clientes de sincronização que enviam o recurso completo (CalDAV).
+func (s *taskService) Save(ctx context.Context, userID primitive.ObjectID, task *entities.Task) error {
+	if task.ID.IsZero() {
+		task.PrepareForCreateAt(userID, s.clock.Now())
+
+		if err := s.checkProject(ctx, userID, task.ProjectID); err != nil {
+			return err
+		}
+
+		if err := s.todos.Create(ctx, task); err != nil {
+			return err
+		}
+
+		publish(ctx, s.bus, events.New(events.TaskCreated, userID, taskEventData(task)))
+		if task.Status == enums.StatusCompleted {
+			publish(ctx, s.bus, events.New(events.TaskCompleted, userID, taskEventData(task)))
+		}
+		return nil
+	}
+
+	previous, err := s.authorizedTask(ctx, userID, task.ID, policy.CanModifyTask)
+	if err != nil {
+		return err
+	}
+
+	if task.ProjectID != nil && (previous.ProjectID == nil || *previous.ProjectID != *task.ProjectID) {
+		if err := s.checkProject(ctx, userID, task.ProjectID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.todos.Update(ctx, userID, task); err != nil {
+		return taskError(err)
+	}
+
+	publish(ctx, s.bus, events.New(events.TaskUpdated, userID, taskEventData(task)))
+	if task.Status == enums.StatusCompleted && previous.Status != enums.StatusCompleted {
+		publish(ctx, s.bus, events.New(events.TaskCompleted, userID, taskEventData(task)))
+	}
+	publishProgress(ctx, s.bus, userID, task, previous.ChecklistProgress(), nil)
+
+	return nil
+}
+
+// Delete remove uma tarefa do usuário
+func (s *taskService) Delete(ctx context.Context, userID, id primitive.ObjectID) error {
+	task, err := s.authorizedTask(ctx, userID, id, policy.CanModifyTask)
+	if err != nil {
+		return err
+	}
+
+	if err := s.todos.Delete(ctx, userID, id); err != nil {
+		return taskError(err)
+	}
+
+	// A tarefa já foi removida; falha ao apagar um anexo só deixa o arquivo órfão
+	for _, attachment := range task.Attachments {
+		if err := s.attachments.Delete(ctx, attachment.ID); err != nil {
+			logging.FromContext(ctx).Warn("erro ao remover anexo da tarefa excluída",
+				"task_id", task.ID.Hex(), "attachment_id", attachment.ID.Hex(), "error", err)
+		}
+	}
+
+	publish(ctx, s.bus, events.New(events.TaskDeleted, userID, taskEventData(task)))
+
+	return nil
+}
+
+// BulkUpdateStatus atualiza o status das tarefas do usuário com os IDs
+// informados. IDs inexistentes ou de outros usuários são ignorados.
+func (s *taskService) BulkUpdateStatus(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID, status enums.TaskStatus) (int64, error) {
+	tasks, err := s.modifiableTasks(ctx, userID, ids)
+	if err != nil || len(tasks) == 0 {
+		return 0, err
+	}
+
+	updated, err := s.todos.BulkUpdateStatus(ctx, userID, taskIDs(tasks), status)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, task := range tasks {
+		previous := task.Status
+		task.SetStatusAt(status, s.clock.Now())
+		task.StatusID = ""
+		task.CustomStatus = nil
+
+		publish(ctx, s.bus, events.New(events.TaskUpdated, userID, taskEventData(task)))
+		if status == enums.StatusCompleted && previous != enums.StatusCompleted {
+			publish(ctx, s.bus, events.New(events.TaskCompleted, userID, taskEventData(task)))
+		}
+	}
+
+	return updated, nil
+}
+
+// BulkDelete remove as tarefas do usuário com os IDs informados. IDs
+// inexistentes ou de outros usuários são ignorados.
+func (s *taskService) BulkDelete(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID) (int64, error) {
+	tasks, err := s.modifiableTasks(ctx, userID, ids)
+	if err != nil || len(tasks) == 0 {
+		return 0, err
+	}
+
+	deleted, err := s.todos.BulkDelete(ctx, userID, taskIDs(tasks))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, task := range tasks {
+		for _, attachment := range task.Attachments {
+			if err := s.attachments.Delete(ctx, attachment.ID); err != nil {
+				logging.FromContext(ctx).Warn("erro ao remover anexo da tarefa excluída",
+					"task_id", task.ID.Hex(), "attachment_id", attachment.ID.Hex(), "error", err)
+			}
+		}
+
+		publish(ctx, s.bus, events.New(events.TaskDeleted, userID, taskEventData(task)))
+	}
+
+	return deleted, nil
+}
+
+// Reorder grava a ordem manual das tarefas de uma coluna (mesmo projeto e
+// status) na ordem dos IDs. Todas devem existir e poder ser alteradas pelo usuário.
+func (s *taskService) Reorder(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID) ([]repositories.TaskPosition, error) {
+	tasks, err := s.modifiableTasks(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) != len(ids) {
+		return nil, ErrTaskNotFound
+	}
+
+	first := tasks[0]
+	for _, task := range tasks[1:] {
+		if task.Status != first.Status || !sameProject(task.ProjectID, first.ProjectID) {
+			return nil, ErrReorderMixedColumns
+		}
+	}
+
+	positions, err := s.todos.SetPositions(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	order := make(map[primitive.ObjectID]int64, len(positions))
+	for _, position := range positions {
+		order[position.ID] = position.Position
+	}
+	for _, task := range tasks {
+		task.Position = order[task.ID]
+		task.PrepareForUpdateAt(s.clock.Now())
+		publish(ctx, s.bus, events.New(events.TaskUpdated, userID, taskEventData(task)))
+	}
+
+	return positions, nil
+}
+
+// sameProject indica se as duas tarefas estão no mesmo projeto (ou ambas sem projeto)
+func sameProject(a, b *primitive.ObjectID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// modifiableTasks busca as tarefas informadas que o usuário pode alterar
+func (s *taskService) modifiableTasks(ctx context.Context, userID primitive.ObjectID, ids []primitive.ObjectID) ([]*entities.Task, error) {
+	tasks, err := s.todos.GetByIDs(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := tasks[:0]
+	for _, task := range tasks {
+		if policy.CanModifyTask(userID, task) {
+			allowed = append(allowed, task)
+		}
+	}
+
+	return allowed, nil
+}
+
+// taskIDs retorna os IDs das tarefas
+func taskIDs(tasks []*entities.Task) []primitive.ObjectID {
+	ids := make([]primitive.ObjectID, len(tasks))
+	for i, task := range tasks {
+		ids[i] = task.ID
+	}
+	return ids
+}
+
+// requestedLabels busca as etiquetas gerenciadas informadas pelo cliente;
+// IDs inexistentes ou de outro usuário resultam em ErrLabelNotFound
+func (s *taskService) requestedLabels(ctx context.Context, userID primitive.ObjectID, hexIDs []string) ([]*entities.Label, error) {
+	ids := make([]primitive.ObjectID, 0, len(hexIDs))
+	seen := make(map[primitive.ObjectID]bool, len(hexIDs))
+	for _, hex := range hexIDs {
+		id, err := primitive.ObjectIDFromHex(hex)
+		if err != nil {
+			return nil, repositories.ErrLabelNotFound
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	labels, err := s.labels.GetByIDs(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(labels) != len(ids) {
+		return nil, repositories.ErrLabelNotFound
+	}
+
+	return labels, nil
+}
+
+// applyLabels aplica label_ids da alteração às etiquetas da tarefa e, quando
+// as etiquetas mudam, recalcula a associação com as etiquetas gerenciadas
+func (s *taskService) applyLabels(ctx context.Context, userID primitive.ObjectID, task *entities.Task, req *taskreq.UpdateTaskRequest, fields bson.M) error {
+	if req.Tags == nil && req.LabelIDs == nil {
+		return nil
+	}
+
+	labels, err := s.labels.List(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if req.LabelIDs != nil {
+		requested, err := s.requestedLabels(ctx, userID, *req.LabelIDs)
+		if err != nil {
+			return err
+		}
+
+		// Sem tags na alteração, as etiquetas gerenciadas atuais dão lugar às enviadas
+		tags := task.Tags
+		if req.Tags == nil {
+			managed := make(map[string]bool, len(labels))
+			for _, label := range labels {
+				managed[label.Name] = true
+			}
+			tags = make([]string, 0, len(task.Tags))
+			for _, tag := range task.Tags {
+				if !managed[tag] {
+					tags = append(tags, tag)
+				}
+			}
+		}
+
+		if task.Tags, err = withLabels(tags, requested); err != nil {
+			return err
+		}
+		fields["tags"] = task.Tags
+	}
+
+	task.LabelIDs = entities.LabelIDsForTags(labels, task.Tags)
+	fields["label_ids"] = nil
+	if len(task.LabelIDs) > 0 {
+		fields["label_ids"] = task.LabelIDs
+	}
+
+	return nil
+}
+
+// withLabels junta às etiquetas os nomes das etiquetas gerenciadas, sem
+// repetir; passar do limite de etiquetas da tarefa resulta em ErrTaskTagLimit
+func withLabels(tags []string, labels []*entities.Label) ([]string, error) {
+	merged := append([]string(nil), tags...)
+	for _, label := range labels {
+		merged = append(merged, label.Name)
+	}
+
+	distinct := make(map[string]bool, len(merged))
+	for _, tag := range merged {
+		if tag = entities.NormalizeTag(tag); tag != "" {
+			distinct[tag] = true
+		}
+	}
+	if len(distinct) > entities.MaxTaskTags {
+		return nil, ErrTaskTagLimit
+	}
+
+	return entities.NormalizeTags(merged), nil
+}
+
+// checkProject garante que o projeto informado existe e aceita tarefas do usuário
+func (s *taskService) checkProject(ctx context.Context, userID primitive.ObjectID, projectID *primitive.ObjectID) error {
+	if projectID == nil {
+		return nil
+	}
+
+	_, err := authorizedProject(ctx, s.projects, userID, *projectID, policy.CanModifyProject)
+	return err
+}
+
+// taskError converte o not-found do repositório no erro de domínio
+func taskError(err error) error {
+	if errors.Is(err, repositories.ErrTodoNotFound) {
+		return ErrTaskNotFound
+	}
+	return err
+}
+
+// GetOverdue retorna as tarefas atrasadas do usuário
+func (s *taskService) GetOverdue(ctx context.Context, userID primitive.ObjectID) ([]*entities.Task, error) {
+	return s.todos.GetOverdueTodos(ctx, userID)
+}
+
+// ListNearby retorna as tarefas do usuário com local dentro do raio informado
+func (s *taskService) ListNearby(ctx context.Context, userID primitive.ObjectID, query repositories.NearbyQuery) ([]*repositories.NearbyTask, error) {
+	return s.todos.ListNearby(ctx, userID, query)
+}
+
+// Suggest retorna as sugestões de títulos e etiquetas para o texto digitado
+func (s *taskService) Suggest(ctx context.Context, userID primitive.ObjectID, query string, limit int64) (*repositories.TaskSuggestions, error) {
+	return s.todos.Suggest(ctx, userID, query, limit)
+}
+
+// Occurrences calcula as próximas count ocorrências da tarefa recorrente a
+// partir do vencimento, no fuso do usuário, sem criar tarefas. Com
+// calendar.skip_weekends nas preferências, as que caem em fim de semana ou
+// feriado vão para o próximo dia útil.
+func (s *taskService) Occurrences(ctx context.Context, userID, id primitive.ObjectID, count int) ([]time.Time, error) {
+	task, err := s.authorizedTask(ctx, userID, id, policy.CanViewTask)
+	if err != nil {
+		return nil, err
+	}
+	if task.Recurrence == "" {
+		return nil, ErrTaskNotRecurring
+	}
+	if task.DueDate == nil {
+		return nil, ErrRecurrenceWithoutDueDate
+	}
+
+	rule, err := recurrence.Parse(task.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var adjust func(time.Time) time.Time
+	if prefs := user.Preferences.Calendar; prefs.SkipWeekends {
+		cal := calendar.Default()
+		adjust = func(t time.Time) time.Time { return cal.Roll(t, prefs.HolidayLocale) }
+	}
+
+	return rule.NextAdjusted(*task.DueDate, s.clock.Now(), count, user.Preferences.Location(), adjust), nil
+}
+
+// Export retorna todas as tarefas do usuário, incluindo o histórico se solicitado
+func (s *taskService) Export(ctx context.Context, userID primitive.ObjectID, includeArchived bool) (*TaskExport, error) {
+	tasks, err := s.todos.GetAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := s.projects.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &TaskExport{Projects: projects, Tasks: tasks}
+
+	if includeArchived {
+		archived, err := s.archive.GetByUserID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		export.Archived = archived
+	}
+
+	return export, nil
+}
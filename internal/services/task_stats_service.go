@@ -0,0 +1,166 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/clock"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// taskStatsTTL é o tempo máximo em que as estatísticas ficam em cache. Limita
+	// a defasagem do que não gera evento (tarefas que passam a atrasar, arquivamento).
+	taskStatsTTL = 30 * time.Second
+	// taskStatsSweepSize é o tamanho do cache a partir do qual entradas vencidas são removidas
+	taskStatsSweepSize = 10000
+)
+
+// TaskStatsService interface define as estatísticas de tarefas em cache
+type TaskStatsService interface {
+	Subscribe(bus events.Bus)
+	Get(ctx context.Context, userID primitive.ObjectID, fresh bool) (*repositories.TaskStats, error)
+	CompletedSeries(ctx context.Context, userID primitive.ObjectID, granularity SeriesGranularity, days int, loc *time.Location) (*CompletedSeries, error)
+}
+
+// SeriesGranularity é o tamanho de cada intervalo da série temporal
+type SeriesGranularity string
+
+const (
+	// GranularityDay agrupa por dia
+	GranularityDay SeriesGranularity = "day"
+	// GranularityWeek agrupa por semana (segunda a domingo)
+	GranularityWeek SeriesGranularity = "week"
+)
+
+// CompletedSeries é a série de tarefas concluídas por intervalo, com todos os
+// intervalos do período (inclusive os sem conclusões)
+type CompletedSeries struct {
+	Granularity SeriesGranularity `json:"granularity"`
+	Timezone    string            `json:"timezone"`
+	From        string            `json:"from"`
+	To          string            `json:"to"`
+	Points      []SeriesPoint     `json:"points"`
+}
+
+// SeriesPoint é um intervalo da série; Date é o primeiro dia (AAAA-MM-DD) no fuso do usuário
+type SeriesPoint struct {
+	Date  string `json:"date"`
+	Count int64  `json:"count"`
+}
+
+// taskStatsEntry é uma entrada do cache
+type taskStatsEntry struct {
+	stats     *repositories.TaskStats
+	expiresAt time.Time
+}
+
+// taskStatsService implementa TaskStatsService com cache em memória por usuário
+type taskStatsService struct {
+	todos   repositories.TodoRepository
+	clock   clock.Clock
+	mu      sync.Mutex
+	entries map[primitive.ObjectID]taskStatsEntry
+}
+
+// NewTaskStatsService cria uma nova instância do serviço
+func NewTaskStatsService(todos repositories.TodoRepository) TaskStatsService {
+	return NewTaskStatsServiceWithClock(todos, clock.System())
+}
+
+// NewTaskStatsServiceWithClock cria o serviço com o relógio informado, usado na
+// validade do cache
+func NewTaskStatsServiceWithClock(todos repositories.TodoRepository, clk clock.Clock) TaskStatsService {
+	return &taskStatsService{todos: todos, clock: clk, entries: make(map[primitive.ObjectID]taskStatsEntry)}
+}
+
+// Subscribe invalida o cache do usuário a cada alteração de tarefa, inclusive
+// as feitas em outras instâncias
+func (s *taskStatsService) Subscribe(bus events.Bus) {
+	for _, eventType := range taskChangeEvents {
+		bus.Subscribe(eventType, s.invalidate, events.IncludeRemote())
+	}
+}
+
+// Get retorna as estatísticas do usuário, do cache quando ainda válidas. fresh
+// ignora o cache e recalcula.
+func (s *taskStatsService) Get(ctx context.Context, userID primitive.ObjectID, fresh bool) (*repositories.TaskStats, error) {
+	now := s.clock.Now()
+
+	if !fresh {
+		s.mu.Lock()
+		entry, ok := s.entries[userID]
+		s.mu.Unlock()
+		if ok && now.Before(entry.expiresAt) {
+			return entry.stats, nil
+		}
+	}
+
+	stats, err := s.todos.GetStatsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.entries) >= taskStatsSweepSize {
+		for id, entry := range s.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(s.entries, id)
+			}
+		}
+	}
+	s.entries[userID] = taskStatsEntry{stats: stats, expiresAt: now.Add(taskStatsTTL)}
+
+	return stats, nil
+}
+
+// CompletedSeries retorna as tarefas concluídas nos últimos days dias, por
+// dia ou semana no fuso loc. A série cobre do intervalo que contém o primeiro
+// dia do período até o intervalo atual.
+func (s *taskStatsService) CompletedSeries(ctx context.Context, userID primitive.ObjectID, granularity SeriesGranularity, days int, loc *time.Location) (*CompletedSeries, error) {
+	now := s.clock.Now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+
+	step := 1
+	start := today.AddDate(0, 0, 1-days)
+	if granularity == GranularityWeek {
+		step = 7
+		// Semanas começam na segunda, como no $dateTrunc do repositório
+		start = start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7))
+	}
+
+	points, err := s.todos.CompletedSeries(ctx, userID, start, string(granularity), loc.String())
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(points))
+	for _, point := range points {
+		counts[point.Date.In(loc).Format(time.DateOnly)] = point.Count
+	}
+
+	series := &CompletedSeries{
+		Granularity: granularity,
+		Timezone:    loc.String(),
+		From:        start.Format(time.DateOnly),
+		To:          today.Format(time.DateOnly),
+		Points:      []SeriesPoint{},
+	}
+	for bucket := start; !bucket.After(today); bucket = bucket.AddDate(0, 0, step) {
+		date := bucket.Format(time.DateOnly)
+		series.Points = append(series.Points, SeriesPoint{Date: date, Count: counts[date]})
+	}
+
+	return series, nil
+}
+
+// invalidate remove as estatísticas do usuário do evento
+func (s *taskStatsService) invalidate(_ context.Context, event events.Event) {
+	s.mu.Lock()
+	delete(s.entries, event.UserID)
+	s.mu.Unlock()
+}
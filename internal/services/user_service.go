@@ -0,0 +1,233 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/devgugga/todo-it/internal/auth"
+	userreq "github.com/devgugga/todo-it/internal/dtos/requests/user"
+	userres "github.com/devgugga/todo-it/internal/dtos/responses/user"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/logging"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// UserService interface define as regras de negócio de usuários
+type UserService interface {
+	Register(ctx context.Context, req *userreq.CreateUserRequest) (*entities.User, error)
+	Login(ctx context.Context, req *userreq.LoginRequest) (*userres.LoginResponse, error)
+	GetByID(ctx context.Context, id primitive.ObjectID) (*entities.User, error)
+	GetProfile(ctx context.Context, id primitive.ObjectID) (*userres.UserProfileResponse, error)
+	Update(ctx context.Context, id primitive.ObjectID, req *userreq.UpdateUserRequest) (*entities.User, error)
+	ChangePassword(ctx context.Context, id primitive.ObjectID, req *userreq.ChangePasswordRequest) error
+	Delete(ctx context.Context, id primitive.ObjectID) error
+	Anonymize(ctx context.Context, id primitive.ObjectID) error
+	UpdatePreferences(ctx context.Context, id primitive.ObjectID, req *userreq.UpdatePreferencesRequest) (*entities.UserPreferences, error)
+}
+
+// userService implementa UserService
+type userService struct {
+	users       repositories.UserRepository
+	todos       repositories.TodoRepository
+	closure     repositories.AccountClosureRepository
+	attachments repositories.AttachmentRepository
+	tokens      *auth.TokenManager
+	bus         events.Bus
+}
+
+// NewUserService cria uma nova instância do serviço
+func NewUserService(users repositories.UserRepository, todos repositories.TodoRepository, closure repositories.AccountClosureRepository, attachments repositories.AttachmentRepository, tokens *auth.TokenManager, bus events.Bus) UserService {
+	return &userService{
+		users:       users,
+		todos:       todos,
+		closure:     closure,
+		attachments: attachments,
+		tokens:      tokens,
+		bus:         bus,
+	}
+}
+
+// Register cadastra um novo usuário
+func (s *userService) Register(ctx context.Context, req *userreq.CreateUserRequest) (*entities.User, error) {
+	exists, err := s.users.Exists(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrEmailAlreadyInUse
+	}
+
+	user, err := req.ToEntity()
+	if err != nil {
+		return nil, fmt.Errorf("erro ao preparar usuário: %w", err)
+	}
+
+	if err := s.users.Create(ctx, user); err != nil {
+		return nil, err
+	}
+
+	publish(ctx, s.bus, events.New(events.UserRegistered, user.ID, map[string]interface{}{
+		"name":  user.Name,
+		"email": user.Email,
+	}))
+
+	return user, nil
+}
+
+// Login autentica o usuário e gera o token de acesso
+func (s *userService) Login(ctx context.Context, req *userreq.LoginRequest) (*userres.LoginResponse, error) {
+	user, err := s.users.GetByEmail(ctx, req.Email)
+	if err != nil || !user.IsActive {
+		return nil, ErrInvalidCredentials
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	// Só depois da senha conferir, para não revelar quais contas estão suspensas
+	if user.IsSuspended() {
+		return nil, ErrAccountSuspended
+	}
+
+	token, expiresAt, err := s.tokens.Generate(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userres.LoginResponse{
+		User:      *userres.NewUserResponse(user),
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// GetByID busca o usuário por ID
+func (s *userService) GetByID(ctx context.Context, id primitive.ObjectID) (*entities.User, error) {
+	return s.users.GetByID(ctx, id)
+}
+
+// GetProfile retorna o perfil do usuário com o resumo das tarefas
+func (s *userService) GetProfile(ctx context.Context, id primitive.ObjectID) (*userres.UserProfileResponse, error) {
+	user, err := s.users.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.todos.GetStatsByUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return userres.NewUserProfileResponse(user, stats.Total, stats.Completed, stats.Pending), nil
+}
+
+// Update atualiza os dados do usuário
+func (s *userService) Update(ctx context.Context, id primitive.ObjectID, req *userreq.UpdateUserRequest) (*entities.User, error) {
+	user, err := s.users.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	req.ApplyToEntity(user)
+
+	if err := s.users.Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// ChangePassword troca a senha do usuário após validar a senha atual
+func (s *userService) ChangePassword(ctx context.Context, id primitive.ObjectID, req *userreq.ChangePasswordRequest) error {
+	user, err := s.users.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := req.ValidateCurrentPassword(user.Password); err != nil {
+		return ErrInvalidPassword
+	}
+
+	hashedPassword, err := req.GetHashedNewPassword()
+	if err != nil {
+		return fmt.Errorf("erro ao gerar hash da senha: %w", err)
+	}
+
+	return s.users.UpdatePassword(ctx, id, hashedPassword)
+}
+
+// Delete desativa a conta do usuário
+func (s *userService) Delete(ctx context.Context, id primitive.ObjectID) error {
+	return s.users.Delete(ctx, id)
+}
+
+// Anonymize encerra a conta removendo os dados pessoais. As tarefas continuam
+// nas estatísticas agregadas, sem textos, etiquetas nem anexos.
+func (s *userService) Anonymize(ctx context.Context, id primitive.ObjectID) error {
+	user, err := s.users.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	placeholder, err := anonymizedEmail(user.Email)
+	if err != nil {
+		return err
+	}
+
+	attachmentIDs, err := s.closure.Anonymize(ctx, id, placeholder)
+	if err != nil {
+		return err
+	}
+
+	// A conta já foi anonimizada; falha ao apagar um anexo só deixa o arquivo órfão
+	for _, attachmentID := range attachmentIDs {
+		if err := s.attachments.Delete(ctx, attachmentID); err != nil {
+			logging.FromContext(ctx).Warn("erro ao remover anexo da conta anonimizada",
+				"attachment_id", attachmentID.Hex(), "error", err)
+		}
+	}
+
+	return nil
+}
+
+// anonymizedEmail gera o email que substitui o original: hash com sal aleatório
+// descartado, único (mantém o índice de email) e sem como recuperar o endereço
+func anonymizedEmail(email string) (string, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("erro ao gerar email anonimizado: %w", err)
+	}
+
+	sum := sha256.Sum256(append(salt, email...))
+	return "anon-" + hex.EncodeToString(sum[:12]) + "@anonymized.invalid", nil
+}
+
+// UpdatePreferences atualiza as preferências do usuário
+func (s *userService) UpdatePreferences(ctx context.Context, id primitive.ObjectID, req *userreq.UpdatePreferencesRequest) (*entities.UserPreferences, error) {
+	user, err := s.users.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences := user.Preferences
+	req.ApplyToEntity(&preferences)
+
+	for _, channel := range preferences.Notifications.Channels {
+		if channel == entities.ChannelSMS && !user.Phone.IsVerified() {
+			return nil, ErrPhoneNotVerified
+		}
+	}
+
+	if err := s.users.UpdatePreferences(ctx, id, preferences); err != nil {
+		return nil, err
+	}
+
+	return &preferences, nil
+}
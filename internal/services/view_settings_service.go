@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	viewreq "github.com/devgugga/todo-it/internal/dtos/requests/view"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/policy"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ViewSettingsService interface define as configurações de visualização
+// (lista ou quadro, colunas, grupos recolhidos e ordenação) do usuário, a
+// padrão e as de cada projeto
+type ViewSettingsService interface {
+	Get(ctx context.Context, userID primitive.ObjectID, projectID *primitive.ObjectID) (*entities.ViewSettings, error)
+	Save(ctx context.Context, userID primitive.ObjectID, projectID *primitive.ObjectID, req *viewreq.UpdateViewSettingsRequest) (*entities.ViewSettings, error)
+}
+
+// viewSettingsService implementa ViewSettingsService
+type viewSettingsService struct {
+	settings repositories.ViewSettingsRepository
+	projects repositories.ProjectRepository
+}
+
+// NewViewSettingsService cria uma nova instância do serviço
+func NewViewSettingsService(settings repositories.ViewSettingsRepository, projects repositories.ProjectRepository) ViewSettingsService {
+	return &viewSettingsService{settings: settings, projects: projects}
+}
+
+// Get retorna a configuração do projeto (nil = padrão do usuário). Sem uma
+// salva, o projeto herda a padrão do usuário e esta, a do sistema; a herdada
+// volta sem ID.
+func (s *viewSettingsService) Get(ctx context.Context, userID primitive.ObjectID, projectID *primitive.ObjectID) (*entities.ViewSettings, error) {
+	if err := s.authorize(ctx, userID, projectID); err != nil {
+		return nil, err
+	}
+
+	settings, err := s.settings.Get(ctx, userID, projectID)
+	if err == nil || !errors.Is(err, repositories.ErrViewSettingsNotFound) {
+		return settings, err
+	}
+
+	if projectID != nil {
+		fallback, err := s.settings.Get(ctx, userID, nil)
+		if err == nil {
+			fallback.ID = primitive.NilObjectID
+			fallback.ProjectID = projectID
+			return fallback, nil
+		}
+		if !errors.Is(err, repositories.ErrViewSettingsNotFound) {
+			return nil, err
+		}
+	}
+
+	return entities.DefaultViewSettings(userID, projectID), nil
+}
+
+// Save grava a configuração do projeto (nil = padrão do usuário)
+func (s *viewSettingsService) Save(ctx context.Context, userID primitive.ObjectID, projectID *primitive.ObjectID, req *viewreq.UpdateViewSettingsRequest) (*entities.ViewSettings, error) {
+	if err := s.authorize(ctx, userID, projectID); err != nil {
+		return nil, err
+	}
+
+	settings := req.ToEntity(userID, projectID)
+	if err := s.settings.Save(ctx, settings); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// authorize verifica se o usuário vê o projeto (dono ou membro); cada membro
+// tem a sua própria configuração
+func (s *viewSettingsService) authorize(ctx context.Context, userID primitive.ObjectID, projectID *primitive.ObjectID) error {
+	if projectID == nil {
+		return nil
+	}
+
+	_, err := authorizedProject(ctx, s.projects, userID, *projectID, policy.CanViewProject)
+	return err
+}
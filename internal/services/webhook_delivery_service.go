@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// WebhookSender envia e registra uma entrega de webhook (notifications.WebhookChannel)
+type WebhookSender interface {
+	Deliver(ctx context.Context, userID primitive.ObjectID, target, eventID string, payload []byte, redeliveryOf *primitive.ObjectID) *entities.WebhookDelivery
+}
+
+// WebhookDeliveryService interface define a consulta e a reentrega das
+// entregas do webhook de notificações
+type WebhookDeliveryService interface {
+	List(ctx context.Context, userID primitive.ObjectID, page, limit int64) ([]*entities.WebhookDelivery, int64, error)
+	Get(ctx context.Context, userID, id primitive.ObjectID) (*entities.WebhookDelivery, error)
+	Redeliver(ctx context.Context, userID, id primitive.ObjectID) (*entities.WebhookDelivery, error)
+}
+
+// webhookDeliveryService implementa WebhookDeliveryService
+type webhookDeliveryService struct {
+	deliveries repositories.WebhookDeliveryRepository
+	users      repositories.UserRepository
+	sender     WebhookSender
+}
+
+// NewWebhookDeliveryService cria uma nova instância do serviço
+func NewWebhookDeliveryService(deliveries repositories.WebhookDeliveryRepository, users repositories.UserRepository, sender WebhookSender) WebhookDeliveryService {
+	return &webhookDeliveryService{
+		deliveries: deliveries,
+		users:      users,
+		sender:     sender,
+	}
+}
+
+// List lista as entregas do usuário, das mais recentes para as mais antigas
+func (s *webhookDeliveryService) List(ctx context.Context, userID primitive.ObjectID, page, limit int64) ([]*entities.WebhookDelivery, int64, error) {
+	return s.deliveries.ListByUser(ctx, userID, page, limit)
+}
+
+// Get retorna uma entrega do usuário, com o corpo enviado
+func (s *webhookDeliveryService) Get(ctx context.Context, userID, id primitive.ObjectID) (*entities.WebhookDelivery, error) {
+	return s.deliveries.GetByID(ctx, userID, id)
+}
+
+// Redeliver reenvia o corpo de uma entrega, com o mesmo event_id, para a URL
+// de webhook atual do usuário. A nova tentativa é registrada e retornada,
+// mesmo quando o destino a recusa.
+func (s *webhookDeliveryService) Redeliver(ctx context.Context, userID, id primitive.ObjectID) (*entities.WebhookDelivery, error) {
+	original, err := s.deliveries.GetByID(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	target := user.Preferences.Notifications.WebhookURL
+	if target == "" {
+		return nil, ErrWebhookNotConfigured
+	}
+
+	// Reentregas de reentregas apontam para a entrega original
+	root := original.ID
+	if original.RedeliveryOf != nil {
+		root = *original.RedeliveryOf
+	}
+
+	return s.sender.Deliver(ctx, userID, target, original.EventID, []byte(original.Payload), &root), nil
+}
@@ -0,0 +1,62 @@
+package testsupport
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/entities"
+	"github.com/devgugga/todo-it/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SeedUser cria um usuário ativo com email único
+func SeedUser(t *testing.T, db database.Client) *entities.User {
+	t.Helper()
+
+	id := primitive.NewObjectID()
+	user := &entities.User{
+		Name:     "Usuário de teste",
+		Email:    fmt.Sprintf("user-%s@example.com", id.Hex()),
+		Password: "hash-de-teste",
+	}
+
+	if err := repositories.NewUserRepository(db).Create(context.Background(), user); err != nil {
+		t.Fatalf("erro ao criar usuário de teste: %v", err)
+	}
+
+	return user
+}
+
+// SeedTask cria uma tarefa do usuário. Campos não informados recebem os
+// padrões de PrepareForCreate (status pending, prioridade medium); IsArchived
+// é mantido, para testar filtros de arquivadas.
+func SeedTask(t *testing.T, db database.Client, userID primitive.ObjectID, task entities.Task) *entities.Task {
+	t.Helper()
+
+	if task.Title == "" {
+		task.Title = "Tarefa de teste"
+	}
+	archived := task.IsArchived
+	task.PrepareForCreate(userID)
+	task.IsArchived = archived
+
+	if err := repositories.NewTodoRepository(db).Create(context.Background(), &task); err != nil {
+		t.Fatalf("erro ao criar tarefa de teste: %v", err)
+	}
+
+	return &task
+}
+
+// SeedTasks cria n tarefas do usuário, com títulos numerados a partir de 1
+func SeedTasks(t *testing.T, db database.Client, userID primitive.ObjectID, n int) []*entities.Task {
+	t.Helper()
+
+	tasks := make([]*entities.Task, 0, n)
+	for i := 1; i <= n; i++ {
+		tasks = append(tasks, SeedTask(t, db, userID, entities.Task{Title: fmt.Sprintf("Tarefa %02d", i)}))
+	}
+
+	return tasks
+}
@@ -0,0 +1,119 @@
+package testsupport
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/auth"
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/devgugga/todo-it/internal/events"
+	"github.com/devgugga/todo-it/internal/handlers"
+	"github.com/devgugga/todo-it/internal/middleware"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// testJWTSecret assina os tokens emitidos por App.Token
+const testJWTSecret = "testsupport-jwt-secret-com-32-caracteres"
+
+// App é a API com as rotas reais sobre o banco de teste, sem servidor de rede
+type App struct {
+	Fiber  *fiber.App
+	Bus    *events.LocalBus
+	tokens *auth.TokenManager
+}
+
+// NewApp monta as rotas de tarefas e projetos em /api/v1, com autenticação
+// e o handler de erros da aplicação
+func NewApp(t *testing.T, db database.Client) *App {
+	t.Helper()
+
+	app := &App{
+		Fiber:  fiber.New(fiber.Config{ErrorHandler: handlers.ErrorHandler}),
+		Bus:    events.NewLocalBus(),
+		tokens: auth.NewTokenManager(testJWTSecret, time.Hour),
+	}
+
+	api := app.Fiber.Group("/api/v1")
+	requireAuth := middleware.RequireAuth(app.tokens, nil)
+	handlers.SetupTodoRoutes(api.Group("/todos", requireAuth), db, app.Bus, auth.NewURLSigner(testJWTSecret, time.Minute))
+	handlers.SetupProjectRoutes(api.Group("/projects", requireAuth), db, app.Bus)
+
+	return app
+}
+
+// Token emite um token de acesso para o usuário
+func (a *App) Token(t *testing.T, userID primitive.ObjectID) string {
+	t.Helper()
+
+	token, _, err := a.tokens.Generate(userID)
+	if err != nil {
+		t.Fatalf("erro ao gerar token: %v", err)
+	}
+	return token
+}
+
+// Response é a resposta de uma requisição feita por App.Do
+type Response struct {
+	Status int
+	Body   []byte
+}
+
+// Decode converte o corpo JSON da resposta em dst
+func (r *Response) Decode(t *testing.T, dst interface{}) {
+	t.Helper()
+
+	if err := json.Unmarshal(r.Body, dst); err != nil {
+		t.Fatalf("resposta não é JSON válido (%v): %s", err, r.Body)
+	}
+}
+
+// Do executa a requisição autenticada com o token informado (vazio para
+// nenhum). body, quando não nil, é enviado como JSON.
+func (a *App) Do(t *testing.T, method, path, token string, body interface{}) *Response {
+	t.Helper()
+
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("erro ao serializar corpo: %v", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	if body != nil {
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	}
+	if token != "" {
+		req.Header.Set(fiber.HeaderAuthorization, "Bearer "+token)
+	}
+
+	resp, err := a.Fiber.Test(req, -1)
+	if err != nil {
+		t.Fatalf("erro na requisição %s %s: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("erro ao ler resposta: %v", err)
+	}
+
+	return &Response{Status: resp.StatusCode, Body: data}
+}
+
+// Expect falha o teste quando o status da resposta difere do esperado
+func (r *Response) Expect(t *testing.T, status int) *Response {
+	t.Helper()
+
+	if r.Status != status {
+		t.Fatalf("status %d, esperado %d: %s", r.Status, status, r.Body)
+	}
+	return r
+}
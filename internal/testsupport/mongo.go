@@ -0,0 +1,79 @@
+// Package testsupport reúne o apoio aos testes de integração: um MongoDB em
+// container (testcontainers), fixtures e uma aplicação Fiber com as rotas
+// reais. Os testes são ignorados (t.Skip) quando não há Docker disponível.
+package testsupport
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/devgugga/todo-it/internal/database"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/mongodb"
+)
+
+// mongoImage é a imagem usada nos testes, na mesma versão major do docker-compose
+const mongoImage = "mongo:7"
+
+// container compartilhado entre os testes do pacote; cada teste usa um banco
+// próprio. O container é removido pelo reaper do testcontainers ao fim do processo.
+var (
+	containerOnce sync.Once
+	containerURI  string
+	containerErr  error
+	databaseSeq   atomic.Int64
+)
+
+// NewDatabase retorna um banco novo e inicializado (collections, validadores e
+// índices, como em InitializeDatabase), removido ao fim do teste
+func NewDatabase(t *testing.T) database.Client {
+	t.Helper()
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+
+	uri, err := mongoURI()
+	if err != nil {
+		t.Fatalf("erro ao iniciar MongoDB: %v", err)
+	}
+
+	config := database.DefaultMongoConfig()
+	config.URI = uri
+	config.DBName = fmt.Sprintf("todo_test_%d_%d", time.Now().UnixNano(), databaseSeq.Add(1))
+
+	db, err := database.InitializeDatabase(config)
+	if err != nil {
+		t.Fatalf("erro ao inicializar banco de teste: %v", err)
+	}
+
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := db.Collections().Tasks.Database().Drop(ctx); err != nil {
+			t.Logf("erro ao remover banco de teste: %v", err)
+		}
+		db.Close()
+	})
+
+	return db
+}
+
+// mongoURI inicia o container na primeira chamada e retorna sua URI
+func mongoURI() (string, error) {
+	containerOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+
+		container, err := mongodb.Run(ctx, mongoImage)
+		if err != nil {
+			containerErr = fmt.Errorf("erro ao criar container: %w", err)
+			return
+		}
+
+		containerURI, containerErr = container.ConnectionString(ctx)
+	})
+
+	return containerURI, containerErr
+}
@@ -0,0 +1,171 @@
+package web
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// indexFile é o documento servido na raiz, nos diretórios e no fallback do SPA
+const indexFile = "index.html"
+
+// Cache-Control dos arquivos: com hash no nome nunca mudam; os demais são
+// revalidados a cada uso pelo ETag (conteúdo inalterado responde 304)
+const (
+	cacheImmutable  = "public, max-age=31536000, immutable"
+	cacheRevalidate = "no-cache"
+)
+
+// etagHashHexChars é quantos dígitos do SHA-256 do conteúdo compõem o ETag
+const etagHashHexChars = 32
+
+// reservedPrefixes nunca recebem o fallback do SPA: rotas inexistentes da API
+// continuam respondendo 404
+var reservedPrefixes = []string{"/api/", "/.well-known/"}
+
+// FilesConfig configura o serviço de arquivos estáticos
+type FilesConfig struct {
+	// SPAFallback responde index.html aos caminhos sem arquivo e sem extensão,
+	// para o roteamento em modo history do frontend
+	SPAFallback bool
+	// ImmutablePaths são os prefixos dos arquivos com hash do conteúdo no nome
+	// (ex.: /assets/ do Vite), servidos com cache de um ano
+	ImmutablePaths []string
+}
+
+// fileETag é o ETag calculado para uma versão do arquivo
+type fileETag struct {
+	modTime time.Time
+	size    int64
+	etag    string
+}
+
+// Files serve os arquivos de root com ETag pelo hash do conteúdo. Deve ser
+// registrado depois das rotas da API: caminhos sem arquivo (e sem fallback)
+// seguem para o próximo handler.
+func Files(root fs.FS, cfg FilesConfig) fiber.Handler {
+	var etags sync.Map
+
+	return func(c *fiber.Ctx) error {
+		if c.Method() != fiber.MethodGet && c.Method() != fiber.MethodHead {
+			return c.Next()
+		}
+
+		name, info, err := resolveFile(root, c.Path())
+		if errors.Is(err, fs.ErrNotExist) && cfg.SPAFallback && acceptsFallback(c.Path()) {
+			name, info, err = resolveFile(root, "/"+indexFile)
+		}
+		if errors.Is(err, fs.ErrNotExist) {
+			return c.Next()
+		}
+		if err != nil {
+			return err
+		}
+
+		// Com o ETag já calculado, a revalidação (304) não lê o arquivo
+		var content []byte
+		etag, ok := cachedETag(&etags, name, info)
+		if !ok {
+			if content, err = fs.ReadFile(root, name); err != nil {
+				return err
+			}
+			etag = storeETag(&etags, name, info, content)
+		}
+
+		c.Set(fiber.HeaderETag, etag)
+		c.Set(fiber.HeaderCacheControl, cacheControl(name, cfg.ImmutablePaths))
+		if !info.ModTime().IsZero() {
+			c.Set(fiber.HeaderLastModified, info.ModTime().UTC().Format(http.TimeFormat))
+		}
+
+		if c.Fresh() {
+			return c.SendStatus(fiber.StatusNotModified)
+		}
+
+		if content == nil {
+			if content, err = fs.ReadFile(root, name); err != nil {
+				return err
+			}
+		}
+
+		c.Type(strings.TrimPrefix(path.Ext(name), "."))
+		return c.Send(content)
+	}
+}
+
+// resolveFile converte o caminho da URL no arquivo de root (o index.html nos
+// diretórios). Caminhos fora de root não existem.
+func resolveFile(root fs.FS, urlPath string) (string, fs.FileInfo, error) {
+	name := strings.TrimPrefix(path.Clean("/"+urlPath), "/")
+	if name == "" {
+		name = indexFile
+	}
+
+	info, err := fs.Stat(root, name)
+	if err == nil && info.IsDir() {
+		name = path.Join(name, indexFile)
+		info, err = fs.Stat(root, name)
+	}
+	if err == nil && info.IsDir() {
+		err = fs.ErrNotExist
+	}
+
+	return name, info, err
+}
+
+// acceptsFallback indica se o caminho é uma rota do frontend: sem extensão e
+// fora dos prefixos reservados
+func acceptsFallback(urlPath string) bool {
+	for _, prefix := range reservedPrefixes {
+		if strings.HasPrefix(urlPath, prefix) {
+			return false
+		}
+	}
+	return path.Ext(urlPath) == ""
+}
+
+// cacheControl escolhe o cache do arquivo: imutável sob os prefixos com hash
+func cacheControl(name string, immutablePaths []string) string {
+	if name == indexFile || strings.HasSuffix(name, "/"+indexFile) {
+		return cacheRevalidate
+	}
+
+	urlPath := "/" + name
+	for _, prefix := range immutablePaths {
+		if strings.HasPrefix(urlPath, prefix) {
+			return cacheImmutable
+		}
+	}
+	return cacheRevalidate
+}
+
+// cachedETag retorna o ETag já calculado para a versão atual do arquivo
+// (mesma data de modificação e tamanho)
+func cachedETag(etags *sync.Map, name string, info fs.FileInfo) (string, bool) {
+	cached, ok := etags.Load(name)
+	if !ok {
+		return "", false
+	}
+
+	entry := cached.(fileETag)
+	if !entry.modTime.Equal(info.ModTime()) || entry.size != info.Size() {
+		return "", false
+	}
+	return entry.etag, true
+}
+
+// storeETag calcula o ETag pelo hash do conteúdo e o guarda para a versão do arquivo
+func storeETag(etags *sync.Map, name string, info fs.FileInfo, content []byte) string {
+	sum := sha256.Sum256(content)
+	etag := `"` + hex.EncodeToString(sum[:])[:etagHashHexChars] + `"`
+	etags.Store(name, fileETag{modTime: info.ModTime(), size: info.Size(), etag: etag})
+	return etag
+}
@@ -0,0 +1,190 @@
+// Interface mínima do todo-it: login, lista de tarefas, adição rápida e
+// conclusão, consumindo a API da mesma origem.
+(function () {
+  "use strict";
+
+  var API = "/api/v1";
+  var TOKEN_KEY = "todoit.token";
+  var PAGE_SIZE = 50;
+
+  var state = { status: "pending", page: 1 };
+
+  var $ = function (id) { return document.getElementById(id); };
+
+  // Sessão guardada no navegador até expirar ou o usuário sair
+  function session() {
+    try {
+      var stored = JSON.parse(localStorage.getItem(TOKEN_KEY));
+      if (stored && stored.token && new Date(stored.expires_at) > new Date()) {
+        return stored;
+      }
+    } catch (e) { /* sessão corrompida: trata como ausente */ }
+    localStorage.removeItem(TOKEN_KEY);
+    return null;
+  }
+
+  function api(method, path, body) {
+    var headers = { "Accept": "application/json" };
+    var current = session();
+    if (current) headers["Authorization"] = "Bearer " + current.token;
+    if (body !== undefined) headers["Content-Type"] = "application/json";
+
+    return fetch(API + path, {
+      method: method,
+      headers: headers,
+      body: body === undefined ? undefined : JSON.stringify(body)
+    }).then(function (response) {
+      return response.json().catch(function () { return {}; }).then(function (payload) {
+        if (response.status === 401 && current) {
+          logout();
+        }
+        if (!response.ok) {
+          var error = new Error(payload.message || "Erro " + response.status);
+          if (response.status === 429) {
+            var retry = response.headers.get("Retry-After");
+            error.message = "Muitas requisições" + (retry ? ", tente novamente em " + retry + "s" : "");
+          }
+          throw error;
+        }
+        return payload.data;
+      });
+    });
+  }
+
+  function showMessage(text) {
+    $("message").textContent = text || "";
+    $("message").hidden = !text;
+  }
+
+  function render() {
+    var loggedIn = session() !== null;
+    $("login-view").hidden = loggedIn;
+    $("tasks-view").hidden = !loggedIn;
+    $("logout").hidden = !loggedIn;
+    if (loggedIn) loadTasks(true);
+  }
+
+  function logout() {
+    localStorage.removeItem(TOKEN_KEY);
+    $("tasks").textContent = "";
+    render();
+  }
+
+  function formatDue(value) {
+    var due = new Date(value);
+    return {
+      text: due.toLocaleDateString(undefined, { day: "2-digit", month: "short" }),
+      overdue: due < new Date()
+    };
+  }
+
+  function taskItem(task) {
+    var item = document.createElement("li");
+    item.className = task.status;
+
+    var checkbox = document.createElement("input");
+    checkbox.type = "checkbox";
+    checkbox.checked = task.status === "completed";
+    checkbox.setAttribute("aria-label", "Concluir " + task.title);
+    checkbox.addEventListener("change", function () {
+      var action = checkbox.checked ? "/complete" : "/reopen";
+      checkbox.disabled = true;
+      api("POST", "/todos/" + task.id + action)
+        .then(function () { item.remove(); updateEmpty(); })
+        .catch(function (error) {
+          checkbox.checked = !checkbox.checked;
+          checkbox.disabled = false;
+          showMessage(error.message);
+        });
+    });
+
+    var title = document.createElement("span");
+    title.className = "title";
+    title.textContent = task.title;
+
+    item.appendChild(checkbox);
+    item.appendChild(title);
+
+    if (task.due_date) {
+      var due = formatDue(task.due_date);
+      var dueLabel = document.createElement("span");
+      dueLabel.className = "due" + (due.overdue && task.status !== "completed" ? " overdue" : "");
+      dueLabel.textContent = due.text;
+      item.appendChild(dueLabel);
+    }
+
+    return item;
+  }
+
+  function updateEmpty() {
+    $("empty").hidden = $("tasks").children.length > 0;
+  }
+
+  function loadTasks(reset) {
+    if (reset) {
+      state.page = 1;
+      $("tasks").textContent = "";
+    }
+
+    var query = "?view=compact&limit=" + PAGE_SIZE + "&page=" + state.page +
+      "&status=" + state.status + "&sort=due_date&order=asc";
+
+    api("GET", "/todos" + query).then(function (data) {
+      data.tasks.forEach(function (task) { $("tasks").appendChild(taskItem(task)); });
+      $("more").hidden = !data.has_next;
+      updateEmpty();
+      showMessage("");
+    }).catch(function (error) { showMessage(error.message); });
+  }
+
+  $("login-form").addEventListener("submit", function (event) {
+    event.preventDefault();
+    var form = event.target;
+
+    api("POST", "/auth/login", {
+      email: form.email.value,
+      password: form.password.value
+    }).then(function (data) {
+      localStorage.setItem(TOKEN_KEY, JSON.stringify({ token: data.token, expires_at: data.expires_at }));
+      form.reset();
+      showMessage("");
+      render();
+    }).catch(function (error) { showMessage(error.message); });
+  });
+
+  $("add-form").addEventListener("submit", function (event) {
+    event.preventDefault();
+    var form = event.target;
+    var title = form.title.value.trim();
+    if (!title) return;
+
+    api("POST", "/todos", { title: title }).then(function (task) {
+      form.reset();
+      showMessage("");
+      if (task.status === state.status) {
+        $("tasks").insertBefore(taskItem(task), $("tasks").firstChild);
+        updateEmpty();
+      }
+    }).catch(function (error) { showMessage(error.message); });
+  });
+
+  $("filters").addEventListener("click", function (event) {
+    var status = event.target.getAttribute("data-status");
+    if (!status) return;
+
+    Array.prototype.forEach.call($("filters").children, function (button) {
+      button.classList.toggle("active", button === event.target);
+    });
+    state.status = status;
+    loadTasks(true);
+  });
+
+  $("more").addEventListener("click", function () {
+    state.page++;
+    loadTasks(false);
+  });
+
+  $("logout").addEventListener("click", logout);
+
+  render();
+})();
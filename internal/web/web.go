@@ -0,0 +1,34 @@
+// Package web serve o frontend pelo próprio binário: a interface mínima
+// embutida (login, lista de tarefas, adição rápida e conclusão) ou o build de
+// um frontend próprio (STATIC_DIR), consumindo a API em /api/v1.
+package web
+
+import (
+	"embed"
+	"io/fs"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+//go:embed static
+var static embed.FS
+
+// contentSecurityPolicy restringe a interface aos próprios arquivos e à API da mesma origem
+const contentSecurityPolicy = "default-src 'self'; img-src 'self' data:; frame-ancestors 'none'; base-uri 'none'; form-action 'self'"
+
+// Handler serve a interface embutida. Deve ser registrado depois das rotas
+// da API: caminhos sem arquivo seguem para o próximo handler (404).
+func Handler() fiber.Handler {
+	root, err := fs.Sub(static, "static")
+	if err != nil {
+		panic(err)
+	}
+	files := Files(root, FilesConfig{})
+
+	return func(c *fiber.Ctx) error {
+		c.Set(fiber.HeaderContentSecurityPolicy, contentSecurityPolicy)
+		c.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+		c.Set(fiber.HeaderReferrerPolicy, "no-referrer")
+		return files(c)
+	}
+}